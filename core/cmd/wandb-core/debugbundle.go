@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+// debugBundleCommand is the subcommand that writes a debug bundle.
+const debugBundleCommand = "debug-bundle"
+
+// runDebugBundle implements the debug-bundle subcommand.
+//
+// It returns the process exit code.
+func runDebugBundle(args []string) int {
+	flags := flag.NewFlagSet(debugBundleCommand, flag.ContinueOnError)
+	runDir := flags.String("run-dir", "", "path to the run directory, like wandb/run-20240101_000000-abcd1234")
+	output := flags.String("output", "", "path of the zip file to write")
+	records := flags.Int("records", 0, "number of records to include from the end of the transaction log")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *runDir == "" {
+		fmt.Fprintln(os.Stderr, "debug-bundle: -run-dir is required")
+		flags.Usage()
+		return 2
+	}
+	if *output == "" {
+		*output = fmt.Sprintf(
+			"wandb-debug-bundle-%s.zip",
+			time.Now().Format("20060102_150405"),
+		)
+	}
+
+	params, err := server.DebugBundleParamsFromRunDir(*runDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "debug-bundle: %v\n", err)
+		return 1
+	}
+	params.TransactionLogTail = *records
+
+	file, err := os.Create(*output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "debug-bundle: %v\n", err)
+		return 1
+	}
+	defer file.Close()
+
+	if err := server.WriteDebugBundle(context.Background(), file, params); err != nil {
+		fmt.Fprintf(os.Stderr, "debug-bundle: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Wrote debug bundle to %s\n", *output)
+	return 0
+}
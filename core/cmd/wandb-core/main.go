@@ -23,6 +23,15 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case debugBundleCommand:
+			os.Exit(runDebugBundle(os.Args[2:]))
+		case previewCommand:
+			os.Exit(runPreview(os.Args[2:]))
+		}
+	}
+
 	// Flags to control the server
 	portFilename := flag.String("port-filename", "port_file.txt", "filename for port to communicate with client")
 	pid := flag.Int("pid", 0, "pid of the process to communicate with")
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+// previewCommand is the subcommand that serves a preview of an offline run.
+const previewCommand = "preview"
+
+// runPreview implements the preview subcommand.
+//
+// It returns the process exit code.
+func runPreview(args []string) int {
+	flags := flag.NewFlagSet(previewCommand, flag.ContinueOnError)
+	file := flags.String("file", "", "path to the run's .wandb file")
+	addr := flags.String("addr", "127.0.0.1:8080", "address to serve the preview on")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *file == "" {
+		fmt.Fprintln(os.Stderr, "preview: -file is required")
+		flags.Usage()
+		return 2
+	}
+
+	preview, err := server.LoadRunPreview(
+		context.Background(),
+		*file,
+		observability.NewNoOpLogger(),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "preview: %v\n", err)
+		return 1
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "preview: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Serving preview of %s at http://%s/\n", *file, listener.Addr())
+	err = http.Serve(listener, preview.Handler())
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "preview: %v\n", err)
+		return 1
+	}
+	return 0
+}
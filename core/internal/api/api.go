@@ -2,6 +2,7 @@
 package api
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"net/url"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/internal/netpause"
 )
 
 const (
@@ -32,14 +34,35 @@ type Backend struct {
 	// The URL prefix for all requests to the W&B API.
 	baseURL *url.URL
 
+	// Other URL prefixes of the same backend, such as a read replica.
+	otherURLs []*url.URL
+
 	// The logger to use for HTTP-related logs.
 	//
 	// Note that these are only useful for debugging, and not helpful to a
 	// user. There's no guarantee that all logs are made at the Debug level.
 	logger *slog.Logger
 
-	// API key for backend requests.
-	apiKey string
+	// Credentials for backend requests.
+	auth AuthProvider
+
+	// Holds back requests while the network is paused, or nil.
+	networkGate *netpause.Gate
+
+	// Chooses the proxy for each request, or nil for the default.
+	proxy func(*http.Request) (*url.URL, error)
+
+	// Wraps the transport of every client.
+	middleware []Middleware
+
+	// The transport shared by all clients, or nil.
+	transport *http.Transport
+
+	// Holds back the first requests until the network is up, or nil.
+	warmup *warmup
+
+	// The User-Agent header for requests to the backend.
+	userAgent string
 }
 
 // An HTTP client for interacting with the W&B backend.
@@ -67,17 +90,13 @@ type Client interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
-type RetryableClient interface {
-	Do(*retryablehttp.Request) (*http.Response, error)
-}
-
 // Implementation of the Client interface.
 type clientImpl struct {
 	// A reference to the backend.
 	backend *Backend
 
-	// The underlying retryable HTTP client.
-	retryableHTTP RetryableClient
+	// Sends requests through the client's middleware chain.
+	transport http.RoundTripper
 
 	// Headers to pass in every request.
 	extraHeaders map[string]string
@@ -104,6 +123,11 @@ type Request struct {
 	// These are sent in addition to any headers set automatically by the
 	// client, such as for auth. The client headers take precedence.
 	Headers map[string]string
+
+	// Context cancels the request and its retries.
+	//
+	// If nil, the request is not canceled.
+	Context context.Context
 }
 
 type BackendOptions struct {
@@ -115,7 +139,69 @@ type BackendOptions struct {
 	Logger *slog.Logger
 
 	// W&B API key.
+	//
+	// Ignored if AuthProvider is set.
 	APIKey string
+
+	// Supplies credentials for each request.
+	//
+	// If nil, APIKey is used.
+	AuthProvider AuthProvider
+
+	// Holds back requests while the network is paused.
+	//
+	// If nil, the network is never paused.
+	NetworkGate *netpause.Gate
+
+	// Chooses the proxy for each request.
+	//
+	// If nil, the proxy is chosen using environment variables.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// The transport for all clients of the backend.
+	//
+	// It may be shared with other backends so that their requests reuse
+	// connections, so it is used as is: it must already choose proxies
+	// like Proxy does. If nil, each client has its own transport.
+	Transport *http.Transport
+
+	// Other URLs that serve the same backend, such as a GraphQL read
+	// replica.
+	//
+	// Requests to these URLs are treated like requests to BaseURL: they
+	// get auth and client headers.
+	AdditionalURLs []*url.URL
+
+	// Middleware for the transport of every client, outermost first.
+	//
+	// It is applied outside of any client's own middleware.
+	Middleware []Middleware
+
+	// How long after creating the backend to wait for it to be reachable
+	// before sending the first request.
+	//
+	// Failed connection attempts during this period don't count toward a
+	// client's RetryMax or its timeout. If zero, there is no warmup period.
+	Warmup time.Duration
+
+	// A product token like "my-tool/1.2" to append to the User-Agent, to
+	// identify the integration making requests.
+	//
+	// It must already be validated. If empty, the User-Agent is just
+	// DefaultUserAgent.
+	UserAgentToken string
+}
+
+// DefaultUserAgent is the User-Agent of requests made by the core.
+const DefaultUserAgent = "wandb-core"
+
+// UserAgent returns the User-Agent identifying the core and, if the token
+// is not empty, the integration using it.
+func UserAgent(token string) string {
+	if token == "" {
+		return DefaultUserAgent
+	}
+	return DefaultUserAgent + " " + token
 }
 
 // Creates a [Backend].
@@ -123,11 +209,26 @@ type BackendOptions struct {
 // The `baseURL` is the scheme and hostname for contacting the server, not
 // including a final slash. Example "http://localhost:8080".
 func New(opts BackendOptions) *Backend {
-	return &Backend{
-		baseURL: opts.BaseURL,
-		logger:  opts.Logger,
-		apiKey:  opts.APIKey,
+	auth := opts.AuthProvider
+	if auth == nil {
+		auth = StaticAPIKey(opts.APIKey)
 	}
+
+	backend := &Backend{
+		baseURL:     opts.BaseURL,
+		otherURLs:   opts.AdditionalURLs,
+		logger:      opts.Logger,
+		auth:        auth,
+		networkGate: opts.NetworkGate,
+		proxy:       opts.Proxy,
+		middleware:  opts.Middleware,
+		transport:   opts.Transport,
+		userAgent:   UserAgent(opts.UserAgentToken),
+	}
+	if opts.Warmup > 0 {
+		backend.warmup = newWarmup(time.Now().Add(opts.Warmup), opts.Logger)
+	}
+	return backend
 }
 
 type ClientOptions struct {
@@ -143,7 +244,8 @@ type ClientOptions struct {
 	// Function that determines whether to retry based on the response.
 	//
 	// If nil, then retries are made on connection errors and server errors
-	// (HTTP status code >=500).
+	// (HTTP status code >=500). Requests whose context has a RetryPredicate
+	// use it instead.
 	RetryPolicy retryablehttp.CheckRetry
 
 	// Timeout for HTTP requests.
@@ -164,6 +266,12 @@ type ClientOptions struct {
 	// on the request and response. Need to make sure that the response body is
 	// available to read by later stages.
 	NetworkPeeker Peeker
+
+	// Middleware for the client's transport, outermost first.
+	//
+	// The chain is, from the outside in: auth, retries, the network peeker,
+	// rate limiting, the backend's middleware, then this middleware.
+	Middleware []Middleware
 }
 
 // Creates a new [Client] for making requests to the [Backend].
@@ -180,6 +288,7 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 	if retryPolicy == nil {
 		retryPolicy = retryablehttp.DefaultRetryPolicy
 	}
+	retryPolicy = withRetryPredicates(retryPolicy)
 	if backend.logger != nil {
 		retryPolicy = withRetryLogging(retryPolicy, backend.logger)
 	}
@@ -193,15 +302,32 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 		)
 	}
 
-	retryableHTTP.HTTPClient.Transport =
-		NewPeekingTransport(
-			opts.NetworkPeeker,
-			NewRateLimitedTransport(retryableHTTP.HTTPClient.Transport),
-		)
+	// The gate is checked before the warmup, which waits for the network.
+	backend.warmup.Install(retryableHTTP)
+	backend.networkGate.Install(retryableHTTP)
 
-	return &clientImpl{
-		backend:       backend,
-		retryableHTTP: retryableHTTP,
-		extraHeaders:  opts.ExtraHeaders,
+	if backend.transport != nil {
+		retryableHTTP.HTTPClient.Transport = backend.transport
+	} else if backend.proxy != nil {
+		if transport, ok := retryableHTTP.HTTPClient.Transport.(*http.Transport); ok {
+			transport.Proxy = backend.proxy
+		}
 	}
+
+	client := &clientImpl{
+		backend:      backend,
+		extraHeaders: opts.ExtraHeaders,
+	}
+
+	middleware := []Middleware{
+		client.authMiddleware,
+		retryingMiddleware(retryableHTTP),
+		peekingMiddleware(opts.NetworkPeeker),
+		rateLimitingMiddleware,
+	}
+	middleware = append(middleware, backend.middleware...)
+	middleware = append(middleware, opts.Middleware...)
+	client.transport = Chain(retryableHTTP.HTTPClient.Transport, middleware...)
+
+	return client
 }
@@ -2,6 +2,8 @@ package api_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +11,7 @@ import (
 	"slices"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -72,6 +75,25 @@ func TestDo_ToWandb_SetsAuth(t *testing.T) {
 	assert.NotEmpty(t, server.Requests()[0].Header.Get("Authorization"))
 }
 
+func TestSend_UserAgentToken(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+
+	backend := api.New(api.BackendOptions{
+		BaseURL:        baseURL,
+		UserAgentToken: "my-tool/1.2",
+	})
+	_, err = backend.NewClient(api.ClientOptions{}).
+		Send(&api.Request{Method: http.MethodGet, Path: "xyz"})
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		"wandb-core my-tool/1.2",
+		server.Requests()[0].Header.Get("User-Agent"))
+}
+
 func TestDo_NotToWandb_NoAuth(t *testing.T) {
 	server := NewRecordingServer()
 
@@ -93,6 +115,144 @@ func TestDo_NotToWandb_NoAuth(t *testing.T) {
 	assert.Empty(t, server.Requests()[0].Header.Get("Authorization"))
 }
 
+func TestDo_ToAdditionalURL_SetsAuth(t *testing.T) {
+	server := NewRecordingServer()
+
+	{
+		defer server.Close()
+		baseURL, err := url.Parse("https://api.example.com")
+		require.NoError(t, err)
+		replicaURL, err := url.Parse(server.URL + "/replica")
+		require.NoError(t, err)
+		req, _ := http.NewRequest(
+			http.MethodGet,
+			server.URL+"/replica/graphql",
+			bytes.NewBufferString("test body"),
+		)
+
+		_, err = api.New(api.BackendOptions{
+			BaseURL:        baseURL,
+			AdditionalURLs: []*url.URL{replicaURL},
+		}).NewClient(api.ClientOptions{}).Do(req)
+
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, server.Requests(), 1)
+	assert.NotEmpty(t, server.Requests()[0].Header.Get("Authorization"))
+}
+
+func TestDo_IdempotencyKey_ReusedAcrossRetries(t *testing.T) {
+	server := NewRecordingServer()
+	server.FailFirst(1)
+
+	{
+		defer server.Close()
+		req, _ := http.NewRequestWithContext(
+			api.WithIdempotencyKey(context.Background(), "test-key"),
+			http.MethodPost,
+			server.URL+"/wandb/graphql",
+			bytes.NewBufferString("test body"),
+		)
+
+		_, err := newClient(t, server.URL+"/wandb", api.ClientOptions{
+			RetryMax:     1,
+			RetryWaitMin: time.Millisecond,
+			RetryWaitMax: time.Millisecond,
+		}).Do(req)
+
+		assert.NoError(t, err)
+	}
+
+	requests := server.Requests()
+	assert.Len(t, requests, 2)
+	for _, req := range requests {
+		assert.Equal(t, "test-key", req.Header.Get(api.IdempotencyKeyHeader))
+	}
+}
+
+func TestDo_NoIdempotencyKey(t *testing.T) {
+	server := NewRecordingServer()
+
+	{
+		defer server.Close()
+		req, _ := http.NewRequest(
+			http.MethodPost,
+			server.URL+"/wandb/graphql",
+			bytes.NewBufferString("test body"),
+		)
+
+		_, err := newClient(t, server.URL+"/wandb", api.ClientOptions{}).
+			Do(req)
+
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, server.Requests(), 1)
+	assert.Empty(t, server.Requests()[0].Header.Get(api.IdempotencyKeyHeader))
+}
+
+func TestDo_RetryPredicate_OverridesPolicy(t *testing.T) {
+	server := NewRecordingServer()
+	server.FailFirst(1)
+
+	{
+		defer server.Close()
+		req, _ := http.NewRequestWithContext(
+			api.WithRetryPredicate(context.Background(), api.NeverRetry),
+			http.MethodPost,
+			server.URL+"/wandb/graphql",
+			bytes.NewBufferString("test body"),
+		)
+
+		resp, err := newClient(t, server.URL+"/wandb", api.ClientOptions{
+			RetryMax:     1,
+			RetryWaitMin: time.Millisecond,
+			RetryWaitMax: time.Millisecond,
+		}).Do(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestDo_RetryPredicate_ReceivesStatus(t *testing.T) {
+	server := NewRecordingServer()
+	server.FailFirst(1)
+	var statusCodes []int
+
+	{
+		defer server.Close()
+		req, _ := http.NewRequestWithContext(
+			api.WithRetryPredicate(context.Background(),
+				func(err error, statusCode int) bool {
+					statusCodes = append(statusCodes, statusCode)
+					return err == nil && statusCode >= 500
+				}),
+			http.MethodPost,
+			server.URL+"/wandb/graphql",
+			bytes.NewBufferString("test body"),
+		)
+
+		_, err := newClient(t, server.URL+"/wandb", api.ClientOptions{
+			RetryMax:     1,
+			RetryWaitMin: time.Millisecond,
+			RetryWaitMax: time.Millisecond,
+		}).Do(req)
+
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, server.Requests(), 2)
+	assert.Equal(t, []int{500, 200}, statusCodes)
+}
+
+func TestNewIdempotencyKey_Unique(t *testing.T) {
+	assert.NotEqual(t, api.NewIdempotencyKey(), api.NewIdempotencyKey())
+}
+
 func newClient(
 	t *testing.T,
 	baseURLString string,
@@ -117,6 +277,26 @@ type RecordingServer struct {
 	*httptest.Server
 
 	requests []RequestCopy
+
+	// The number of requests to fail with a server error before succeeding.
+	failuresLeft int
+
+	// Requests with this Authorization header are rejected with a 401.
+	rejectedAuth string
+}
+
+// Makes the server respond to the next n requests with a 500 status code.
+func (s *RecordingServer) FailFirst(n int) {
+	s.Lock()
+	defer s.Unlock()
+	s.failuresLeft = n
+}
+
+// Makes the server reject requests authenticated with the API key.
+func (s *RecordingServer) RejectAPIKey(apiKey string) {
+	s.Lock()
+	defer s.Unlock()
+	s.rejectedAuth = "Basic " + base64.StdEncoding.EncodeToString([]byte("api:"+apiKey))
 }
 
 // All requests recorded by the server.
@@ -147,6 +327,17 @@ func NewRecordingServer() *RecordingServer {
 					Header: r.Header,
 				})
 
+			if rs.rejectedAuth != "" && r.Header.Get("Authorization") == rs.rejectedAuth {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			if rs.failuresLeft > 0 {
+				rs.failuresLeft--
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
 			_, _ = w.Write([]byte("OK"))
 		}),
 	)
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// AuthProvider supplies the credentials for requests to the W&B backend.
+//
+// The token is used in place of an API key. It is requested before every
+// request, so implementations should cache tokens and only fetch new ones
+// when they expire.
+type AuthProvider interface {
+	// Token returns the token to authenticate the next request with.
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshableAuthProvider is an AuthProvider whose tokens can be revoked
+// or expire early.
+//
+// If the backend rejects a request with HTTP 401, Invalidate is called with
+// the rejected token and the request is retried once with a new one.
+type RefreshableAuthProvider interface {
+	AuthProvider
+
+	// Invalidate discards the token so that Token returns a different one.
+	Invalidate(ctx context.Context, token string) error
+}
+
+// ErrCredentialsExpired is returned when the backend rejects a request's
+// credentials and refreshing them doesn't produce new ones.
+var ErrCredentialsExpired = errors.New("api: credentials expired")
+
+// StaticAPIKey is an AuthProvider that always returns the same API key.
+type StaticAPIKey string
+
+func (k StaticAPIKey) Token(context.Context) (string, error) {
+	return string(k), nil
+}
+
+// Unauthenticated is an AuthProvider for requests that are sent without
+// credentials, like the one that creates an anonymous API key.
+var Unauthenticated AuthProvider = unauthenticated{}
+
+type unauthenticated struct{}
+
+func (unauthenticated) Token(context.Context) (string, error) {
+	return "", nil
+}
+
+// ReloadableAPIKey is an API key that is read again if the backend rejects
+// it.
+//
+// It lets runs that outlive their API key pick up a new one, for example
+// after the user logs in again and the key in the .netrc file changes.
+type ReloadableAPIKey struct {
+	mu sync.Mutex
+
+	// key is the current API key.
+	key string
+
+	// reload reads the API key from where it originally came from.
+	reload func() (string, error)
+}
+
+// NewReloadableAPIKey returns a provider that starts with the given key
+// and calls reload to get a new one when it's rejected.
+func NewReloadableAPIKey(
+	key string,
+	reload func() (string, error),
+) *ReloadableAPIKey {
+	return &ReloadableAPIKey{key: key, reload: reload}
+}
+
+// Token implements AuthProvider.Token.
+func (k *ReloadableAPIKey) Token(context.Context) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.key, nil
+}
+
+// Invalidate implements RefreshableAuthProvider.Invalidate.
+//
+// The key is reloaded right away. If the reloaded key is the same, Token
+// keeps returning it.
+func (k *ReloadableAPIKey) Invalidate(_ context.Context, token string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.key != token {
+		// Another request already reloaded the key.
+		return nil
+	}
+
+	key, err := k.reload()
+	if err != nil {
+		return fmt.Errorf("api: failed to reload API key: %v", err)
+	}
+	if key != "" {
+		k.key = key
+	}
+	return nil
+}
+
+// setAuthHeaders identifies the client and authenticates the request with
+// the given token.
+func (client *clientImpl) setAuthHeaders(req *http.Request, token string) {
+	req.Header.Set("User-Agent", client.backend.userAgent)
+	if _, ok := client.backend.auth.(unauthenticated); ok {
+		return
+	}
+	req.Header.Set(
+		"Authorization",
+		"Basic "+base64.StdEncoding.EncodeToString([]byte("api:"+token)),
+	)
+}
+
+// authMiddleware authenticates requests to the W&B backend, refreshing the
+// token and sending the request again once if the backend rejects it.
+//
+// It is the outermost middleware, so a request whose credentials are
+// rejected is not retried with the same credentials. Requests to other
+// hosts are passed through without credentials.
+func (client *clientImpl) authMiddleware(next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !client.isToWandb(req) {
+			return next.RoundTrip(req)
+		}
+		return client.roundTripWithAuth(next, req)
+	})
+}
+
+// roundTripWithAuth authenticates and sends a request, refreshing the token
+// and sending it again if the backend rejects it.
+func (client *clientImpl) roundTripWithAuth(
+	next http.RoundTripper,
+	req *http.Request,
+) (*http.Response, error) {
+	auth := client.backend.auth
+
+	token, err := auth.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to get auth token: %v", err)
+	}
+
+	// The body may be needed a second time.
+	req = req.Clone(req.Context())
+	if err := makeBodyRewindable(req); err != nil {
+		return nil, fmt.Errorf("api: failed to read request body: %v", err)
+	}
+	client.setAuthHeaders(req, token)
+
+	resp, err := next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	refreshable, ok := auth.(RefreshableAuthProvider)
+	if !ok {
+		return resp, nil
+	}
+
+	if err := refreshable.Invalidate(req.Context(), token); err != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf(
+			"%w: %s rejected the credentials and refreshing them failed: %v",
+			ErrCredentialsExpired,
+			req.URL.Host,
+			err,
+		)
+	}
+	newToken, err := auth.Token(req.Context())
+	if err != nil {
+		client.logWarn("api: failed to refresh auth token", err)
+		return resp, nil
+	}
+	if newToken == token {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf(
+			"%w: %s rejected the credentials and refreshing them"+
+				" returned the same ones; log in again with `wandb login`"+
+				" or update the credentials for this run",
+			ErrCredentialsExpired,
+			req.URL.Host,
+		)
+	}
+
+	_ = resp.Body.Close()
+	retryReq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		retryReq.Body, err = req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("api: failed to read request body: %v", err)
+		}
+	}
+	client.setAuthHeaders(retryReq, newToken)
+	return next.RoundTrip(retryReq)
+}
+
+// makeBodyRewindable sets the request's GetBody if it has a body that
+// can't be read again.
+func makeBodyRewindable(req *http.Request) error {
+	if req.GetBody != nil || req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+func (client *clientImpl) logWarn(msg string, err error) {
+	if client.backend.logger != nil {
+		client.backend.logger.Warn(msg, "error", err)
+	}
+}
@@ -0,0 +1,192 @@
+package api_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// rotatingAuth returns a new token each time the previous one is
+// invalidated.
+type rotatingAuth struct {
+	generation  int
+	invalidated []string
+	err         error
+}
+
+func (a *rotatingAuth) Token(context.Context) (string, error) {
+	if a.err != nil {
+		return "", a.err
+	}
+	return fmt.Sprintf("token-%d", a.generation), nil
+}
+
+func (a *rotatingAuth) Invalidate(_ context.Context, token string) error {
+	a.invalidated = append(a.invalidated, token)
+	a.generation++
+	return nil
+}
+
+func newClientWithAuth(
+	t *testing.T,
+	baseURLString string,
+	auth api.AuthProvider,
+) api.Client {
+	baseURL, err := url.Parse(baseURLString)
+	require.NoError(t, err)
+
+	backend := api.New(api.BackendOptions{BaseURL: baseURL, AuthProvider: auth})
+	return backend.NewClient(api.ClientOptions{})
+}
+
+func basicAuth(token string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte("api:"+token))
+}
+
+func TestAuthProvider_UsesToken(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+
+	resp, err := newClientWithAuth(t, server.URL+"/wandb", &rotatingAuth{}).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, basicAuth("token-0"), server.Requests()[0].Header.Get("Authorization"))
+}
+
+func TestAuthProvider_RefreshesOn401(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	server.RejectAPIKey("token-0")
+	auth := &rotatingAuth{}
+
+	resp, err := newClientWithAuth(t, server.URL+"/wandb", auth).
+		Send(&api.Request{
+			Method: http.MethodPost,
+			Path:   "graphql",
+			Body:   []byte("body"),
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"token-0"}, auth.invalidated)
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, basicAuth("token-1"), requests[1].Header.Get("Authorization"))
+	assert.Equal(t, "body", requests[1].Body)
+}
+
+func TestAuthProvider_RefreshesOn401_BodyWithoutGetBody(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	server.RejectAPIKey("token-0")
+	req, err := http.NewRequest(
+		http.MethodPost,
+		server.URL+"/wandb/graphql",
+		strings.NewReader("body"),
+	)
+	require.NoError(t, err)
+	req.GetBody = nil
+
+	resp, err := newClientWithAuth(t, server.URL+"/wandb", &rotatingAuth{}).Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "body", requests[0].Body)
+	assert.Equal(t, "body", requests[1].Body)
+}
+
+func TestAuthProvider_Unauthenticated(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+
+	_, err := newClientWithAuth(t, server.URL+"/wandb", api.Unauthenticated).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	require.NoError(t, err)
+	assert.NotContains(t, server.Requests()[0].Header, "Authorization")
+}
+
+func TestAuthProvider_StaticKeyNotRetried(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	server.RejectAPIKey("bad-key")
+
+	resp, err := newClientWithAuth(t, server.URL+"/wandb", api.StaticAPIKey("bad-key")).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestAuthProvider_Error(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+
+	_, err := newClientWithAuth(t, server.URL+"/wandb",
+		&rotatingAuth{err: fmt.Errorf("vault is sealed")}).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	assert.ErrorContains(t, err, "vault is sealed")
+	assert.Empty(t, server.Requests())
+}
+
+func TestReloadableAPIKey_ReloadsOn401(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	server.RejectAPIKey("old-key")
+	auth := api.NewReloadableAPIKey("old-key", func() (string, error) {
+		return "new-key", nil
+	})
+
+	resp, err := newClientWithAuth(t, server.URL+"/wandb", auth).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, basicAuth("new-key"), requests[1].Header.Get("Authorization"))
+}
+
+func TestReloadableAPIKey_SameKeyIsExpired(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	server.RejectAPIKey("old-key")
+	auth := api.NewReloadableAPIKey("old-key", func() (string, error) {
+		return "old-key", nil
+	})
+
+	_, err := newClientWithAuth(t, server.URL+"/wandb", auth).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	assert.ErrorIs(t, err, api.ErrCredentialsExpired)
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestReloadableAPIKey_ReloadError(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	server.RejectAPIKey("old-key")
+	auth := api.NewReloadableAPIKey("old-key", func() (string, error) {
+		return "", fmt.Errorf("no .netrc file")
+	})
+
+	_, err := newClientWithAuth(t, server.URL+"/wandb", auth).
+		Send(&api.Request{Method: http.MethodPost, Path: "graphql"})
+
+	assert.ErrorIs(t, err, api.ErrCredentialsExpired)
+	assert.ErrorContains(t, err, "no .netrc file")
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// credentialHelperTimeout is how long the helper may run.
+	credentialHelperTimeout = 30 * time.Second
+
+	// credentialRefreshMargin is how long before they expire that
+	// credentials are refreshed.
+	credentialRefreshMargin = time.Minute
+)
+
+// HelperCredentials are the credentials printed by a credential helper.
+//
+// The helper is run with the argument "get" and must print a JSON object
+// with these fields to stdout. All fields are optional.
+type HelperCredentials struct {
+	// APIKey is the W&B API key.
+	APIKey string `json:"api_key"`
+
+	// ProxyUsername and ProxyPassword authenticate with the HTTP proxy.
+	ProxyUsername string `json:"proxy_username"`
+	ProxyPassword string `json:"proxy_password"`
+
+	// ExpiresAt is when the credentials expire, in RFC 3339 format.
+	//
+	// If unset, the credentials are used until the backend rejects them.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CredentialHelper gets backend and proxy credentials by running an
+// external command, like git and docker credential helpers.
+//
+// Credentials are cached until shortly before they expire. It is an
+// AuthProvider that falls back to a static API key if the helper doesn't
+// print one.
+type CredentialHelper struct {
+	mu sync.Mutex
+
+	// command is the helper executable and its arguments.
+	command []string
+
+	// fallbackAPIKey is used if the helper doesn't print an API key.
+	fallbackAPIKey string
+
+	// credentials are the cached credentials, or nil.
+	credentials *HelperCredentials
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// NewCredentialHelper returns a helper that runs the given command.
+//
+// The command is split on whitespace.
+func NewCredentialHelper(command string, fallbackAPIKey string) *CredentialHelper {
+	return &CredentialHelper{
+		command:        strings.Fields(command),
+		fallbackAPIKey: fallbackAPIKey,
+		now:            time.Now,
+	}
+}
+
+// Credentials returns the cached credentials, running the helper if they
+// are missing or about to expire.
+func (h *CredentialHelper) Credentials(ctx context.Context) (*HelperCredentials, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.credentials != nil &&
+		(h.credentials.ExpiresAt.IsZero() ||
+			h.now().Before(h.credentials.ExpiresAt.Add(-credentialRefreshMargin))) {
+		return h.credentials, nil
+	}
+
+	credentials, err := h.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h.credentials = credentials
+	return credentials, nil
+}
+
+// Token implements AuthProvider.Token.
+func (h *CredentialHelper) Token(ctx context.Context) (string, error) {
+	credentials, err := h.Credentials(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if credentials.APIKey == "" {
+		return h.fallbackAPIKey, nil
+	}
+	return credentials.APIKey, nil
+}
+
+// Invalidate implements RefreshableAuthProvider.Invalidate.
+//
+// The helper is run again the next time credentials are needed.
+func (h *CredentialHelper) Invalidate(_ context.Context, token string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.credentials != nil && h.credentials.APIKey == token {
+		h.credentials = nil
+	}
+	return nil
+}
+
+// Proxy chooses a proxy like http.ProxyFromEnvironment and adds the
+// helper's proxy credentials to it.
+//
+// It can be used as the Proxy function of an http.Transport.
+func (h *CredentialHelper) Proxy(req *http.Request) (*url.URL, error) {
+	proxyURL, err := http.ProxyFromEnvironment(req)
+	if err != nil || proxyURL == nil {
+		return proxyURL, err
+	}
+
+	credentials, err := h.Credentials(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials.ProxyUsername != "" {
+		proxyURL = &url.URL{
+			Scheme:   proxyURL.Scheme,
+			Host:     proxyURL.Host,
+			Path:     proxyURL.Path,
+			RawQuery: proxyURL.RawQuery,
+			User: url.UserPassword(
+				credentials.ProxyUsername,
+				credentials.ProxyPassword,
+			),
+		}
+	}
+
+	return proxyURL, nil
+}
+
+// run runs the helper and parses its output.
+func (h *CredentialHelper) run(ctx context.Context) (*HelperCredentials, error) {
+	if len(h.command) == 0 {
+		return nil, errors.New("api: no credential helper command")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, credentialHelperTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.command[0], append(h.command[1:], "get")...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf(
+			"api: credential helper failed: %v: %s",
+			err,
+			strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	var credentials HelperCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		return nil, fmt.Errorf("api: invalid credential helper output: %v", err)
+	}
+
+	return &credentials, nil
+}
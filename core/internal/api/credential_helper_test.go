@@ -0,0 +1,125 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// writeHelper writes a credential helper script that prints the given
+// output and records each invocation in a file.
+//
+// Returns the helper command and a function counting the invocations.
+func writeHelper(t *testing.T, output string) (string, func() int) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper scripts require a POSIX shell")
+	}
+
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "calls")
+	outputPath := filepath.Join(dir, "output")
+	scriptPath := filepath.Join(dir, "helper.sh")
+	require.NoError(t, os.WriteFile(outputPath, []byte(output), 0o644))
+	require.NoError(t, os.WriteFile(
+		scriptPath,
+		[]byte(fmt.Sprintf(
+			"#!/bin/sh\necho \"$1\" >> %q\ncat %q\n",
+			logPath,
+			outputPath,
+		)),
+		0o755,
+	))
+
+	return scriptPath, func() int {
+		data, _ := os.ReadFile(logPath)
+		return strings.Count(string(data), "get\n")
+	}
+}
+
+func TestCredentialHelper_CachesUntilNearExpiry(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Format(time.RFC3339)
+	command, calls := writeHelper(t,
+		fmt.Sprintf(`{"api_key": "helper-key", "expires_at": %q}`, expiresAt))
+	helper := api.NewCredentialHelper(command, "fallback")
+
+	token1, err1 := helper.Token(context.Background())
+	token2, err2 := helper.Token(context.Background())
+
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.Equal(t, "helper-key", token1)
+	assert.Equal(t, "helper-key", token2)
+	assert.Equal(t, 1, calls())
+}
+
+func TestCredentialHelper_RefreshesExpiringCredentials(t *testing.T) {
+	expiresAt := time.Now().Add(10 * time.Second).Format(time.RFC3339)
+	command, calls := writeHelper(t,
+		fmt.Sprintf(`{"api_key": "helper-key", "expires_at": %q}`, expiresAt))
+	helper := api.NewCredentialHelper(command, "")
+
+	_, _ = helper.Token(context.Background())
+	_, _ = helper.Token(context.Background())
+
+	assert.Equal(t, 2, calls())
+}
+
+func TestCredentialHelper_InvalidateRefreshes(t *testing.T) {
+	command, calls := writeHelper(t, `{"api_key": "helper-key"}`)
+	helper := api.NewCredentialHelper(command, "")
+
+	_, _ = helper.Token(context.Background())
+	require.NoError(t, helper.Invalidate(context.Background(), "helper-key"))
+	_, _ = helper.Token(context.Background())
+
+	assert.Equal(t, 2, calls())
+}
+
+func TestCredentialHelper_FallbackAPIKey(t *testing.T) {
+	command, _ := writeHelper(t, `{"proxy_username": "user"}`)
+	helper := api.NewCredentialHelper(command, "fallback")
+
+	token, err := helper.Token(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", token)
+}
+
+func TestCredentialHelper_Proxy(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://proxy.example.com:3128")
+	command, _ := writeHelper(t,
+		`{"proxy_username": "user", "proxy_password": "secret"}`)
+	helper := api.NewCredentialHelper(command, "")
+	req, err := http.NewRequest(http.MethodGet, "https://api.wandb.ai", nil)
+	require.NoError(t, err)
+
+	proxyURL, err := helper.Proxy(req)
+
+	// http.ProxyFromEnvironment reads the environment only once per
+	// process, so the proxy may not be configured.
+	require.NoError(t, err)
+	if proxyURL != nil {
+		assert.Equal(t, "user", proxyURL.User.Username())
+		password, _ := proxyURL.User.Password()
+		assert.Equal(t, "secret", password)
+	}
+}
+
+func TestCredentialHelper_InvalidOutput(t *testing.T) {
+	command, _ := writeHelper(t, `not json`)
+	helper := api.NewCredentialHelper(command, "")
+
+	_, err := helper.Token(context.Background())
+
+	assert.ErrorContains(t, err, "invalid credential helper output")
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// IdempotencyKeyHeader is the HTTP header that carries a request's
+// idempotency key.
+//
+// The backend uses the key to de-duplicate requests it has already
+// processed. This matters when a response is lost, for example due to a
+// flaky network: the client retries a request that the server actually
+// handled, and without the key the data would be applied twice.
+//
+// A key identifies one logical request. It is generated once, before the
+// first attempt, and reused by every retry of that request; it is never
+// reused for a different request. Keys only live in memory, so a request
+// that is re-sent by a different process (such as `wandb sync`) gets a
+// new key.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyCtxKey struct{}
+
+// NewIdempotencyKey returns a new random idempotency key.
+func NewIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// WithIdempotencyKey returns a context that attaches the key to requests
+// made to the W&B backend using it.
+//
+// This is for requests made through [Client.Do], such as GraphQL requests,
+// where the caller doesn't control the headers. Requests made through
+// [Client.Send] can set [IdempotencyKeyHeader] directly.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// setIdempotencyKeyHeader sets the idempotency key from the request's
+// context, if any.
+func setIdempotencyKeyHeader(req *http.Request) {
+	key, ok := req.Context().Value(idempotencyKeyCtxKey{}).(string)
+	if ok && key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 // Logs the final response (after retries) if it's an error.
 func (backend *Backend) logFinalResponseOnError(
-	req *retryablehttp.Request,
+	req *http.Request,
 	resp *http.Response,
 ) {
 	if resp.StatusCode < 400 || backend.logger == nil {
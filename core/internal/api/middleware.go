@@ -0,0 +1,75 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Middleware wraps an HTTP transport to add behavior to requests, such as
+// signing them, adding tracing headers or recording metrics.
+//
+// Auth and retries are themselves middleware at the start of every
+// client's chain, so middleware after them runs for every attempt of a
+// retried request, after auth and client headers are set. It must not
+// read the request body without restoring it.
+//
+// Middleware is configured in code through [BackendOptions] and
+// [ClientOptions]; it is not a plugin interface for users.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to an [http.RoundTripper].
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Chain wraps a transport in middleware.
+//
+// The first middleware is the outermost: it sees requests first and
+// responses last.
+func Chain(transport http.RoundTripper, middleware ...Middleware) http.RoundTripper {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+	return transport
+}
+
+// peekingMiddleware reports responses to the peeker.
+func peekingMiddleware(peeker Peeker) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return NewPeekingTransport(peeker, next)
+	}
+}
+
+// rateLimitingMiddleware throttles requests according to the backend's
+// rate-limit headers.
+func rateLimitingMiddleware(next http.RoundTripper) http.RoundTripper {
+	return NewRateLimitedTransport(next)
+}
+
+// retryingMiddleware sends each request with the retryable client, which
+// sends every attempt through the rest of the chain.
+func retryingMiddleware(retryableHTTP *retryablehttp.Client) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		retryableHTTP.HTTPClient.Transport = next
+
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			retryableReq, err := retryablehttp.FromRequest(req)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := retryableHTTP.Do(retryableReq)
+
+			// This is a bug that happens with retryablehttp sometimes.
+			if err == nil && resp == nil {
+				return nil, errors.New("api: nil error and nil response")
+			}
+
+			return resp, err
+		})
+	}
+}
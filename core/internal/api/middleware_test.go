@@ -0,0 +1,83 @@
+package api_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// appendHeader returns middleware that appends the name to a header.
+func appendHeader(name string) api.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return api.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Add("X-Chain", name)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func TestMiddleware_Order(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+	backend := api.New(api.BackendOptions{
+		BaseURL:    baseURL,
+		Middleware: []api.Middleware{appendHeader("backend")},
+	})
+	client := backend.NewClient(api.ClientOptions{
+		Middleware: []api.Middleware{appendHeader("client1"), appendHeader("client2")},
+	})
+
+	_, err = client.Send(&api.Request{Method: http.MethodGet, Path: "test"})
+
+	require.NoError(t, err)
+	require.Len(t, server.Requests(), 1)
+	assert.Equal(t,
+		[]string{"backend", "client1", "client2"},
+		server.Requests()[0].Header.Values("X-Chain"))
+}
+
+func TestMiddleware_SeesEachAttemptWithAuth(t *testing.T) {
+	server := NewRecordingServer()
+	server.FailFirst(1)
+	defer server.Close()
+	var attempts []string
+
+	_, err := newClient(t, server.URL+"/wandb", api.ClientOptions{
+		RetryMax:     1,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+		Middleware: []api.Middleware{
+			func(next http.RoundTripper) http.RoundTripper {
+				return api.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					attempts = append(attempts, req.Header.Get("Authorization"))
+					return next.RoundTrip(req)
+				})
+			},
+		},
+	}).Send(&api.Request{Method: http.MethodGet, Path: "test"})
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2)
+	for _, auth := range attempts {
+		assert.NotEmpty(t, auth)
+	}
+}
+
+func TestChain_NoMiddleware(t *testing.T) {
+	transport := api.RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	chained := api.Chain(transport)
+
+	resp, err := chained.RoundTrip(&http.Request{})
+	assert.Nil(t, resp)
+	assert.NoError(t, err)
+}
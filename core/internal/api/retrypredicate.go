@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryPredicate decides whether a failed request attempt is retried.
+//
+// It receives the error from the attempt, if any, and the response's
+// HTTP status code, or 0 if there was no response. The attempt is retried
+// if it returns true and the client hasn't used up its retries.
+type RetryPredicate func(err error, statusCode int) bool
+
+// NeverRetry is a RetryPredicate for requests that must not be retried,
+// such as non-idempotent mutations.
+func NeverRetry(error, int) bool { return false }
+
+type retryPredicateCtxKey struct{}
+
+// WithRetryPredicate returns a context that makes requests to the W&B
+// backend using it retry according to the predicate instead of the
+// client's retry policy.
+//
+// Like WithIdempotencyKey, this is for requests made through [Client.Do],
+// such as GraphQL operations, where the caller only controls the context.
+// It lets an operation override the default classification of retryable
+// errors. Requests are never retried after the context is done.
+func WithRetryPredicate(
+	ctx context.Context,
+	predicate RetryPredicate,
+) context.Context {
+	return context.WithValue(ctx, retryPredicateCtxKey{}, predicate)
+}
+
+// withRetryPredicates wraps a retry policy to use the request's
+// RetryPredicate if it has one.
+func withRetryPredicates(
+	policy retryablehttp.CheckRetry,
+) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		predicate, ok := ctx.Value(retryPredicateCtxKey{}).(RetryPredicate)
+		if !ok || predicate == nil {
+			return policy(ctx, resp, err)
+		}
+
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return predicate(err, statusCode), nil
+	}
+}
@@ -1,39 +1,38 @@
 package api
 
 import (
-	"encoding/base64"
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
-
-	"github.com/hashicorp/go-retryablehttp"
 )
 
 func (client *clientImpl) Send(req *Request) (*http.Response, error) {
-	retryableReq, err := retryablehttp.NewRequest(
+	ctx := req.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
 		req.Method,
 		client.backend.baseURL.JoinPath(req.Path).String(),
-		req.Body,
+		bytes.NewReader(req.Body),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("api: failed to create request: %v", err)
 	}
 
 	for headerKey, headerValue := range req.Headers {
-		retryableReq.Header.Set(headerKey, headerValue)
+		httpReq.Header.Set(headerKey, headerValue)
 	}
-	client.setClientHeaders(retryableReq)
-	client.setAuthHeaders(retryableReq)
 
-	return client.sendToWandbBackend(retryableReq)
+	return client.sendToWandbBackend(httpReq)
 }
 
 func (client *clientImpl) Do(req *http.Request) (*http.Response, error) {
-	retryableReq, err := retryablehttp.FromRequest(req)
-	if err != nil {
-		return nil, fmt.Errorf("api: failed to parse request: %v", err)
-	}
-
 	if !client.isToWandb(req) {
 		if client.backend.logger != nil {
 			client.backend.logger.Warn(
@@ -44,46 +43,54 @@ func (client *clientImpl) Do(req *http.Request) (*http.Response, error) {
 			)
 		}
 
-		return client.send(retryableReq)
+		return client.send(req)
 	}
 
-	return client.sendToWandbBackend(retryableReq)
+	return client.sendToWandbBackend(req)
 }
 
 // Returns whether the request would go to the W&B backend.
 func (client *clientImpl) isToWandb(req *http.Request) bool {
-	if req.URL.Host != client.backend.baseURL.Host {
+	if isUnderURL(req, client.backend.baseURL) {
+		return true
+	}
+
+	for _, otherURL := range client.backend.otherURLs {
+		if isUnderURL(req, otherURL) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns whether the request's URL starts with the base URL.
+func isUnderURL(req *http.Request, baseURL *url.URL) bool {
+	if req.URL.Host != baseURL.Host {
 		return false
 	}
 
-	return strings.HasPrefix(req.URL.Path, client.backend.baseURL.Path)
+	return strings.HasPrefix(req.URL.Path, baseURL.Path)
 }
 
 // Sends a request intended for the W&B backend.
 func (client *clientImpl) sendToWandbBackend(
-	req *retryablehttp.Request,
+	req *http.Request,
 ) (*http.Response, error) {
 	client.setClientHeaders(req)
-	client.setAuthHeaders(req)
+	setIdempotencyKeyHeader(req)
 
-	resp, err := client.send(req)
-
-	// This is a bug that happens with retryablehttp sometimes.
-	if err == nil && resp == nil {
-		return nil, fmt.Errorf("api: nil error and nil response")
-	}
-
-	return resp, err
+	return client.send(req)
 }
 
-// Sends any HTTP request.
+// Sends any HTTP request through the client's middleware chain.
 func (client *clientImpl) send(
-	req *retryablehttp.Request,
+	req *http.Request,
 ) (*http.Response, error) {
-	resp, err := client.retryableHTTP.Do(req)
+	resp, err := client.transport.RoundTrip(req)
 
 	if err != nil {
-		return nil, fmt.Errorf("api: failed sending: %v", err)
+		return nil, fmt.Errorf("api: failed sending: %w", err)
 	}
 	if resp == nil {
 		return nil, fmt.Errorf("api: no response")
@@ -93,17 +100,8 @@ func (client *clientImpl) send(
 	return resp, nil
 }
 
-func (client *clientImpl) setClientHeaders(req *retryablehttp.Request) {
+func (client *clientImpl) setClientHeaders(req *http.Request) {
 	for headerKey, headerValue := range client.extraHeaders {
 		req.Header.Set(headerKey, headerValue)
 	}
 }
-
-func (client *clientImpl) setAuthHeaders(req *retryablehttp.Request) {
-	req.Header.Set("User-Agent", "wandb-core")
-	req.Header.Set(
-		"Authorization",
-		"Basic "+base64.StdEncoding.EncodeToString(
-			[]byte("api:"+client.backend.apiKey)),
-	)
-}
@@ -4,24 +4,16 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 )
 
-type MockRetryableHTTPClient struct {
-}
-
-func (m *MockRetryableHTTPClient) Do(req *retryablehttp.Request) (*http.Response, error) {
-	return nil, nil
-}
-
 func TestSend_NoResponse(t *testing.T) {
-	mockRetryableHTTPClient := new(MockRetryableHTTPClient)
-
 	client := &clientImpl{
-		retryableHTTP: mockRetryableHTTPClient,
+		transport: RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, nil
+		}),
 	}
-	req, _ := retryablehttp.NewRequest("GET", "http://example.com", nil)
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
 	resp, err := client.send(req)
 
 	// An error should be returned indicating that no response was received.
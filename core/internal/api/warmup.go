@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// Delays between connection attempts during the warmup period.
+	warmupRetryWaitMin = 250 * time.Millisecond
+	warmupRetryWaitMax = 2 * time.Second
+)
+
+// warmup holds back a backend's first requests until the backend can be
+// reached or a deadline passes.
+//
+// Networking in a new container or VM may come up slightly after the
+// process starts, so the first requests can fail to resolve or connect
+// to the backend. Waiting for the network before the first attempt keeps
+// those failures from using up retries or surfacing as errors.
+//
+// The warmup ends for all clients of the backend once any request to it
+// connects. A nil warmup never waits.
+type warmup struct {
+	until  time.Time
+	logger *slog.Logger
+
+	// mu is held while probing, so that concurrent requests wait for the
+	// same probe.
+	mu   sync.Mutex
+	done bool
+}
+
+func newWarmup(until time.Time, logger *slog.Logger) *warmup {
+	return &warmup{until: until, logger: logger}
+}
+
+// Install makes the client wait for the warmup before each attempt.
+//
+// Like with the network gate, waiting happens before the request's
+// timeout starts, so that the warmup can take longer than one attempt.
+func (w *warmup) Install(client *retryablehttp.Client) {
+	if w == nil {
+		return
+	}
+
+	next := client.RequestLogHook
+	client.RequestLogHook = func(
+		logger retryablehttp.Logger,
+		req *http.Request,
+		attempt int,
+	) {
+		w.Wait(req.Context(), client.HTTPClient.Transport, req.URL)
+		if next != nil {
+			next(logger, req, attempt)
+		}
+	}
+}
+
+// Wait blocks until the host of the URL can be reached, the warmup period
+// ends, or the context is done.
+//
+// The host is probed with HEAD requests sent through the transport. Any
+// response, even an error status, means the network is up.
+func (w *warmup) Wait(
+	ctx context.Context,
+	transport http.RoundTripper,
+	target *url.URL,
+) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wait := warmupRetryWaitMin
+	for !w.done {
+		err := w.probe(ctx, transport, target)
+		if err == nil || !isConnectionError(err) ||
+			time.Now().Add(wait).After(w.until) {
+			w.done = true
+			return
+		}
+
+		if w.logger != nil {
+			w.logger.Debug(
+				"api: waiting for connection during warmup",
+				"error", err,
+			)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+
+		wait = min(2*wait, warmupRetryWaitMax)
+	}
+}
+
+// probe sends a HEAD request to the URL's host.
+func (w *warmup) probe(
+	ctx context.Context,
+	transport http.RoundTripper,
+	target *url.URL,
+) error {
+	ctx, cancel := context.WithDeadline(ctx, w.until)
+	defer cancel()
+
+	root := &url.URL{Scheme: target.Scheme, Host: target.Host}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, root.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// isConnectionError reports whether the error happened before the
+// request reached the server, such as a DNS or dial failure.
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
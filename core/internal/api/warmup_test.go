@@ -0,0 +1,133 @@
+package api_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// failToDial returns middleware that fails the first n attempts as if the
+// host couldn't be resolved.
+func failToDial(n int, bodies *[]string) api.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return api.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			var body []byte
+			if req.Body != nil {
+				body, _ = io.ReadAll(req.Body)
+				req.Body = io.NopCloser(bytes.NewReader(body))
+			}
+			*bodies = append(*bodies, string(body))
+
+			if len(*bodies) <= n {
+				return nil, &net.OpError{
+					Op:  "dial",
+					Net: "tcp",
+					Err: &net.DNSError{Err: "no such host", Name: req.URL.Host},
+				}
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func TestWarmup_WaitsForConnection(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+	var bodies []string
+
+	_, err = api.New(api.BackendOptions{
+		BaseURL:    baseURL,
+		Middleware: []api.Middleware{failToDial(2, &bodies)},
+		Warmup:     time.Minute,
+	}).NewClient(api.ClientOptions{}).Send(&api.Request{
+		Method: http.MethodPost,
+		Path:   "test",
+		Body:   []byte("body"),
+	})
+
+	// The host is probed until it's reachable, and then the request is
+	// sent once.
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "", "", "body"}, bodies)
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, http.MethodHead, requests[0].Method)
+	assert.Equal(t, http.MethodPost, requests[1].Method)
+	assert.Equal(t, "body", requests[1].Body)
+}
+
+func TestWarmup_OutsideRequestTimeout(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+	var bodies []string
+
+	// The warmup waits longer than the timeout of a single attempt.
+	_, err = api.New(api.BackendOptions{
+		BaseURL:    baseURL,
+		Middleware: []api.Middleware{failToDial(2, &bodies)},
+		Warmup:     time.Minute,
+	}).NewClient(api.ClientOptions{
+		NonRetryTimeout: 100 * time.Millisecond,
+	}).Send(&api.Request{
+		Method: http.MethodGet,
+		Path:   "test",
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, server.Requests(), 2)
+}
+
+func TestWarmup_EndsOnceConnected(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+	var bodies []string
+	backend := api.New(api.BackendOptions{
+		BaseURL:    baseURL,
+		Middleware: []api.Middleware{failToDial(1, &bodies)},
+		Warmup:     time.Minute,
+	})
+
+	for _, path := range []string{"first", "second"} {
+		_, err = backend.NewClient(api.ClientOptions{}).Send(&api.Request{
+			Method: http.MethodGet,
+			Path:   path,
+		})
+		require.NoError(t, err)
+	}
+
+	// Only the first request waits for the probe.
+	assert.Len(t, server.Requests(), 3)
+}
+
+func TestWarmup_Disabled(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+	var bodies []string
+
+	_, err = api.New(api.BackendOptions{
+		BaseURL:    baseURL,
+		Middleware: []api.Middleware{failToDial(1, &bodies)},
+	}).NewClient(api.ClientOptions{}).Send(&api.Request{
+		Method: http.MethodGet,
+		Path:   "test",
+	})
+
+	assert.ErrorContains(t, err, "no such host")
+	assert.Empty(t, server.Requests())
+}
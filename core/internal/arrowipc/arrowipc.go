@@ -0,0 +1,572 @@
+// Package arrowipc encodes columns of numbers in the Apache Arrow IPC
+// streaming format.
+//
+// It implements the small part of the format needed to store numeric run
+// history: a schema of non-nullable 64-bit integer and floating point
+// columns followed by uncompressed record batches. Streams it writes can be
+// read by any Arrow implementation, for example pyarrow.ipc.open_stream.
+//
+// See https://arrow.apache.org/docs/format/Columnar.html#ipc-streaming-format.
+package arrowipc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Type is the type of a column's values.
+type Type byte
+
+const (
+	// Int64 is a column of signed 64-bit integers.
+	Int64 Type = iota + 1
+
+	// Float64 is a column of double precision floats.
+	Float64
+)
+
+func (t Type) String() string {
+	switch t {
+	case Int64:
+		return "int64"
+	case Float64:
+		return "float64"
+	default:
+		return fmt.Sprintf("Type(%d)", byte(t))
+	}
+}
+
+// Column is a named column of values.
+//
+// Ints holds the values of an Int64 column and Floats the values of
+// a Float64 column.
+type Column struct {
+	Name   string
+	Type   Type
+	Ints   []int64
+	Floats []float64
+}
+
+// Len returns the number of values in the column.
+func (c *Column) Len() int {
+	if c.Type == Float64 {
+		return len(c.Floats)
+	}
+	return len(c.Ints)
+}
+
+// errMalformed is returned for streams that aren't valid Arrow IPC.
+var errMalformed = errors.New("arrowipc: malformed stream")
+
+const (
+	// continuationMarker starts every encapsulated message.
+	continuationMarker = 0xFFFFFFFF
+
+	// metadataVersionV5 is the Arrow 1.0 metadata version, which is
+	// written. Streams with the previous version, V4, have the same layout
+	// for the types supported here and are read too.
+	metadataVersionV4 = 3
+	metadataVersionV5 = 4
+
+	// Values of the MessageHeader union.
+	headerSchema      = 1
+	headerRecordBatch = 3
+
+	// Values of the Type union.
+	typeInt           = 2
+	typeFloatingPoint = 3
+
+	// precisionDouble is the FloatingPoint precision of 64-bit floats.
+	precisionDouble = 2
+)
+
+// Vtable offsets of the flatbuffer fields that are read.
+//
+// A field's vtable offset is 4 plus twice its index in the table.
+const (
+	messageVersion    = 4
+	messageHeaderType = 6
+	messageHeader     = 8
+	messageBodyLength = 10
+
+	schemaFields = 6
+
+	fieldName     = 4
+	fieldTypeType = 8
+	fieldType     = 10
+
+	intBitWidth = 4
+	intSigned   = 6
+
+	floatingPointPrecision = 4
+
+	recordBatchLength      = 4
+	recordBatchNodes       = 6
+	recordBatchBuffers     = 8
+	recordBatchCompression = 10
+)
+
+// AppendStream appends a stream holding the columns to dst.
+//
+// The stream has a schema message, a single record batch and an
+// end-of-stream marker. All columns must have the same length.
+func AppendStream(dst []byte, columns []Column) ([]byte, error) {
+	rows := 0
+	for i := range columns {
+		column := &columns[i]
+
+		if column.Type != Int64 && column.Type != Float64 {
+			return nil, fmt.Errorf(
+				"arrowipc: column %q has unsupported type %v",
+				column.Name, column.Type)
+		}
+
+		if i == 0 {
+			rows = column.Len()
+		} else if column.Len() != rows {
+			return nil, fmt.Errorf(
+				"arrowipc: column %q has %d values, expected %d",
+				column.Name, column.Len(), rows)
+		}
+	}
+
+	builder := flatbuffers.NewBuilder(1024)
+	dst = appendMessage(dst, buildSchemaMessage(builder, columns), nil)
+
+	builder.Reset()
+	body := make([]byte, 0, 8*rows*len(columns))
+	for i := range columns {
+		body = appendValues(body, &columns[i])
+	}
+	dst = appendMessage(dst, buildRecordBatchMessage(builder, columns, rows), body)
+
+	// The end-of-stream marker is a message with empty metadata.
+	dst = binary.LittleEndian.AppendUint32(dst, continuationMarker)
+	return binary.LittleEndian.AppendUint32(dst, 0), nil
+}
+
+// appendMessage appends an encapsulated message to dst.
+//
+// The metadata is padded so that the body starts on an 8-byte boundary
+// relative to the start of the message.
+func appendMessage(dst []byte, metadata []byte, body []byte) []byte {
+	padding := (8 - len(metadata)%8) % 8
+
+	dst = binary.LittleEndian.AppendUint32(dst, continuationMarker)
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(metadata)+padding))
+	dst = append(dst, metadata...)
+	dst = append(dst, make([]byte, padding)...)
+	return append(dst, body...)
+}
+
+// appendValues appends the column's values in little-endian order.
+func appendValues(dst []byte, column *Column) []byte {
+	if column.Type == Float64 {
+		for _, value := range column.Floats {
+			dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(value))
+		}
+	} else {
+		for _, value := range column.Ints {
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(value))
+		}
+	}
+	return dst
+}
+
+// buildSchemaMessage returns the metadata of the stream's schema message.
+func buildSchemaMessage(
+	builder *flatbuffers.Builder,
+	columns []Column,
+) []byte {
+	fields := make([]flatbuffers.UOffsetT, len(columns))
+	for i := range columns {
+		fields[i] = buildField(builder, &columns[i])
+	}
+
+	builder.StartVector(4, len(fields), 4)
+	for i := len(fields) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(fields[i])
+	}
+	fieldsVector := builder.EndVector(len(fields))
+
+	// Schema: endianness, fields, custom_metadata, features.
+	// Little-endian is the default endianness.
+	builder.StartObject(4)
+	builder.PrependUOffsetTSlot(1, fieldsVector, 0)
+	schema := builder.EndObject()
+
+	return finishMessage(builder, headerSchema, schema, 0)
+}
+
+// buildField adds the schema field describing the column.
+func buildField(
+	builder *flatbuffers.Builder,
+	column *Column,
+) flatbuffers.UOffsetT {
+	name := builder.CreateString(column.Name)
+
+	var typeType byte
+	var typ flatbuffers.UOffsetT
+	switch column.Type {
+	case Int64:
+		// Int: bitWidth, is_signed.
+		typeType = typeInt
+		builder.StartObject(2)
+		builder.PrependInt32Slot(0, 64, 0)
+		builder.PrependBoolSlot(1, true, false)
+		typ = builder.EndObject()
+	case Float64:
+		// FloatingPoint: precision.
+		typeType = typeFloatingPoint
+		builder.StartObject(1)
+		builder.PrependInt16Slot(0, precisionDouble, 0)
+		typ = builder.EndObject()
+	}
+
+	// Some readers require the children vector even for primitive types.
+	builder.StartVector(4, 0, 4)
+	children := builder.EndVector(0)
+
+	// Field: name, nullable, type_type, type, dictionary, children,
+	// custom_metadata.
+	builder.StartObject(7)
+	builder.PrependUOffsetTSlot(0, name, 0)
+	builder.PrependByteSlot(2, typeType, 0)
+	builder.PrependUOffsetTSlot(3, typ, 0)
+	builder.PrependUOffsetTSlot(5, children, 0)
+	return builder.EndObject()
+}
+
+// buildRecordBatchMessage returns the metadata of a record batch message
+// whose body holds the columns' values one after another.
+//
+// Each column has an empty validity buffer, since no values are null,
+// followed by its data buffer.
+func buildRecordBatchMessage(
+	builder *flatbuffers.Builder,
+	columns []Column,
+	rows int,
+) []byte {
+	// FieldNode structs: length, null_count.
+	builder.StartVector(16, len(columns), 8)
+	for range columns {
+		builder.Prep(8, 16)
+		builder.PrependInt64(0)
+		builder.PrependInt64(int64(rows))
+	}
+	nodes := builder.EndVector(len(columns))
+
+	// Buffer structs: offset, length. Prepended in reverse order.
+	columnSize := int64(8 * rows)
+	bodyLength := columnSize * int64(len(columns))
+	builder.StartVector(16, 2*len(columns), 8)
+	for i := len(columns) - 1; i >= 0; i-- {
+		offset := columnSize * int64(i)
+
+		builder.Prep(8, 16)
+		builder.PrependInt64(columnSize)
+		builder.PrependInt64(offset)
+
+		builder.Prep(8, 16)
+		builder.PrependInt64(0)
+		builder.PrependInt64(offset)
+	}
+	buffers := builder.EndVector(2 * len(columns))
+
+	// RecordBatch: length, nodes, buffers, compression.
+	builder.StartObject(4)
+	builder.PrependInt64Slot(0, int64(rows), 0)
+	builder.PrependUOffsetTSlot(1, nodes, 0)
+	builder.PrependUOffsetTSlot(2, buffers, 0)
+	recordBatch := builder.EndObject()
+
+	return finishMessage(builder, headerRecordBatch, recordBatch, bodyLength)
+}
+
+// finishMessage adds the Message table and returns the finished metadata.
+func finishMessage(
+	builder *flatbuffers.Builder,
+	headerType byte,
+	header flatbuffers.UOffsetT,
+	bodyLength int64,
+) []byte {
+	// Message: version, header_type, header, bodyLength, custom_metadata.
+	builder.StartObject(5)
+	builder.PrependInt16Slot(0, metadataVersionV5, 0)
+	builder.PrependByteSlot(1, headerType, 0)
+	builder.PrependUOffsetTSlot(2, header, 0)
+	builder.PrependInt64Slot(3, bodyLength, 0)
+	builder.Finish(builder.EndObject())
+
+	return builder.FinishedBytes()
+}
+
+// ReadStream returns the columns of a stream.
+//
+// The values of all of the stream's record batches are concatenated.
+// Returns an error if the stream uses parts of the format that this package
+// doesn't write, such as null values, other types or compression.
+func ReadStream(data []byte) (columns []Column, err error) {
+	// The flatbuffers runtime panics on out-of-range offsets rather than
+	// validating them.
+	defer func() {
+		if recover() != nil {
+			columns, err = nil, errMalformed
+		}
+	}()
+
+	reader := &streamReader{data: data}
+
+	message, body, err := reader.next()
+	switch {
+	case err != nil:
+		return nil, err
+	case message == nil:
+		return nil, fmt.Errorf("arrowipc: stream has no schema")
+	case message.headerType != headerSchema:
+		return nil, fmt.Errorf("arrowipc: stream doesn't start with a schema")
+	}
+	if columns, err = readSchema(&message.header); err != nil {
+		return nil, err
+	}
+
+	for {
+		message, body, err = reader.next()
+		switch {
+		case err != nil:
+			return nil, err
+		case message == nil:
+			return columns, nil
+		case message.headerType != headerRecordBatch:
+			return nil, fmt.Errorf(
+				"arrowipc: unsupported message type %d",
+				message.headerType)
+		}
+
+		if err := readRecordBatch(&message.header, body, columns); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// streamReader splits a stream into messages.
+type streamReader struct {
+	data []byte
+}
+
+// message is the decoded metadata of a message.
+type message struct {
+	headerType byte
+	header     flatbuffers.Table
+}
+
+// next returns the next message and its body.
+//
+// Returns a nil message at the end of the stream.
+func (r *streamReader) next() (*message, []byte, error) {
+	if len(r.data) == 0 {
+		// The end-of-stream marker is optional.
+		return nil, nil, nil
+	}
+	if len(r.data) < 8 ||
+		binary.LittleEndian.Uint32(r.data) != continuationMarker {
+		return nil, nil, errMalformed
+	}
+
+	size := int(binary.LittleEndian.Uint32(r.data[4:]))
+	if size == 0 {
+		return nil, nil, nil
+	}
+	if size > len(r.data)-8 {
+		return nil, nil, errMalformed
+	}
+	metadata := r.data[8 : 8+size]
+	r.data = r.data[8+size:]
+
+	table := flatbuffers.Table{
+		Bytes: metadata,
+		Pos:   flatbuffers.GetUOffsetT(metadata),
+	}
+	version := table.GetInt16Slot(messageVersion, 0)
+	if version != metadataVersionV4 && version != metadataVersionV5 {
+		return nil, nil, fmt.Errorf(
+			"arrowipc: unsupported metadata version %d", version)
+	}
+
+	bodyLength := table.GetInt64Slot(messageBodyLength, 0)
+	if bodyLength < 0 || bodyLength > int64(len(r.data)) {
+		return nil, nil, errMalformed
+	}
+	body := r.data[:bodyLength]
+	r.data = r.data[bodyLength:]
+
+	msg := &message{headerType: table.GetByteSlot(messageHeaderType, 0)}
+	offset := table.Offset(messageHeader)
+	if offset == 0 {
+		return nil, nil, errMalformed
+	}
+	table.Union(&msg.header, flatbuffers.UOffsetT(offset))
+
+	return msg, body, nil
+}
+
+// readSchema returns empty columns for the fields of a Schema table.
+func readSchema(schema *flatbuffers.Table) ([]Column, error) {
+	offset := flatbuffers.UOffsetT(schema.Offset(schemaFields))
+	if offset == 0 {
+		return nil, nil
+	}
+
+	count, err := vectorLen(schema, offset, 4)
+	if err != nil {
+		return nil, err
+	}
+	start := schema.Vector(offset)
+	columns := make([]Column, count)
+	for i := range columns {
+		field := flatbuffers.Table{
+			Bytes: schema.Bytes,
+			Pos:   schema.Indirect(start + flatbuffers.UOffsetT(4*i)),
+		}
+
+		if nameOffset := field.Offset(fieldName); nameOffset != 0 {
+			columns[i].Name = string(field.ByteVector(
+				flatbuffers.UOffsetT(nameOffset) + field.Pos))
+		}
+
+		typ, err := readFieldType(&field)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"arrowipc: column %q: %v", columns[i].Name, err)
+		}
+		columns[i].Type = typ
+	}
+
+	return columns, nil
+}
+
+// readFieldType returns the type of a Field table.
+func readFieldType(field *flatbuffers.Table) (Type, error) {
+	offset := field.Offset(fieldType)
+	if offset == 0 {
+		return 0, errMalformed
+	}
+
+	var typ flatbuffers.Table
+	field.Union(&typ, flatbuffers.UOffsetT(offset))
+
+	switch typeType := field.GetByteSlot(fieldTypeType, 0); typeType {
+	case typeInt:
+		if typ.GetInt32Slot(intBitWidth, 0) == 64 &&
+			typ.GetBoolSlot(intSigned, false) {
+			return Int64, nil
+		}
+	case typeFloatingPoint:
+		if typ.GetInt16Slot(floatingPointPrecision, 0) == precisionDouble {
+			return Float64, nil
+		}
+	}
+
+	return 0, errors.New("unsupported type")
+}
+
+// readRecordBatch appends the values of a RecordBatch table to the columns.
+func readRecordBatch(
+	recordBatch *flatbuffers.Table,
+	body []byte,
+	columns []Column,
+) error {
+	if recordBatch.Offset(recordBatchCompression) != 0 {
+		return errors.New("arrowipc: compressed record batches aren't supported")
+	}
+
+	length := recordBatch.GetInt64Slot(recordBatchLength, 0)
+	nodes, err := readStructs(recordBatch, recordBatchNodes)
+	if err != nil {
+		return err
+	}
+	buffers, err := readStructs(recordBatch, recordBatchBuffers)
+	if err != nil {
+		return err
+	}
+	if len(nodes) != len(columns) || len(buffers) != 2*len(columns) {
+		return errMalformed
+	}
+
+	for i := range columns {
+		column := &columns[i]
+
+		// FieldNode: length, null_count.
+		if nodes[i][0] != length {
+			return errMalformed
+		}
+		if nodes[i][1] != 0 {
+			return fmt.Errorf(
+				"arrowipc: column %q has null values", column.Name)
+		}
+
+		// Buffer: offset, length. The validity buffer is unused
+		// without nulls.
+		offset, size := buffers[2*i+1][0], buffers[2*i+1][1]
+		if length < 0 || length > int64(len(body))/8 ||
+			offset < 0 || size < 8*length ||
+			offset > int64(len(body))-8*length {
+			return errMalformed
+		}
+		values := body[offset : offset+8*length]
+
+		for j := 0; j < len(values); j += 8 {
+			value := binary.LittleEndian.Uint64(values[j:])
+			if column.Type == Float64 {
+				column.Floats = append(column.Floats, math.Float64frombits(value))
+			} else {
+				column.Ints = append(column.Ints, int64(value))
+			}
+		}
+	}
+
+	return nil
+}
+
+// readStructs returns the fields of a vector of structs made of two
+// 64-bit integers, such as FieldNode and Buffer.
+func readStructs(
+	table *flatbuffers.Table,
+	vtableOffset flatbuffers.VOffsetT,
+) ([][2]int64, error) {
+	offset := flatbuffers.UOffsetT(table.Offset(vtableOffset))
+	if offset == 0 {
+		return nil, nil
+	}
+
+	count, err := vectorLen(table, offset, 16)
+	if err != nil {
+		return nil, err
+	}
+	structs := make([][2]int64, count)
+	start := table.Vector(offset)
+	for i := range structs {
+		pos := start + flatbuffers.UOffsetT(16*i)
+		structs[i][0] = table.GetInt64(pos)
+		structs[i][1] = table.GetInt64(pos + 8)
+	}
+	return structs, nil
+}
+
+// vectorLen returns the length of the table's vector at the offset,
+// checking that its elements fit in the flatbuffer.
+func vectorLen(
+	table *flatbuffers.Table,
+	offset flatbuffers.UOffsetT,
+	elemSize int,
+) (int, error) {
+	count := table.VectorLen(offset)
+	if count < 0 || count > len(table.Bytes)/elemSize {
+		return 0, errMalformed
+	}
+	return count, nil
+}
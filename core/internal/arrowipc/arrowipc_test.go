@@ -0,0 +1,107 @@
+package arrowipc_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/arrowipc"
+)
+
+func TestRoundTrip(t *testing.T) {
+	columns := []arrowipc.Column{
+		{Name: "_step", Type: arrowipc.Int64, Ints: []int64{0, 1, math.MinInt64}},
+		{Name: "loss", Type: arrowipc.Float64, Floats: []float64{0.5, math.Inf(-1), -0.0}},
+		{Name: "", Type: arrowipc.Int64, Ints: []int64{7, 8, math.MaxInt64}},
+	}
+
+	data, err := arrowipc.AppendStream([]byte("prefix"), columns)
+	require.NoError(t, err)
+	assert.Equal(t, "prefix", string(data[:6]))
+
+	result, err := arrowipc.ReadStream(data[6:])
+	require.NoError(t, err)
+	assert.Equal(t, columns, result)
+}
+
+func TestRoundTrip_NaN(t *testing.T) {
+	columns := []arrowipc.Column{
+		{Name: "x", Type: arrowipc.Float64, Floats: []float64{math.NaN()}},
+	}
+
+	data, err := arrowipc.AppendStream(nil, columns)
+	require.NoError(t, err)
+	result, err := arrowipc.ReadStream(data)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.True(t, math.IsNaN(result[0].Floats[0]))
+}
+
+func TestRoundTrip_NoRows(t *testing.T) {
+	columns := []arrowipc.Column{{Name: "x", Type: arrowipc.Int64}}
+
+	data, err := arrowipc.AppendStream(nil, columns)
+	require.NoError(t, err)
+	result, err := arrowipc.ReadStream(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, columns, result)
+}
+
+func TestAppendStream_Aligned(t *testing.T) {
+	data, err := arrowipc.AppendStream(nil, []arrowipc.Column{
+		{Name: "abc", Type: arrowipc.Float64, Floats: []float64{1, 2}},
+	})
+	require.NoError(t, err)
+
+	// Metadata is padded to a multiple of 8 bytes so that bodies stay
+	// aligned.
+	schemaSize := binary.LittleEndian.Uint32(data[4:])
+	assert.Zero(t, schemaSize%8)
+	assert.Zero(t, len(data)%8)
+}
+
+func TestAppendStream_MismatchedLengths(t *testing.T) {
+	_, err := arrowipc.AppendStream(nil, []arrowipc.Column{
+		{Name: "a", Type: arrowipc.Int64, Ints: []int64{1, 2}},
+		{Name: "b", Type: arrowipc.Float64, Floats: []float64{1}},
+	})
+
+	assert.ErrorContains(t, err, `column "b" has 1 values, expected 2`)
+}
+
+func TestAppendStream_UnsupportedType(t *testing.T) {
+	_, err := arrowipc.AppendStream(nil, []arrowipc.Column{{Name: "a"}})
+
+	assert.ErrorContains(t, err, `column "a" has unsupported type`)
+}
+
+func TestReadStream_Truncated(t *testing.T) {
+	data, err := arrowipc.AppendStream(nil, []arrowipc.Column{
+		{Name: "a", Type: arrowipc.Int64, Ints: []int64{1, 2, 3}},
+	})
+	require.NoError(t, err)
+
+	// Cut into the record batch body, before the end-of-stream marker.
+	_, err = arrowipc.ReadStream(data[:len(data)-9])
+
+	assert.Error(t, err)
+}
+
+func TestReadStream_Malformed(t *testing.T) {
+	data, err := arrowipc.AppendStream(nil, []arrowipc.Column{
+		{Name: "a", Type: arrowipc.Int64, Ints: []int64{1, 2, 3}},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < len(data); i++ {
+		corrupted := append([]byte{}, data...)
+		corrupted[i] ^= 0xFF
+
+		assert.NotPanics(t, func() { _, _ = arrowipc.ReadStream(data[:i]) })
+		assert.NotPanics(t, func() { _, _ = arrowipc.ReadStream(corrupted) })
+	}
+}
@@ -0,0 +1,181 @@
+// Package capabilities detects optional features of the W&B backend.
+//
+// Backends of different versions support different GraphQL fields, and
+// operations using a missing field fail entirely. Features are detected by
+// introspecting the GraphQL schema so that unsupported features can be
+// skipped with a single warning rather than failing repeatedly.
+package capabilities
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// Feature is an optional backend feature.
+//
+// It is supported if the GraphQL type has the field.
+type Feature struct {
+	// Description names the feature in warnings.
+	Description string
+
+	// TypeName is the GraphQL object or input type with the field.
+	TypeName string
+
+	// FieldName is the field whose presence indicates support.
+	FieldName string
+}
+
+// ArtifactAliasAdditions is adding aliases to an existing artifact version
+// through the AddAliases mutation.
+var ArtifactAliasAdditions = Feature{
+	Description: "adding aliases to existing artifact versions",
+	TypeName:    "AddAliasesInput",
+	FieldName:   "aliases",
+}
+
+// OptionalFeatures are the features detected when a stream starts.
+var OptionalFeatures = []Feature{
+	ArtifactAliasAdditions,
+}
+
+// Capabilities are the detected features of a backend.
+//
+// Each GraphQL type is introspected at most once per session. If the
+// schema can't be introspected, features are assumed to be supported, so
+// that behavior is unchanged for backends that disable introspection.
+//
+// A nil Capabilities supports all features.
+type Capabilities struct {
+	mu sync.Mutex
+
+	client  graphql.Client
+	logger  *observability.CoreLogger
+	printer *observability.Printer
+
+	// typeFields are the known fields of each introspected type, or nil for
+	// types that couldn't be introspected.
+	typeFields map[string]map[string]bool
+
+	// warned are features whose absence was already reported.
+	warned map[Feature]bool
+}
+
+func New(
+	client graphql.Client,
+	logger *observability.CoreLogger,
+	printer *observability.Printer,
+) *Capabilities {
+	return &Capabilities{
+		client:     client,
+		logger:     logger,
+		printer:    printer,
+		typeFields: make(map[string]map[string]bool),
+		warned:     make(map[Feature]bool),
+	}
+}
+
+// Detect checks which of the features the backend supports.
+//
+// It is meant to be called when a stream starts, so that unsupported
+// features are reported once up front. The results are cached for
+// Supports, which waits for detection to finish.
+func (c *Capabilities) Detect(ctx context.Context, features ...Feature) {
+	for _, feature := range features {
+		c.Supports(ctx, feature)
+	}
+}
+
+// Supports reports whether the backend supports the feature.
+//
+// The first time an unsupported feature is checked, a warning is printed
+// saying that it is skipped.
+func (c *Capabilities) Supports(ctx context.Context, feature Feature) bool {
+	if c == nil || c.client == nil {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fields, checked := c.typeFields[feature.TypeName]
+	if !checked {
+		fields = c.introspect(ctx, feature.TypeName)
+		c.typeFields[feature.TypeName] = fields
+	}
+
+	if fields == nil || fields[feature.FieldName] {
+		return true
+	}
+
+	if !c.warned[feature] {
+		c.warned[feature] = true
+		message := fmt.Sprintf(
+			"The W&B server doesn't support %s; skipping it.",
+			feature.Description)
+		c.logger.Warn("capabilities: unsupported feature", "feature", feature.Description)
+		if c.printer != nil {
+			c.printer.Write(message)
+		}
+	}
+	return false
+}
+
+const typeFieldsQuery = `
+query TypeFields($name: String!) {
+	__type(name: $name) {
+		fields { name }
+		inputFields { name }
+	}
+}
+`
+
+type typeFieldsResponse struct {
+	Type *struct {
+		Fields      []struct{ Name string } `json:"fields"`
+		InputFields []struct{ Name string } `json:"inputFields"`
+	} `json:"__type"`
+}
+
+// introspect returns the names of the type's fields.
+//
+// Returns nil if the schema can't be introspected. A type that doesn't
+// exist has no fields.
+func (c *Capabilities) introspect(
+	ctx context.Context,
+	typeName string,
+) map[string]bool {
+	var data typeFieldsResponse
+	err := c.client.MakeRequest(
+		ctx,
+		&graphql.Request{
+			OpName:    "TypeFields",
+			Query:     typeFieldsQuery,
+			Variables: map[string]any{"name": typeName},
+		},
+		&graphql.Response{Data: &data},
+	)
+	if err != nil {
+		c.logger.Warn(
+			"capabilities: failed to introspect type, assuming all features are supported",
+			"type", typeName,
+			"error", err,
+		)
+		return nil
+	}
+
+	fields := make(map[string]bool)
+	if data.Type == nil {
+		return fields
+	}
+	for _, field := range data.Type.Fields {
+		fields[field.Name] = true
+	}
+	for _, field := range data.Type.InputFields {
+		fields[field.Name] = true
+	}
+	return fields
+}
@@ -0,0 +1,78 @@
+package capabilities_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/capabilities"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+var testFeature = capabilities.Feature{
+	Description: "test feature",
+	TypeName:    "TestInput",
+	FieldName:   "newField",
+}
+
+func TestSupports_FieldPresent(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("TypeFields"),
+		`{"__type": {"inputFields": [{"name": "oldField"}, {"name": "newField"}]}}`,
+	)
+	c := capabilities.New(mockGQL, observability.NewNoOpLogger(), nil)
+
+	assert.True(t, c.Supports(context.Background(), testFeature))
+}
+
+func TestSupports_FieldMissing_WarnsOnce(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("TypeFields"),
+		`{"__type": {"inputFields": [{"name": "oldField"}]}}`,
+	)
+	printer := observability.NewPrinter()
+	c := capabilities.New(mockGQL, observability.NewNoOpLogger(), printer)
+
+	assert.False(t, c.Supports(context.Background(), testFeature))
+	assert.False(t, c.Supports(context.Background(), testFeature))
+
+	assert.Len(t, mockGQL.AllRequests(), 1)
+	assert.Equal(t,
+		[]string{"The W&B server doesn't support test feature; skipping it."},
+		printer.Read())
+}
+
+func TestSupports_IntrospectionFails_AssumesSupported(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	c := capabilities.New(mockGQL, observability.NewNoOpLogger(), nil)
+
+	assert.True(t, c.Supports(context.Background(), testFeature))
+	assert.True(t, c.Supports(context.Background(), testFeature))
+	assert.Len(t, mockGQL.AllRequests(), 1)
+}
+
+func TestSupports_Nil(t *testing.T) {
+	var c *capabilities.Capabilities
+
+	assert.True(t, c.Supports(context.Background(), testFeature))
+}
+
+func TestDetect_CachesResults(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("TypeFields"),
+		`{"__type": {"inputFields": [{"name": "oldField"}]}}`,
+	)
+	printer := observability.NewPrinter()
+	c := capabilities.New(mockGQL, observability.NewNoOpLogger(), printer)
+
+	c.Detect(context.Background(), testFeature)
+	assert.Len(t, printer.Read(), 1)
+
+	assert.False(t, c.Supports(context.Background(), testFeature))
+	assert.Len(t, mockGQL.AllRequests(), 1)
+	assert.Empty(t, printer.Read())
+}
@@ -0,0 +1,104 @@
+package clocksync
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// timestampKey is the history key holding when a row was logged, in
+// seconds since the Unix epoch.
+const timestampKey = "_timestamp"
+
+// Corrector shifts timestamps recorded with the local clock.
+//
+// A nil Corrector leaves timestamps unchanged.
+type Corrector struct {
+	// offset is added to local times.
+	offset time.Duration
+}
+
+// NewCorrectorOrNil returns a Corrector adding the offset to timestamps,
+// or nil if the offset is zero.
+func NewCorrectorOrNil(offset time.Duration) *Corrector {
+	if offset == 0 {
+		return nil
+	}
+	return &Corrector{offset: offset}
+}
+
+// Offset returns the duration added to timestamps.
+func (c *Corrector) Offset() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return c.offset
+}
+
+// Now returns the corrected current time.
+func (c *Corrector) Now() time.Time {
+	return time.Now().Add(c.Offset())
+}
+
+// Correct shifts the timestamps in the record.
+//
+// This includes the timestamps of history rows, including their
+// "_timestamp" values, system metrics, console output and the run's start
+// time. The record is modified in place.
+func (c *Corrector) Correct(record *service.Record) {
+	if c == nil {
+		return
+	}
+
+	switch x := record.GetRecordType().(type) {
+	case *service.Record_History:
+		x.History.Timestamp = c.correctTimestamp(x.History.GetTimestamp())
+		for _, item := range x.History.GetItem() {
+			c.correctTimestampItem(item)
+		}
+	case *service.Record_Stats:
+		x.Stats.Timestamp = c.correctTimestamp(x.Stats.GetTimestamp())
+	case *service.Record_Output:
+		x.Output.Timestamp = c.correctTimestamp(x.Output.GetTimestamp())
+	case *service.Record_OutputRaw:
+		x.OutputRaw.Timestamp = c.correctTimestamp(x.OutputRaw.GetTimestamp())
+	case *service.Record_Run:
+		x.Run.StartTime = c.correctTimestamp(x.Run.GetStartTime())
+	case *service.Record_Request:
+		if partial := x.Request.GetPartialHistory(); partial != nil {
+			partial.Timestamp = c.correctTimestamp(partial.GetTimestamp())
+			for _, item := range partial.GetItem() {
+				c.correctTimestampItem(item)
+			}
+		}
+	}
+}
+
+// correctTimestamp returns the shifted timestamp, or nil if it is nil.
+func (c *Corrector) correctTimestamp(
+	timestamp *timestamppb.Timestamp,
+) *timestamppb.Timestamp {
+	if timestamp == nil {
+		return nil
+	}
+	return timestamppb.New(timestamp.AsTime().Add(c.offset))
+}
+
+// correctTimestampItem shifts the item's value if it is a "_timestamp".
+func (c *Corrector) correctTimestampItem(item *service.HistoryItem) {
+	if item.GetKey() != timestampKey {
+		return
+	}
+
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(item.GetValueJson()), 64)
+	if err != nil {
+		return
+	}
+
+	item.ValueJson = strconv.FormatFloat(
+		seconds+c.offset.Seconds(), 'f', -1, 64)
+}
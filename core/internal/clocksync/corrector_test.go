@@ -0,0 +1,71 @@
+package clocksync_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/clocksync"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestCorrect_History(t *testing.T) {
+	logged := time.Unix(1000, 0)
+	record := &service.Record{RecordType: &service.Record_History{
+		History: &service.HistoryRecord{
+			Timestamp: timestamppb.New(logged),
+			Item: []*service.HistoryItem{
+				{Key: "_timestamp", ValueJson: "1000.25"},
+				{Key: "loss", ValueJson: "1000"},
+			},
+		},
+	}}
+
+	clocksync.NewCorrectorOrNil(90 * time.Second).Correct(record)
+
+	assert.Equal(t,
+		logged.Add(90*time.Second),
+		record.GetHistory().GetTimestamp().AsTime().Local())
+	assert.Equal(t, "1090.25", record.GetHistory().GetItem()[0].GetValueJson())
+	assert.Equal(t, "1000", record.GetHistory().GetItem()[1].GetValueJson())
+}
+
+func TestCorrect_Stats(t *testing.T) {
+	sampled := time.Unix(1000, 0)
+	record := &service.Record{RecordType: &service.Record_Stats{
+		Stats: &service.StatsRecord{Timestamp: timestamppb.New(sampled)},
+	}}
+
+	clocksync.NewCorrectorOrNil(-time.Second).Correct(record)
+
+	assert.Equal(t,
+		sampled.Add(-time.Second),
+		record.GetStats().GetTimestamp().AsTime().Local())
+}
+
+func TestCorrect_NilTimestampUnchanged(t *testing.T) {
+	record := &service.Record{RecordType: &service.Record_Output{
+		Output: &service.OutputRecord{},
+	}}
+
+	clocksync.NewCorrectorOrNil(time.Second).Correct(record)
+
+	assert.Nil(t, record.GetOutput().GetTimestamp())
+}
+
+func TestNilCorrector(t *testing.T) {
+	corrector := clocksync.NewCorrectorOrNil(0)
+	record := &service.Record{RecordType: &service.Record_History{
+		History: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: "_timestamp", ValueJson: "1"}},
+		},
+	}}
+
+	corrector.Correct(record)
+
+	assert.Nil(t, corrector)
+	assert.Zero(t, corrector.Offset())
+	assert.Equal(t, "1", record.GetHistory().GetItem()[0].GetValueJson())
+}
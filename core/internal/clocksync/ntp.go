@@ -0,0 +1,102 @@
+// Package clocksync corrects timestamps recorded on hosts whose clocks are
+// wrong, such as containers with misconfigured clocks.
+package clocksync
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// ntpPacketBytes is the size of an NTP packet without extensions.
+	ntpPacketBytes = 48
+
+	// ntpDefaultPort is the port of NTP servers.
+	ntpDefaultPort = "123"
+
+	// ntpEpochOffset is the number of seconds from the NTP epoch (1900)
+	// to the Unix epoch (1970).
+	ntpEpochOffset = 2208988800
+
+	// ntpModeClient and ntpModeServer are the modes of requests and
+	// responses.
+	ntpModeClient = 3
+	ntpModeServer = 4
+
+	// ntpVersion is the protocol version of requests.
+	ntpVersion = 4
+)
+
+// QueryOffset asks an NTP server how far the local clock is behind it.
+//
+// The server is a host name or address, optionally with a port. The result
+// is positive if the local clock is slow and must be added to local times
+// to correct them. It uses a single SNTP exchange (RFC 4330), which is
+// accurate to about half the network round-trip time.
+func QueryOffset(ctx context.Context, server string) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, ntpDefaultPort)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return 0, fmt.Errorf("clocksync: %v", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	request := make([]byte, ntpPacketBytes)
+	request[0] = ntpVersion<<3 | ntpModeClient
+
+	sentAt := time.Now()
+	putNTPTime(request[40:48], sentAt)
+	if _, err := conn.Write(request); err != nil {
+		return 0, fmt.Errorf("clocksync: %v", err)
+	}
+
+	response := make([]byte, ntpPacketBytes)
+	n, err := conn.Read(response)
+	receivedAt := time.Now()
+	if err != nil {
+		return 0, fmt.Errorf("clocksync: %v", err)
+	}
+
+	switch {
+	case n < ntpPacketBytes:
+		return 0, errors.New("clocksync: NTP response is too short")
+	case response[0]&0x7 != ntpModeServer:
+		return 0, errors.New("clocksync: NTP response is not from a server")
+	case response[1] == 0:
+		return 0, errors.New("clocksync: NTP server refused the request")
+	case !bytes.Equal(response[24:32], request[40:48]):
+		return 0, errors.New("clocksync: NTP response is for another request")
+	}
+
+	serverReceivedAt := ntpTime(response[32:40])
+	serverSentAt := ntpTime(response[40:48])
+	return (serverReceivedAt.Sub(sentAt) + serverSentAt.Sub(receivedAt)) / 2, nil
+}
+
+// putNTPTime encodes the time as an NTP timestamp.
+func putNTPTime(b []byte, t time.Time) {
+	seconds := uint64(t.Unix() + ntpEpochOffset)
+	fraction := uint64(t.Nanosecond()) << 32 / uint64(time.Second)
+	binary.BigEndian.PutUint64(b, seconds<<32|fraction)
+}
+
+// ntpTime decodes an NTP timestamp.
+func ntpTime(b []byte) time.Time {
+	timestamp := binary.BigEndian.Uint64(b)
+	seconds := int64(timestamp>>32) - ntpEpochOffset
+	nanos := (timestamp & 0xffffffff) * uint64(time.Second) >> 32
+	return time.Unix(seconds, int64(nanos))
+}
@@ -0,0 +1,100 @@
+package clocksync_test
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/clocksync"
+)
+
+// serveNTP answers NTP requests on a local port with a clock that is ahead
+// by the offset, modifying responses with `tamper`.
+//
+// Returns the server's address.
+func serveNTP(
+	t *testing.T,
+	offset time.Duration,
+	tamper func(response []byte),
+) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		request := make([]byte, 48)
+		for {
+			_, addr, err := conn.ReadFrom(request)
+			if err != nil {
+				return
+			}
+
+			response := make([]byte, 48)
+			response[0] = 4<<3 | 4 // version 4, server mode
+			response[1] = 1        // stratum
+			copy(response[24:32], request[40:48])
+			now := ntpTimestamp(time.Now().Add(offset))
+			binary.BigEndian.PutUint64(response[32:40], now)
+			binary.BigEndian.PutUint64(response[40:48], now)
+			if tamper != nil {
+				tamper(response)
+			}
+
+			_, _ = conn.WriteTo(response, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func ntpTimestamp(t time.Time) uint64 {
+	seconds := uint64(t.Unix() + 2208988800)
+	fraction := uint64(t.Nanosecond()) << 32 / uint64(time.Second)
+	return seconds<<32 | fraction
+}
+
+func queryWithTimeout(server string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return clocksync.QueryOffset(ctx, server)
+}
+
+func TestQueryOffset(t *testing.T) {
+	server := serveNTP(t, -90*time.Second, nil)
+
+	offset, err := queryWithTimeout(server)
+
+	require.NoError(t, err)
+	assert.InDelta(t, -90*time.Second, offset, float64(100*time.Millisecond))
+}
+
+func TestQueryOffset_KissOfDeath(t *testing.T) {
+	server := serveNTP(t, 0, func(response []byte) { response[1] = 0 })
+
+	_, err := queryWithTimeout(server)
+
+	assert.ErrorContains(t, err, "refused")
+}
+
+func TestQueryOffset_WrongOrigin(t *testing.T) {
+	server := serveNTP(t, 0, func(response []byte) { response[24]++ })
+
+	_, err := queryWithTimeout(server)
+
+	assert.ErrorContains(t, err, "another request")
+}
+
+func TestQueryOffset_Timeout(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = queryWithTimeout(conn.LocalAddr().String())
+
+	assert.Error(t, err)
+}
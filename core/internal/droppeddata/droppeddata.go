@@ -0,0 +1,136 @@
+// Package droppeddata keeps track of data that never made it to the
+// backend, so that it can be reported when a run finishes.
+package droppeddata
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Category is a kind of dropped data.
+type Category string
+
+const (
+	// HistoryRateLimited counts history points over the rate limit.
+	HistoryRateLimited Category = "history_rate_limited"
+
+	// MetricLimitExceeded counts history and summary values of new metrics
+	// over the limit on distinct metrics.
+	MetricLimitExceeded Category = "metric_limit_exceeded"
+
+	// ValueTooLarge counts history and summary values over the size limit.
+	ValueTooLarge Category = "value_too_large"
+
+	// FileUploadFailed counts files that failed to upload.
+	FileUploadFailed Category = "file_upload_failed"
+
+	// LoggedAfterFinish counts history, summary and config updates logged
+	// after the run finished.
+	LoggedAfterFinish Category = "logged_after_finish"
+
+	// SystemMetricsDropped counts system metrics samples dropped because
+	// too many were waiting to be sent.
+	SystemMetricsDropped Category = "system_metrics_dropped"
+)
+
+// maxExamples is how many keys or paths are kept per category.
+const maxExamples = 5
+
+// Tracker counts dropped data by category.
+//
+// It is safe for concurrent use. A nil Tracker ignores everything.
+type Tracker struct {
+	mu sync.Mutex
+
+	// categories are the counts and examples for each category.
+	categories map[Category]*categoryStats
+}
+
+type categoryStats struct {
+	count    int64
+	examples []string
+}
+
+// New returns an empty Tracker.
+func New() *Tracker {
+	return &Tracker{categories: make(map[Category]*categoryStats)}
+}
+
+// Record counts one dropped item.
+//
+// The key identifies the item, like a metric name or a file path. The
+// first few distinct keys in each category are kept as examples.
+func (t *Tracker) Record(category Category, key string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats, ok := t.categories[category]
+	if !ok {
+		stats = &categoryStats{}
+		t.categories[category] = stats
+	}
+
+	stats.count++
+	if len(stats.examples) < maxExamples && !slices.Contains(stats.examples, key) {
+		stats.examples = append(stats.examples, key)
+	}
+}
+
+// IsEmpty reports whether nothing was dropped.
+func (t *Tracker) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.categories) == 0
+}
+
+// Summary returns the counts and examples so far, sorted by category.
+func (t *Tracker) Summary() *service.DroppedDataSummary {
+	summary := &service.DroppedDataSummary{}
+	if t == nil {
+		return summary
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for category, stats := range t.categories {
+		summary.Categories = append(summary.Categories,
+			&service.DroppedDataCategory{
+				Name:     string(category),
+				Count:    stats.count,
+				Examples: append([]string(nil), stats.examples...),
+			})
+	}
+	sort.Slice(summary.Categories, func(i, j int) bool {
+		return summary.Categories[i].Name < summary.Categories[j].Name
+	})
+
+	return summary
+}
+
+// Format returns a human-readable description of a summary, with one
+// line per category, or the empty string if nothing was dropped.
+func Format(summary *service.DroppedDataSummary) string {
+	lines := make([]string, 0, len(summary.GetCategories()))
+	for _, category := range summary.GetCategories() {
+		line := fmt.Sprintf("%s: %d", category.GetName(), category.GetCount())
+		if len(category.GetExamples()) > 0 {
+			line += fmt.Sprintf(" (e.g. %s)", strings.Join(category.GetExamples(), ", "))
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
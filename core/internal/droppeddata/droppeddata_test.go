@@ -0,0 +1,61 @@
+package droppeddata_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/droppeddata"
+)
+
+func TestNilTracker(t *testing.T) {
+	var tracker *droppeddata.Tracker
+
+	tracker.Record(droppeddata.FileUploadFailed, "file.txt")
+
+	assert.True(t, tracker.IsEmpty())
+	assert.Empty(t, tracker.Summary().GetCategories())
+}
+
+func TestSummary(t *testing.T) {
+	tracker := droppeddata.New()
+
+	tracker.Record(droppeddata.ValueTooLarge, "a")
+	tracker.Record(droppeddata.FileUploadFailed, "file.txt")
+	tracker.Record(droppeddata.ValueTooLarge, "a")
+	tracker.Record(droppeddata.ValueTooLarge, "b")
+	summary := tracker.Summary()
+
+	require.Len(t, summary.Categories, 2)
+	assert.Equal(t, "file_upload_failed", summary.Categories[0].Name)
+	assert.EqualValues(t, 1, summary.Categories[0].Count)
+	assert.Equal(t, "value_too_large", summary.Categories[1].Name)
+	assert.EqualValues(t, 3, summary.Categories[1].Count)
+	assert.Equal(t, []string{"a", "b"}, summary.Categories[1].Examples)
+}
+
+func TestSummary_LimitsExamples(t *testing.T) {
+	tracker := droppeddata.New()
+
+	for i := 0; i < 10; i++ {
+		tracker.Record(droppeddata.HistoryRateLimited, fmt.Sprintf("metric%d", i))
+	}
+	summary := tracker.Summary()
+
+	require.Len(t, summary.Categories, 1)
+	assert.EqualValues(t, 10, summary.Categories[0].Count)
+	assert.Equal(t,
+		[]string{"metric0", "metric1", "metric2", "metric3", "metric4"},
+		summary.Categories[0].Examples)
+}
+
+func TestFormat(t *testing.T) {
+	tracker := droppeddata.New()
+	tracker.Record(droppeddata.FileUploadFailed, "a.txt")
+	tracker.Record(droppeddata.FileUploadFailed, "b.txt")
+
+	assert.Equal(t,
+		"file_upload_failed: 2 (e.g. a.txt, b.txt)",
+		droppeddata.Format(tracker.Summary()))
+}
@@ -0,0 +1,209 @@
+// Package faultinject injects synthetic network faults for testing.
+//
+// It simulates a flaky backend so that retry and offline behavior can be
+// tested without one. It is only enabled through a debug setting.
+package faultinject
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// defaultDelay is how long delayed requests wait if no delay is given.
+const defaultDelay = time.Second
+
+// Config is how often each kind of fault happens.
+type Config struct {
+	// DelayRate is the fraction of requests that are delayed.
+	DelayRate float64
+
+	// Delay is how long delayed requests wait before being sent.
+	Delay time.Duration
+
+	// ThrottleRate is the fraction of requests answered with a
+	// 429 Too Many Requests response instead of being sent.
+	ThrottleRate float64
+
+	// ResetRate is the fraction of requests that fail with a connection
+	// reset instead of being sent.
+	ResetRate float64
+
+	// Seed seeds the random choice of faults, for reproducible runs.
+	//
+	// If zero, a random seed is used.
+	Seed int64
+}
+
+// Parse parses a fault specification like "delay=0.1,throttle=0.05".
+//
+// The keys are "delay", "throttle" and "reset", whose values are the
+// fraction of requests with the fault, "delay_time" for the delay's length
+// and "seed" for the random seed. An empty specification disables faults.
+func Parse(spec string) (Config, error) {
+	config := Config{Delay: defaultDelay}
+	if spec == "" {
+		return config, nil
+	}
+
+	for _, field := range strings.Split(spec, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return Config{}, fmt.Errorf("faultinject: expected key=value, got %q", field)
+		}
+
+		var err error
+		switch key {
+		case "delay":
+			config.DelayRate, err = parseRate(value)
+		case "delay_time":
+			config.Delay, err = time.ParseDuration(value)
+		case "throttle":
+			config.ThrottleRate, err = parseRate(value)
+		case "reset":
+			config.ResetRate, err = parseRate(value)
+		case "seed":
+			config.Seed, err = strconv.ParseInt(value, 10, 64)
+		default:
+			err = fmt.Errorf("unknown fault %q", key)
+		}
+		if err != nil {
+			return Config{}, fmt.Errorf("faultinject: %s: %v", key, err)
+		}
+	}
+
+	if config.ThrottleRate+config.ResetRate > 1 {
+		return Config{}, fmt.Errorf(
+			"faultinject: throttle and reset rates add up to more than 1")
+	}
+
+	return config, nil
+}
+
+func parseRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("rate %v is not between 0 and 1", rate)
+	}
+	return rate, nil
+}
+
+// Enabled reports whether the configuration injects any faults.
+func (c Config) Enabled() bool {
+	return c.DelayRate > 0 || c.ThrottleRate > 0 || c.ResetRate > 0
+}
+
+// Injector adds faults to HTTP requests.
+//
+// A nil Injector injects nothing.
+type Injector struct {
+	config Config
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// New returns an Injector for the configuration, or nil if it injects
+// no faults.
+func New(config Config) *Injector {
+	if !config.Enabled() {
+		return nil
+	}
+
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return &Injector{
+		config: config,
+		rand:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Wrap returns a transport that injects faults into requests sent
+// through next.
+//
+// If the Injector is nil, next is returned unchanged so that there is no
+// overhead.
+func (inj *Injector) Wrap(next http.RoundTripper) http.RoundTripper {
+	if inj == nil {
+		return next
+	}
+	return &transport{injector: inj, next: next}
+}
+
+// roll returns two random numbers in [0, 1) to choose the faults.
+func (inj *Injector) roll() (delay, failure float64) {
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	return inj.rand.Float64(), inj.rand.Float64()
+}
+
+type transport struct {
+	injector *Injector
+	next     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	config := t.injector.config
+	delayRoll, failureRoll := t.injector.roll()
+
+	if delayRoll < config.DelayRate {
+		select {
+		case <-time.After(config.Delay):
+		case <-req.Context().Done():
+			closeBody(req)
+			return nil, req.Context().Err()
+		}
+	}
+
+	switch {
+	case failureRoll < config.ThrottleRate:
+		closeBody(req)
+		return throttledResponse(req), nil
+
+	case failureRoll < config.ThrottleRate+config.ResetRate:
+		closeBody(req)
+		return nil, &net.OpError{
+			Op:  "read",
+			Net: "tcp",
+			Err: os.NewSyscallError("read", syscall.ECONNRESET),
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// throttledResponse returns a synthetic 429 response to the request.
+func throttledResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:     "429 Too Many Requests (injected)",
+		StatusCode: http.StatusTooManyRequests,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Retry-After": []string{"1"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}
+}
+
+// closeBody closes the request body, as a RoundTripper must even when
+// it doesn't send the request.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
@@ -0,0 +1,113 @@
+package faultinject_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/faultinject"
+)
+
+func TestParse(t *testing.T) {
+	config, err := faultinject.Parse(
+		"delay=0.1, delay_time=2s, throttle=0.05, reset=0.01, seed=7")
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		faultinject.Config{
+			DelayRate:    0.1,
+			Delay:        2 * time.Second,
+			ThrottleRate: 0.05,
+			ResetRate:    0.01,
+			Seed:         7,
+		},
+		config)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	testCases := map[string]string{
+		"timeout=0.1":           `unknown fault "timeout"`,
+		"delay":                 "expected key=value",
+		"throttle=2":            "not between 0 and 1",
+		"delay_time=soon":       "delay_time",
+		"throttle=0.6,reset=.5": "add up to more than 1",
+	}
+
+	for spec, expected := range testCases {
+		t.Run(spec, func(t *testing.T) {
+			_, err := faultinject.Parse(spec)
+			assert.ErrorContains(t, err, expected)
+		})
+	}
+}
+
+func TestNew_DisabledIsInert(t *testing.T) {
+	config, err := faultinject.Parse("")
+	require.NoError(t, err)
+
+	injector := faultinject.New(config)
+	assert.Nil(t, injector)
+	assert.Same(t, http.DefaultTransport, injector.Wrap(http.DefaultTransport))
+}
+
+func TestWrap_InjectsFaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	send := func(config faultinject.Config) (*http.Response, error) {
+		client := &http.Client{
+			Transport: faultinject.New(config).Wrap(http.DefaultTransport),
+		}
+		resp, err := client.Get(server.URL)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	resp, err := send(faultinject.Config{ThrottleRate: 1})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	_, err = send(faultinject.Config{ResetRate: 1})
+	assert.True(t, errors.Is(err, syscall.ECONNRESET))
+
+	start := time.Now()
+	resp, err = send(faultinject.Config{
+		DelayRate: 1,
+		Delay:     50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestWrap_RateIsApproximate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	client := &http.Client{
+		Transport: faultinject.New(faultinject.Config{
+			ThrottleRate: 0.25,
+			Seed:         1,
+		}).Wrap(http.DefaultTransport),
+	}
+
+	throttled := 0
+	for i := 0; i < 400; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusTooManyRequests {
+			throttled++
+		}
+	}
+
+	assert.InDelta(t, 100, throttled, 40)
+}
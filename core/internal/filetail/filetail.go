@@ -0,0 +1,164 @@
+// Package filetail follows files that are being appended to.
+package filetail
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// Tailer reports lines appended to a file.
+//
+// Only newly-appended bytes are read on each change. If the file is
+// truncated or replaced, as happens when a log file is rotated, the
+// Tailer starts over from the beginning of the new file.
+type Tailer struct {
+	mu sync.Mutex
+
+	path   string
+	logger *observability.CoreLogger
+
+	// onLines is invoked with complete lines read from the file.
+	onLines func([]string)
+
+	// info is the file that was last read, used to detect replacement.
+	info os.FileInfo
+
+	// offset is the number of bytes of the file that were read.
+	offset int64
+
+	// partial is the incomplete last line of the file.
+	partial []byte
+
+	// isFinished is whether Finish was called.
+	isFinished bool
+}
+
+// New creates a Tailer for the file at the path.
+//
+// `onLines` is invoked with lines without their trailing newline. It may
+// run in a different goroutine.
+func New(
+	path string,
+	logger *observability.CoreLogger,
+	onLines func([]string),
+) *Tailer {
+	return &Tailer{
+		path:    path,
+		logger:  logger,
+		onLines: onLines,
+	}
+}
+
+// Start begins following the file and returns the lines it already
+// contains.
+//
+// Like in Finish, the existing lines are returned rather than passed to
+// the callback, so that the caller can process them synchronously. The
+// callback may only block on the caller once Start returns.
+//
+// The file must exist, or an error is returned.
+func (t *Tailer) Start(w watcher.Watcher) ([]string, error) {
+	if err := w.Watch(t.path, t.Poll); err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.readLines(), nil
+}
+
+// Poll reads any lines appended since the last read.
+func (t *Tailer) Poll() {
+	t.mu.Lock()
+	if t.isFinished {
+		t.mu.Unlock()
+		return
+	}
+	lines := t.readLines()
+	t.mu.Unlock()
+
+	if len(lines) > 0 {
+		t.onLines(lines)
+	}
+}
+
+// Finish reads the rest of the file and stops following it.
+//
+// The remaining lines, including an incomplete last line, are returned
+// rather than passed to the callback, so that the caller can process them
+// synchronously.
+func (t *Tailer) Finish() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.isFinished {
+		return nil
+	}
+	t.isFinished = true
+
+	lines := t.readLines()
+	if len(t.partial) > 0 {
+		lines = append(lines, string(t.partial))
+		t.partial = nil
+	}
+	return lines
+}
+
+// readLines reads new complete lines from the file.
+//
+// The mutex must be held.
+func (t *Tailer) readLines() []string {
+	file, err := os.Open(t.path)
+	if err != nil {
+		// The file may be temporarily missing while it is rotated.
+		if !os.IsNotExist(err) {
+			t.logger.CaptureError("filetail: failed to open file", err, "path", t.path)
+		}
+		return nil
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		t.logger.CaptureError("filetail: failed to stat file", err, "path", t.path)
+		return nil
+	}
+
+	if t.info != nil && (!os.SameFile(t.info, info) || info.Size() < t.offset) {
+		t.logger.Info("filetail: file was rotated", "path", t.path)
+		t.offset = 0
+		t.partial = nil
+	}
+	t.info = info
+
+	if info.Size() == t.offset {
+		return nil
+	}
+
+	data, err := io.ReadAll(io.NewSectionReader(file, t.offset, info.Size()-t.offset))
+	if err != nil {
+		t.logger.CaptureError("filetail: failed to read file", err, "path", t.path)
+		return nil
+	}
+	t.offset += int64(len(data))
+
+	data = append(t.partial, data...)
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		t.partial = data
+		return nil
+	}
+	t.partial = bytes.Clone(data[end+1:])
+
+	lines := strings.Split(string(data[:end]), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
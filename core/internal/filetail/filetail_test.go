@@ -0,0 +1,95 @@
+package filetail_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetail"
+	"github.com/wandb/wandb/core/internal/watchertest"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func appendToFile(t *testing.T, path string, content string) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func setup(t *testing.T) (string, *watchertest.FakeWatcher, *filetail.Tailer, *[]string) {
+	path := filepath.Join(t.TempDir(), "train.log")
+	appendToFile(t, path, "existing\n")
+
+	var lines []string
+	w := watchertest.NewFakeWatcher()
+	tailer := filetail.New(path, observability.NewNoOpLogger(),
+		func(newLines []string) { lines = append(lines, newLines...) })
+	existing, err := tailer.Start(w)
+	require.NoError(t, err)
+	lines = append(lines, existing...)
+
+	return path, w, tailer, &lines
+}
+
+func TestReadsAppendedLines(t *testing.T) {
+	path, w, _, lines := setup(t)
+
+	appendToFile(t, path, "one\ntw")
+	w.OnChange(path)
+	appendToFile(t, path, "o\r\nthree\n")
+	w.OnChange(path)
+
+	assert.Equal(t, []string{"existing", "one", "two", "three"}, *lines)
+}
+
+func TestTruncatedFile(t *testing.T) {
+	path, w, _, lines := setup(t)
+
+	require.NoError(t, os.Truncate(path, 0))
+	appendToFile(t, path, "new\n")
+	w.OnChange(path)
+
+	assert.Equal(t, []string{"existing", "new"}, *lines)
+}
+
+func TestReplacedFile(t *testing.T) {
+	path, w, _, lines := setup(t)
+
+	rotated := filepath.Join(filepath.Dir(path), "train.log.1")
+	require.NoError(t, os.Rename(path, rotated))
+	appendToFile(t, path, "after rotation, longer than before\n")
+	w.OnChange(path)
+
+	assert.Equal(t,
+		[]string{"existing", "after rotation, longer than before"},
+		*lines)
+}
+
+func TestFinish(t *testing.T) {
+	path, w, tailer, lines := setup(t)
+
+	appendToFile(t, path, "last\nno newline")
+	remaining := tailer.Finish()
+	appendToFile(t, path, "ignored\n")
+	w.OnChange(path)
+
+	assert.Equal(t, []string{"existing"}, *lines)
+	assert.Equal(t, []string{"last", "no newline"}, remaining)
+	assert.Empty(t, tailer.Finish())
+}
+
+func TestStart_MissingFile(t *testing.T) {
+	tailer := filetail.New(
+		filepath.Join(t.TempDir(), "missing.log"),
+		observability.NewNoOpLogger(),
+		func([]string) {},
+	)
+
+	_, err := tailer.Start(watchertest.NewFakeWatcher())
+
+	assert.Error(t, err)
+}
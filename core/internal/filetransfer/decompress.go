@@ -0,0 +1,48 @@
+package filetransfer
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Decompressor returns a reader of the decompressed contents of r.
+type Decompressor func(r io.Reader) (io.ReadCloser, error)
+
+var (
+	decompressorsMu sync.RWMutex
+	decompressors   = map[string]Decompressor{
+		"gzip": func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	}
+)
+
+// RegisterDecompressor adds support for downloading files stored with
+// the given encoding, such as "zstd".
+//
+// Only "gzip" is supported by default.
+func RegisterDecompressor(encoding string, decompressor Decompressor) {
+	decompressorsMu.Lock()
+	defer decompressorsMu.Unlock()
+	decompressors[encoding] = decompressor
+}
+
+// NewDecompressingReader returns a reader that decompresses r.
+//
+// An empty or "identity" encoding returns r unchanged.
+func NewDecompressingReader(encoding string, r io.Reader) (io.ReadCloser, error) {
+	if encoding == "" || encoding == "identity" {
+		return io.NopCloser(r), nil
+	}
+
+	decompressorsMu.RLock()
+	decompressor, ok := decompressors[encoding]
+	decompressorsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("filetransfer: unsupported encoding %q", encoding)
+	}
+
+	return decompressor(r)
+}
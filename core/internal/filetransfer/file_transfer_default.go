@@ -1,6 +1,8 @@
 package filetransfer
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -23,6 +25,10 @@ type DefaultFileTransfer struct {
 
 	// fileTransferStats is used to track upload/download progress
 	fileTransferStats FileTransferStats
+
+	// urlRefreshMax is how many times an upload may get a new URL after
+	// its URL expires
+	urlRefreshMax int
 }
 
 // NewDefaultFileTransfer creates a new fileTransfer
@@ -30,17 +36,84 @@ func NewDefaultFileTransfer(
 	client *retryablehttp.Client,
 	logger *observability.CoreLogger,
 	fileTransferStats FileTransferStats,
+	urlRefreshMax int,
 ) *DefaultFileTransfer {
 	fileTransfer := &DefaultFileTransfer{
 		logger:            logger,
 		client:            client,
 		fileTransferStats: fileTransferStats,
+		urlRefreshMax:     urlRefreshMax,
 	}
+
+	// Count each request's retries in its task.
+	requestLogHook := client.RequestLogHook
+	client.RequestLogHook = func(
+		l retryablehttp.Logger,
+		req *http.Request,
+		retry int,
+	) {
+		if task, ok := req.Context().Value(taskContextKey{}).(*Task); ok && retry > 0 {
+			task.Retries++
+		}
+		if requestLogHook != nil {
+			requestLogHook(l, req, retry)
+		}
+	}
+
 	return fileTransfer
 }
 
+// taskContextKey is the context key for the task that sent a request.
+type taskContextKey struct{}
+
+// withTask returns the request with the task in its context, so that its
+// retries are counted.
+func withTask(req *retryablehttp.Request, task *Task) *retryablehttp.Request {
+	ctx := task.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return req.WithContext(context.WithValue(ctx, taskContextKey{}, task))
+}
+
 // Upload uploads a file to the server
+//
+// Transient errors are retried by the HTTP client with the same URL. If the
+// URL expired, a new one is requested and the upload starts over, up to
+// urlRefreshMax times.
 func (ft *DefaultFileTransfer) Upload(task *Task) error {
+	for refreshes := 0; ; refreshes++ {
+		err := ft.uploadOnce(task)
+		if !errors.Is(err, ErrUploadURLExpired) ||
+			task.RefreshUploadURL == nil ||
+			refreshes >= ft.urlRefreshMax {
+			return err
+		}
+
+		ft.logger.Info(
+			"default file transfer: refreshing expired upload URL",
+			"path", task.Path,
+			"attempt", refreshes+1,
+		)
+
+		ctx := task.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		url, headers, refreshErr := task.RefreshUploadURL(ctx)
+		if refreshErr != nil {
+			return fmt.Errorf(
+				"file transfer: upload: failed to refresh upload URL: %v: %w",
+				refreshErr, err)
+		}
+		task.Url = url
+		task.Headers = headers
+		task.Retries++
+	}
+}
+
+// uploadOnce uploads a file to the task's current URL.
+func (ft *DefaultFileTransfer) uploadOnce(task *Task) error {
 	ft.logger.Debug("default file transfer: uploading file", "path", task.Path, "url", task.Url)
 
 	// open the file for reading and defer closing it
@@ -102,21 +175,29 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 		}
 		req.Header.Set(parts[0], parts[1])
 	}
-	if task.Context != nil {
-		req = req.WithContext(task.Context)
-	}
-	resp, err := ft.client.Do(req)
+	resp, err := ft.client.Do(withTask(req, task))
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if IsUploadURLExpired(resp.StatusCode, body) {
+			return fmt.Errorf("file transfer: upload: %s: %w", resp.Status, ErrUploadURLExpired)
+		}
 		return fmt.Errorf("file transfer: upload: failed to upload: %s", resp.Status)
 	}
 	return nil
 }
 
 // Download downloads a file from the server
+//
+// If the task has a Writer, the file is streamed to it instead of Path.
 func (ft *DefaultFileTransfer) Download(task *Task) error {
+	if task.Writer != nil {
+		return ft.downloadToWriter(task)
+	}
+
 	ft.logger.Debug("default file transfer: downloading file", "path", task.Path, "url", task.Url)
 	dir := path.Dir(task.Path)
 
@@ -132,8 +213,7 @@ func (ft *DefaultFileTransfer) Download(task *Task) error {
 		return err
 	}
 
-	// TODO: redo it to use the progress writer, to track the download progress
-	resp, err := ft.client.Get(task.Url)
+	resp, err := ft.requestDownload(task)
 	if err != nil {
 		return err
 	}
@@ -141,6 +221,7 @@ func (ft *DefaultFileTransfer) Download(task *Task) error {
 	// open the file for writing and defer closing it
 	file, err := os.Create(task.Path)
 	if err != nil {
+		_ = resp.Body.Close()
 		return err
 	}
 	defer func(file *os.File) {
@@ -149,17 +230,89 @@ func (ft *DefaultFileTransfer) Download(task *Task) error {
 		}
 	}(file)
 
+	return ft.copyDownload(task, resp, file)
+}
+
+// downloadToWriter streams a download to the task's Writer.
+//
+// Transient errors before the body arrives are retried by the HTTP client.
+// If reading the body fails, the download starts over as long as the
+// writer can be seeked back to the start, up to the client's retry limit.
+func (ft *DefaultFileTransfer) downloadToWriter(task *Task) error {
+	ft.logger.Debug("default file transfer: streaming download", "url", task.Url)
+	seeker, canRestart := task.Writer.(io.Seeker)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := ft.requestDownload(task)
+		if err != nil {
+			return err
+		}
+
+		err = ft.copyDownload(task, resp, task.Writer)
+		if err == nil || !canRestart || attempt >= ft.client.RetryMax {
+			return err
+		}
+
+		ft.logger.Warn(
+			"default file transfer: restarting interrupted download",
+			"url", task.Url, "error", err)
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("file transfer: download: cannot restart: %v", err)
+		}
+		task.Retries++
+	}
+}
+
+// requestDownload sends the request for a download.
+//
+// The caller must pass the response to copyDownload.
+func (ft *DefaultFileTransfer) requestDownload(task *Task) (*http.Response, error) {
+	// TODO: redo it to use the progress writer, to track the download progress
+	req, err := retryablehttp.NewRequest(http.MethodGet, task.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if task.Raw {
+		// Otherwise, Go transparently decompresses gzip responses.
+		req.Header.Set("Accept-Encoding", "identity")
+		if task.StoredEncoding != "" {
+			req.Header.Set("Accept-Encoding", task.StoredEncoding)
+		}
+	}
+	return ft.client.Do(withTask(req, task))
+}
+
+// copyDownload writes a download's response body to w and closes it.
+func (ft *DefaultFileTransfer) copyDownload(
+	task *Task,
+	resp *http.Response,
+	w io.Writer,
+) error {
 	defer func(file io.ReadCloser) {
 		if err := file.Close(); err != nil {
 			ft.logger.CaptureError("file transfer: download: error closing response reader", err, "path", task.Path)
 		}
 	}(resp.Body)
 
-	_, err = io.Copy(file, resp.Body)
-	if err != nil {
-		return err
+	// The response may already have been decompressed by the HTTP client
+	// if it was served with a Content-Encoding.
+	var body io.Reader = resp.Body
+	if !task.Raw && !resp.Uncompressed {
+		encoding := task.StoredEncoding
+		if encoding == "" {
+			encoding = resp.Header.Get("Content-Encoding")
+		}
+
+		decompressed, err := NewDecompressingReader(encoding, resp.Body)
+		if err != nil {
+			return err
+		}
+		defer decompressed.Close()
+		body = decompressed
 	}
-	return nil
+
+	_, err := io.Copy(w, body)
+	return err
 }
 
 type ProgressReader struct {
@@ -1,17 +1,21 @@
 package filetransfer_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/pkg/observability"
 )
@@ -38,6 +42,7 @@ func TestDefaultFileTransfer_Download(t *testing.T) {
 		retryablehttp.NewClient(),
 		observability.NewNoOpLogger(),
 		filetransfer.NewFileTransferStats(),
+		0,
 	)
 
 	// Mocking task
@@ -97,6 +102,7 @@ func TestDefaultFileTransfer_Upload(t *testing.T) {
 		retryablehttp.NewClient(),
 		observability.NewNoOpLogger(),
 		filetransfer.NewFileTransferStats(),
+		0,
 	)
 
 	// Creating a file to be uploaded
@@ -155,6 +161,7 @@ func TestDefaultFileTransfer_UploadContextCancelled(t *testing.T) {
 		impatientClient(),
 		observability.NewNoOpLogger(),
 		filetransfer.NewFileTransferStats(),
+		0,
 	)
 
 	tempFile, err := os.CreateTemp("", "")
@@ -180,6 +187,7 @@ func TestDefaultFileTransfer_UploadNoServer(t *testing.T) {
 		impatientClient(),
 		observability.NewNoOpLogger(),
 		filetransfer.NewFileTransferStats(),
+		0,
 	)
 
 	tempFile, err := os.CreateTemp("", "")
@@ -201,6 +209,119 @@ func TestDefaultFileTransfer_UploadNoServer(t *testing.T) {
 	assert.Contains(t, err.Error(), "giving up after 2 attempt(s)")
 }
 
+func TestDefaultFileTransfer_UploadRefreshesExpiredURL(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			if r.URL.Path == "/expired" {
+				w.WriteHeader(http.StatusForbidden)
+			}
+		}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		3,
+	)
+	tempFile, err := os.CreateTemp("", "")
+	require.NoError(t, err)
+	defer os.Remove(tempFile.Name())
+
+	task := &filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: tempFile.Name(),
+		Url:  server.URL + "/expired",
+		RefreshUploadURL: func(ctx context.Context) (string, []string, error) {
+			return server.URL + "/fresh", []string{"X-Test:1"}, nil
+		},
+	}
+	err = ft.Upload(task)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/expired", "/fresh"}, paths)
+	assert.Equal(t, []string{"X-Test:1"}, task.Headers)
+	assert.Equal(t, 1, task.Retries)
+}
+
+func TestDefaultFileTransfer_UploadCapsURLRefreshes(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusForbidden)
+		}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		2,
+	)
+	tempFile, err := os.CreateTemp("", "")
+	require.NoError(t, err)
+	defer os.Remove(tempFile.Name())
+
+	refreshes := 0
+	task := &filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: tempFile.Name(),
+		Url:  server.URL,
+		RefreshUploadURL: func(ctx context.Context) (string, []string, error) {
+			refreshes++
+			return server.URL, nil, nil
+		},
+	}
+	err = ft.Upload(task)
+
+	assert.ErrorIs(t, err, filetransfer.ErrUploadURLExpired)
+	assert.Equal(t, 2, refreshes)
+	assert.Equal(t, 3, requests)
+}
+
+func TestDefaultFileTransfer_UploadServerErrorKeepsURL(t *testing.T) {
+	refreshed := false
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		3,
+	)
+	tempFile, err := os.CreateTemp("", "")
+	require.NoError(t, err)
+	defer os.Remove(tempFile.Name())
+
+	task := &filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: tempFile.Name(),
+		Url:  server.URL,
+		RefreshUploadURL: func(ctx context.Context) (string, []string, error) {
+			refreshed = true
+			return server.URL, nil, nil
+		},
+	}
+	err = ft.Upload(task)
+
+	assert.ErrorContains(t, err, "giving up after 2 attempt(s)")
+	assert.False(t, refreshed)
+	assert.Equal(t, 1, task.Retries)
+}
+
+func TestIsUploadURLExpired(t *testing.T) {
+	assert.True(t, filetransfer.IsUploadURLExpired(http.StatusForbidden, nil))
+	assert.True(t, filetransfer.IsUploadURLExpired(
+		http.StatusBadRequest, []byte("<Code>ExpiredToken</Code>")))
+	assert.False(t, filetransfer.IsUploadURLExpired(
+		http.StatusBadRequest, []byte("<Code>InvalidArgument</Code>")))
+	assert.False(t, filetransfer.IsUploadURLExpired(http.StatusNotFound, nil))
+}
+
 func uploadToServerWithHandler(
 	t *testing.T,
 	handler func(w http.ResponseWriter, r *http.Request),
@@ -211,6 +332,7 @@ func uploadToServerWithHandler(
 		impatientClient(),
 		observability.NewNoOpLogger(),
 		filetransfer.NewFileTransferStats(),
+		0,
 	)
 
 	tempFile, err := os.CreateTemp("", "")
@@ -232,3 +354,81 @@ func impatientClient() *retryablehttp.Client {
 	client.RetryWaitMin = 1 * time.Millisecond
 	return client
 }
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestDefaultFileTransfer_DownloadDecompresses(t *testing.T) {
+	content := []byte("test content for download")
+	compressed := gzipBytes(t, content)
+
+	testCases := []struct {
+		name           string
+		header         string
+		storedEncoding string
+		raw            bool
+		expected       []byte
+	}{
+		{"stored encoding", "", "gzip", false, content},
+		{"content encoding header", "gzip", "", false, content},
+		{"raw", "gzip", "gzip", true, compressed},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					if tc.header != "" {
+						w.Header().Set("Content-Encoding", tc.header)
+					}
+					_, _ = w.Write(compressed)
+				}))
+			defer server.Close()
+			ft := filetransfer.NewDefaultFileTransfer(
+				retryablehttp.NewClient(),
+				observability.NewNoOpLogger(),
+				filetransfer.NewFileTransferStats(),
+				0,
+			)
+			task := &filetransfer.Task{
+				Path:           filepath.Join(t.TempDir(), "file.txt"),
+				Url:            server.URL,
+				StoredEncoding: tc.storedEncoding,
+				Raw:            tc.raw,
+			}
+
+			require.NoError(t, ft.Download(task))
+
+			downloaded, err := os.ReadFile(task.Path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, downloaded)
+		})
+	}
+}
+
+func TestDefaultFileTransfer_DownloadUnsupportedEncoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("data"))
+		}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		retryablehttp.NewClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		0,
+	)
+
+	err := ft.Download(&filetransfer.Task{
+		Path:           filepath.Join(t.TempDir(), "file.txt"),
+		Url:            server.URL,
+		StoredEncoding: "br",
+	})
+
+	assert.ErrorContains(t, err, `unsupported encoding "br"`)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/wandb/wandb/core/internal/droppeddata"
+	"github.com/wandb/wandb/core/internal/healthprobe"
 	"github.com/wandb/wandb/core/pkg/service"
 
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -45,8 +47,18 @@ type fileTransferManager struct {
 	// fileTransferStats keeps track of upload/download statistics
 	fileTransferStats FileTransferStats
 
-	// semaphore is the semaphore for limiting concurrency
-	semaphore chan struct{}
+	// droppedData records failed uploads
+	//
+	// It may be nil.
+	droppedData *droppeddata.Tracker
+
+	// healthStatus records successful uploads
+	//
+	// It may be nil.
+	healthStatus *healthprobe.Status
+
+	// scheduler decides when tasks start
+	scheduler *Scheduler
 
 	// settings is the settings for the file transfer
 	settings *service.Settings
@@ -87,18 +99,43 @@ func WithFileTransferStats(fileTransferStats FileTransferStats) FileTransferMana
 	}
 }
 
+func WithDroppedData(droppedData *droppeddata.Tracker) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.droppedData = droppedData
+	}
+}
+
+func WithHealthStatus(healthStatus *healthprobe.Status) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.healthStatus = healthStatus
+	}
+}
+
+// WithScheduler sets the scheduler for the manager's tasks.
+//
+// The scheduler should also be installed in the FileTransfer's retry
+// client so that requests are throttled.
+func WithScheduler(scheduler *Scheduler) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.scheduler = scheduler
+	}
+}
+
 func NewFileTransferManager(opts ...FileTransferManagerOption) FileTransferManager {
 
 	fm := fileTransferManager{
-		inChan:    make(chan *Task, bufferSize),
-		wg:        &sync.WaitGroup{},
-		semaphore: make(chan struct{}, defaultConcurrencyLimit),
+		inChan: make(chan *Task, bufferSize),
+		wg:     &sync.WaitGroup{},
 	}
 
 	for _, opt := range opts {
 		opt(&fm)
 	}
 
+	if fm.scheduler == nil {
+		fm.scheduler = NewScheduler(SchedulerParams{})
+	}
+
 	return &fm
 }
 
@@ -115,11 +152,9 @@ func (fm *fileTransferManager) Start() {
 			fm.logger.Debug("fileTransfer: got task", "task", task)
 			// spin up a goroutine per task
 			go func(task *Task) {
-				// Acquire the semaphore
-				fm.semaphore <- struct{}{}
+				fm.scheduler.acquire(task.Priority)
 				task.Err = fm.transfer(task)
-				// Release the semaphore
-				<-fm.semaphore
+				fm.scheduler.release()
 
 				if task.Err != nil {
 					fm.logger.CaptureError(
@@ -127,6 +162,11 @@ func (fm *fileTransferManager) Start() {
 						task.Err,
 						"path", task.Path, "url", task.Url,
 					)
+					if task.Type == UploadTask {
+						fm.droppedData.Record(droppeddata.FileUploadFailed, task.Path)
+					}
+				} else if task.Type == UploadTask {
+					fm.healthStatus.UploadSucceeded()
 				}
 
 				// Execute the callback.
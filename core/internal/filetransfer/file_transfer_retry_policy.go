@@ -1,12 +1,20 @@
 package filetransfer
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/hashicorp/go-retryablehttp"
 )
 
+// ErrUploadURLExpired means an upload was rejected because its presigned
+// URL expired.
+//
+// Retrying with the same URL cannot succeed; a new URL is needed.
+var ErrUploadURLExpired = errors.New("filetransfer: upload URL expired")
+
 // FileTransferRetryPolicy is the retry policy to be used for file operations.
 func FileTransferRetryPolicy(
 	ctx context.Context,
@@ -18,9 +26,30 @@ func FileTransferRetryPolicy(
 	// This happens if reading the file fails, for example if it is a directory
 	// or if it doesn't exist. retryablehttp's default policy for this situation
 	// is to retry, which we do not want.
+	//
+	// Stalled transfers are the exception, since the timeout is per attempt.
 	if err != nil {
+		if errors.Is(err, ErrTransferStalled) && ctx.Err() == nil {
+			return true, err
+		}
 		return false, err
 	}
 
 	return retryablehttp.ErrorPropagatedRetryPolicy(ctx, resp, err)
 }
+
+// IsUploadURLExpired reports whether an upload response means that the
+// presigned URL expired.
+//
+// Storage providers reject expired URLs with a 403, or with a 400 that
+// mentions the expiration in the body.
+func IsUploadURLExpired(statusCode int, body []byte) bool {
+	switch statusCode {
+	case http.StatusForbidden:
+		return true
+	case http.StatusBadRequest:
+		return bytes.Contains(bytes.ToLower(body), []byte("expired"))
+	default:
+		return false
+	}
+}
@@ -0,0 +1,32 @@
+package filetransfer
+
+import "net/http"
+
+// NewHeadersTransport returns a RoundTripper that adds headers to each
+// request.
+//
+// Headers that are already set on a request are left unchanged, so that
+// headers required by upload URLs take precedence.
+func NewHeadersTransport(
+	base http.RoundTripper,
+	headers map[string]string,
+) http.RoundTripper {
+	return &headersTransport{base: base, headers: headers}
+}
+
+type headersTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
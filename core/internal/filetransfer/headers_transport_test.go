@@ -0,0 +1,43 @@
+package filetransfer_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+func TestHeadersTransport(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			received = r.Header.Clone()
+		}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: filetransfer.NewHeadersTransport(
+			http.DefaultTransport,
+			map[string]string{
+				"X-Route-To":   "team-a",
+				"X-Goog-Meta":  "extra",
+				"X-Other-Flag": "1",
+			},
+		),
+	}
+	req, err := http.NewRequest(http.MethodPut, server.URL, http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("X-Goog-Meta", "signed")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, "team-a", received.Get("X-Route-To"))
+	assert.Equal(t, "1", received.Get("X-Other-Flag"))
+	assert.Equal(t, "signed", received.Get("X-Goog-Meta"))
+	assert.Empty(t, req.Header.Get("X-Route-To"))
+}
@@ -0,0 +1,330 @@
+package filetransfer
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/clients"
+	"golang.org/x/time/rate"
+)
+
+// TaskPriority orders transfers that are waiting to start.
+//
+// Higher priorities start first. Tasks with the same priority start in the
+// order they were added. A waiting task gains a priority level for each
+// aging interval it waits, so that lower-priority tasks are not starved.
+type TaskPriority int
+
+const (
+	PriorityLow    TaskPriority = -1
+	PriorityNormal TaskPriority = 0
+	PriorityHigh   TaskPriority = 1
+)
+
+const (
+	defaultBackoffMin    = 1 * time.Second
+	defaultBackoffMax    = 60 * time.Second
+	defaultAgingInterval = 30 * time.Second
+)
+
+// SchedulerParams configures a Scheduler.
+type SchedulerParams struct {
+	// Concurrency is the maximum number of transfers in progress at once.
+	//
+	// If zero, a default is used.
+	Concurrency int
+
+	// RateLimit is the maximum number of requests per second, including
+	// retries.
+	//
+	// If zero, requests are not rate-limited.
+	RateLimit float64
+
+	// BackoffMin and BackoffMax bound how long requests are paused after
+	// the storage backend throttles or fails a request.
+	//
+	// If zero, defaults are used.
+	BackoffMin time.Duration
+	BackoffMax time.Duration
+
+	// AgingInterval is how long a transfer waits to gain a priority level.
+	//
+	// If zero, a default is used.
+	AgingInterval time.Duration
+}
+
+// Scheduler coordinates file transfers that share a storage backend.
+//
+// It limits how many transfers are in progress, starts waiting transfers in
+// priority order, and throttles requests. When any request fails with a
+// connection error or a 429 or 5xx response, all requests pause for a
+// backoff period that grows with consecutive failures. This way, artifact
+// uploads and run file uploads back off together rather than each on its
+// own schedule.
+type Scheduler struct {
+	mu sync.Mutex
+
+	// concurrency is the maximum value of inProgress.
+	concurrency int
+
+	// inProgress is the number of transfers that have started and not
+	// finished.
+	inProgress int
+
+	// waiting are transfers waiting for inProgress to go below concurrency,
+	// in the order they were added.
+	waiting []*waiter
+
+	// agingInterval is how long a waiter waits to gain a priority level.
+	agingInterval time.Duration
+
+	// limiter throttles requests; it is nil if there is no rate limit.
+	limiter *rate.Limiter
+
+	backoffMin time.Duration
+	backoffMax time.Duration
+
+	// failures is the number of consecutive throttled or failed requests.
+	failures int
+
+	// pausedUntil is when requests may be sent again after a failure.
+	pausedUntil time.Time
+}
+
+func NewScheduler(params SchedulerParams) *Scheduler {
+	if params.Concurrency <= 0 {
+		params.Concurrency = defaultConcurrencyLimit
+	}
+	if params.BackoffMin <= 0 {
+		params.BackoffMin = defaultBackoffMin
+	}
+	if params.BackoffMax <= 0 {
+		params.BackoffMax = defaultBackoffMax
+	}
+	if params.AgingInterval <= 0 {
+		params.AgingInterval = defaultAgingInterval
+	}
+
+	s := &Scheduler{
+		concurrency:   params.Concurrency,
+		backoffMin:    params.BackoffMin,
+		backoffMax:    params.BackoffMax,
+		agingInterval: params.AgingInterval,
+	}
+
+	if params.RateLimit > 0 {
+		s.limiter = rate.NewLimiter(
+			rate.Limit(params.RateLimit),
+			max(1, int(params.RateLimit)),
+		)
+	}
+
+	return s
+}
+
+// Install makes the client send requests subject to the scheduler's rate
+// limit and backoff.
+//
+// Requests wait before each attempt, outside of the HTTP client's timeout.
+// The scheduler replaces the client's Backoff: a connection error or a 429
+// or 5xx response pauses all requests sent through the scheduler, and the
+// retry is sent once the pause ends. Other retried failures back off only
+// the failed request.
+func (s *Scheduler) Install(client *retryablehttp.Client) {
+	nextRequestHook := client.RequestLogHook
+	client.RequestLogHook = func(
+		logger retryablehttp.Logger,
+		req *http.Request,
+		attempt int,
+	) {
+		_ = s.waitToSend(req.Context())
+
+		if nextRequestHook != nil {
+			nextRequestHook(logger, req, attempt)
+		}
+	}
+
+	nextResponseHook := client.ResponseLogHook
+	client.ResponseLogHook = func(
+		logger retryablehttp.Logger,
+		resp *http.Response,
+	) {
+		s.observe(resp)
+
+		if nextResponseHook != nil {
+			nextResponseHook(logger, resp)
+		}
+	}
+
+	client.Backoff = s.backoff
+}
+
+// acquire blocks until the transfer may start.
+//
+// Each call must be followed by a call to release.
+func (s *Scheduler) acquire(priority TaskPriority) {
+	s.mu.Lock()
+	if s.inProgress < s.concurrency && len(s.waiting) == 0 {
+		s.inProgress++
+		s.mu.Unlock()
+		return
+	}
+
+	w := &waiter{
+		priority: priority,
+		since:    time.Now(),
+		ready:    make(chan struct{}),
+	}
+	s.waiting = append(s.waiting, w)
+	s.mu.Unlock()
+
+	<-w.ready
+}
+
+// release marks a transfer as finished, letting the next one start.
+func (s *Scheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Hand the slot directly to the next transfer.
+	if len(s.waiting) > 0 {
+		close(s.popNextLocked().ready)
+		return
+	}
+
+	s.inProgress--
+}
+
+// popNextLocked removes and returns the waiter to start next.
+//
+// It is the waiter with the highest priority after aging, or the earliest
+// such waiter if there are several.
+func (s *Scheduler) popNextLocked() *waiter {
+	now := time.Now()
+	next := 0
+	nextPriority := s.waiting[0].agedPriority(now, s.agingInterval)
+	for i, w := range s.waiting[1:] {
+		if priority := w.agedPriority(now, s.agingInterval); priority > nextPriority {
+			next = i + 1
+			nextPriority = priority
+		}
+	}
+
+	w := s.waiting[next]
+	s.waiting = append(s.waiting[:next], s.waiting[next+1:]...)
+	return w
+}
+
+// waitToSend blocks until a request may be sent.
+//
+// Returns an error if the context is canceled first.
+func (s *Scheduler) waitToSend(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		pause := time.Until(s.pausedUntil)
+		s.mu.Unlock()
+
+		if pause <= 0 {
+			break
+		}
+
+		timer := time.NewTimer(pause)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+
+	if s.limiter != nil {
+		return s.limiter.Wait(ctx)
+	}
+	return nil
+}
+
+// observe updates the backoff state after a response.
+//
+// A 429 or 5xx response pauses all requests, and a successful response
+// resets the backoff.
+func (s *Scheduler) observe(resp *http.Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case isThrottled(resp):
+		s.pauseLocked(resp)
+	case resp.StatusCode < http.StatusBadRequest:
+		s.failures = 0
+	}
+}
+
+// backoff is a retryablehttp.Backoff for requests sent through the
+// scheduler.
+//
+// Requests that failed with a connection error or were throttled are
+// retried once the pause of all requests ends, so it returns zero for
+// them. Other retried requests back off on their own.
+func (s *Scheduler) backoff(
+	_, _ time.Duration,
+	attempt int,
+	resp *http.Response,
+) time.Duration {
+	switch {
+	case resp == nil:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.pauseLocked(nil)
+		return 0
+
+	case isThrottled(resp):
+		// Already paused by observe.
+		return 0
+
+	default:
+		return clients.ExponentialBackoffWithJitter(
+			s.backoffMin,
+			s.backoffMax,
+			attempt,
+			resp,
+		)
+	}
+}
+
+// pauseLocked pauses all requests for a backoff period that grows with
+// consecutive failures.
+func (s *Scheduler) pauseLocked(resp *http.Response) {
+	pause := clients.ExponentialBackoffWithJitter(
+		s.backoffMin,
+		s.backoffMax,
+		s.failures,
+		resp,
+	)
+	s.failures++
+
+	if until := time.Now().Add(min(pause, s.backoffMax)); until.After(s.pausedUntil) {
+		s.pausedUntil = until
+	}
+}
+
+// isThrottled reports whether the response asks clients to back off.
+func isThrottled(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+}
+
+// waiter is a transfer waiting to start.
+type waiter struct {
+	priority TaskPriority
+	since    time.Time
+	ready    chan struct{}
+}
+
+// agedPriority is the waiter's priority raised by one level per aging
+// interval it has waited.
+func (w *waiter) agedPriority(now time.Time, agingInterval time.Duration) TaskPriority {
+	return w.priority + TaskPriority(now.Sub(w.since)/agingInterval)
+}
@@ -0,0 +1,167 @@
+package filetransfer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForWaiters blocks until n transfers are waiting to start.
+func waitForWaiters(t *testing.T, s *Scheduler, n int) {
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.waiting) == n
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestScheduler_StartsHigherPriorityFirst(t *testing.T) {
+	s := NewScheduler(SchedulerParams{Concurrency: 1})
+	started := make(chan TaskPriority, 3)
+	s.acquire(PriorityNormal)
+
+	go func() {
+		s.acquire(PriorityLow)
+		started <- PriorityLow
+		s.release()
+	}()
+	waitForWaiters(t, s, 1)
+	go func() {
+		s.acquire(PriorityHigh)
+		started <- PriorityHigh
+		s.release()
+	}()
+	waitForWaiters(t, s, 2)
+	s.release()
+
+	assert.Equal(t, PriorityHigh, <-started)
+	assert.Equal(t, PriorityLow, <-started)
+}
+
+func TestScheduler_LimitsConcurrency(t *testing.T) {
+	s := NewScheduler(SchedulerParams{Concurrency: 2})
+	s.acquire(PriorityNormal)
+	s.acquire(PriorityNormal)
+
+	started := make(chan struct{})
+	go func() {
+		s.acquire(PriorityNormal)
+		close(started)
+	}()
+	waitForWaiters(t, s, 1)
+	s.release()
+
+	<-started
+	assert.Equal(t, 2, s.inProgress)
+}
+
+func TestScheduler_AgingStartsLowerPriorityEventually(t *testing.T) {
+	s := NewScheduler(SchedulerParams{
+		Concurrency:   1,
+		AgingInterval: 50 * time.Millisecond,
+	})
+	started := make(chan TaskPriority, 2)
+	s.acquire(PriorityNormal)
+
+	go func() {
+		s.acquire(PriorityLow)
+		started <- PriorityLow
+		s.release()
+	}()
+	waitForWaiters(t, s, 1)
+	time.Sleep(150 * time.Millisecond)
+	go func() {
+		s.acquire(PriorityHigh)
+		started <- PriorityHigh
+		s.release()
+	}()
+	waitForWaiters(t, s, 2)
+	s.release()
+
+	assert.Equal(t, PriorityLow, <-started)
+	assert.Equal(t, PriorityHigh, <-started)
+}
+
+// newScheduledClient returns a retry client installed in the scheduler.
+func newScheduledClient(s *Scheduler) *retryablehttp.Client {
+	client := retryablehttp.NewClient()
+	client.Logger = nil
+	client.RetryMax = 1
+	s.Install(client)
+	return client
+}
+
+func TestScheduler_PausesAllRequestsAfterFailure(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+	defer server.Close()
+	s := NewScheduler(SchedulerParams{
+		BackoffMin: 100 * time.Millisecond,
+		BackoffMax: 100 * time.Millisecond,
+	})
+	failing := newScheduledClient(s)
+	failing.RetryMax = 0
+	other := newScheduledClient(s)
+
+	resp, err := failing.Get(server.URL)
+	require.ErrorContains(t, err, "giving up")
+	require.Nil(t, resp)
+	failedAt := time.Now()
+	resp, err = other.Get(server.URL + "/other")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.GreaterOrEqual(t, time.Since(failedAt), 90*time.Millisecond)
+	assert.Zero(t, s.failures)
+}
+
+func TestScheduler_BacksOffRetryOnce(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+	defer server.Close()
+	s := NewScheduler(SchedulerParams{
+		BackoffMin: 200 * time.Millisecond,
+		BackoffMax: 200 * time.Millisecond,
+	})
+	client := newScheduledClient(s)
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.EqualValues(t, 2, requests.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+	assert.Less(t, time.Since(start), 390*time.Millisecond)
+}
+
+func TestScheduler_PauseDoesNotCountAgainstTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	s := NewScheduler(SchedulerParams{})
+	s.pausedUntil = time.Now().Add(200 * time.Millisecond)
+	client := newScheduledClient(s)
+	client.HTTPClient.Timeout = 100 * time.Millisecond
+
+	resp, err := client.Get(server.URL)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+}
@@ -0,0 +1,106 @@
+package filetransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ErrTransferStalled is returned when a request makes no progress for
+// longer than its timeout.
+var ErrTransferStalled = errors.New("filetransfer: no progress before timeout")
+
+// NewStallTimeoutTransport returns a RoundTripper that aborts a request if
+// no data is sent or received for the given duration.
+//
+// Unlike http.Client.Timeout, this doesn't limit the total duration of a
+// request, so large files can take as long as they need on a slow but
+// working connection. The timeout applies to each attempt separately.
+func NewStallTimeoutTransport(
+	base http.RoundTripper,
+	timeout time.Duration,
+) http.RoundTripper {
+	return &stallTimeoutTransport{base: base, timeout: timeout}
+}
+
+type stallTimeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *stallTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancelCause(req.Context())
+	watchdog := &stallWatchdog{
+		timeout: t.timeout,
+		timer: time.AfterFunc(t.timeout, func() {
+			cancel(fmt.Errorf("%w (%v)", ErrTransferStalled, t.timeout))
+		}),
+	}
+
+	req = req.WithContext(ctx)
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = &stallReader{ReadCloser: req.Body, ctx: ctx, watchdog: watchdog}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		watchdog.stop()
+		if cause := context.Cause(ctx); errors.Is(cause, ErrTransferStalled) {
+			err = cause
+		}
+		cancel(nil)
+		return nil, err
+	}
+
+	watchdog.reset()
+	resp.Body = &stallReader{
+		ReadCloser: resp.Body,
+		ctx:        ctx,
+		watchdog:   watchdog,
+		onClose:    func() { cancel(nil) },
+	}
+	return resp, nil
+}
+
+// stallWatchdog cancels a request when its timer fires.
+type stallWatchdog struct {
+	timeout time.Duration
+	timer   *time.Timer
+}
+
+func (w *stallWatchdog) reset() { w.timer.Reset(w.timeout) }
+func (w *stallWatchdog) stop()  { w.timer.Stop() }
+
+// stallReader resets the watchdog whenever data is read.
+type stallReader struct {
+	io.ReadCloser
+	ctx      context.Context
+	watchdog *stallWatchdog
+	onClose  func()
+}
+
+func (r *stallReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.watchdog.reset()
+	}
+
+	if err != nil && err != io.EOF {
+		if cause := context.Cause(r.ctx); errors.Is(cause, ErrTransferStalled) {
+			err = cause
+		}
+	}
+	return n, err
+}
+
+func (r *stallReader) Close() error {
+	err := r.ReadCloser.Close()
+	if r.onClose != nil {
+		r.watchdog.stop()
+		r.onClose()
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+package filetransfer_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+func TestStallTimeout_SlowButSteadyTransferSucceeds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			for i := 0; i < 10; i++ {
+				_, _ = w.Write([]byte("x"))
+				w.(http.Flusher).Flush()
+				time.Sleep(20 * time.Millisecond)
+			}
+		}))
+	defer server.Close()
+	client := &http.Client{Transport: filetransfer.NewStallTimeoutTransport(
+		http.DefaultTransport, 100*time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, "xxxxxxxxxx", string(body))
+}
+
+func TestStallTimeout_StalledRequestFails(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			<-unblock
+		}))
+	defer server.Close()
+	defer close(unblock)
+	client := &http.Client{Transport: filetransfer.NewStallTimeoutTransport(
+		http.DefaultTransport, 50*time.Millisecond)}
+
+	_, err := client.Get(server.URL)
+
+	assert.ErrorIs(t, err, filetransfer.ErrTransferStalled)
+}
+
+func TestFileTransferRetryPolicy_RetriesStalledTransfers(t *testing.T) {
+	retry, _ := filetransfer.FileTransferRetryPolicy(
+		context.Background(), nil, filetransfer.ErrTransferStalled)
+
+	assert.True(t, retry)
+}
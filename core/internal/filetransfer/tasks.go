@@ -1,6 +1,9 @@
 package filetransfer
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type TaskType int
 
@@ -17,6 +20,9 @@ type Task struct {
 	// Type is the type of task (upload or download)
 	Type TaskType
 
+	// Priority orders the task relative to others waiting to start.
+	Priority TaskPriority
+
 	// Path is the local path to the file
 	Path string
 
@@ -29,9 +35,35 @@ type Task struct {
 	// Headers to send on the upload
 	Headers []string
 
+	// RefreshUploadURL gets a new upload URL and headers when the backend
+	// rejects Url as expired, or nil if the URL can't be refreshed.
+	RefreshUploadURL func(ctx context.Context) (url string, headers []string, err error)
+
 	// Size is the size of the file
 	Size int64
 
+	// StoredEncoding is how a downloaded file is compressed on the server,
+	// like "gzip".
+	//
+	// Downloads are decompressed before being written to Path. If empty,
+	// the response's Content-Encoding header is used instead.
+	StoredEncoding string
+
+	// Writer receives a download instead of the file at Path, if set.
+	//
+	// A download that fails partway is restarted only if Writer implements
+	// io.Seeker, in which case it is seeked back to the start; writers that
+	// can't be rewound opt out of retrying.
+	Writer io.Writer
+
+	// Raw disables decompression so that downloads are written exactly as
+	// stored.
+	Raw bool
+
+	// Retries is how many times the transfer was retried, either after a
+	// transient error or with a refreshed upload URL.
+	Retries int
+
 	// Error, if any.
 	Err error
 
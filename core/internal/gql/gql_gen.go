@@ -8,6 +8,24 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
+// AddAliasesAddAliasesAddAliasesPayload includes the requested fields of the GraphQL type AddAliasesPayload.
+type AddAliasesAddAliasesAddAliasesPayload struct {
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns AddAliasesAddAliasesAddAliasesPayload.Success, and is useful for accessing the field via an interface.
+func (v *AddAliasesAddAliasesAddAliasesPayload) GetSuccess() bool { return v.Success }
+
+// AddAliasesResponse is returned by AddAliases on success.
+type AddAliasesResponse struct {
+	AddAliases *AddAliasesAddAliasesAddAliasesPayload `json:"addAliases"`
+}
+
+// GetAddAliases returns AddAliasesResponse.AddAliases, and is useful for accessing the field via an interface.
+func (v *AddAliasesResponse) GetAddAliases() *AddAliasesAddAliasesAddAliasesPayload {
+	return v.AddAliases
+}
+
 type AlertSeverity string
 
 const (
@@ -27,6 +45,81 @@ func (v *ArtifactAliasInput) GetArtifactCollectionName() string { return v.Artif
 // GetAlias returns ArtifactAliasInput.Alias, and is useful for accessing the field via an interface.
 func (v *ArtifactAliasInput) GetAlias() string { return v.Alias }
 
+type ArtifactCollectionAliasInput struct {
+	EntityName             string `json:"entityName"`
+	ProjectName            string `json:"projectName"`
+	ArtifactCollectionName string `json:"artifactCollectionName"`
+	Alias                  string `json:"alias"`
+}
+
+// GetEntityName returns ArtifactCollectionAliasInput.EntityName, and is useful for accessing the field via an interface.
+func (v *ArtifactCollectionAliasInput) GetEntityName() string { return v.EntityName }
+
+// GetProjectName returns ArtifactCollectionAliasInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *ArtifactCollectionAliasInput) GetProjectName() string { return v.ProjectName }
+
+// GetArtifactCollectionName returns ArtifactCollectionAliasInput.ArtifactCollectionName, and is useful for accessing the field via an interface.
+func (v *ArtifactCollectionAliasInput) GetArtifactCollectionName() string {
+	return v.ArtifactCollectionName
+}
+
+// GetAlias returns ArtifactCollectionAliasInput.Alias, and is useful for accessing the field via an interface.
+func (v *ArtifactCollectionAliasInput) GetAlias() string { return v.Alias }
+
+// ArtifactFileURLArtifact includes the requested fields of the GraphQL type Artifact.
+type ArtifactFileURLArtifact struct {
+	Files ArtifactFileURLArtifactFilesFileConnection `json:"files"`
+}
+
+// GetFiles returns ArtifactFileURLArtifact.Files, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLArtifact) GetFiles() ArtifactFileURLArtifactFilesFileConnection {
+	return v.Files
+}
+
+// ArtifactFileURLArtifactFilesFileConnection includes the requested fields of the GraphQL type FileConnection.
+type ArtifactFileURLArtifactFilesFileConnection struct {
+	Edges []ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdge `json:"edges"`
+}
+
+// GetEdges returns ArtifactFileURLArtifactFilesFileConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLArtifactFilesFileConnection) GetEdges() []ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdge {
+	return v.Edges
+}
+
+// ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdge includes the requested fields of the GraphQL type FileEdge.
+type ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdge struct {
+	Node *ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile `json:"node"`
+}
+
+// GetNode returns ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdge.Node, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdge) GetNode() *ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile {
+	return v.Node
+}
+
+// ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile includes the requested fields of the GraphQL type File.
+type ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile struct {
+	Name      string `json:"name"`
+	DirectUrl string `json:"directUrl"`
+}
+
+// GetName returns ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile.Name, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile) GetName() string {
+	return v.Name
+}
+
+// GetDirectUrl returns ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile.DirectUrl, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLArtifactFilesFileConnectionEdgesFileEdgeNodeFile) GetDirectUrl() string {
+	return v.DirectUrl
+}
+
+// ArtifactFileURLResponse is returned by ArtifactFileURL on success.
+type ArtifactFileURLResponse struct {
+	Artifact *ArtifactFileURLArtifact `json:"artifact"`
+}
+
+// GetArtifact returns ArtifactFileURLResponse.Artifact, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLResponse) GetArtifact() *ArtifactFileURLArtifact { return v.Artifact }
+
 // ArtifactFileURLsArtifact includes the requested fields of the GraphQL type Artifact.
 type ArtifactFileURLsArtifact struct {
 	Files ArtifactFileURLsArtifactFilesFileConnection `json:"files"`
@@ -216,6 +309,36 @@ func (v *CommitArtifactResponse) GetCommitArtifact() *CommitArtifactCommitArtifa
 	return v.CommitArtifact
 }
 
+// CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload includes the requested fields of the GraphQL type CreateAnonymousEntityPayload.
+type CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload struct {
+	ApiKey *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey `json:"apiKey"`
+}
+
+// GetApiKey returns CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload.ApiKey, and is useful for accessing the field via an interface.
+func (v *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload) GetApiKey() *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey {
+	return v.ApiKey
+}
+
+// CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey includes the requested fields of the GraphQL type ApiKey.
+type CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey struct {
+	Name string `json:"name"`
+}
+
+// GetName returns CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey.Name, and is useful for accessing the field via an interface.
+func (v *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey) GetName() string {
+	return v.Name
+}
+
+// CreateAnonymousApiKeyResponse is returned by CreateAnonymousApiKey on success.
+type CreateAnonymousApiKeyResponse struct {
+	CreateAnonymousEntity *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload `json:"createAnonymousEntity"`
+}
+
+// GetCreateAnonymousEntity returns CreateAnonymousApiKeyResponse.CreateAnonymousEntity, and is useful for accessing the field via an interface.
+func (v *CreateAnonymousApiKeyResponse) GetCreateAnonymousEntity() *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload {
+	return v.CreateAnonymousEntity
+}
+
 // CreateArtifactCreateArtifactCreateArtifactPayload includes the requested fields of the GraphQL type CreateArtifactPayload.
 type CreateArtifactCreateArtifactCreateArtifactPayload struct {
 	Artifact CreateArtifactCreateArtifactCreateArtifactPayloadArtifact `json:"artifact"`
@@ -517,6 +640,38 @@ func (v *NotifyScriptableRunAlertResponse) GetNotifyScriptableRunAlert() *Notify
 	return v.NotifyScriptableRunAlert
 }
 
+// PreflightEntity includes the requested fields of the GraphQL type Entity.
+type PreflightEntity struct {
+	Id string `json:"id"`
+}
+
+// GetId returns PreflightEntity.Id, and is useful for accessing the field via an interface.
+func (v *PreflightEntity) GetId() string { return v.Id }
+
+// PreflightProject includes the requested fields of the GraphQL type Project.
+type PreflightProject struct {
+	Id       string `json:"id"`
+	ReadOnly *bool  `json:"readOnly"`
+}
+
+// GetId returns PreflightProject.Id, and is useful for accessing the field via an interface.
+func (v *PreflightProject) GetId() string { return v.Id }
+
+// GetReadOnly returns PreflightProject.ReadOnly, and is useful for accessing the field via an interface.
+func (v *PreflightProject) GetReadOnly() *bool { return v.ReadOnly }
+
+// PreflightResponse is returned by Preflight on success.
+type PreflightResponse struct {
+	Entity  *PreflightEntity  `json:"entity"`
+	Project *PreflightProject `json:"project"`
+}
+
+// GetEntity returns PreflightResponse.Entity, and is useful for accessing the field via an interface.
+func (v *PreflightResponse) GetEntity() *PreflightEntity { return v.Entity }
+
+// GetProject returns PreflightResponse.Project, and is useful for accessing the field via an interface.
+func (v *PreflightResponse) GetProject() *PreflightProject { return v.Project }
+
 // RunResumeStatusModelProject includes the requested fields of the GraphQL type Project.
 type RunResumeStatusModelProject struct {
 	Id     string                                `json:"id"`
@@ -896,6 +1051,30 @@ func (v *ViewerViewerUserTeamsEntityConnectionEdgesEntityEdgeNodeEntity) GetName
 	return v.Name
 }
 
+// __AddAliasesInput is used internally by genqlient
+type __AddAliasesInput struct {
+	ArtifactID string                         `json:"artifactID"`
+	Aliases    []ArtifactCollectionAliasInput `json:"aliases"`
+}
+
+// GetArtifactID returns __AddAliasesInput.ArtifactID, and is useful for accessing the field via an interface.
+func (v *__AddAliasesInput) GetArtifactID() string { return v.ArtifactID }
+
+// GetAliases returns __AddAliasesInput.Aliases, and is useful for accessing the field via an interface.
+func (v *__AddAliasesInput) GetAliases() []ArtifactCollectionAliasInput { return v.Aliases }
+
+// __ArtifactFileURLInput is used internally by genqlient
+type __ArtifactFileURLInput struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns __ArtifactFileURLInput.Id, and is useful for accessing the field via an interface.
+func (v *__ArtifactFileURLInput) GetId() string { return v.Id }
+
+// GetName returns __ArtifactFileURLInput.Name, and is useful for accessing the field via an interface.
+func (v *__ArtifactFileURLInput) GetName() string { return v.Name }
+
 // __ArtifactFileURLsInput is used internally by genqlient
 type __ArtifactFileURLsInput struct {
 	Id      string  `json:"id"`
@@ -1130,6 +1309,18 @@ func (v *__NotifyScriptableRunAlertInput) GetSeverity() *AlertSeverity { return
 // GetWaitDuration returns __NotifyScriptableRunAlertInput.WaitDuration, and is useful for accessing the field via an interface.
 func (v *__NotifyScriptableRunAlertInput) GetWaitDuration() *int64 { return v.WaitDuration }
 
+// __PreflightInput is used internally by genqlient
+type __PreflightInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+}
+
+// GetEntity returns __PreflightInput.Entity, and is useful for accessing the field via an interface.
+func (v *__PreflightInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __PreflightInput.Project, and is useful for accessing the field via an interface.
+func (v *__PreflightInput) GetProject() string { return v.Project }
+
 // __RunResumeStatusInput is used internally by genqlient
 type __RunResumeStatusInput struct {
 	Project *string `json:"project"`
@@ -1274,6 +1465,87 @@ func (v *__UseArtifactInput) GetRunName() string { return v.RunName }
 // GetArtifactID returns __UseArtifactInput.ArtifactID, and is useful for accessing the field via an interface.
 func (v *__UseArtifactInput) GetArtifactID() string { return v.ArtifactID }
 
+// The query or mutation executed by AddAliases.
+const AddAliases_Operation = `
+mutation AddAliases ($artifactID: ID!, $aliases: [ArtifactCollectionAliasInput!]!) {
+	addAliases(input: {artifactID:$artifactID,aliases:$aliases}) {
+		success
+	}
+}
+`
+
+func AddAliases(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	artifactID string,
+	aliases []ArtifactCollectionAliasInput,
+) (*AddAliasesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "AddAliases",
+		Query:  AddAliases_Operation,
+		Variables: &__AddAliasesInput{
+			ArtifactID: artifactID,
+			Aliases:    aliases,
+		},
+	}
+	var err_ error
+
+	var data_ AddAliasesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ArtifactFileURL.
+const ArtifactFileURL_Operation = `
+query ArtifactFileURL ($id: ID!, $name: String!) {
+	artifact(id: $id) {
+		files(names: [$name], first: 1) {
+			edges {
+				node {
+					name
+					directUrl
+				}
+			}
+		}
+	}
+}
+`
+
+func ArtifactFileURL(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	name string,
+) (*ArtifactFileURLResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ArtifactFileURL",
+		Query:  ArtifactFileURL_Operation,
+		Variables: &__ArtifactFileURLInput{
+			Id:   id,
+			Name: name,
+		},
+	}
+	var err_ error
+
+	var data_ ArtifactFileURLResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ArtifactFileURLs.
 const ArtifactFileURLs_Operation = `
 query ArtifactFileURLs ($id: ID!, $cursor: String, $perPage: Int) {
@@ -1436,6 +1708,39 @@ func CommitArtifact(
 	return &data_, err_
 }
 
+// The query or mutation executed by CreateAnonymousApiKey.
+const CreateAnonymousApiKey_Operation = `
+mutation CreateAnonymousApiKey {
+	createAnonymousEntity(input: {}) {
+		apiKey {
+			name
+		}
+	}
+}
+`
+
+func CreateAnonymousApiKey(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*CreateAnonymousApiKeyResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateAnonymousApiKey",
+		Query:  CreateAnonymousApiKey_Operation,
+	}
+	var err_ error
+
+	var data_ CreateAnonymousApiKeyResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by CreateArtifact.
 const CreateArtifact_Operation = `
 mutation CreateArtifact ($entityName: String!, $projectName: String!, $artifactTypeName: String!, $artifactCollectionName: String!, $runName: String, $digest: String!, $description: String, $aliases: [ArtifactAliasInput!], $metadata: JSONString, $ttlDurationSeconds: Int64, $historyStep: Int64, $distributedID: String, $clientID: ID!, $sequenceClientID: ID!) {
@@ -1748,6 +2053,47 @@ func NotifyScriptableRunAlert(
 	return &data_, err_
 }
 
+// The query or mutation executed by Preflight.
+const Preflight_Operation = `
+query Preflight ($entity: String!, $project: String!) {
+	entity(name: $entity) {
+		id
+	}
+	project(name: $project, entityName: $entity) {
+		id
+		readOnly
+	}
+}
+`
+
+func Preflight(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+) (*PreflightResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Preflight",
+		Query:  Preflight_Operation,
+		Variables: &__PreflightInput{
+			Entity:  entity,
+			Project: project,
+		},
+	}
+	var err_ error
+
+	var data_ PreflightResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by RunResumeStatus.
 const RunResumeStatus_Operation = `
 query RunResumeStatus ($project: String, $entity: String, $name: String!) {
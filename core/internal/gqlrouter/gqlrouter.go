@@ -0,0 +1,144 @@
+// Package gqlrouter sends GraphQL queries and mutations to different
+// endpoints.
+//
+// Some deployments serve reads from a replica, so queries can go to a
+// separate endpoint from mutations.
+package gqlrouter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// Client is a GraphQL client that routes each request by operation type.
+//
+// Queries go to the query client. Mutations, subscriptions and requests
+// whose type can't be determined go to the mutation client, since it is
+// the primary endpoint.
+type Client struct {
+	query    graphql.Client
+	mutation graphql.Client
+}
+
+func New(query, mutation graphql.Client) *Client {
+	return &Client{query: query, mutation: mutation}
+}
+
+// MakeRequest implements graphql.Client.MakeRequest.
+func (c *Client) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if IsQuery(req.Query) {
+		return c.query.MakeRequest(ctx, req, resp)
+	}
+	return c.mutation.MakeRequest(ctx, req, resp)
+}
+
+// IsQuery reports whether the first operation in the document is a query.
+//
+// Fragment definitions and comments before the operation are skipped. An
+// anonymous operation like "{ viewer { id } }" is a query.
+func IsQuery(document string) bool {
+	for i := 0; i < len(document); {
+		switch c := document[i]; {
+		case c == '#':
+			i = skipComment(document, i)
+
+		case c == '{':
+			return true
+
+		case isNameStart(c):
+			start := i
+			for i < len(document) && isNameContinue(document[i]) {
+				i++
+			}
+
+			switch document[start:i] {
+			case "query":
+				return true
+			case "fragment":
+				i = skipFragment(document, i)
+			default:
+				return false
+			}
+
+		default:
+			i++
+		}
+	}
+
+	return false
+}
+
+// skipFragment returns the index just past the selection set of the
+// fragment definition whose body starts at or after i.
+func skipFragment(document string, i int) int {
+	depth := 0
+
+	for i < len(document) {
+		switch document[i] {
+		case '#':
+			i = skipComment(document, i)
+			continue
+
+		case '"':
+			i = skipString(document, i)
+			continue
+
+		case '{':
+			depth++
+
+		case '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+
+		i++
+	}
+
+	return i
+}
+
+// skipComment returns the index just past the comment starting at i.
+func skipComment(document string, i int) int {
+	end := strings.IndexByte(document[i:], '\n')
+	if end < 0 {
+		return len(document)
+	}
+	return i + end + 1
+}
+
+// skipString returns the index just past the string starting at i.
+func skipString(document string, i int) int {
+	if strings.HasPrefix(document[i:], `"""`) {
+		end := strings.Index(document[i+3:], `"""`)
+		if end < 0 {
+			return len(document)
+		}
+		return i + 3 + end + 3
+	}
+
+	for j := i + 1; j < len(document); j++ {
+		switch document[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1
+		}
+	}
+	return len(document)
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || ('0' <= c && c <= '9')
+}
@@ -0,0 +1,71 @@
+package gqlrouter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/gqlrouter"
+)
+
+func TestIsQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		document string
+		isQuery  bool
+	}{
+		{"query", "query Viewer { viewer { id } }", true},
+		{"anonymous query", "{ viewer { id } }", true},
+		{"mutation", "mutation UpsertBucket($id: String) { upsertBucket { id } }", false},
+		{"subscription", "subscription OnRun { run { id } }", false},
+		{"leading comment", "# mutation\nquery Viewer { viewer { id } }", true},
+		{
+			"fragment first",
+			`fragment RunFields on Run { id name(arg: "{") }
+			mutation UpdateRun { run { ...RunFields } }`,
+			false,
+		},
+		{
+			"fragment before query",
+			"fragment F on Run { id { nested } }\nquery Q { run { ...F } }",
+			true,
+		},
+		{"empty", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isQuery, gqlrouter.IsQuery(tc.document))
+		})
+	}
+}
+
+type recordingClient struct {
+	requests []string
+}
+
+func (c *recordingClient) MakeRequest(
+	_ context.Context,
+	req *graphql.Request,
+	_ *graphql.Response,
+) error {
+	c.requests = append(c.requests, req.OpName)
+	return nil
+}
+
+func TestClient_RoutesByOperationType(t *testing.T) {
+	query := &recordingClient{}
+	mutation := &recordingClient{}
+	client := gqlrouter.New(query, mutation)
+
+	_ = client.MakeRequest(context.Background(),
+		&graphql.Request{OpName: "Viewer", Query: "query Viewer { viewer { id } }"},
+		&graphql.Response{})
+	_ = client.MakeRequest(context.Background(),
+		&graphql.Request{OpName: "UpsertBucket", Query: "mutation UpsertBucket { upsertBucket { id } }"},
+		&graphql.Response{})
+
+	assert.Equal(t, []string{"Viewer"}, query.requests)
+	assert.Equal(t, []string{"UpsertBucket"}, mutation.requests)
+}
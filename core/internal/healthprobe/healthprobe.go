@@ -0,0 +1,183 @@
+// Package healthprobe serves an HTTP endpoint that reports when a run last
+// synced with the backend, for use in liveness and readiness probes.
+package healthprobe
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// defaultMaxSyncAge is how long ago the last sync may have been for the
+// run to be ready, if the probe doesn't say.
+//
+// Filestream heartbeats are sent every 30 seconds even if there's no data,
+// so a healthy online run syncs at least that often.
+const defaultMaxSyncAge = 2 * time.Minute
+
+// Status tracks the times of a run's last successful syncs.
+//
+// It is safe for concurrent use. A nil Status ignores recorded syncs.
+type Status struct {
+	// offline is whether the run is offline and never syncs.
+	offline bool
+
+	// startTime is when tracking started, used as the last sync time
+	// before the first sync.
+	startTime time.Time
+
+	// lastFileStreamSync is the Unix time in nanoseconds of the last
+	// successful filestream request, or 0.
+	lastFileStreamSync atomic.Int64
+
+	// lastUpload is the Unix time in nanoseconds of the last successful
+	// file upload, or 0.
+	lastUpload atomic.Int64
+}
+
+// New returns a Status for an online or offline run.
+func New(offline bool) *Status {
+	return &Status{offline: offline, startTime: time.Now()}
+}
+
+// FileStreamSynced records a successful filestream request.
+func (s *Status) FileStreamSynced() {
+	if s == nil {
+		return
+	}
+	s.lastFileStreamSync.Store(time.Now().UnixNano())
+}
+
+// UploadSucceeded records a successful file upload.
+func (s *Status) UploadSucceeded() {
+	if s == nil {
+		return
+	}
+	s.lastUpload.Store(time.Now().UnixNano())
+}
+
+// Report is the JSON body returned by the probe endpoints.
+type Report struct {
+	// Online is whether the run syncs to the backend.
+	Online bool `json:"online"`
+
+	// LastFileStreamSync is when data was last sent through the
+	// filestream API, or nil if never.
+	LastFileStreamSync *time.Time `json:"last_filestream_sync"`
+
+	// LastUpload is when a file was last uploaded, or nil if never.
+	LastUpload *time.Time `json:"last_upload"`
+
+	// Ready is whether the run synced recently enough.
+	//
+	// It is only set by the readiness endpoint.
+	Ready *bool `json:"ready,omitempty"`
+}
+
+// Report returns the current status.
+func (s *Status) Report() Report {
+	return Report{
+		Online:             !s.offline,
+		LastFileStreamSync: unixNanoOrNil(s.lastFileStreamSync.Load()),
+		LastUpload:         unixNanoOrNil(s.lastUpload.Load()),
+	}
+}
+
+// IsReady returns whether the run synced within maxAge.
+//
+// Offline runs are always ready. Before the first sync, the time since
+// tracking started is used instead.
+func (s *Status) IsReady(maxAge time.Duration) bool {
+	if s.offline {
+		return true
+	}
+
+	last := s.startTime
+	for _, t := range []int64{s.lastFileStreamSync.Load(), s.lastUpload.Load()} {
+		if t != 0 && time.Unix(0, t).After(last) {
+			last = time.Unix(0, t)
+		}
+	}
+	return time.Since(last) <= maxAge
+}
+
+// Handler returns the HTTP handler for the probe endpoints.
+//
+// "/healthz" always responds with 200 and the current status. "/readyz"
+// responds with 503 instead if there was no successful sync within the
+// number of seconds given by the "max_age_seconds" query parameter.
+func (s *Status) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		writeReport(w, http.StatusOK, s.Report())
+	})
+	mux.HandleFunc("GET /readyz", s.serveReady)
+	return mux
+}
+
+func (s *Status) serveReady(w http.ResponseWriter, r *http.Request) {
+	maxAge := defaultMaxSyncAge
+	if param := r.URL.Query().Get("max_age_seconds"); param != "" {
+		seconds, err := strconv.ParseFloat(param, 64)
+		if err != nil || seconds < 0 {
+			http.Error(w,
+				fmt.Sprintf("invalid max_age_seconds: %q", param),
+				http.StatusBadRequest)
+			return
+		}
+		maxAge = time.Duration(seconds * float64(time.Second))
+	}
+
+	ready := s.IsReady(maxAge)
+	report := s.Report()
+	report.Ready = &ready
+
+	status := http.StatusOK
+	if !ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeReport(w, status, report)
+}
+
+// Serve starts serving the probe endpoints on the address.
+//
+// It returns the address the server is bound to, which differs from addr
+// if addr has port 0, and a function that stops the server.
+func (s *Status) Serve(addr string) (boundAddr string, stop func(), err error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", nil, fmt.Errorf("healthprobe: %v", err)
+	}
+
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() { _ = server.Serve(listener) }()
+
+	return listener.Addr().String(), func() { _ = server.Close() }, nil
+}
+
+func writeReport(w http.ResponseWriter, status int, report Report) {
+	data, err := json.Marshal(report)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+func unixNanoOrNil(t int64) *time.Time {
+	if t == 0 {
+		return nil
+	}
+	value := time.Unix(0, t).UTC()
+	return &value
+}
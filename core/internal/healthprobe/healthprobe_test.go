@@ -0,0 +1,95 @@
+package healthprobe_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/healthprobe"
+)
+
+func get(t *testing.T, status *healthprobe.Status, path string) (int, healthprobe.Report) {
+	recorder := httptest.NewRecorder()
+	status.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var report healthprobe.Report
+	if recorder.Code != http.StatusBadRequest {
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+	}
+	return recorder.Code, report
+}
+
+func TestHealthz(t *testing.T) {
+	status := healthprobe.New(false)
+	status.FileStreamSynced()
+
+	code, report := get(t, status, "/healthz")
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, report.Online)
+	require.NotNil(t, report.LastFileStreamSync)
+	assert.WithinDuration(t, time.Now(), *report.LastFileStreamSync, time.Minute)
+	assert.Nil(t, report.LastUpload)
+	assert.Nil(t, report.Ready)
+}
+
+func TestReadyz_RecentSync(t *testing.T) {
+	status := healthprobe.New(false)
+	status.UploadSucceeded()
+
+	code, report := get(t, status, "/readyz?max_age_seconds=60")
+
+	assert.Equal(t, http.StatusOK, code)
+	require.NotNil(t, report.Ready)
+	assert.True(t, *report.Ready)
+}
+
+func TestReadyz_NoRecentSync(t *testing.T) {
+	status := healthprobe.New(false)
+	time.Sleep(5 * time.Millisecond)
+
+	code, report := get(t, status, "/readyz?max_age_seconds=0.001")
+
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	require.NotNil(t, report.Ready)
+	assert.False(t, *report.Ready)
+}
+
+func TestReadyz_OfflineIsReady(t *testing.T) {
+	status := healthprobe.New(true)
+	time.Sleep(5 * time.Millisecond)
+
+	code, report := get(t, status, "/readyz?max_age_seconds=0.001")
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.False(t, report.Online)
+}
+
+func TestReadyz_InvalidMaxAge(t *testing.T) {
+	code, _ := get(t, healthprobe.New(false), "/readyz?max_age_seconds=soon")
+
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestNilStatusIgnoresSyncs(t *testing.T) {
+	var status *healthprobe.Status
+
+	status.FileStreamSynced()
+	status.UploadSucceeded()
+}
+
+func TestServe(t *testing.T) {
+	status := healthprobe.New(false)
+	addr, stop, err := status.Serve("127.0.0.1:0")
+	require.NoError(t, err)
+	defer stop()
+
+	resp, err := http.Get("http://" + addr + "/healthz")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
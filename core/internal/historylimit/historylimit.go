@@ -0,0 +1,81 @@
+// Package historylimit limits how often individual metrics are logged to
+// history.
+package historylimit
+
+import (
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Limiter enforces a maximum rate of history points per metric.
+//
+// It protects against runaway logging, such as a metric logged in a tight
+// loop by mistake. Each metric gets its own token bucket, so a noisy metric
+// does not affect the others.
+//
+// A nil Limiter allows everything.
+type Limiter struct {
+	// limit is the maximum number of points per second for each metric.
+	limit rate.Limit
+
+	// burst is the number of points a metric may log at once.
+	burst int
+
+	// limiters are the token buckets for each metric.
+	limiters map[string]*rate.Limiter
+
+	// dropped is the number of points dropped for each metric.
+	dropped map[string]int
+}
+
+// New returns a Limiter allowing `pointsPerSecond` points for each metric.
+//
+// Returns nil if `pointsPerSecond` is not positive, which means there is
+// no limit.
+func New(pointsPerSecond float64) *Limiter {
+	if pointsPerSecond <= 0 {
+		return nil
+	}
+
+	return &Limiter{
+		limit:    rate.Limit(pointsPerSecond),
+		burst:    int(math.Max(1, math.Ceil(pointsPerSecond))),
+		limiters: make(map[string]*rate.Limiter),
+		dropped:  make(map[string]int),
+	}
+}
+
+// Allow reports whether a point for the metric logged at `now` is within
+// the limit.
+//
+// Points that aren't allowed are counted as dropped.
+func (l *Limiter) Allow(metric string, now time.Time) bool {
+	if l == nil {
+		return true
+	}
+
+	limiter, ok := l.limiters[metric]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[metric] = limiter
+	}
+
+	if limiter.AllowN(now, 1) {
+		return true
+	}
+
+	l.dropped[metric]++
+	return false
+}
+
+// Dropped returns the number of points dropped for each metric.
+//
+// Metrics without dropped points are not included.
+func (l *Limiter) Dropped() map[string]int {
+	if l == nil {
+		return nil
+	}
+	return l.dropped
+}
@@ -0,0 +1,37 @@
+package historylimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/historylimit"
+)
+
+func TestNew_NoLimit(t *testing.T) {
+	limiter := historylimit.New(0)
+
+	assert.Nil(t, limiter)
+	for i := 0; i < 100; i++ {
+		assert.True(t, limiter.Allow("loss", time.Unix(0, 0)))
+	}
+	assert.Empty(t, limiter.Dropped())
+}
+
+func TestAllow_DropsExcessPointsPerMetric(t *testing.T) {
+	limiter := historylimit.New(2)
+	now := time.Unix(1000, 0)
+
+	assert.True(t, limiter.Allow("loss", now))
+	assert.True(t, limiter.Allow("loss", now))
+	assert.False(t, limiter.Allow("loss", now))
+	assert.False(t, limiter.Allow("loss", now))
+
+	// Other metrics have their own budget.
+	assert.True(t, limiter.Allow("acc", now))
+
+	// The budget refills over time.
+	assert.True(t, limiter.Allow("loss", now.Add(time.Second)))
+
+	assert.Equal(t, map[string]int{"loss": 2}, limiter.Dropped())
+}
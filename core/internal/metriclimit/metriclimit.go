@@ -0,0 +1,61 @@
+// Package metriclimit limits how many distinct metrics a run may have.
+package metriclimit
+
+// Limiter caps the number of distinct metric names.
+//
+// It protects against metric names generated by mistake, such as names that
+// include a timestamp, which create an unbounded number of metrics. Once
+// the cap is reached, metrics that already exist are still allowed, but new
+// ones are rejected.
+//
+// A nil Limiter allows everything.
+type Limiter struct {
+	// max is the maximum number of distinct metrics.
+	max int
+
+	// metrics are the allowed metric names.
+	metrics map[string]struct{}
+}
+
+// New returns a Limiter allowing up to `max` distinct metrics.
+//
+// Returns nil if `max` is not positive, which means there is no limit.
+func New(max int) *Limiter {
+	if max <= 0 {
+		return nil
+	}
+
+	return &Limiter{
+		max:     max,
+		metrics: make(map[string]struct{}),
+	}
+}
+
+// Max returns the maximum number of distinct metrics, or 0 if unlimited.
+func (l *Limiter) Max() int {
+	if l == nil {
+		return 0
+	}
+	return l.max
+}
+
+// Allow reports whether a value for the metric may be logged.
+//
+// A metric is allowed if it was allowed before or if there is room for
+// another metric.
+func (l *Limiter) Allow(metric string) bool {
+	if l == nil {
+		return true
+	}
+
+	if _, ok := l.metrics[metric]; ok {
+		return true
+	}
+
+	if len(l.metrics) >= l.max {
+		return false
+	}
+
+	l.metrics[metric] = struct{}{}
+	return true
+}
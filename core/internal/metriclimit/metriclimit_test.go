@@ -0,0 +1,29 @@
+package metriclimit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/metriclimit"
+)
+
+func TestNew_NoLimit(t *testing.T) {
+	limiter := metriclimit.New(0)
+
+	assert.Nil(t, limiter)
+	assert.Zero(t, limiter.Max())
+	assert.True(t, limiter.Allow("loss"))
+}
+
+func TestAllow_RejectsNewMetricsOverLimit(t *testing.T) {
+	limiter := metriclimit.New(2)
+
+	assert.True(t, limiter.Allow("loss"))
+	assert.True(t, limiter.Allow("acc"))
+	assert.False(t, limiter.Allow("lr"))
+
+	// Existing metrics keep logging.
+	assert.True(t, limiter.Allow("loss"))
+	assert.True(t, limiter.Allow("acc"))
+	assert.False(t, limiter.Allow("lr"))
+}
@@ -0,0 +1,166 @@
+// Package netpause lets operators pause all network requests at runtime.
+package netpause
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Gate holds back network requests while it is paused.
+//
+// Requests that are in flight when the gate is paused are not interrupted,
+// but they are not retried until the gate is resumed. Data that is waiting
+// to be sent stays buffered in memory, and records are still written to the
+// transaction log on disk.
+//
+// A nil Gate is never paused.
+type Gate struct {
+	mu sync.Mutex
+
+	// resumed is closed when the gate is resumed, or nil if the gate is
+	// not paused.
+	resumed chan struct{}
+}
+
+// New returns a Gate that is not paused.
+func New() *Gate {
+	return &Gate{}
+}
+
+// Pause holds back new requests until Resume is called.
+func (g *Gate) Pause() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.resumed == nil {
+		g.resumed = make(chan struct{})
+	}
+}
+
+// Resume lets requests through again.
+func (g *Gate) Resume() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.resumed != nil {
+		close(g.resumed)
+		g.resumed = nil
+	}
+}
+
+// IsPaused reports whether requests are being held back.
+func (g *Gate) IsPaused() bool {
+	if g == nil {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.resumed != nil
+}
+
+// Wait blocks until the gate is not paused or the context is done.
+func (g *Gate) Wait(ctx context.Context) error {
+	if g == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	resumed := g.resumed
+	g.mu.Unlock()
+
+	if resumed == nil {
+		return nil
+	}
+
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Install makes the client wait for the gate before each attempt.
+//
+// Waiting happens before the request's timeout starts, so that a long
+// pause doesn't cause timeouts.
+func (g *Gate) Install(client *retryablehttp.Client) {
+	if g == nil {
+		return
+	}
+
+	next := client.RequestLogHook
+	client.RequestLogHook = func(
+		logger retryablehttp.Logger,
+		req *http.Request,
+		attempt int,
+	) {
+		_ = g.Wait(req.Context())
+
+		if next != nil {
+			next(logger, req, attempt)
+		}
+	}
+}
+
+// WatchFile pauses the gate while a file exists.
+//
+// The file is checked every period. onChange is called with the new state
+// whenever the gate is paused or resumed. Returns a function that stops
+// watching and resumes the gate.
+func (g *Gate) WatchFile(
+	path string,
+	period time.Duration,
+	onChange func(paused bool),
+) (stop func()) {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+
+	check := func() {
+		_, err := os.Stat(path)
+		shouldPause := err == nil
+
+		if shouldPause == g.IsPaused() {
+			return
+		}
+
+		if shouldPause {
+			g.Pause()
+		} else {
+			g.Resume()
+		}
+		onChange(shouldPause)
+	}
+
+	check()
+	go func() {
+		defer close(finished)
+
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				check()
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-finished
+			g.Resume()
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package netpause_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/netpause"
+)
+
+func TestNilGateIsNeverPaused(t *testing.T) {
+	var gate *netpause.Gate
+
+	assert.False(t, gate.IsPaused())
+	assert.NoError(t, gate.Wait(context.Background()))
+}
+
+func TestWait_RespectsContext(t *testing.T) {
+	gate := netpause.New()
+	gate.Pause()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, gate.Wait(ctx), context.Canceled)
+}
+
+func TestInstall_HoldsBackRequests(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+		}))
+	defer server.Close()
+
+	gate := netpause.New()
+	client := retryablehttp.NewClient()
+	client.Logger = nil
+	gate.Install(client)
+	gate.Pause()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, requests.Load())
+
+	gate.Resume()
+	<-done
+	assert.EqualValues(t, 1, requests.Load())
+}
+
+func TestWatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pause")
+	require.NoError(t, os.WriteFile(path, nil, 0o644))
+	gate := netpause.New()
+	changes := make(chan bool, 10)
+
+	stop := gate.WatchFile(path, time.Millisecond, func(paused bool) {
+		changes <- paused
+	})
+	defer stop()
+
+	assert.True(t, <-changes)
+	assert.True(t, gate.IsPaused())
+
+	require.NoError(t, os.Remove(path))
+	assert.False(t, <-changes)
+	assert.False(t, gate.IsPaused())
+}
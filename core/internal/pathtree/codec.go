@@ -0,0 +1,93 @@
+package pathtree
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is a serialization format for a tree.
+type Format int
+
+const (
+	FormatYaml Format = iota
+	FormatJson
+)
+
+// Codec converts trees to and from a serialized format.
+type Codec interface {
+	// Marshal serializes the tree.
+	Marshal(tree TreeData) ([]byte, error)
+
+	// Unmarshal deserializes a tree.
+	Unmarshal(data []byte) (TreeData, error)
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = make(map[Format]Codec)
+)
+
+func init() {
+	RegisterCodec(FormatYaml, yamlCodec{})
+	RegisterCodec(FormatJson, jsonCodec{})
+}
+
+// RegisterCodec makes a codec available for the given format.
+//
+// It is meant to be called from init functions. It panics if the codec is
+// nil or if a codec is already registered for the format.
+func RegisterCodec(format Format, codec Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+
+	if codec == nil {
+		panic("pathtree: RegisterCodec codec is nil")
+	}
+	if _, exists := codecs[format]; exists {
+		panic(fmt.Sprintf("pathtree: RegisterCodec called twice for format %v", format))
+	}
+	codecs[format] = codec
+}
+
+// codecFor returns the codec registered for the format.
+func codecFor(format Format) (Codec, error) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+
+	codec, ok := codecs[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported format: %v", format)
+	}
+	return codec, nil
+}
+
+type yamlCodec struct{}
+
+func (yamlCodec) Marshal(tree TreeData) ([]byte, error) {
+	return yaml.Marshal(tree)
+}
+
+func (yamlCodec) Unmarshal(data []byte) (TreeData, error) {
+	tree := make(TreeData)
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(tree TreeData) ([]byte, error) {
+	return json.Marshal(tree)
+}
+
+func (jsonCodec) Unmarshal(data []byte) (TreeData, error) {
+	tree := make(TreeData)
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
@@ -0,0 +1,10 @@
+package pathtree
+
+// UnregisterCodec removes the codec registered for the format, so that
+// tests can register codecs without affecting other tests.
+func UnregisterCodec(format Format) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+
+	delete(codecs, format)
+}
@@ -2,6 +2,11 @@ package pathtree
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // TreeData is an internal representation for a nested key-value pair.
@@ -36,6 +41,34 @@ func NewFrom(tree TreeData) *PathTree {
 	return &PathTree{tree}
 }
 
+// Deserializes a tree using the codec registered for the format.
+func NewFromSerialized(format Format, data []byte) (*PathTree, error) {
+	codec, err := codecFor(format)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := codec.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if tree == nil {
+		tree = make(TreeData)
+	}
+
+	return &PathTree{tree}, nil
+}
+
+// Serializes the tree using the codec registered for the format.
+func (pt *PathTree) Serialize(format Format) ([]byte, error) {
+	codec, err := codecFor(format)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.Marshal(pt.tree)
+}
+
 // Returns the underlying config tree.
 //
 // Provided temporarily as part of a refactor. Avoid using this, especially
@@ -69,6 +102,154 @@ func (pt *PathTree) ApplyUpdate(
 	}
 }
 
+// Updates values in the tree, merging maps into existing subtrees.
+//
+// This is like ApplyUpdate, except that a map value for a path that is
+// already a subtree is merged into it recursively, so keys missing from the
+// update keep their values. Other values replace whatever is at the path.
+// Errors are passed to `onError` and skipped.
+func (pt *PathTree) ApplyMerge(
+	items []*PathItem,
+	onError func(error),
+) {
+	for _, item := range items {
+		if err := mergeAtPath(pt.tree, item.Path, item.Value); err != nil {
+			onError(err)
+		}
+	}
+}
+
+// Updates values in the tree, indexing into lists.
+//
+// This is like ApplyUpdate, except that a numeric path segment that
+// follows a list-valued leaf replaces the list element at that index
+// instead of the whole list. Indices must be in bounds. Errors are passed
+// to `onError` and skipped.
+//
+// Lists, and maps inside lists, are copied before being modified, so
+// clones of the tree are not affected.
+func (pt *PathTree) ApplyIndexedUpdate(
+	items []*PathItem,
+	onError func(error),
+) {
+	for _, item := range items {
+		if _, err := setIndexed(pt.tree, item.Path, item.Value, false); err != nil {
+			onError(err)
+		}
+	}
+}
+
+// Returns the value at the path, indexing into lists.
+//
+// A numeric path segment that follows a list-valued leaf selects the list
+// element at that index. Returns an error if the path doesn't exist.
+func (pt *PathTree) GetIndexed(path TreePath) (any, error) {
+	var node any = pt.tree
+	for i, key := range path {
+		switch x := node.(type) {
+		case TreeData:
+			child, ok := x[key]
+			if !ok {
+				return nil, fmt.Errorf("pathtree: no value at path %v", path[:i+1])
+			}
+			node = child
+
+		case []any:
+			index, err := listIndex(x, key, path[:i+1])
+			if err != nil {
+				return nil, err
+			}
+			node = x[index]
+
+		default:
+			return nil, fmt.Errorf(
+				"pathtree: value at path %v is type %T, not a map or list",
+				path[:i],
+				node,
+			)
+		}
+	}
+
+	return node, nil
+}
+
+// Sets the value at the path below the node, indexing into lists.
+//
+// Returns the updated node. Lists are always copied, since deepCopy shares
+// them between clones of the tree. For the same reason, maps inside lists
+// are copied, which is indicated by `shared`; other maps are updated in
+// place. Nothing is modified if there is an error.
+func setIndexed(node any, path TreePath, value any, shared bool) (any, error) {
+	key := path[0]
+
+	switch x := node.(type) {
+	case TreeData:
+		if shared {
+			x = maps.Clone(x)
+		}
+
+		if len(path) == 1 {
+			x[key] = value
+			return x, nil
+		}
+
+		child, ok := x[key]
+		if !ok {
+			child = make(TreeData)
+		}
+		newChild, err := setIndexed(child, path[1:], value, shared)
+		if err != nil {
+			return nil, err
+		}
+		x[key] = newChild
+		return x, nil
+
+	case []any:
+		index, err := listIndex(x, key, path[:1])
+		if err != nil {
+			return nil, err
+		}
+
+		newElement := value
+		if len(path) > 1 {
+			newElement, err = setIndexed(x[index], path[1:], value, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		clone := slices.Clone(x)
+		clone[index] = newElement
+		return clone, nil
+
+	default:
+		return nil, fmt.Errorf(
+			"pathtree: value at %q is type %T, not a map or list",
+			key,
+			node,
+		)
+	}
+}
+
+// Parses a path segment as an index into the list.
+func listIndex(list []any, key string, path TreePath) (int, error) {
+	index, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("pathtree: value at path %v is a list, but %q is not an index", path, key)
+	}
+
+	if index < 0 || index >= len(list) {
+		return 0, fmt.Errorf(
+			"pathtree: index %d out of range for list of length %d at path %v",
+			index,
+			len(list),
+			path,
+		)
+	}
+
+	return index, nil
+}
+
 // Removes values from the tree.
 func (pt *PathTree) ApplyRemove(
 	items []*PathItem,
@@ -158,6 +339,33 @@ func updateAtPath(
 	return nil
 }
 
+// Merges the value into the tree at the path.
+//
+// If both the value and the existing node are maps, each key of the value
+// is merged recursively. Otherwise, the value is set at the path.
+func mergeAtPath(
+	tree TreeData,
+	path TreePath,
+	value any,
+) error {
+	update, ok := value.(TreeData)
+	if !ok {
+		return updateAtPath(tree, path, value)
+	}
+
+	existing := getSubtree(tree, path)
+	if existing == nil {
+		return updateAtPath(tree, path, value)
+	}
+
+	for key, child := range update {
+		if err := mergeAtPath(existing, TreePath{key}, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Returns the subtree at the path, or nil if it does not exist.
 func getSubtree(
 	tree TreeData,
@@ -228,3 +436,65 @@ func deepCopy(tree TreeData) (TreeData, error) {
 	}
 	return clone, nil
 }
+
+// MergeDuplicateLeaves resolves updates that set the same dot-separated
+// key more than once.
+//
+// The backend flattens nested keys using ".", so {"a": {"b": 1}} and
+// {"a.b": 2} refer to the same key "a.b". The last value for each key wins.
+//
+// If no key is set twice, the updates are returned unchanged. Otherwise,
+// the updates are returned as a list of leaves without the overwritten
+// values, along with the duplicated keys in the order they were found.
+func MergeDuplicateLeaves(items []*PathItem) ([]*PathItem, []string) {
+	var leaves []*PathItem
+	for _, item := range items {
+		leaves = appendLeaves(leaves, item.Path, item.Value)
+	}
+
+	lastIndex := make(map[string]int, len(leaves))
+	var duplicates []string
+	for i, leaf := range leaves {
+		key := strings.Join(leaf.Path, ".")
+		if _, isDuplicate := lastIndex[key]; isDuplicate &&
+			!slices.Contains(duplicates, key) {
+			duplicates = append(duplicates, key)
+		}
+		lastIndex[key] = i
+	}
+
+	if len(duplicates) == 0 {
+		return items, nil
+	}
+
+	merged := make([]*PathItem, 0, len(lastIndex))
+	for i, leaf := range leaves {
+		if lastIndex[strings.Join(leaf.Path, ".")] == i {
+			merged = append(merged, leaf)
+		}
+	}
+	return merged, duplicates
+}
+
+// appendLeaves appends the leaves of a value at the path.
+//
+// Empty maps are treated as leaves.
+func appendLeaves(leaves []*PathItem, path TreePath, value any) []*PathItem {
+	subtree, ok := value.(TreeData)
+	if !ok || len(subtree) == 0 {
+		return append(leaves, &PathItem{Path: path, Value: value})
+	}
+
+	keys := make([]string, 0, len(subtree))
+	for key := range subtree {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		leaves = appendLeaves(leaves,
+			append(slices.Clone(path), key),
+			subtree[key])
+	}
+	return leaves
+}
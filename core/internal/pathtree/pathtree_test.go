@@ -106,3 +106,213 @@ func TestFlattenEmptyTree(t *testing.T) {
 		t.Errorf("Expected no items, got %d", len(items))
 	}
 }
+
+func TestSerializeRoundTrip(t *testing.T) {
+	treeData := pathtree.TreeData{
+		"config": map[string]interface{}{
+			"setting1": "value1",
+			"nested": map[string]interface{}{
+				"setting2": "value2",
+			},
+		},
+	}
+
+	for _, format := range []pathtree.Format{
+		pathtree.FormatJson,
+		pathtree.FormatYaml,
+	} {
+		data, err := pathtree.NewFrom(treeData).Serialize(format)
+		if err != nil {
+			t.Fatalf("Serialize(%v) failed: %v", format, err)
+		}
+
+		pt, err := pathtree.NewFromSerialized(format, data)
+		if err != nil {
+			t.Fatalf("NewFromSerialized(%v) failed: %v", format, err)
+		}
+		if !reflect.DeepEqual(pt.Tree(), treeData) {
+			t.Errorf("Expected %v, got %v", treeData, pt.Tree())
+		}
+	}
+}
+
+func TestSerializeUnsupportedFormat(t *testing.T) {
+	unknown := pathtree.Format(-1)
+
+	if _, err := pathtree.New().Serialize(unknown); err == nil {
+		t.Error("Serialize() should fail for an unregistered format")
+	}
+	if _, err := pathtree.NewFromSerialized(unknown, nil); err == nil {
+		t.Error("NewFromSerialized() should fail for an unregistered format")
+	}
+}
+
+// keysCodec serializes only the top-level keys of a tree.
+type keysCodec struct{}
+
+func (keysCodec) Marshal(tree pathtree.TreeData) ([]byte, error) {
+	keys := make([]string, 0, len(tree))
+	for key := range tree {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return []byte(strings.Join(keys, ",")), nil
+}
+
+func (keysCodec) Unmarshal(data []byte) (pathtree.TreeData, error) {
+	tree := make(pathtree.TreeData)
+	for _, key := range strings.Split(string(data), ",") {
+		tree[key] = true
+	}
+	return tree, nil
+}
+
+func TestRegisterCodec(t *testing.T) {
+	format := pathtree.Format(100)
+	pathtree.RegisterCodec(format, keysCodec{})
+	t.Cleanup(func() { pathtree.UnregisterCodec(format) })
+
+	data, err := pathtree.NewFrom(pathtree.TreeData{"b": 1, "a": 2}).Serialize(format)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	if string(data) != "a,b" {
+		t.Errorf("Expected %q, got %q", "a,b", string(data))
+	}
+
+	pt, err := pathtree.NewFromSerialized(format, []byte("x,y"))
+	if err != nil {
+		t.Fatalf("NewFromSerialized() failed: %v", err)
+	}
+	expected := pathtree.TreeData{"x": true, "y": true}
+	if !reflect.DeepEqual(pt.Tree(), expected) {
+		t.Errorf("Expected %v, got %v", expected, pt.Tree())
+	}
+}
+
+func TestRegisterCodecTwicePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterCodec() should panic for an already registered format")
+		}
+	}()
+
+	pathtree.RegisterCodec(pathtree.FormatJson, keysCodec{})
+}
+
+func TestApplyMerge(t *testing.T) {
+	pt := pathtree.NewFrom(pathtree.TreeData{
+		"optimizer": pathtree.TreeData{
+			"lr":       0.1,
+			"momentum": 0.9,
+			"schedule": pathtree.TreeData{"warmup": 10.0, "decay": "cosine"},
+		},
+		"layers": 3.0,
+	})
+
+	pt.ApplyMerge(
+		[]*pathtree.PathItem{
+			{Path: pathtree.TreePath{"optimizer", "lr"}, Value: 0.01},
+			{
+				Path: pathtree.TreePath{"optimizer"},
+				Value: pathtree.TreeData{
+					"schedule": pathtree.TreeData{"warmup": 5.0},
+				},
+			},
+			{Path: pathtree.TreePath{"layers"}, Value: pathtree.TreeData{"n": 4.0}},
+		},
+		func(err error) { t.Errorf("unexpected error: %v", err) },
+	)
+
+	expected := pathtree.TreeData{
+		"optimizer": pathtree.TreeData{
+			"lr":       0.01,
+			"momentum": 0.9,
+			"schedule": pathtree.TreeData{"warmup": 5.0, "decay": "cosine"},
+		},
+		"layers": pathtree.TreeData{"n": 4.0},
+	}
+	if !reflect.DeepEqual(pt.Tree(), expected) {
+		t.Errorf("Expected %v, got %v", expected, pt.Tree())
+	}
+}
+
+func TestApplyMerge_ErrorThroughLeaf(t *testing.T) {
+	pt := pathtree.NewFrom(pathtree.TreeData{"a": 1.0})
+
+	var errs []error
+	pt.ApplyMerge(
+		[]*pathtree.PathItem{
+			{Path: pathtree.TreePath{"a", "b"}, Value: 2.0},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	if len(errs) != 1 {
+		t.Errorf("Expected 1 error, got %v", errs)
+	}
+}
+
+func TestApplyIndexedUpdate(t *testing.T) {
+	original := []any{1.0, pathtree.TreeData{"a": 1.0}, 3.0}
+	pt := pathtree.NewFrom(pathtree.TreeData{
+		"metrics": original,
+		"scalar":  5.0,
+	})
+
+	var errs []error
+	pt.ApplyIndexedUpdate(
+		[]*pathtree.PathItem{
+			{Path: pathtree.TreePath{"metrics", "0"}, Value: 10.0},
+			{Path: pathtree.TreePath{"metrics", "1", "a"}, Value: 20.0},
+			{Path: pathtree.TreePath{"metrics", "3"}, Value: 0.0},
+			{Path: pathtree.TreePath{"metrics", "x"}, Value: 0.0},
+			{Path: pathtree.TreePath{"scalar", "0"}, Value: 0.0},
+			{Path: pathtree.TreePath{"new", "key"}, Value: 1.0},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	expected := pathtree.TreeData{
+		"metrics": []any{10.0, pathtree.TreeData{"a": 20.0}, 3.0},
+		"scalar":  5.0,
+		"new":     pathtree.TreeData{"key": 1.0},
+	}
+	if !reflect.DeepEqual(pt.Tree(), expected) {
+		t.Errorf("Expected %v, got %v", expected, pt.Tree())
+	}
+	if len(errs) != 3 {
+		t.Errorf("Expected 3 errors, got %v", errs)
+	}
+	if !reflect.DeepEqual(original, []any{1.0, pathtree.TreeData{"a": 1.0}, 3.0}) {
+		t.Errorf("Expected the original list to be unchanged, got %v", original)
+	}
+}
+
+func TestGetIndexed(t *testing.T) {
+	pt := pathtree.NewFrom(pathtree.TreeData{
+		"metrics": []any{1.0, []any{"nested"}},
+		"scalar":  5.0,
+	})
+
+	value, err := pt.GetIndexed(pathtree.TreePath{"metrics", "1", "0"})
+	if err != nil || value != "nested" {
+		t.Errorf("Expected \"nested\", got %v (error %v)", value, err)
+	}
+
+	value, err = pt.GetIndexed(pathtree.TreePath{"scalar"})
+	if err != nil || value != 5.0 {
+		t.Errorf("Expected 5, got %v (error %v)", value, err)
+	}
+
+	for _, path := range []pathtree.TreePath{
+		{"metrics", "2"},
+		{"metrics", "-1"},
+		{"scalar", "0"},
+		{"missing"},
+	} {
+		if _, err := pt.GetIndexed(path); err == nil {
+			t.Errorf("Expected an error for path %v", path)
+		}
+	}
+}
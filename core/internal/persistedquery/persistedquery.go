@@ -0,0 +1,188 @@
+// Package persistedquery implements automatic persisted queries (APQ) for
+// GraphQL.
+//
+// Instead of the query text, requests contain its SHA-256 hash. If the
+// backend doesn't know the hash yet, it responds with a
+// "PersistedQueryNotFound" error and the request is sent again with the
+// full query, which the backend then remembers for later requests.
+package persistedquery
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+const (
+	// notFoundMessage is the error returned for unknown hashes.
+	notFoundMessage = "PersistedQueryNotFound"
+
+	// notFoundCode is the error code returned for unknown hashes.
+	notFoundCode = "PERSISTED_QUERY_NOT_FOUND"
+
+	// notSupportedMessage is the error returned by backends without APQ.
+	notSupportedMessage = "PersistedQueryNotSupported"
+
+	// notSupportedCode is the error code returned by backends without APQ.
+	notSupportedCode = "PERSISTED_QUERY_NOT_SUPPORTED"
+)
+
+// Client is a GraphQL client that sends persisted queries.
+//
+// If the backend reports that it doesn't support persisted queries, the
+// client falls back to always sending the full query.
+type Client struct {
+	// endpoint is the GraphQL URL.
+	endpoint string
+
+	// httpClient sends requests.
+	httpClient graphql.Doer
+
+	// unsupported is set once the backend says it doesn't support
+	// persisted queries.
+	unsupported atomic.Bool
+}
+
+// request is the body of a persisted query request.
+type request struct {
+	Query      string     `json:"query,omitempty"`
+	Variables  any        `json:"variables,omitempty"`
+	OpName     string     `json:"operationName"`
+	Extensions extensions `json:"extensions"`
+}
+
+type extensions struct {
+	PersistedQuery persistedQuery `json:"persistedQuery"`
+}
+
+type persistedQuery struct {
+	Version    int    `json:"version"`
+	SHA256Hash string `json:"sha256Hash"`
+}
+
+// NewClient returns a client that sends persisted queries to the endpoint.
+func NewClient(endpoint string, httpClient graphql.Doer) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{endpoint: endpoint, httpClient: httpClient}
+}
+
+// MakeRequest implements graphql.Client.MakeRequest.
+//
+// The query hash is sent first. The full query is sent only if the backend
+// doesn't know the hash.
+func (c *Client) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	hash := sha256.Sum256([]byte(req.Query))
+	body := request{
+		Variables: req.Variables,
+		OpName:    req.OpName,
+		Extensions: extensions{
+			PersistedQuery: persistedQuery{
+				Version:    1,
+				SHA256Hash: hex.EncodeToString(hash[:]),
+			},
+		},
+	}
+
+	if !c.unsupported.Load() {
+		err := c.send(ctx, &body, resp)
+		switch {
+		case hasError(resp, notSupportedMessage, notSupportedCode):
+			c.unsupported.Store(true)
+		case !hasError(resp, notFoundMessage, notFoundCode):
+			return err
+		}
+	}
+
+	body.Query = req.Query
+	return c.send(ctx, &body, resp)
+}
+
+// send makes one request and decodes the response into resp.
+func (c *Client) send(
+	ctx context.Context,
+	body *request,
+	resp *graphql.Response,
+) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.endpoint,
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+
+	resp.Extensions = nil
+	resp.Errors = nil
+
+	// Decode into a copy so that a "null" data field in an error response
+	// doesn't clear the caller's data pointer.
+	attempt := graphql.Response{Data: resp.Data}
+	decodeErr := json.Unmarshal(respBody, &attempt)
+
+	if httpResp.StatusCode != http.StatusOK {
+		// Some backends report unknown hashes with a 4xx status.
+		if decodeErr == nil && len(attempt.Errors) > 0 {
+			resp.Errors = attempt.Errors
+			return attempt.Errors
+		}
+		return fmt.Errorf("returned error %v: %s", httpResp.Status, respBody)
+	}
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	resp.Extensions = attempt.Extensions
+	resp.Errors = attempt.Errors
+	if len(attempt.Errors) > 0 {
+		return attempt.Errors
+	}
+	return nil
+}
+
+// hasError reports whether the response has an error with the given
+// message or extension code.
+func hasError(resp *graphql.Response, message string, code string) bool {
+	for _, err := range resp.Errors {
+		if err == nil {
+			continue
+		}
+		if err.Message == message || err.Extensions["code"] == code {
+			return true
+		}
+	}
+	return false
+}
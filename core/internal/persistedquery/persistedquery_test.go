@@ -0,0 +1,137 @@
+package persistedquery_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/persistedquery"
+)
+
+const testQuery = "query Viewer { viewer { id } }"
+
+type requestBody struct {
+	Query      string `json:"query"`
+	Extensions struct {
+		PersistedQuery struct {
+			Version    int    `json:"version"`
+			SHA256Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions"`
+}
+
+// fakeBackend is a GraphQL server that supports persisted queries.
+type fakeBackend struct {
+	sync.Mutex
+
+	// supported is whether the backend supports persisted queries.
+	supported bool
+
+	// known are the hashes of registered queries.
+	known map[string]string
+
+	// requests are the bodies of all requests received.
+	requests []requestBody
+}
+
+func (b *fakeBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.Lock()
+	defer b.Unlock()
+
+	var body requestBody
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	b.requests = append(b.requests, body)
+	hash := body.Extensions.PersistedQuery.SHA256Hash
+
+	switch {
+	case !b.supported && body.Query == "":
+		_, _ = w.Write([]byte(
+			`{"errors": [{"message": "PersistedQueryNotSupported"}]}`))
+	case body.Query == "" && b.known[hash] == "":
+		_, _ = w.Write([]byte(`{"data": null, "errors": [{` +
+			`"message": "PersistedQueryNotFound",` +
+			`"extensions": {"code": "PERSISTED_QUERY_NOT_FOUND"}}]}`))
+	default:
+		if b.supported {
+			b.known[hash] = body.Query
+		}
+		_, _ = w.Write([]byte(`{"data": {"viewer": {"id": "abc"}}}`))
+	}
+}
+
+func makeRequest(t *testing.T, client graphql.Client) string {
+	var data struct {
+		Viewer struct{ ID string } `json:"viewer"`
+	}
+	err := client.MakeRequest(
+		context.Background(),
+		&graphql.Request{Query: testQuery, OpName: "Viewer"},
+		&graphql.Response{Data: &data},
+	)
+	require.NoError(t, err)
+	return data.Viewer.ID
+}
+
+func queryHash() string {
+	hash := sha256.Sum256([]byte(testQuery))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestFallsBackToFullQueryThenUsesHash(t *testing.T) {
+	backend := &fakeBackend{supported: true, known: map[string]string{}}
+	server := httptest.NewServer(backend)
+	defer server.Close()
+	client := persistedquery.NewClient(server.URL, server.Client())
+
+	assert.Equal(t, "abc", makeRequest(t, client))
+	assert.Equal(t, "abc", makeRequest(t, client))
+
+	require.Len(t, backend.requests, 3)
+	for _, request := range backend.requests {
+		assert.Equal(t, 1, request.Extensions.PersistedQuery.Version)
+		assert.Equal(t, queryHash(), request.Extensions.PersistedQuery.SHA256Hash)
+	}
+	assert.Empty(t, backend.requests[0].Query)
+	assert.Equal(t, testQuery, backend.requests[1].Query)
+	assert.Empty(t, backend.requests[2].Query)
+}
+
+func TestStopsSendingHashesIfUnsupported(t *testing.T) {
+	backend := &fakeBackend{supported: false}
+	server := httptest.NewServer(backend)
+	defer server.Close()
+	client := persistedquery.NewClient(server.URL, server.Client())
+
+	assert.Equal(t, "abc", makeRequest(t, client))
+	assert.Equal(t, "abc", makeRequest(t, client))
+
+	require.Len(t, backend.requests, 3)
+	assert.Empty(t, backend.requests[0].Query)
+	assert.Equal(t, testQuery, backend.requests[1].Query)
+	assert.Equal(t, testQuery, backend.requests[2].Query)
+}
+
+func TestReturnsOtherErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"errors": [{"message": "bad"}]}`))
+		}))
+	defer server.Close()
+	client := persistedquery.NewClient(server.URL, server.Client())
+
+	err := client.MakeRequest(
+		context.Background(),
+		&graphql.Request{Query: testQuery},
+		&graphql.Response{},
+	)
+
+	assert.ErrorContains(t, err, "bad")
+}
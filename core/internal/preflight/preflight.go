@@ -0,0 +1,204 @@
+// Package preflight checks that a run can be logged before it starts.
+//
+// Misconfigured credentials or projects otherwise only surface when the
+// run is created, possibly after a long job has already been launched.
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// Check is one of the things verified before a run starts.
+type Check string
+
+const (
+	// CheckConnectivity verifies that the backend can be reached.
+	CheckConnectivity Check = "connectivity"
+
+	// CheckAuthentication verifies that the backend accepts the credentials.
+	CheckAuthentication Check = "authentication"
+
+	// CheckPermissions verifies that runs can be written to the project.
+	CheckPermissions Check = "permissions"
+)
+
+// Error is a failed preflight check.
+type Error struct {
+	// Check is the check that failed.
+	Check Check
+
+	// Message explains the failure and how to fix it.
+	Message string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("preflight: %s check failed: %s: %v",
+			e.Check, e.Message, e.Err)
+	}
+	return fmt.Sprintf("preflight: %s check failed: %s", e.Check, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Run checks that the backend is reachable, that it accepts the client's
+// credentials, and that runs can be written to the project.
+//
+// An empty entity means the user's default entity. If the project doesn't
+// exist yet, only the entity is checked, since the project is created with
+// the first run.
+//
+// Returns nil if the run can start and an *Error otherwise.
+func Run(
+	ctx context.Context,
+	client graphql.Client,
+	entity, project string,
+) error {
+	viewer, err := gql.Viewer(ctx, client)
+	if err != nil {
+		return requestError(err, CheckAuthentication)
+	}
+	if viewer.GetViewer() == nil {
+		return &Error{
+			Check:   CheckAuthentication,
+			Message: "the backend did not recognize the credentials; log in with `wandb login`",
+		}
+	}
+
+	if entity == "" {
+		if viewerEntity := viewer.GetViewer().GetEntity(); viewerEntity != nil {
+			entity = *viewerEntity
+		}
+	}
+	if entity == "" {
+		return &Error{
+			Check:   CheckPermissions,
+			Message: "no entity is set and the user has no default entity",
+		}
+	}
+	if project == "" {
+		return nil
+	}
+
+	data, err := gql.Preflight(ctx, client, entity, project)
+	if err != nil {
+		return requestError(err, CheckPermissions)
+	}
+
+	if data.GetEntity() == nil {
+		return &Error{
+			Check: CheckPermissions,
+			Message: fmt.Sprintf(
+				"entity %q does not exist or the user cannot access it",
+				entity),
+		}
+	}
+	if p := data.GetProject(); p != nil &&
+		p.GetReadOnly() != nil && *p.GetReadOnly() {
+		return &Error{
+			Check: CheckPermissions,
+			Message: fmt.Sprintf(
+				"the user cannot write to project %s/%s",
+				entity, project),
+		}
+	}
+
+	return nil
+}
+
+// requestError attributes a failed request to the check it indicates.
+//
+// Errors that aren't about the connection or the credentials, such as
+// GraphQL errors in the response, are attributed to the fallback check.
+func requestError(err error, fallback Check) *Error {
+	switch {
+	case isAuthError(err):
+		return &Error{
+			Check:   CheckAuthentication,
+			Message: "the backend rejected the credentials; check the API key",
+			Err:     err,
+		}
+	case isConnectionError(err):
+		return &Error{
+			Check:   CheckConnectivity,
+			Message: "could not reach the backend; check the base URL and network",
+			Err:     err,
+		}
+	default:
+		return &Error{
+			Check:   fallback,
+			Message: "the backend rejected the request",
+			Err:     err,
+		}
+	}
+}
+
+// httpStatusPattern finds the HTTP status in a genqlient error, which
+// reports it only in the message, as in
+// "returned error 401 Unauthorized: ...".
+var httpStatusPattern = regexp.MustCompile(`returned error (\d{3})\b`)
+
+// httpStatus returns the HTTP status code of a failed request, or 0 if
+// the backend didn't respond with an error status.
+func httpStatus(err error) int {
+	match := httpStatusPattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+
+	status, _ := strconv.Atoi(match[1])
+	return status
+}
+
+// isAuthError reports whether the backend rejected the credentials.
+func isAuthError(err error) bool {
+	if errors.Is(err, api.ErrCredentialsExpired) {
+		return true
+	}
+
+	status := httpStatus(err)
+	return status == http.StatusUnauthorized || status == http.StatusForbidden
+}
+
+// isConnectionError reports whether the request failed without a valid
+// response from the backend.
+//
+// Client errors other than authentication errors, like 400 or 404, mean
+// the backend was reached and rejected the request, so they aren't
+// connection errors.
+func isConnectionError(err error) bool {
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	// Server errors, like a 502 from a proxy, mean the backend itself
+	// couldn't be reached, as do errors that exhausted retries.
+	if status := httpStatus(err); status >= 500 {
+		return true
+	}
+	return strings.Contains(err.Error(), "giving up after")
+}
@@ -0,0 +1,160 @@
+package preflight_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/preflight"
+)
+
+const viewerResponse = `{"viewer": {"id": "user-id", "entity": "my-user"}}`
+
+func runPreflight(
+	t *testing.T,
+	client graphql.Client,
+	entity, project string,
+) *preflight.Error {
+	t.Helper()
+
+	err := preflight.Run(context.Background(), client, entity, project)
+	if err == nil {
+		return nil
+	}
+
+	var preflightErr *preflight.Error
+	require.ErrorAs(t, err, &preflightErr)
+	return preflightErr
+}
+
+func TestRun_OK(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(gqlmock.WithOpName("Viewer"), viewerResponse)
+	mockGQL.StubMatchOnce(
+		gomock.All(
+			gqlmock.WithOpName("Preflight"),
+			gqlmock.WithVariables(
+				gqlmock.GQLVar("entity", gomock.Eq("my-user")),
+				gqlmock.GQLVar("project", gomock.Eq("my-project")),
+			),
+		),
+		`{"entity": {"id": "e"}, "project": {"id": "p", "readOnly": false}}`,
+	)
+
+	assert.Nil(t, runPreflight(t, mockGQL, "", "my-project"))
+	assert.True(t, mockGQL.AllStubsUsed())
+}
+
+func TestRun_NewProject(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(gqlmock.WithOpName("Viewer"), viewerResponse)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("Preflight"),
+		`{"entity": {"id": "e"}, "project": null}`,
+	)
+
+	assert.Nil(t, runPreflight(t, mockGQL, "team", "new-project"))
+}
+
+func TestRun_ReadOnlyProject(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(gqlmock.WithOpName("Viewer"), viewerResponse)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("Preflight"),
+		`{"entity": {"id": "e"}, "project": {"id": "p", "readOnly": true}}`,
+	)
+
+	err := runPreflight(t, mockGQL, "team", "project")
+
+	require.NotNil(t, err)
+	assert.Equal(t, preflight.CheckPermissions, err.Check)
+	assert.Contains(t, err.Message, "cannot write to project team/project")
+}
+
+func TestRun_MissingEntity(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(gqlmock.WithOpName("Viewer"), viewerResponse)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("Preflight"),
+		`{"entity": null, "project": null}`,
+	)
+
+	err := runPreflight(t, mockGQL, "nobody", "project")
+
+	require.NotNil(t, err)
+	assert.Equal(t, preflight.CheckPermissions, err.Check)
+	assert.Contains(t, err.Message, `entity "nobody" does not exist`)
+}
+
+func TestRun_NoViewer(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(gqlmock.WithOpName("Viewer"), `{"viewer": null}`)
+
+	err := runPreflight(t, mockGQL, "team", "project")
+
+	require.NotNil(t, err)
+	assert.Equal(t, preflight.CheckAuthentication, err.Check)
+}
+
+func TestRun_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+	defer server.Close()
+
+	err := runPreflight(t,
+		graphql.NewClient(server.URL, server.Client()),
+		"team", "project")
+
+	require.NotNil(t, err)
+	assert.Equal(t, preflight.CheckAuthentication, err.Check)
+}
+
+func TestRun_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	err := runPreflight(t,
+		graphql.NewClient(server.URL, http.DefaultClient),
+		"team", "project")
+
+	require.NotNil(t, err)
+	assert.Equal(t, preflight.CheckConnectivity, err.Check)
+}
+
+func TestRun_ClassifiesHTTPErrorsByStatus(t *testing.T) {
+	// Client errors are attributed to the failing query's own check,
+	// which for the first query is authentication.
+	for _, tc := range []struct {
+		status int
+		check  preflight.Check
+	}{
+		{http.StatusBadRequest, preflight.CheckAuthentication},
+		{http.StatusForbidden, preflight.CheckAuthentication},
+		{http.StatusNotFound, preflight.CheckAuthentication},
+		{http.StatusBadGateway, preflight.CheckConnectivity},
+	} {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tc.status)
+				}))
+			defer server.Close()
+
+			err := runPreflight(t,
+				graphql.NewClient(server.URL, server.Client()),
+				"team", "project")
+
+			require.NotNil(t, err)
+			assert.Equal(t, tc.check, err.Check)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package runconfig
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// FileRefType is the "_type" of a config value that refers to a run file
+// holding the actual value.
+const FileRefType = "file-ref"
+
+// fileBackedDir is the run files subdirectory for file-backed values.
+const fileBackedDir = "config"
+
+// FileRef is stored in the config in place of a file-backed value.
+type FileRef struct {
+	Type string `json:"_type"`
+
+	// Path is the run file with the value's JSON, relative to the run's
+	// files directory.
+	Path string `json:"path"`
+
+	// Size is the size of the file in bytes.
+	Size int `json:"size"`
+
+	// Digest is the B64MD5 of the file.
+	Digest string `json:"digest"`
+}
+
+// OffloadFileBacked writes the record's file-backed values to files and
+// replaces them by references.
+//
+// Files are written under filesDir, and their paths relative to it are
+// returned. The record is not modified; if any value is offloaded, a
+// modified copy is returned. Values that fail to be written are kept
+// inline and their errors are passed to `onError`.
+func OffloadFileBacked(
+	record *service.ConfigRecord,
+	filesDir string,
+	onError func(error),
+) (*service.ConfigRecord, []string) {
+	if !hasFileBacked(record.GetUpdate()) {
+		return record, nil
+	}
+
+	record = proto.Clone(record).(*service.ConfigRecord)
+	var paths []string
+
+	for _, item := range record.GetUpdate() {
+		if !item.GetFileBacked() {
+			continue
+		}
+
+		ref, err := writeFileBacked(item, filesDir)
+		if err != nil {
+			onError(err)
+			continue
+		}
+
+		refJSON, err := json.Marshal(ref)
+		if err != nil {
+			onError(err)
+			continue
+		}
+
+		item.ValueJson = string(refJSON)
+		item.FileBacked = false
+		paths = append(paths, ref.Path)
+	}
+
+	return record, paths
+}
+
+func hasFileBacked(items []*service.ConfigItem) bool {
+	for _, item := range items {
+		if item.GetFileBacked() {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFileBacked writes the item's value to its run file.
+func writeFileBacked(item *service.ConfigItem, filesDir string) (*FileRef, error) {
+	if !json.Valid([]byte(item.GetValueJson())) {
+		return nil, fmt.Errorf(
+			"runconfig: invalid JSON for file-backed value %v",
+			keyPath(item))
+	}
+
+	path := fileBackedPath(keyPath(item))
+	realPath := filepath.Join(filesDir, filepath.FromSlash(path))
+	data := []byte(item.GetValueJson())
+
+	if err := os.MkdirAll(filepath.Dir(realPath), 0o755); err != nil {
+		return nil, fmt.Errorf("runconfig: %v", err)
+	}
+	if err := os.WriteFile(realPath, data, 0o644); err != nil {
+		return nil, fmt.Errorf("runconfig: %v", err)
+	}
+
+	return &FileRef{
+		Type:   FileRefType,
+		Path:   path,
+		Size:   len(data),
+		Digest: utils.ComputeB64MD5(data),
+	}, nil
+}
+
+// fileBackedPath returns the run file path for a value at the key path.
+//
+// Each key is a path component, so that distinct key paths like
+// ["a.b"] and ["a", "b"] get distinct files. Keys are escaped so that
+// they can't add path components or refer to parent directories.
+func fileBackedPath(keyPath []string) string {
+	escaped := make([]string, len(keyPath))
+	for i, key := range keyPath {
+		escaped[i] = escapePathComponent(key)
+	}
+	return fileBackedDir + "/" + strings.Join(escaped, "/") + ".json"
+}
+
+// escapePathComponent escapes a key for use as a file or directory name.
+//
+// Dots are escaped so that no key becomes "." or "..", and the empty key
+// becomes "%", which escaping never otherwise produces.
+func escapePathComponent(key string) string {
+	if key == "" {
+		return "%"
+	}
+	return strings.ReplaceAll(url.PathEscape(key), ".", "%2E")
+}
@@ -7,14 +7,13 @@ import (
 	"github.com/wandb/wandb/core/internal/corelib"
 	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/pkg/service"
-	"gopkg.in/yaml.v3"
 )
 
-type Format int
+type Format = pathtree.Format
 
 const (
-	FormatYaml Format = iota
-	FormatJson
+	FormatYaml = pathtree.FormatYaml
+	FormatJson = pathtree.FormatJson
 )
 
 // The configuration of a run.
@@ -43,42 +42,33 @@ func NewFrom(tree pathtree.TreeData) *RunConfig {
 
 func (rc *RunConfig) Serialize(format Format) ([]byte, error) {
 
-	value := make(map[string]any)
+	value := make(pathtree.TreeData)
 	for treeKey, treeValue := range rc.pathTree.Tree() {
 		value[treeKey] = map[string]any{"value": treeValue}
 	}
 
-	switch format {
-	case FormatYaml:
-		return yaml.Marshal(value)
-	case FormatJson:
-		return json.Marshal(value)
-	default:
-		return nil, fmt.Errorf("unsupported format: %v", format)
-	}
+	return pathtree.NewFrom(value).Serialize(format)
 }
 
 // Updates and/or removes values from the configuration tree.
 //
+// Updates overwrite whole subtrees unless the record sets `merge`, in which
+// case updating a subtree with a map keeps the subtree's keys that the map
+// doesn't set. Without `merge`, a numeric key below a list sets the list
+// element at that index. Removals are applied after updates.
+//
 // Does a best-effort job to apply all changes. Errors are passed to `onError`
 // and skipped.
 func (rc *RunConfig) ApplyChangeRecord(
 	configRecord *service.ConfigRecord,
 	onError func(error),
 ) {
-	updates := make([]*pathtree.PathItem, 0, len(configRecord.GetUpdate()))
-	for _, item := range configRecord.GetUpdate() {
-		var value any
-		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
-			onError(err)
-			continue
-		}
-		updates = append(updates, &pathtree.PathItem{
-			Path:  keyPath(item),
-			Value: value,
-		})
+	updates := decodeItems(configRecord.GetUpdate(), onError)
+	if configRecord.GetMerge() {
+		rc.pathTree.ApplyMerge(updates, onError)
+	} else {
+		rc.pathTree.ApplyIndexedUpdate(updates, onError)
 	}
-	rc.pathTree.ApplyUpdate(updates, onError)
 	removes := make([]*pathtree.PathItem, 0, len(configRecord.GetRemove()))
 	for _, item := range configRecord.GetRemove() {
 		removes = append(removes, &pathtree.PathItem{
@@ -153,6 +143,32 @@ func (rc *RunConfig) internalSubtree() pathtree.TreeData {
 	return node.(pathtree.TreeData)
 }
 
+// Items returns the config as one item per top-level key.
+//
+// The "_wandb" subtree is internal and left out.
+func (rc *RunConfig) Items() ([]*service.ConfigItem, error) {
+	tree := rc.pathTree.Tree()
+
+	items := make([]*service.ConfigItem, 0, len(tree))
+	for key, value := range tree {
+		if key == "_wandb" {
+			continue
+		}
+
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"runconfig: failed to marshal value for %q: %v", key, err)
+		}
+		items = append(items, &service.ConfigItem{
+			Key:       key,
+			ValueJson: string(valueJSON),
+		})
+	}
+
+	return items, nil
+}
+
 func (rc *RunConfig) Tree() pathtree.TreeData {
 	return rc.pathTree.Tree()
 }
@@ -161,6 +177,28 @@ func (rc *RunConfig) CloneTree() (pathtree.TreeData, error) {
 	return rc.pathTree.CloneTree()
 }
 
+// decodeItems parses the JSON values of config items.
+//
+// Items that fail to parse are passed to `onError` and skipped.
+func decodeItems(
+	items []*service.ConfigItem,
+	onError func(error),
+) []*pathtree.PathItem {
+	decoded := make([]*pathtree.PathItem, 0, len(items))
+	for _, item := range items {
+		var value any
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			onError(err)
+			continue
+		}
+		decoded = append(decoded, &pathtree.PathItem{
+			Path:  keyPath(item),
+			Value: value,
+		})
+	}
+	return decoded
+}
+
 // keyPath returns the key path for the given config item.
 // If the item has a nested key, it returns the nested key.
 // Otherwise, it returns a slice with the key.
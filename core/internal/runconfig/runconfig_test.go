@@ -1,13 +1,17 @@
 package runconfig_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/internal/corelib"
 	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
 )
 
 func TestConfigUpdate(t *testing.T) {
@@ -45,6 +49,84 @@ func TestConfigUpdate(t *testing.T) {
 	)
 }
 
+func TestConfigUpdate_ReplacesSubtree(t *testing.T) {
+	runConfig := runconfig.NewFrom(pathtree.TreeData{
+		"optimizer": pathtree.TreeData{
+			"name": "adam",
+			"lr":   0.1,
+		},
+	})
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "optimizer", ValueJson: `{"name": "sgd"}`},
+			},
+		}, ignoreError,
+	)
+
+	assert.Equal(t,
+		pathtree.TreeData{
+			"optimizer": pathtree.TreeData{"name": "sgd"},
+		},
+		runConfig.Tree(),
+	)
+}
+
+func TestConfigUpdate_SetsListElement(t *testing.T) {
+	runConfig := runconfig.NewFrom(pathtree.TreeData{
+		"layers": []any{64.0, 128.0, 256.0},
+	})
+	clone, err := runConfig.CloneTree()
+	require.NoError(t, err)
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{NestedKey: []string{"layers", "1"}, ValueJson: `512`},
+			},
+		}, ignoreError,
+	)
+
+	assert.Equal(t,
+		pathtree.TreeData{"layers": []any{64.0, 512.0, 256.0}},
+		runConfig.Tree(),
+	)
+	assert.Equal(t,
+		pathtree.TreeData{"layers": []any{64.0, 128.0, 256.0}},
+		clone,
+	)
+}
+
+func TestConfigUpdate_MergesPartialNestedUpdates(t *testing.T) {
+	runConfig := runconfig.NewFrom(pathtree.TreeData{
+		"optimizer": pathtree.TreeData{
+			"name": "adam",
+			"lr":   0.1,
+		},
+	})
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "optimizer", ValueJson: `{"lr": 0.01, "beta": 0.9}`},
+			},
+			Merge: true,
+		}, ignoreError,
+	)
+
+	assert.Equal(t,
+		pathtree.TreeData{
+			"optimizer": pathtree.TreeData{
+				"name": "adam",
+				"lr":   0.01,
+				"beta": 0.9,
+			},
+		},
+		runConfig.Tree(),
+	)
+}
+
 func TestConfigRemove(t *testing.T) {
 	runConfig := runconfig.NewFrom(pathtree.TreeData{
 		"a": 9,
@@ -151,3 +233,85 @@ func TestCloneTree(t *testing.T) {
 		runConfig.Tree(),
 	)
 }
+
+func TestOffloadFileBacked(t *testing.T) {
+	filesDir := t.TempDir()
+	record := &service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "lr", ValueJson: "0.1"},
+			{
+				NestedKey:  []string{"data", "schema/v1"},
+				ValueJson:  `{"columns":["a","b"]}`,
+				FileBacked: true,
+			},
+		},
+	}
+
+	offloaded, paths := runconfig.OffloadFileBacked(record, filesDir, ignoreError)
+
+	assert.Equal(t, []string{"config/data/schema%2Fv1.json"}, paths)
+	content, err := os.ReadFile(filepath.Join(filesDir, "config", "data", "schema%2Fv1.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"columns":["a","b"]}`, string(content))
+	assert.Equal(t, "0.1", offloaded.Update[0].ValueJson)
+	assert.False(t, offloaded.Update[1].FileBacked)
+	assert.JSONEq(t,
+		`{
+			"_type": "file-ref",
+			"path": "config/data/schema%2Fv1.json",
+			"size": 21,
+			"digest": "`+utils.ComputeB64MD5(content)+`"
+		}`,
+		offloaded.Update[1].ValueJson)
+	assert.True(t, record.Update[1].FileBacked, "input was modified")
+}
+
+func TestOffloadFileBacked_DottedKeysDontCollide(t *testing.T) {
+	record := &service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "a.b", ValueJson: "1", FileBacked: true},
+			{NestedKey: []string{"a", "b"}, ValueJson: "2", FileBacked: true},
+			{NestedKey: []string{"..", ""}, ValueJson: "3", FileBacked: true},
+		},
+	}
+
+	_, paths := runconfig.OffloadFileBacked(record, t.TempDir(), ignoreError)
+
+	assert.Equal(t,
+		[]string{
+			"config/a%2Eb.json",
+			"config/a/b.json",
+			"config/%2E%2E/%.json",
+		},
+		paths)
+}
+
+func TestOffloadFileBacked_NoFileBackedValues(t *testing.T) {
+	record := &service.ConfigRecord{
+		Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+	}
+
+	offloaded, paths := runconfig.OffloadFileBacked(record, t.TempDir(), ignoreError)
+
+	assert.Same(t, record, offloaded)
+	assert.Empty(t, paths)
+}
+
+func TestOffloadFileBacked_InvalidJSONStaysInline(t *testing.T) {
+	var errs []error
+	record := &service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "bad", ValueJson: "{", FileBacked: true},
+		},
+	}
+
+	offloaded, paths := runconfig.OffloadFileBacked(
+		record,
+		t.TempDir(),
+		func(err error) { errs = append(errs, err) },
+	)
+
+	assert.Empty(t, paths)
+	assert.Equal(t, "{", offloaded.Update[0].ValueJson)
+	assert.Len(t, errs, 1)
+}
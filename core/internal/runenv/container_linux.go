@@ -0,0 +1,106 @@
+//go:build linux
+
+package runenv
+
+import (
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// containerIDPattern matches the 64-character hex IDs used by Docker,
+// containerd, CRI-O and Podman.
+var containerIDPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// cgroupScopePrefixes are prefixes of the systemd scopes that container
+// runtimes create for containers, like "docker-<id>.scope".
+var cgroupScopePrefixes = []string{"docker-", "cri-containerd-", "crio-", "libpod-"}
+
+// cgroupRuntimeDirs are cgroup directories that container runtimes put
+// containers in, like "/docker/<id>" or "/kubepods/.../<id>".
+var cgroupRuntimeDirs = []string{"docker", "kubepods", "libpod", "containerd", "crio"}
+
+// mountinfoIDPattern matches the directories that container runtimes
+// mount files like /etc/hostname from.
+var mountinfoIDPattern = regexp.MustCompile(
+	`(?:/docker/containers|/overlay-containers|/io\.containerd\.runtime\.v2\.task/[^/\s]+)` +
+		`/([0-9a-f]{64})/`,
+)
+
+// containerID returns the ID of the container the process runs in, or
+// the empty string if it's not in a container or the ID is unknown.
+//
+// The ID appears in the process's cgroup path with cgroups v1, and in
+// the paths of files the runtime mounts into the container with v2.
+func containerID() string {
+	if data, err := os.ReadFile("/proc/self/cgroup"); err == nil {
+		if id := containerIDFromCgroup(string(data)); id != "" {
+			return id
+		}
+	}
+
+	if data, err := os.ReadFile("/proc/self/mountinfo"); err == nil {
+		return containerIDFromMountinfo(string(data))
+	}
+
+	return ""
+}
+
+// containerIDFromCgroup finds a container ID in the contents of
+// /proc/self/cgroup.
+//
+// Only the last element of a cgroup path is considered, and only if it
+// is a runtime's scope or is under a runtime's directory, so that
+// unrelated hex strings are not mistaken for IDs.
+func containerIDFromCgroup(cgroup string) string {
+	for _, line := range strings.Split(cgroup, "\n") {
+		// Lines look like "<hierarchy>:<controllers>:<path>".
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		dir, name := path.Split(fields[2])
+
+		name = strings.TrimSuffix(name, ".scope")
+		isScope := false
+		for _, prefix := range cgroupScopePrefixes {
+			if trimmed, ok := strings.CutPrefix(name, prefix); ok {
+				name = trimmed
+				isScope = true
+				break
+			}
+		}
+
+		if !containerIDPattern.MatchString(name) {
+			continue
+		}
+		if isScope || isRuntimeDir(dir) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// isRuntimeDir reports whether a cgroup directory belongs to a container
+// runtime.
+func isRuntimeDir(dir string) bool {
+	for _, element := range strings.Split(dir, "/") {
+		for _, runtimeDir := range cgroupRuntimeDirs {
+			if strings.HasPrefix(element, runtimeDir) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containerIDFromMountinfo finds a container ID in the contents of
+// /proc/self/mountinfo.
+func containerIDFromMountinfo(mountinfo string) string {
+	if match := mountinfoIDPattern.FindStringSubmatch(mountinfo); match != nil {
+		return match[1]
+	}
+	return ""
+}
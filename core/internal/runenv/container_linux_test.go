@@ -0,0 +1,48 @@
+//go:build linux
+
+package runenv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerIDFromCgroup(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+
+	for _, cgroup := range []string{
+		"12:memory:/docker/" + id,
+		"0::/system.slice/docker-" + id + ".scope",
+		"11:cpu:/kubepods/burstable/pod1234/" + id,
+		"0::/kubepods.slice/kubepods-pod1.slice/cri-containerd-" + id + ".scope",
+		"0::/machine.slice/libpod-" + id + ".scope",
+	} {
+		assert.Equal(t, id, containerIDFromCgroup("1:name=systemd:/\n"+cgroup+"\n"), cgroup)
+	}
+}
+
+func TestContainerIDFromCgroup_IgnoresOtherHexStrings(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+
+	for _, cgroup := range []string{
+		"0::/",
+		"0::/user.slice/" + id,
+		"0::/docker/" + id + "/child",
+		"0::/system.slice/session-" + id + ".scope",
+	} {
+		assert.Empty(t, containerIDFromCgroup(cgroup), cgroup)
+	}
+}
+
+func TestContainerIDFromMountinfo(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+
+	assert.Equal(t, id, containerIDFromMountinfo(
+		"600 590 254:1 /var/lib/docker/containers/"+id+"/hostname /etc/hostname rw\n"))
+	assert.Equal(t, id, containerIDFromMountinfo(
+		"600 590 0:50 /containers/storage/overlay-containers/"+id+"/userdata/hostname /etc/hostname rw\n"))
+	assert.Empty(t, containerIDFromMountinfo(
+		"600 590 254:1 /home/user/"+id+"/data /data rw\n"))
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package runenv
+
+// containerID returns the empty string, as containers are only detected
+// on Linux.
+func containerID() string {
+	return ""
+}
@@ -0,0 +1,25 @@
+//go:build !windows
+
+package runenv
+
+import (
+	"fmt"
+	"runtime"
+
+	"golang.org/x/sys/unix"
+)
+
+// osDescription names the operating system, its version and the
+// architecture, like "Linux-6.1.0-amd64".
+func osDescription() string {
+	var uname unix.Utsname
+	if err := unix.Uname(&uname); err != nil {
+		return fmt.Sprintf("%s-%s", runtime.GOOS, runtime.GOARCH)
+	}
+
+	return fmt.Sprintf("%s-%s-%s",
+		unix.ByteSliceToString(uname.Sysname[:]),
+		unix.ByteSliceToString(uname.Release[:]),
+		runtime.GOARCH,
+	)
+}
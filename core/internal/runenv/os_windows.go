@@ -0,0 +1,17 @@
+//go:build windows
+
+package runenv
+
+import (
+	"fmt"
+	"runtime"
+
+	"golang.org/x/sys/windows"
+)
+
+// osDescription names the operating system, its version and the
+// architecture, like "Windows-10.0.19045-amd64".
+func osDescription() string {
+	major, minor, build := windows.RtlGetNtVersionNumbers()
+	return fmt.Sprintf("Windows-%d.%d.%d-%s", major, minor, build, runtime.GOARCH)
+}
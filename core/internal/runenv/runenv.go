@@ -0,0 +1,75 @@
+// Package runenv describes the environment a run executes in.
+//
+// The description is part of the run's metadata, so that every client
+// records the same details for reproducibility.
+package runenv
+
+import (
+	"os"
+	"path"
+	"runtime"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/mem"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Capture returns metadata about the machine and process of the run.
+//
+// Only environment variables matching one of `envVars` are included; the
+// entries may be glob patterns like "CUDA_*". Details that can't be
+// determined are left unset.
+func Capture(envVars []string) *service.MetadataRequest {
+	metadata := &service.MetadataRequest{
+		Os:              osDescription(),
+		Docker:          os.Getenv("WANDB_DOCKER"),
+		ContainerId:     containerID(),
+		CpuCountLogical: uint32(runtime.NumCPU()),
+		Env:             allowedEnv(os.Environ(), envVars),
+	}
+
+	if virtualMem, err := mem.VirtualMemory(); err == nil {
+		metadata.Memory = &service.MemoryInfo{Total: virtualMem.Total}
+	}
+
+	return metadata
+}
+
+// allowedEnv returns the variables in `environ` matching the patterns.
+//
+// Returns nil if none match.
+func allowedEnv(environ []string, patterns []string) map[string]string {
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	var env map[string]string
+	for _, entry := range environ {
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok || !matchesAny(name, patterns) {
+			continue
+		}
+
+		if env == nil {
+			env = make(map[string]string)
+		}
+		env[name] = value
+	}
+	return env
+}
+
+// matchesAny reports whether the name matches one of the glob patterns.
+//
+// Invalid patterns only match themselves.
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern == name {
+			return true
+		}
+		if match, _ := path.Match(pattern, name); match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package runenv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/runenv"
+)
+
+func TestCapture(t *testing.T) {
+	metadata := runenv.Capture(nil)
+
+	assert.NotEmpty(t, metadata.GetOs())
+	assert.Positive(t, metadata.GetCpuCountLogical())
+	assert.Nil(t, metadata.GetEnv())
+}
+
+func TestCapture_EnvAllowlist(t *testing.T) {
+	t.Setenv("RUNENV_TEST_VISIBLE", "1")
+	t.Setenv("RUNENV_TEST_EXACT", "2")
+	t.Setenv("RUNENV_TEST_SECRET", "hunter2")
+
+	metadata := runenv.Capture([]string{"RUNENV_TEST_V*", "RUNENV_TEST_EXACT"})
+
+	assert.Equal(t,
+		map[string]string{
+			"RUNENV_TEST_VISIBLE": "1",
+			"RUNENV_TEST_EXACT":   "2",
+		},
+		metadata.GetEnv())
+}
+
+func TestCapture_DockerImage(t *testing.T) {
+	t.Setenv("WANDB_DOCKER", "example/image@sha256:abc")
+
+	metadata := runenv.Capture(nil)
+
+	assert.Equal(t, "example/image@sha256:abc", metadata.GetDocker())
+}
@@ -0,0 +1,177 @@
+package runfiles
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// UploadPolicy is when to upload a file in the run's files directory.
+type UploadPolicy int
+
+const (
+	// UploadPolicyIgnore means the file is not uploaded.
+	UploadPolicyIgnore UploadPolicy = iota
+
+	// UploadPolicyNow means the file is uploaded once when it appears.
+	UploadPolicyNow
+
+	// UploadPolicyEnd means the file is uploaded at the end of the run.
+	UploadPolicyEnd
+
+	// UploadPolicyLive means the file is uploaded whenever it changes and
+	// again at the end of the run.
+	UploadPolicyLive
+)
+
+func (p UploadPolicy) String() string {
+	switch p {
+	case UploadPolicyNow:
+		return "now"
+	case UploadPolicyEnd:
+		return "end"
+	case UploadPolicyLive:
+		return "live"
+	default:
+		return "ignore"
+	}
+}
+
+func parseUploadPolicy(s string) (UploadPolicy, error) {
+	switch s {
+	case "ignore":
+		return UploadPolicyIgnore, nil
+	case "now":
+		return UploadPolicyNow, nil
+	case "end":
+		return UploadPolicyEnd, nil
+	case "live":
+		return UploadPolicyLive, nil
+	default:
+		return UploadPolicyIgnore, fmt.Errorf("unknown upload policy %q", s)
+	}
+}
+
+// FilesPolicyRule applies a policy to files matching a glob.
+type FilesPolicyRule struct {
+	// Glob is a pattern for paths relative to the files directory.
+	//
+	// It has the semantics of Python's fnmatch, like globs passed to
+	// `wandb.save()`: "*" matches any characters including "/", "?"
+	// matches one character, and "[seq]" and "[!seq]" match one character
+	// in or not in seq.
+	Glob string
+
+	Policy UploadPolicy
+
+	// re is Glob translated into a regular expression.
+	re *regexp.Regexp
+}
+
+// FilesPolicy decides which files in the run's files directory to upload
+// and when.
+type FilesPolicy struct {
+	// Rules are checked in order, and the last matching rule wins.
+	Rules []FilesPolicyRule
+
+	// Default is the policy for files that match no rule.
+	Default UploadPolicy
+}
+
+// ParseFilesPolicy parses the `_files_policy` setting.
+//
+// Each entry is "<policy>:<glob>". An entry without a glob sets the
+// default policy. Returns nil if there are no entries.
+func ParseFilesPolicy(entries []string) (*FilesPolicy, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	policy := &FilesPolicy{}
+	for _, entry := range entries {
+		name, glob, hasGlob := strings.Cut(entry, ":")
+
+		uploadPolicy, err := parseUploadPolicy(name)
+		if err != nil {
+			return nil, fmt.Errorf("runfiles: bad files policy %q: %v", entry, err)
+		}
+
+		if !hasGlob {
+			policy.Default = uploadPolicy
+			continue
+		}
+
+		re, err := regexp.Compile(fnmatchToRegexp(glob))
+		if err != nil {
+			return nil, fmt.Errorf("runfiles: bad files policy %q: %v", entry, err)
+		}
+		policy.Rules = append(policy.Rules,
+			FilesPolicyRule{Glob: glob, Policy: uploadPolicy, re: re})
+	}
+
+	return policy, nil
+}
+
+// Match returns the policy for a path relative to the files directory.
+func (p *FilesPolicy) Match(path string) UploadPolicy {
+	path = filepath.ToSlash(path)
+
+	for i := len(p.Rules) - 1; i >= 0; i-- {
+		if p.Rules[i].re.MatchString(path) {
+			return p.Rules[i].Policy
+		}
+	}
+
+	return p.Default
+}
+
+// fnmatchToRegexp translates a glob into a regular expression that matches
+// the same strings as Python's fnmatch.
+//
+// Like in Python, a "[" without a closing "]" matches itself.
+func fnmatchToRegexp(glob string) string {
+	var re strings.Builder
+	re.WriteString("(?s)^")
+
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			re.WriteString(".*")
+		case '?':
+			re.WriteString(".")
+		case '[':
+			// A "]" right after "[" or "[!" is part of the set.
+			j := i + 1
+			if j < len(glob) && glob[j] == '!' {
+				j++
+			}
+			if j < len(glob) && glob[j] == ']' {
+				j++
+			}
+			for j < len(glob) && glob[j] != ']' {
+				j++
+			}
+			if j >= len(glob) {
+				re.WriteString(`\[`)
+				continue
+			}
+
+			set := glob[i+1 : j]
+			re.WriteByte('[')
+			if strings.HasPrefix(set, "!") {
+				re.WriteByte('^')
+				set = set[1:]
+			}
+			// QuoteMeta doesn't escape "-", so ranges keep working.
+			re.WriteString(regexp.QuoteMeta(set))
+			re.WriteByte(']')
+			i = j
+		default:
+			re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	re.WriteString("$")
+	return re.String()
+}
@@ -0,0 +1,59 @@
+package runfiles_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runfiles"
+)
+
+func TestFilesPolicy_LastMatchWins(t *testing.T) {
+	policy, err := runfiles.ParseFilesPolicy([]string{
+		"end",
+		"live:*.log",
+		"ignore:debug*.log",
+		"now:checkpoints/*",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, runfiles.UploadPolicyEnd, policy.Match("results.csv"))
+	assert.Equal(t, runfiles.UploadPolicyLive, policy.Match("train.log"))
+	assert.Equal(t, runfiles.UploadPolicyIgnore, policy.Match("debug-1.log"))
+	assert.Equal(t, runfiles.UploadPolicyNow, policy.Match("checkpoints/1.pt"))
+}
+
+func TestFilesPolicy_FnmatchSemantics(t *testing.T) {
+	policy, err := runfiles.ParseFilesPolicy([]string{
+		"ignore",
+		"now:*.txt",
+		"end:model-?.pt",
+		"live:logs/[!.]*",
+		"live:[[]x].csv",
+		"end:data[",
+	})
+	require.NoError(t, err)
+
+	// "*" matches across directories, like in Python's fnmatch.
+	assert.Equal(t, runfiles.UploadPolicyNow, policy.Match("a/b/c.txt"))
+	assert.Equal(t, runfiles.UploadPolicyEnd, policy.Match("model-1.pt"))
+	assert.Equal(t, runfiles.UploadPolicyIgnore, policy.Match("model-10.pt"))
+	assert.Equal(t, runfiles.UploadPolicyLive, policy.Match("logs/sub/out"))
+	assert.Equal(t, runfiles.UploadPolicyIgnore, policy.Match("logs/.hidden"))
+	assert.Equal(t, runfiles.UploadPolicyLive, policy.Match("[x].csv"))
+	assert.Equal(t, runfiles.UploadPolicyEnd, policy.Match("data["))
+}
+
+func TestParseFilesPolicy_Empty(t *testing.T) {
+	policy, err := runfiles.ParseFilesPolicy(nil)
+
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+}
+
+func TestParseFilesPolicy_Invalid(t *testing.T) {
+	for _, entry := range []string{"sometimes:*", "now:[z-a]", "*.txt"} {
+		_, err := runfiles.ParseFilesPolicy([]string{entry})
+		assert.Error(t, err, entry)
+	}
+}
@@ -56,6 +56,27 @@ type UploaderTesting interface {
 	FlushSchedulingForTest()
 }
 
+// CommittedFiles remembers which versions of run files were uploaded.
+type CommittedFiles interface {
+	// IsCommitted reports whether the file's current version was uploaded.
+	IsCommitted(realPath, runPath string) bool
+
+	// MarkCommitted records that the file's current version was uploaded.
+	MarkCommitted(realPath, runPath string) error
+}
+
+// StagingArea holds copies of files being uploaded.
+//
+// Uploading a copy keeps a file that changes during its upload from being
+// sent half-written.
+type StagingArea interface {
+	// Stage copies the file and returns the path of the copy.
+	Stage(path string) (string, error)
+
+	// Release drops a reference to a copy returned by Stage.
+	Release(stagedPath string) bool
+}
+
 type UploaderParams struct {
 	Ctx          context.Context
 	Logger       *observability.CoreLogger
@@ -65,6 +86,25 @@ type UploaderParams struct {
 	GraphQL      graphql.Client
 	FileWatcher  watcher.Watcher
 
+	// Policy for files that appear in the run's files directory.
+	//
+	// If set, the files directory and its subdirectories are watched and
+	// new files are uploaded according to the policy. If nil, only files
+	// saved explicitly are uploaded.
+	FilesPolicy *FilesPolicy
+
+	// Files that were uploaded by an earlier attempt, like an interrupted
+	// sync.
+	//
+	// If set, committed files are not uploaded again, and files are
+	// marked committed once uploaded.
+	CommittedFiles CommittedFiles
+
+	// Where to copy files before uploading them, or nil.
+	//
+	// If nil, files are uploaded from the run's files directory.
+	Staging StagingArea
+
 	// How long to wait to batch upload operations.
 	//
 	// This helps if multiple uploads are scheduled around the same time by
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +20,7 @@ import (
 	"github.com/wandb/wandb/core/internal/runfilestest"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/waitingtest"
+	"github.com/wandb/wandb/core/internal/watcher"
 	"github.com/wandb/wandb/core/internal/watchertest"
 	"github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/filestreamtest"
@@ -58,6 +61,23 @@ func writeEmptyFile(t *testing.T, path string) {
 	)
 }
 
+// fakeStaging stages files under new names without copying them.
+type fakeStaging struct {
+	mu       sync.Mutex
+	released []string
+}
+
+func (s *fakeStaging) Stage(path string) (string, error) {
+	return path + ".staged", nil
+}
+
+func (s *fakeStaging) Release(stagedPath string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released = append(s.released, stagedPath)
+	return true
+}
+
 func TestUploader(t *testing.T) {
 	var fakeFileStream *filestreamtest.FakeFileStream
 	var fakeFileTransfer *filetransfertest.FakeFileTransferManager
@@ -80,6 +100,15 @@ func TestUploader(t *testing.T) {
 	// The _sync mode to set on Settings.
 	var isSync bool
 
+	// The _files_policy to use in the uploader.
+	var filesPolicy []string
+
+	// The _files_min_stable_seconds to set on Settings.
+	var filesMinStableSeconds float64
+
+	// The staging area to use in the uploader, or nil.
+	var staging *fakeStaging
+
 	// Resets test objects and runs a given test.
 	runTest := func(
 		name string,
@@ -92,6 +121,9 @@ func TestUploader(t *testing.T) {
 		ignoreGlobs = []string{}
 		isOffline = false
 		isSync = false
+		filesPolicy = nil
+		filesMinStableSeconds = 0
+		staging = nil
 		configure()
 
 		fakeFileStream = filestreamtest.NewFakeFileStream()
@@ -103,20 +135,31 @@ func TestUploader(t *testing.T) {
 
 		fakeFileWatcher = watchertest.NewFakeWatcher()
 
-		uploader = NewUploader(runfilestest.WithTestDefaults(UploaderParams{
+		policy, err := ParseFilesPolicy(filesPolicy)
+		require.NoError(t, err)
+
+		params := UploaderParams{
 			Ctx:          context.Background(),
 			GraphQL:      mockGQLClient,
 			FileStream:   fakeFileStream,
 			FileTransfer: fakeFileTransfer,
 			FileWatcher:  fakeFileWatcher,
+			FilesPolicy:  policy,
 			BatchDelay:   batchDelay,
 			Settings: settings.From(&service.Settings{
 				FilesDir:    &wrapperspb.StringValue{Value: filesDir},
 				IgnoreGlobs: &service.ListStringValue{Value: ignoreGlobs},
 				XOffline:    &wrapperspb.BoolValue{Value: isOffline},
 				XSync:       &wrapperspb.BoolValue{Value: isSync},
+				XFilesMinStableSeconds: &wrapperspb.DoubleValue{
+					Value: filesMinStableSeconds,
+				},
 			}),
-		}))
+		}
+		if staging != nil {
+			params.Staging = staging
+		}
+		uploader = NewUploader(runfilestest.WithTestDefaults(params))
 
 		t.Run(name, test)
 	}
@@ -143,6 +186,26 @@ func TestUploader(t *testing.T) {
 			})
 	}
 
+	runTest("uploads staged copies and releases them",
+		func() { staging = &fakeStaging{} },
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "test.txt")
+			writeEmptyFile(t, filepath.Join(filesDir, "test.txt"))
+
+			uploader.Process(&service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Path: "test.txt", Policy: service.FilesItem_NOW},
+				},
+			})
+			uploader.Finish()
+
+			stagedPath := filepath.Join(filesDir, "test.txt") + ".staged"
+			require.Len(t, fakeFileTransfer.Tasks(), 1)
+			assert.Equal(t, stagedPath, fakeFileTransfer.Tasks()[0].Path)
+			assert.Equal(t, "test.txt", fakeFileTransfer.Tasks()[0].Name)
+			assert.Equal(t, []string{stagedPath}, staging.released)
+		})
+
 	runTest("Process with 'live' policy watches file",
 		func() {},
 		func(t *testing.T) {
@@ -175,6 +238,60 @@ func TestUploader(t *testing.T) {
 			assert.Len(t, fakeFileTransfer.Tasks(), 0)
 		})
 
+	runTest("files policy uploads new files by last matching glob",
+		func() {
+			filesPolicy = []string{"now", "ignore:*.tmp", "end:*.ckpt"}
+		},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "out.txt")
+			stubCreateRunFilesOneFile(mockGQLClient, "checkpoints/model.ckpt")
+			for _, name := range []string{
+				"out.txt",
+				"scratch/a.tmp",
+				"checkpoints/model.ckpt",
+			} {
+				writeEmptyFile(t, filepath.Join(filesDir, name))
+				fakeFileWatcher.OnChange(filepath.Join(filesDir, name))
+			}
+
+			uploader.(UploaderTesting).FlushSchedulingForTest()
+			require.Len(t, fakeFileTransfer.Tasks(), 1)
+			assert.Equal(t, "out.txt", fakeFileTransfer.Tasks()[0].Name)
+
+			uploader.UploadRemaining()
+			uploader.Finish()
+			require.Len(t, fakeFileTransfer.Tasks(), 2)
+			assert.Equal(t, "checkpoints/model.ckpt", fakeFileTransfer.Tasks()[1].Name)
+		})
+
+	runTest("files policy waits for files to be stable",
+		func() {
+			filesPolicy = []string{"now"}
+			filesMinStableSeconds = 2.5
+		},
+		func(t *testing.T) {
+			assert.Equal(t,
+				watcher.TreeOptions{MinStableAge: 2500 * time.Millisecond},
+				fakeFileWatcher.TreeOptions(filesDir))
+			uploader.Finish()
+		})
+
+	runTest("files policy skips explicitly saved files",
+		func() { filesPolicy = []string{"live:*"} },
+		func(t *testing.T) {
+			writeEmptyFile(t, filepath.Join(filesDir, "test.txt"))
+			uploader.Process(&service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Path: "test.txt", Policy: service.FilesItem_END},
+				},
+			})
+
+			fakeFileWatcher.OnChange(filepath.Join(filesDir, "test.txt"))
+			uploader.Finish()
+
+			assert.Len(t, fakeFileTransfer.Tasks(), 0)
+		})
+
 	runTest("Process sets file category",
 		func() {},
 		func(t *testing.T) {
@@ -344,6 +461,35 @@ func TestUploader(t *testing.T) {
 			assert.Len(t, fakeFileTransfer.Tasks(), 0)
 		})
 
+	runTest("upload tasks can refresh their URL",
+		func() {},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "test.txt")
+			mockGQLClient.StubMatchOnce(
+				gqlmock.WithOpName("CreateRunFiles"),
+				`{
+					"createRunFiles": {
+						"runID": "test-run",
+						"uploadHeaders": ["Header1:Value1"],
+						"files": [
+							{"name": "test.txt", "uploadUrl": "URL2"}
+						]
+					}
+				}`,
+			)
+			writeEmptyFile(t, filepath.Join(filesDir, "test.txt"))
+
+			uploader.UploadNow("test.txt")
+			uploader.Finish()
+			require.Len(t, fakeFileTransfer.Tasks(), 1)
+			url, headers, err := fakeFileTransfer.Tasks()[0].
+				RefreshUploadURL(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, "URL2", url)
+			assert.Equal(t, []string{"Header1:Value1"}, headers)
+		})
+
 	runTest("UploadRemaining uploads all files using GraphQL response",
 		func() { filesDir = filepath.Join(t.TempDir(), "files") },
 		func(t *testing.T) {
@@ -1,6 +1,7 @@
 package runfiles
 
 import (
+	"context"
 	"sync"
 
 	"github.com/wandb/wandb/core/internal/filetransfer"
@@ -16,6 +17,15 @@ type savedFile struct {
 	ftm    filetransfer.FileTransferManager
 	logger *observability.CoreLogger
 
+	// Where to record successful uploads, or nil.
+	committedFiles CommittedFiles
+
+	// Where to copy the file before uploading it, or nil.
+	staging StagingArea
+
+	// Gets a new upload URL and headers if the current one expires.
+	refreshUploadURL func(ctx context.Context) (string, []string, error)
+
 	// The path to the actual file.
 	realPath string
 
@@ -49,15 +59,22 @@ func newSavedFile(
 	fs filestream.FileStream,
 	ftm filetransfer.FileTransferManager,
 	logger *observability.CoreLogger,
+	committedFiles CommittedFiles,
+	staging StagingArea,
+	refreshUploadURL func(ctx context.Context) (string, []string, error),
 	realPath string,
 	runPath string,
 ) *savedFile {
 	return &savedFile{
-		fs:       fs,
-		ftm:      ftm,
-		logger:   logger,
-		realPath: realPath,
-		runPath:  runPath,
+		fs:             fs,
+		ftm:            ftm,
+		logger:         logger,
+		committedFiles: committedFiles,
+		staging:        staging,
+
+		refreshUploadURL: refreshUploadURL,
+		realPath:         realPath,
+		runPath:          runPath,
 
 		wg: &sync.WaitGroup{},
 	}
@@ -101,10 +118,12 @@ func (f *savedFile) doUpload(uploadURL string, uploadHeaders []string) {
 	task := &filetransfer.Task{
 		FileKind: f.category,
 		Type:     filetransfer.UploadTask,
-		Path:     f.realPath,
+		Path:     f.stage(),
 		Name:     f.runPath,
 		Url:      uploadURL,
 		Headers:  uploadHeaders,
+
+		RefreshUploadURL: f.refreshUploadURL,
 	}
 
 	f.isUploading = true
@@ -117,12 +136,48 @@ func (f *savedFile) doUpload(uploadURL string, uploadHeaders []string) {
 	f.ftm.AddTask(task)
 }
 
+// stage copies the file to the staging area and returns the path to
+// upload.
+//
+// If the file can't be staged, the file itself is uploaded.
+func (f *savedFile) stage() string {
+	if f.staging == nil {
+		return f.realPath
+	}
+
+	stagedPath, err := f.staging.Stage(f.realPath)
+	if err != nil {
+		f.logger.Warn(
+			"runfiles: failed to stage file, uploading it directly",
+			"path", f.runPath,
+			"error", err,
+		)
+		return f.realPath
+	}
+	return stagedPath
+}
+
 // onFinishUpload marks an upload completed and triggers another if scheduled.
 func (f *savedFile) onFinishUpload(task *filetransfer.Task) {
+	if task.Path != f.realPath {
+		f.staging.Release(task.Path)
+	}
+
 	if task.Err == nil {
 		f.fs.StreamUpdate(&filestream.FilesUploadedUpdate{
 			RelativePath: f.runPath,
 		})
+
+		if f.committedFiles != nil {
+			if err := f.committedFiles.MarkCommitted(f.realPath, f.runPath); err != nil {
+				f.logger.CaptureError(
+					"runfiles: failed to mark file committed",
+					err,
+					"path",
+					f.runPath,
+				)
+			}
+		}
 	}
 
 	f.Lock()
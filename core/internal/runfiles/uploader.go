@@ -44,6 +44,18 @@ type uploader struct {
 
 	// A watcher for 'live' mode files.
 	watcher watcher.Watcher
+
+	// Policy for files that appear in the files directory, or nil.
+	filesPolicy *FilesPolicy
+
+	// Policies chosen for files seen in the files directory.
+	policyFiles map[string]UploadPolicy
+
+	// Files uploaded by an earlier attempt, or nil.
+	committedFiles CommittedFiles
+
+	// Where to copy files before uploading them, or nil.
+	staging StagingArea
 }
 
 func newUploader(params UploaderParams) *uploader {
@@ -62,6 +74,12 @@ func newUploader(params UploaderParams) *uploader {
 		stateMu:  &sync.Mutex{},
 
 		watcher: params.FileWatcher,
+
+		filesPolicy: params.FilesPolicy,
+		policyFiles: make(map[string]UploadPolicy),
+
+		committedFiles: params.CommittedFiles,
+		staging:        params.Staging,
 	}
 
 	uploader.uploadBatcher = newUploadBatcher(
@@ -69,9 +87,94 @@ func newUploader(params UploaderParams) *uploader {
 		uploader.upload,
 	)
 
+	if uploader.filesPolicy != nil {
+		uploader.watchFilesDir()
+	}
+
 	return uploader
 }
 
+// watchFilesDir applies the files policy to files as they appear in the
+// run's files directory.
+func (u *uploader) watchFilesDir() {
+	// Everything is uploaded at the end in sync mode.
+	if u.settings.Proto.GetXSync().GetValue() {
+		return
+	}
+
+	filesDir := u.settings.GetFilesDir()
+	err := u.watcher.WatchDirRecursive(filesDir, func(path string) {
+		runPath, err := filepath.Rel(filesDir, path)
+		if err != nil {
+			u.logger.CaptureError("runfiles: bad path from watcher", err)
+			return
+		}
+
+		u.applyFilesPolicy(runPath)
+	})
+
+	if err != nil {
+		u.logger.CaptureError(
+			"runfiles: error watching files directory",
+			err,
+			"path",
+			filesDir,
+		)
+		return
+	}
+
+	// Avoid uploading files that are still being written.
+	if age := u.settings.GetFilesMinStableAge(); age > 0 {
+		err = u.watcher.SetTreeOptions(filesDir, watcher.TreeOptions{
+			MinStableAge: age,
+		})
+		if err != nil {
+			u.logger.CaptureError("runfiles: error configuring files directory watch", err)
+		}
+	}
+}
+
+// applyFilesPolicy handles a new or modified file in the files directory.
+//
+// Files that were saved explicitly or that are uploaded by the run itself
+// keep their own policies.
+func (u *uploader) applyFilesPolicy(runPath string) {
+	u.stateMu.Lock()
+	defer u.stateMu.Unlock()
+
+	if u.isFinished {
+		return
+	}
+
+	policy, seen := u.policyFiles[runPath]
+	if seen {
+		if policy == UploadPolicyLive {
+			u.uploadBatcher.Add([]string{runPath})
+		}
+		return
+	}
+
+	_, isKnown := u.knownFiles[runPath]
+	_, isAtEnd := u.uploadAtEnd[runPath]
+	if isKnown || isAtEnd {
+		u.policyFiles[runPath] = UploadPolicyIgnore
+		return
+	}
+
+	policy = u.filesPolicy.Match(runPath)
+	u.policyFiles[runPath] = policy
+
+	switch policy {
+	case UploadPolicyNow:
+		u.uploadBatcher.Add([]string{runPath})
+	case UploadPolicyEnd:
+		u.uploadAtEnd[runPath] = struct{}{}
+	case UploadPolicyLive:
+		u.uploadBatcher.Add([]string{runPath})
+		u.uploadAtEnd[runPath] = struct{}{}
+	}
+}
+
 func (u *uploader) Process(record *service.FilesRecord) {
 	if !u.lockForOperation("Process") {
 		return
@@ -195,6 +298,9 @@ func (u *uploader) knownFile(runPath string) *savedFile {
 			u.fs,
 			u.ftm,
 			u.logger,
+			u.committedFiles,
+			u.staging,
+			u.uploadURLRefresher(runPath),
 			u.toRealPath(runPath),
 			runPath,
 		)
@@ -203,6 +309,36 @@ func (u *uploader) knownFile(runPath string) *savedFile {
 	return u.knownFiles[runPath]
 }
 
+// uploadURLRefresher returns a function that gets a new upload URL for a
+// file whose URL expired.
+func (u *uploader) uploadURLRefresher(
+	runPath string,
+) func(ctx context.Context) (string, []string, error) {
+	return func(ctx context.Context) (string, []string, error) {
+		response, err := gql.CreateRunFiles(
+			ctx,
+			u.graphQL,
+			u.settings.GetEntity(),
+			u.settings.GetProject(),
+			u.settings.GetRunID(),
+			[]string{runPath},
+		)
+		if err != nil {
+			return "", nil, err
+		}
+
+		files := response.CreateRunFiles.Files
+		if len(files) != 1 || files[0].UploadUrl == nil {
+			return "", nil, fmt.Errorf(
+				"runfiles: CreateRunFiles returned no upload URL for %q",
+				runPath,
+			)
+		}
+
+		return *files[0].UploadUrl, response.CreateRunFiles.UploadHeaders, nil
+	}
+}
+
 // Acquires the stateMu mutex if Finish() has not been called.
 //
 // Returns whether the mutex was locked. If it was locked, the caller
@@ -238,6 +374,7 @@ func (u *uploader) upload(relativePaths []string) {
 
 	relativePaths = u.filterNonExistingAndWarn(relativePaths)
 	relativePaths = u.filterIgnored(relativePaths)
+	relativePaths = u.filterCommitted(relativePaths)
 	u.uploadWG.Add(len(relativePaths))
 
 	go func() {
@@ -323,6 +460,24 @@ outerLoop:
 	return includedPaths
 }
 
+// Filters any paths that were already uploaded by an earlier attempt.
+func (u *uploader) filterCommitted(relativePaths []string) []string {
+	if u.committedFiles == nil {
+		return relativePaths
+	}
+
+	uncommittedPaths := make([]string, 0, len(relativePaths))
+	for _, relativePath := range relativePaths {
+		if u.committedFiles.IsCommitted(u.toRealPath(relativePath), relativePath) {
+			u.logger.Debug("runfiles: skipping committed file", "path", relativePath)
+		} else {
+			uncommittedPaths = append(uncommittedPaths, relativePath)
+		}
+	}
+
+	return uncommittedPaths
+}
+
 // Schedules a file upload task.
 //
 // Decrements `uploadWG` when the task is complete or fails.
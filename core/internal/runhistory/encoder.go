@@ -0,0 +1,122 @@
+package runhistory
+
+import (
+	"bytes"
+	"fmt"
+
+	json "github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Encoder writes history rows to a buffer as JSON objects.
+//
+// It produces the same objects as serializing a RunHistory, but writes
+// each key and value to the buffer as it goes instead of first building
+// a tree of all decoded values. This keeps allocations low for wide rows
+// that log hundreds of metrics per step.
+//
+// An Encoder is not safe for concurrent use.
+type Encoder struct {
+	buf     *bytes.Buffer
+	emitter json.Emitter
+
+	// seen holds the keys of the row being encoded
+	seen map[string]struct{}
+}
+
+// NewEncoder returns an encoder that appends rows to the buffer.
+func NewEncoder(buf *bytes.Buffer) *Encoder {
+	return &Encoder{
+		buf:     buf,
+		emitter: json.NewEmitter(buf),
+		seen:    make(map[string]struct{}),
+	}
+}
+
+// Encode appends the history row made of the items to the buffer.
+//
+// Each value is decoded and written on its own, so only one value is held
+// in memory at a time. Items whose values cannot be decoded are passed to
+// onError and skipped, like in ApplyChangeRecord.
+//
+// Rows with nested keys or repeated keys need merging, so they are built
+// as a RunHistory instead.
+func (e *Encoder) Encode(
+	items []*service.HistoryItem,
+	onError func(error),
+) error {
+	if e.needsMerge(items) {
+		return e.encodeMerged(items, onError)
+	}
+
+	e.buf.WriteByte('{')
+	first := true
+	for _, item := range items {
+		start := e.buf.Len()
+		if !first {
+			e.buf.WriteByte(',')
+		}
+
+		if err := e.emitter.Emit(item.GetKey()); err != nil {
+			return err
+		}
+		e.buf.WriteByte(':')
+
+		if err := e.writeValue(item.GetValueJson()); err != nil {
+			e.buf.Truncate(start)
+			onError(err)
+			continue
+		}
+		first = false
+	}
+	e.buf.WriteByte('}')
+
+	return nil
+}
+
+// needsMerge reports whether the items must be merged into a tree because
+// some are nested or set the same key.
+func (e *Encoder) needsMerge(items []*service.HistoryItem) bool {
+	clear(e.seen)
+
+	for _, item := range items {
+		if len(item.GetNestedKey()) > 0 {
+			return true
+		}
+		if _, ok := e.seen[item.GetKey()]; ok {
+			return true
+		}
+		e.seen[item.GetKey()] = struct{}{}
+	}
+
+	return false
+}
+
+// writeValue decodes a JSON value and writes it to the buffer.
+//
+// Re-encoding keeps the output identical to Serialize, which normalizes
+// numbers and drops whitespace that would split the history line.
+func (e *Encoder) writeValue(valueJSON string) error {
+	value, err := json.UnmarshalString(valueJSON)
+	if err != nil {
+		return err
+	}
+	return e.emitter.Emit(value)
+}
+
+// encodeMerged appends a row that needs merging using a RunHistory.
+func (e *Encoder) encodeMerged(
+	items []*service.HistoryItem,
+	onError func(error),
+) error {
+	rh := New()
+	rh.ApplyChangeRecord(items, onError)
+
+	line, err := rh.Serialize()
+	if err != nil {
+		return fmt.Errorf("runhistory: failed to serialize row: %v", err)
+	}
+	e.buf.Write(line)
+	return nil
+}
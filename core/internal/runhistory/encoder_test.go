@@ -0,0 +1,147 @@
+package runhistory_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	json "github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// serialize returns the row as serialized by a RunHistory.
+func serialize(t *testing.T, items []*service.HistoryItem) map[string]any {
+	t.Helper()
+	rh := runhistory.New()
+	rh.ApplyChangeRecord(items, func(error) {})
+	line, err := rh.Serialize()
+	if err != nil {
+		t.Fatal("Serialize failed:", err)
+	}
+	row, err := json.UnmarshalObject(line)
+	if err != nil {
+		t.Fatal("invalid JSON:", err)
+	}
+	return row
+}
+
+// encode returns the row as written by an Encoder.
+func encode(t *testing.T, items []*service.HistoryItem) (map[string]any, []error) {
+	t.Helper()
+	var buf bytes.Buffer
+	var errs []error
+	err := runhistory.NewEncoder(&buf).Encode(items,
+		func(err error) { errs = append(errs, err) })
+	if err != nil {
+		t.Fatal("Encode failed:", err)
+	}
+	row, err := json.UnmarshalObject(buf.Bytes())
+	if err != nil {
+		t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+	}
+	return row, errs
+}
+
+func TestEncoder_MatchesSerialize(t *testing.T) {
+	testCases := map[string][]*service.HistoryItem{
+		"flat": {
+			{Key: "loss", ValueJson: "0.500000"},
+			{Key: "name \"quoted\"", ValueJson: `"a\nb"`},
+			{Key: "list", ValueJson: "[1, 2,\n 3]"},
+			{Key: "inf", ValueJson: "Infinity"},
+			{Key: "ninf", ValueJson: "-Infinity"},
+		},
+		"nested": {
+			{Key: "loss", ValueJson: "1"},
+			{NestedKey: []string{"eval", "loss"}, ValueJson: "2"},
+			{NestedKey: []string{"eval", "acc"}, ValueJson: "3"},
+		},
+		"repeated": {
+			{Key: "loss", ValueJson: "1"},
+			{Key: "loss", ValueJson: "2"},
+		},
+	}
+
+	for name, items := range testCases {
+		t.Run(name, func(t *testing.T) {
+			row, errs := encode(t, items)
+			if len(errs) > 0 {
+				t.Error("onError should not be called", errs)
+			}
+			if expected := serialize(t, items); !reflect.DeepEqual(row, expected) {
+				t.Errorf("Expected %v, got %v", expected, row)
+			}
+		})
+	}
+}
+
+func TestEncoder_SkipsInvalidValues(t *testing.T) {
+	row, errs := encode(t, []*service.HistoryItem{
+		{Key: "bad", ValueJson: "{"},
+		{Key: "good", ValueJson: "1"},
+		{Key: "also bad", ValueJson: "nope"},
+	})
+
+	if len(errs) != 2 {
+		t.Errorf("Expected 2 errors, got %v", errs)
+	}
+	if expected := map[string]any{"good": int64(1)}; !reflect.DeepEqual(row, expected) {
+		t.Errorf("Expected %v, got %v", expected, row)
+	}
+}
+
+// wideRows returns history rows that each log many metrics.
+func wideRows(steps, metrics int) [][]*service.HistoryItem {
+	rows := make([][]*service.HistoryItem, steps)
+	for step := range rows {
+		row := make([]*service.HistoryItem, 0, metrics+1)
+		row = append(row, &service.HistoryItem{
+			Key:       "_step",
+			ValueJson: fmt.Sprint(step),
+		})
+		for i := 0; i < metrics; i++ {
+			row = append(row, &service.HistoryItem{
+				Key:       fmt.Sprintf("train/metric_%d", i),
+				ValueJson: fmt.Sprintf("%d.%06d", step, i),
+			})
+		}
+		rows[step] = row
+	}
+	return rows
+}
+
+func BenchmarkEncoder_WideRows(b *testing.B) {
+	rows := wideRows(100, 500)
+	var buf bytes.Buffer
+	encoder := runhistory.NewEncoder(&buf)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			buf.Reset()
+			if err := encoder.Encode(row, func(error) {}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkSerialize_WideRows(b *testing.B) {
+	rows := wideRows(100, 500)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			rh := runhistory.New()
+			rh.ApplyChangeRecord(row, func(error) {})
+			if _, err := rh.Serialize(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -141,3 +141,51 @@ func keyPath(item *service.HistoryItem) []string {
 	}
 	return []string{item.GetKey()}
 }
+
+// MergeDuplicateKeys resolves history items that set the same key more
+// than once, such as a nested "a": {"b": ...} and a literal "a.b".
+//
+// The last value for each key wins. Returns the items and the duplicated
+// keys. If there are no duplicates, the items are returned unchanged.
+func MergeDuplicateKeys(
+	items []*service.HistoryItem,
+) ([]*service.HistoryItem, []string, error) {
+	updates := make([]*pathtree.PathItem, 0, len(items))
+	for _, item := range items {
+		value, err := json.Unmarshal([]byte(item.GetValueJson()))
+		if err != nil {
+			return items, nil, err
+		}
+		updates = append(updates,
+			&pathtree.PathItem{
+				Path:  keyPath(item),
+				Value: value,
+			})
+	}
+
+	merged, duplicates := pathtree.MergeDuplicateLeaves(updates)
+	if len(duplicates) == 0 {
+		return items, nil, nil
+	}
+
+	result := make([]*service.HistoryItem, 0, len(merged))
+	for _, leaf := range merged {
+		value, err := json.Marshal(leaf.Value)
+		if err != nil {
+			return items, nil, err
+		}
+
+		if len(leaf.Path) == 1 {
+			result = append(result, &service.HistoryItem{
+				Key:       leaf.Path[0],
+				ValueJson: string(value),
+			})
+		} else {
+			result = append(result, &service.HistoryItem{
+				NestedKey: leaf.Path,
+				ValueJson: string(value),
+			})
+		}
+	}
+	return result, duplicates, nil
+}
@@ -116,3 +116,51 @@ func TestSerialize(t *testing.T) {
 	}
 
 }
+
+func TestMergeDuplicateKeys(t *testing.T) {
+	items := []*service.HistoryItem{
+		{Key: "a", ValueJson: `{"b": 1, "c": 2}`},
+		{Key: "a.b", ValueJson: "3"},
+		{Key: "d", ValueJson: "4"},
+	}
+
+	merged, duplicates, err := runhistory.MergeDuplicateKeys(items)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(duplicates, []string{"a.b"}) {
+		t.Errorf("expected duplicates [a.b], got %v", duplicates)
+	}
+	values := make(map[string]string)
+	for _, item := range merged {
+		key := item.Key
+		if len(item.NestedKey) > 0 {
+			key = strings.Join(item.NestedKey, "/")
+		}
+		values[key] = item.ValueJson
+	}
+	expected := map[string]string{"a/c": "2", "a.b": "3", "d": "4"}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
+
+func TestMergeDuplicateKeys_NoDuplicates(t *testing.T) {
+	items := []*service.HistoryItem{
+		{Key: "a", ValueJson: `{"b": 1}`},
+		{Key: "a.c", ValueJson: "3"},
+	}
+
+	merged, duplicates, err := runhistory.MergeDuplicateKeys(items)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(duplicates) != 0 {
+		t.Errorf("expected no duplicates, got %v", duplicates)
+	}
+	if !reflect.DeepEqual(merged, items) {
+		t.Errorf("expected items to be unchanged, got %v", merged)
+	}
+}
@@ -0,0 +1,43 @@
+//go:build windows
+
+package runlock
+
+import (
+	"errors"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+var errLocked = errors.New("runlock: file is locked")
+
+// lockOffset is where the locked byte is.
+//
+// Windows locks prevent other processes from reading the locked range, so
+// the lock is placed far past the owner info that other processes read.
+const lockOffset = 1 << 30
+
+func tryLockFile(file *os.File) error {
+	err := windows.LockFileEx(
+		windows.Handle(file.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY,
+		0,
+		1,
+		0,
+		&windows.Overlapped{Offset: lockOffset},
+	)
+	if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+		return errLocked
+	}
+	return err
+}
+
+func unlockFile(file *os.File) error {
+	return windows.UnlockFileEx(
+		windows.Handle(file.Fd()),
+		0,
+		1,
+		0,
+		&windows.Overlapped{Offset: lockOffset},
+	)
+}
@@ -0,0 +1,156 @@
+// Package runlock detects processes logging to the same run on a machine.
+//
+// Two processes that use the same run ID, for example because they derive
+// it from the same seed, would otherwise silently interleave their data
+// into one run. Each process holds a lock for its run while it's active.
+// Locks are released by the OS if the process dies, so they never go
+// stale.
+package runlock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+)
+
+// Lock is held by the process logging to a run.
+type Lock struct {
+	file *os.File
+	path string
+}
+
+// HeldError is returned when another process holds the lock.
+type HeldError struct {
+	// Holder describes the process holding the lock, if known.
+	Holder string
+}
+
+func (e *HeldError) Error() string {
+	if e.Holder == "" {
+		return "runlock: the run is in use by another process"
+	}
+	return fmt.Sprintf("runlock: the run is in use by %s", e.Holder)
+}
+
+// owner is the content of a lock file.
+type owner struct {
+	PID       int       `json:"pid"`
+	Host      string    `json:"host"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+func (o owner) String() string {
+	return fmt.Sprintf(
+		"process %d on host %q (started %s)",
+		o.PID, o.Host, o.StartedAt.Format(time.RFC3339))
+}
+
+// Key identifies a run across processes.
+func Key(baseURL, entity, project, runID string) string {
+	sum := sha256.Sum256([]byte(
+		strings.Join([]string{baseURL, entity, project, runID}, "\x00")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// Acquire locks the run with the key, using a lock file in the directory.
+//
+// Returns a *HeldError if another process holds the lock.
+func Acquire(dir, key string) (*Lock, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("runlock: %v", err)
+	}
+	path := filepath.Join(dir, key+".lock")
+
+	for {
+		file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("runlock: %v", err)
+		}
+
+		if err := tryLockFile(file); err != nil {
+			defer file.Close()
+			if errors.Is(err, errLocked) {
+				return nil, &HeldError{Holder: readOwner(file)}
+			}
+			return nil, fmt.Errorf("runlock: failed to lock %s: %v", path, err)
+		}
+
+		// The previous holder removes the file when releasing the lock, so
+		// the file we locked may no longer be the one at the path.
+		if isCurrentFile(file, path) {
+			lock := &Lock{file: file, path: path}
+			lock.writeOwner()
+			return lock, nil
+		}
+
+		_ = unlockFile(file)
+		_ = file.Close()
+	}
+}
+
+// Release unlocks the run.
+//
+// It is safe to call on a nil Lock.
+func (l *Lock) Release() error {
+	if l == nil {
+		return nil
+	}
+
+	// Remove the file before unlocking so that no other process can lock
+	// it after it's unlocked but before it's removed.
+	_ = os.Remove(l.path)
+	_ = unlockFile(l.file)
+	return l.file.Close()
+}
+
+// writeOwner records this process in the lock file.
+func (l *Lock) writeOwner() {
+	host, _ := os.Hostname()
+	data, err := json.Marshal(owner{
+		PID:       os.Getpid(),
+		Host:      host,
+		StartedAt: time.Now(),
+	})
+	if err != nil {
+		return
+	}
+
+	if err := l.file.Truncate(0); err != nil {
+		return
+	}
+	_, _ = l.file.WriteAt(data, 0)
+}
+
+// readOwner describes the process holding the lock file.
+//
+// Returns an empty string if the file doesn't say.
+func readOwner(file *os.File) string {
+	data := make([]byte, 4096)
+	n, _ := file.ReadAt(data, 0)
+
+	var o owner
+	if err := json.Unmarshal(data[:n], &o); err != nil || o.PID == 0 {
+		return ""
+	}
+	return o.String()
+}
+
+// isCurrentFile reports whether the open file is the one at the path.
+func isCurrentFile(file *os.File, path string) bool {
+	openInfo, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(openInfo, pathInfo)
+}
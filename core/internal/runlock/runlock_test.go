@@ -0,0 +1,51 @@
+package runlock_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runlock"
+)
+
+func TestAcquire_HeldByAnotherLock(t *testing.T) {
+	dir := t.TempDir()
+	key := runlock.Key("https://api.wandb.ai", "entity", "project", "run")
+
+	lock, err := runlock.Acquire(dir, key)
+	require.NoError(t, err)
+	defer lock.Release()
+	_, err = runlock.Acquire(dir, key)
+
+	var held *runlock.HeldError
+	require.ErrorAs(t, err, &held)
+	assert.Contains(t, held.Holder, fmt.Sprintf("process %d", os.Getpid()))
+}
+
+func TestAcquire_AfterRelease(t *testing.T) {
+	dir := t.TempDir()
+	key := runlock.Key("https://api.wandb.ai", "entity", "project", "run")
+
+	lock, err := runlock.Acquire(dir, key)
+	require.NoError(t, err)
+	require.NoError(t, lock.Release())
+	lock, err = runlock.Acquire(dir, key)
+
+	require.NoError(t, err)
+	assert.NoError(t, lock.Release())
+}
+
+func TestAcquire_DifferentRuns(t *testing.T) {
+	dir := t.TempDir()
+
+	lock1, err := runlock.Acquire(dir,
+		runlock.Key("https://api.wandb.ai", "entity", "project", "run1"))
+	require.NoError(t, err)
+	defer lock1.Release()
+	lock2, err := runlock.Acquire(dir,
+		runlock.Key("https://api.wandb.ai", "entity", "project", "run2"))
+	require.NoError(t, err)
+	defer lock2.Release()
+}
@@ -0,0 +1,112 @@
+// Package runname generates run names from templates.
+package runname
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// shortIDLength is the number of run ID characters in {short_id}.
+const shortIDLength = 4
+
+// Fields are the values that can be substituted into a template.
+type Fields struct {
+	Project string
+	RunID   string
+	Group   string
+	JobType string
+
+	// Time is when the run started, used for {date} and {time}.
+	Time time.Time
+}
+
+// placeholders maps each placeholder name to how it's resolved.
+var placeholders = map[string]func(Fields) string{
+	"project":  func(f Fields) string { return f.Project },
+	"run_id":   func(f Fields) string { return f.RunID },
+	"short_id": func(f Fields) string { return shortID(f.RunID) },
+	"group":    func(f Fields) string { return f.Group },
+	"job_type": func(f Fields) string { return f.JobType },
+	"date":     func(f Fields) string { return f.Time.Format("2006-01-02") },
+	"time":     func(f Fields) string { return f.Time.Format("150405") },
+}
+
+// Template is a parsed run name template like "{project}-{date}-{short_id}".
+type Template struct {
+	// parts alternate between literal text and placeholder names,
+	// starting with literal text.
+	parts []string
+}
+
+// Parse parses a run name template.
+//
+// Returns an error if the template has an unknown placeholder or an
+// unmatched brace. Literal braces are written as "{{" and "}}".
+func Parse(template string) (*Template, error) {
+	parts := []string{""}
+	var literal strings.Builder
+
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; {
+		case strings.HasPrefix(template[i:], "{{"),
+			strings.HasPrefix(template[i:], "}}"):
+			literal.WriteByte(c)
+			i++
+
+		case c == '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf(
+					"runname: unclosed placeholder in %q", template)
+			}
+
+			name := template[i+1 : i+end]
+			if name == "entity" {
+				// The default entity is only known once the run is
+				// created on the server, after its name is chosen.
+				return nil, fmt.Errorf(
+					"runname: {entity} is not supported in %q", template)
+			}
+			if _, ok := placeholders[name]; !ok {
+				return nil, fmt.Errorf(
+					"runname: unknown placeholder {%s} in %q", name, template)
+			}
+
+			parts[len(parts)-1] = literal.String()
+			literal.Reset()
+			parts = append(parts, name, "")
+			i += end
+
+		case c == '}':
+			return nil, fmt.Errorf("runname: unmatched '}' in %q", template)
+
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	parts[len(parts)-1] = literal.String()
+
+	return &Template{parts: parts}, nil
+}
+
+// Execute returns the run name for the fields.
+func (t *Template) Execute(fields Fields) string {
+	var name strings.Builder
+	for i, part := range t.parts {
+		if i%2 == 0 {
+			name.WriteString(part)
+		} else {
+			name.WriteString(placeholders[part](fields))
+		}
+	}
+	return name.String()
+}
+
+// shortID returns the first characters of the run ID.
+func shortID(runID string) string {
+	if len(runID) <= shortIDLength {
+		return runID
+	}
+	return runID[:shortIDLength]
+}
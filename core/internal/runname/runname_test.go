@@ -0,0 +1,54 @@
+package runname_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runname"
+)
+
+func TestExecute(t *testing.T) {
+	fields := runname.Fields{
+		Project: "vision",
+		RunID:   "ab12cd34",
+		Group:   "sweep",
+		JobType: "train",
+		Time:    time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+	}
+
+	testCases := map[string]string{
+		"{project}-{date}-{short_id}":        "vision-2024-05-06-ab12",
+		"{group}/{job_type}":                 "sweep/train",
+		"run {run_id} at {time}":             "run ab12cd34 at 070809",
+		"{{literal}} {project}":              "{literal} vision",
+		"no placeholders":                    "no placeholders",
+		"{short_id}{short_id}{{}}{short_id}": "ab12ab12{}ab12",
+	}
+
+	for template, expected := range testCases {
+		t.Run(template, func(t *testing.T) {
+			parsed, err := runname.Parse(template)
+			require.NoError(t, err)
+			assert.Equal(t, expected, parsed.Execute(fields))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	testCases := map[string]string{
+		"{project}-{unknown}": "unknown placeholder {unknown}",
+		"{project":            "unclosed placeholder",
+		"project}":            "unmatched '}'",
+		"{}":                  "unknown placeholder {}",
+		"{entity}-{project}":  "{entity} is not supported",
+	}
+
+	for template, expected := range testCases {
+		t.Run(template, func(t *testing.T) {
+			_, err := runname.Parse(template)
+			assert.ErrorContains(t, err, expected)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package runsummary
+
+import (
+	json "github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// MetaKey is the top-level key of the aggregation metadata included by
+// WithAggregations.
+const MetaKey = "_summary_meta"
+
+// AggregationKind is how a summary value was computed from history.
+type AggregationKind string
+
+const (
+	// AggregationLast is the most recently logged value.
+	AggregationLast AggregationKind = "last"
+
+	// AggregationMin is the smallest logged value.
+	AggregationMin AggregationKind = "min"
+
+	// AggregationMax is the largest logged value.
+	AggregationMax AggregationKind = "max"
+
+	// AggregationMean is the mean of the logged values.
+	AggregationMean AggregationKind = "mean"
+
+	// AggregationHistogram is a histogram of the logged values.
+	AggregationHistogram AggregationKind = "histogram"
+)
+
+// Aggregation describes how a summary value was computed.
+type Aggregation struct {
+	Kind AggregationKind
+
+	// Count is the number of values averaged for AggregationMean.
+	//
+	// It allows combining means correctly. It is ignored for other kinds.
+	Count int64
+}
+
+// SerializeOption configures Serialize.
+type SerializeOption func(*serializeOptions)
+
+type serializeOptions struct {
+	includeAggregations bool
+}
+
+// WithAggregations includes how each value was aggregated.
+//
+// The metadata is under MetaKey, in a tree parallel to the summary whose
+// leaves are objects with an "aggregation" field and, for means, a "count"
+// field. Values without a known aggregation, like those set directly by
+// the user, are omitted.
+func WithAggregations() SerializeOption {
+	return func(opts *serializeOptions) {
+		opts.includeAggregations = true
+	}
+}
+
+// SetAggregation records how the value at the path was computed.
+//
+// It is forgotten when the value is next updated or removed, so it must be
+// set after applying the update it describes.
+func (rs *RunSummary) SetAggregation(path []string, aggregation Aggregation) {
+	if len(path) == 0 {
+		return
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.aggregations.ApplyUpdate(
+		[]*pathtree.PathItem{{Path: path, Value: aggregation}},
+		func(error) {},
+	)
+}
+
+// serializeWithAggregationsLocked serializes the summary with the
+// aggregation metadata under MetaKey.
+//
+// The mutex must be held.
+func (rs *RunSummary) serializeWithAggregationsLocked() ([]byte, error) {
+	meta := pathtree.New()
+	leaves := rs.aggregations.Flatten()
+	updates := make([]*pathtree.PathItem, 0, len(leaves))
+	for _, leaf := range leaves {
+		aggregation := leaf.Value.(Aggregation)
+		value := pathtree.TreeData{"aggregation": string(aggregation.Kind)}
+		if aggregation.Kind == AggregationMean {
+			value["count"] = aggregation.Count
+		}
+		updates = append(updates, &pathtree.PathItem{
+			Path:  leaf.Path,
+			Value: value,
+		})
+	}
+	meta.ApplyUpdate(updates, func(error) {})
+
+	tree := make(pathtree.TreeData, len(rs.pathTree.Tree())+1)
+	for key, value := range rs.pathTree.Tree() {
+		tree[key] = value
+	}
+	tree[MetaKey] = meta.Tree()
+
+	return json.Marshal(tree)
+}
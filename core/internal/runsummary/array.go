@@ -0,0 +1,116 @@
+package runsummary
+
+import (
+	"math"
+	"strings"
+
+	json "github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ReduceArray returns the summary of an array value logged to history.
+//
+// Numeric arrays such as embeddings or per-class scores can be large, so by
+// default they are summarized as an object with the array's length and the
+// min, max and mean of its numbers, including those in nested arrays. NaN
+// values are ignored for these statistics, which are omitted if there are
+// no numbers. ARRAY_ENDS keeps the length and first and last elements
+// instead, and ARRAY_FULL keeps the whole array.
+//
+// Returns the value unchanged and false if it is not an array, if it has
+// elements other than numbers and numeric arrays, or if the mode is
+// ARRAY_FULL.
+func ReduceArray(
+	valueJSON string,
+	mode service.MetricSummary_ArrayMode,
+) (string, bool) {
+	if mode == service.MetricSummary_ARRAY_FULL ||
+		!strings.HasPrefix(strings.TrimSpace(valueJSON), "[") {
+		return valueJSON, false
+	}
+
+	value, err := json.Unmarshal([]byte(valueJSON))
+	array, ok := value.([]any)
+	if err != nil || !ok || !isNumeric(array) {
+		return valueJSON, false
+	}
+
+	reduced := map[string]any{"length": int64(len(array))}
+	switch mode {
+	case service.MetricSummary_ARRAY_ENDS:
+		if len(array) > 0 {
+			reduced["first"] = array[0]
+			reduced["last"] = array[len(array)-1]
+		}
+	default:
+		var stats arrayStats
+		stats.add(array)
+		if stats.count > 0 {
+			reduced["min"] = stats.min
+			reduced["max"] = stats.max
+			reduced["mean"] = stats.sum / float64(stats.count)
+		}
+	}
+
+	reducedJSON, err := json.Marshal(reduced)
+	if err != nil {
+		return valueJSON, false
+	}
+	return string(reducedJSON), true
+}
+
+// isNumeric reports whether the array contains only numbers and numeric
+// arrays.
+func isNumeric(array []any) bool {
+	for _, element := range array {
+		switch element := element.(type) {
+		case int64, float64:
+		case []any:
+			if !isNumeric(element) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// arrayStats accumulates statistics of the numbers in an array.
+type arrayStats struct {
+	count    int
+	min, max float64
+	sum      float64
+}
+
+// add includes the numbers in the array and in its nested arrays.
+func (s *arrayStats) add(array []any) {
+	for _, element := range array {
+		var x float64
+		switch element := element.(type) {
+		case int64:
+			x = float64(element)
+		case float64:
+			x = element
+		case []any:
+			s.add(element)
+			continue
+		default:
+			continue
+		}
+
+		if math.IsNaN(x) {
+			continue
+		}
+
+		if s.count == 0 {
+			s.min, s.max = x, x
+		} else {
+			s.min = min(s.min, x)
+			s.max = max(s.max, x)
+		}
+		s.sum += x
+		s.count++
+	}
+}
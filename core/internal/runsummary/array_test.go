@@ -0,0 +1,54 @@
+package runsummary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestReduceArray(t *testing.T) {
+	testCases := []struct {
+		name      string
+		valueJSON string
+		mode      service.MetricSummary_ArrayMode
+		expected  string
+		reduced   bool
+	}{
+		{"stats", "[2, 4, [6]]", service.MetricSummary_ARRAY_STATS,
+			`{"length":3,"max":6,"mean":4,"min":2}`, true},
+		{"stats of NaN", "[NaN]", service.MetricSummary_ARRAY_STATS,
+			`{"length":1}`, true},
+		{"ends", `[1, [2], 3]`, service.MetricSummary_ARRAY_ENDS,
+			`{"first":1,"last":3,"length":3}`, true},
+		{"strings", `["a", "b"]`, service.MetricSummary_ARRAY_STATS,
+			`["a", "b"]`, false},
+		{"mixed", `[1, "b", 3]`, service.MetricSummary_ARRAY_ENDS,
+			`[1, "b", 3]`, false},
+		{"nested non-numeric", `[1, [{"a": 2}]]`, service.MetricSummary_ARRAY_STATS,
+			`[1, [{"a": 2}]]`, false},
+		{"empty ends", "[]", service.MetricSummary_ARRAY_ENDS,
+			`{"length":0}`, true},
+		{"full", "[1, 2]", service.MetricSummary_ARRAY_FULL,
+			"[1, 2]", false},
+		{"not an array", `{"a": [1]}`, service.MetricSummary_ARRAY_STATS,
+			`{"a": [1]}`, false},
+		{"invalid", "[1,", service.MetricSummary_ARRAY_STATS,
+			"[1,", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, reduced := runsummary.ReduceArray(tc.valueJSON, tc.mode)
+
+			assert.Equal(t, tc.reduced, reduced)
+			if reduced {
+				assert.JSONEq(t, tc.expected, result)
+			} else {
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package runsummary
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	json "github.com/wandb/simplejsonext"
+)
+
+const (
+	// MaxHistogramBins is the largest number of bins of a summary
+	// histogram, matching the limit of wandb.Histogram.
+	MaxHistogramBins = 512
+
+	// histogramCentroids is how many distinct values a Histogram keeps
+	// before merging nearby ones.
+	histogramCentroids = 1024
+)
+
+// Histogram approximates the distribution of a stream of numbers in
+// bounded memory.
+//
+// It keeps up to a fixed number of centroids, each a mean and a count of
+// values, and merges the two closest centroids when it runs out, as in
+// the streaming histogram of Ben-Haim and Tom-Tov. Until then, and in
+// particular for metrics logged only a few times, it is exact.
+//
+// The zero value is an empty histogram.
+type Histogram struct {
+	// centroids are sorted by mean.
+	centroids []centroid
+
+	min, max float64
+	count    int64
+}
+
+type centroid struct {
+	mean  float64
+	count int64
+}
+
+// Add adds a value to the histogram.
+//
+// NaN and infinite values are ignored.
+func (h *Histogram) Add(value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+
+	if h.count == 0 {
+		h.min, h.max = value, value
+	} else {
+		h.min, h.max = min(h.min, value), max(h.max, value)
+	}
+	h.count++
+
+	i := sort.Search(len(h.centroids), func(i int) bool {
+		return h.centroids[i].mean >= value
+	})
+	if i < len(h.centroids) && h.centroids[i].mean == value {
+		h.centroids[i].count++
+		return
+	}
+
+	h.centroids = slices.Insert(h.centroids, i, centroid{mean: value, count: 1})
+	if len(h.centroids) > histogramCentroids {
+		h.mergeClosest()
+	}
+}
+
+// mergeClosest replaces the two centroids with the closest means by their
+// weighted average.
+func (h *Histogram) mergeClosest() {
+	closest := 0
+	for i := 1; i < len(h.centroids)-1; i++ {
+		if h.centroids[i+1].mean-h.centroids[i].mean <
+			h.centroids[closest+1].mean-h.centroids[closest].mean {
+			closest = i
+		}
+	}
+
+	a, b := h.centroids[closest], h.centroids[closest+1]
+	count := a.count + b.count
+	h.centroids[closest] = centroid{
+		mean:  (a.mean*float64(a.count) + b.mean*float64(b.count)) / float64(count),
+		count: count,
+	}
+	h.centroids = slices.Delete(h.centroids, closest+1, closest+2)
+}
+
+// Count returns the number of values added to the histogram.
+func (h *Histogram) Count() int64 {
+	return h.count
+}
+
+// Bins returns the edges and counts of equal-width bins spanning the
+// values added to the histogram.
+//
+// There is one more edge than counts. The number of bins is clamped to
+// [1, MaxHistogramBins]. If all values are equal, the single bin is one
+// unit wide and centered on the value, like numpy.histogram. Returns nil
+// slices if the histogram is empty.
+func (h *Histogram) Bins(numBins int) (edges []float64, counts []int64) {
+	if h.count == 0 {
+		return nil, nil
+	}
+
+	lo, hi := h.min, h.max
+	if lo == hi {
+		lo, hi, numBins = lo-0.5, hi+0.5, 1
+	}
+	numBins = min(max(numBins, 1), MaxHistogramBins)
+
+	width := (hi - lo) / float64(numBins)
+	edges = make([]float64, numBins+1)
+	for i := range edges {
+		edges[i] = lo + float64(i)*width
+	}
+	edges[numBins] = hi
+
+	counts = make([]int64, numBins)
+	for _, c := range h.centroids {
+		bin := int((c.mean - lo) / width)
+		counts[min(max(bin, 0), numBins-1)] += c.count
+	}
+
+	return edges, counts
+}
+
+// SummaryJSON returns the histogram as a wandb.Histogram value with the
+// given number of bins, or false if it is empty.
+func (h *Histogram) SummaryJSON(numBins int) (string, bool) {
+	edges, counts := h.Bins(numBins)
+	if len(counts) == 0 {
+		return "", false
+	}
+
+	bins := make([]any, len(edges))
+	for i, edge := range edges {
+		bins[i] = edge
+	}
+	values := make([]any, len(counts))
+	for i, count := range counts {
+		values[i] = count
+	}
+
+	encoded, err := json.Marshal(map[string]any{
+		"_type":  "histogram",
+		"bins":   bins,
+		"values": values,
+	})
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
@@ -0,0 +1,78 @@
+package runsummary_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/runsummary"
+)
+
+func TestHistogram_Empty(t *testing.T) {
+	var histogram runsummary.Histogram
+	histogram.Add(math.NaN())
+	histogram.Add(math.Inf(1))
+
+	_, ok := histogram.SummaryJSON(8)
+	assert.False(t, ok)
+	assert.Zero(t, histogram.Count())
+}
+
+func TestHistogram_FewValues(t *testing.T) {
+	var histogram runsummary.Histogram
+	for _, value := range []float64{1, 2, 3, 3} {
+		histogram.Add(value)
+	}
+
+	edges, counts := histogram.Bins(2)
+	assert.Equal(t, []float64{1, 2, 3}, edges)
+	assert.Equal(t, []int64{1, 3}, counts)
+
+	summary, ok := histogram.SummaryJSON(2)
+	assert.True(t, ok)
+	assert.JSONEq(t,
+		`{"_type": "histogram", "bins": [1, 2, 3], "values": [1, 3]}`,
+		summary)
+}
+
+func TestHistogram_SingleValue(t *testing.T) {
+	var histogram runsummary.Histogram
+	histogram.Add(5)
+	histogram.Add(5)
+
+	edges, counts := histogram.Bins(64)
+	assert.Equal(t, []float64{4.5, 5.5}, edges)
+	assert.Equal(t, []int64{2}, counts)
+}
+
+func TestHistogram_ClampsBins(t *testing.T) {
+	var histogram runsummary.Histogram
+	histogram.Add(0)
+	histogram.Add(1)
+
+	_, counts := histogram.Bins(0)
+	assert.Len(t, counts, 1)
+
+	_, counts = histogram.Bins(10000)
+	assert.Len(t, counts, runsummary.MaxHistogramBins)
+}
+
+func TestHistogram_ManyValues(t *testing.T) {
+	var histogram runsummary.Histogram
+	for i := range 100000 {
+		histogram.Add(float64(i % 5000))
+	}
+
+	edges, counts := histogram.Bins(10)
+	assert.Equal(t, 0.0, edges[0])
+	assert.Equal(t, 4999.0, edges[10])
+
+	var total int64
+	for _, count := range counts {
+		assert.InDelta(t, 10000, count, 200)
+		total += count
+	}
+	assert.EqualValues(t, 100000, total)
+	assert.EqualValues(t, 100000, histogram.Count())
+}
@@ -0,0 +1,53 @@
+package runsummary
+
+// Types of media values that are stored compactly in the summary.
+//
+// When media like images are logged to history, the client also puts
+// them into the summary. The history entry is the full description of
+// the file (hashes, dimensions, captions, etc.), but the summary only
+// needs enough to locate the latest file.
+var compactMediaTypes = map[string]bool{
+	"image-file":    true,
+	"audio-file":    true,
+	"video-file":    true,
+	"html-file":     true,
+	"object3D-file": true,
+	"molecule-file": true,
+}
+
+// compactMedia replaces media values by a reference to their file.
+//
+// The reference keeps only the "_type" and "path" keys. Maps are
+// processed recursively; other values are returned unchanged.
+func compactMedia(value any) any {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	if ref, ok := mediaRef(obj); ok {
+		return ref
+	}
+
+	for key, child := range obj {
+		obj[key] = compactMedia(child)
+	}
+	return obj
+}
+
+// mediaRef returns a compact reference to the media file described by obj.
+//
+// Returns false if obj does not describe a supported media file.
+func mediaRef(obj map[string]any) (map[string]any, bool) {
+	mediaType, ok := obj["_type"].(string)
+	if !ok || !compactMediaTypes[mediaType] {
+		return nil, false
+	}
+
+	path, ok := obj["path"].(string)
+	if !ok {
+		return nil, false
+	}
+
+	return map[string]any{"_type": mediaType, "path": path}, true
+}
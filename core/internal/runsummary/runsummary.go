@@ -2,6 +2,8 @@ package runsummary
 
 import (
 	"fmt"
+	"slices"
+	"sync"
 
 	// TODO: use simplejsonext for now until we replace the usage of json with
 	// protocol buffer and proto json marshaler
@@ -11,19 +13,38 @@ import (
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
+// RunSummary is the summary of a run.
+//
+// It is safe to use from multiple goroutines.
 type RunSummary struct {
+	mu       sync.RWMutex
 	pathTree *pathtree.PathTree
+
+	// pinned are key paths included in every summary update.
+	pinned [][]string
+
+	// aggregations describe how values were computed, with an Aggregation
+	// at the path of each described value.
+	aggregations *pathtree.PathTree
 }
 
 func New() *RunSummary {
-	return &RunSummary{pathTree: pathtree.New()}
+	return &RunSummary{
+		pathTree:     pathtree.New(),
+		aggregations: pathtree.New(),
+	}
 }
 
 func NewFrom(tree pathtree.TreeData) *RunSummary {
-	return &RunSummary{pathTree: pathtree.NewFrom(tree)}
+	return &RunSummary{
+		pathTree:     pathtree.NewFrom(tree),
+		aggregations: pathtree.New(),
+	}
 }
 
-// Updates and/or removes values from the configuration tree.
+// Updates and/or removes values from the summary tree.
+//
+// A numeric key below a list sets the list element at that index.
 //
 // Does a best-effort job to apply all changes. Errors are passed to `onError`
 // and skipped.
@@ -31,6 +52,8 @@ func (rs *RunSummary) ApplyChangeRecord(
 	summaryRecord *service.SummaryRecord,
 	onError func(error),
 ) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
 
 	updates := make([]*pathtree.PathItem, 0, len(summaryRecord.GetUpdate()))
 	for _, item := range summaryRecord.GetUpdate() {
@@ -41,10 +64,11 @@ func (rs *RunSummary) ApplyChangeRecord(
 		}
 		updates = append(updates, &pathtree.PathItem{
 			Path:  keyPath(item),
-			Value: update,
+			Value: compactMedia(update),
 		})
 	}
-	rs.pathTree.ApplyUpdate(updates, onError)
+	rs.pathTree.ApplyIndexedUpdate(updates, onError)
+	rs.aggregations.ApplyRemove(updates)
 
 	removes := make([]*pathtree.PathItem, 0, len(summaryRecord.GetRemove()))
 	for _, item := range summaryRecord.GetRemove() {
@@ -53,6 +77,7 @@ func (rs *RunSummary) ApplyChangeRecord(
 		})
 	}
 	rs.pathTree.ApplyRemove(removes)
+	rs.aggregations.ApplyRemove(removes)
 }
 
 // Flatten the summary tree into a slice of SummaryItems.
@@ -62,6 +87,9 @@ func (rs *RunSummary) ApplyChangeRecord(
 // The tree traversal is depth-first but based on a map, so the order is not
 // guaranteed.
 func (rs *RunSummary) Flatten() ([]*service.SummaryItem, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
 	leaves := rs.pathTree.Flatten()
 
 	summary := make([]*service.SummaryItem, 0, len(leaves))
@@ -99,19 +127,122 @@ func (rs *RunSummary) Flatten() ([]*service.SummaryItem, error) {
 
 // Clones the tree. This is useful for creating a snapshot of the tree.
 func (rs *RunSummary) CloneTree() (pathtree.TreeData, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
 
 	return rs.pathTree.CloneTree()
 }
 
-// Tree returns the tree data.
-func (rs *RunSummary) Tree() pathtree.TreeData {
+// Get returns the value at the path in the summary.
+//
+// Nested values are returned by reference and must not be modified.
+func (rs *RunSummary) Get(path ...string) (any, bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	return rs.getLocked(path)
+}
+
+func (rs *RunSummary) getLocked(path []string) (any, bool) {
+	tree := rs.pathTree.Tree()
+	for _, key := range path[:len(path)-1] {
+		subtree, ok := tree[key].(pathtree.TreeData)
+		if !ok {
+			return nil, false
+		}
+		tree = subtree
+	}
 
-	return rs.pathTree.Tree()
+	value, ok := tree[path[len(path)-1]]
+	return value, ok
+}
+
+// Pin marks the key to be included in every summary update.
+//
+// Returns an error if the key isn't in the summary.
+func (rs *RunSummary) Pin(item *service.SummaryItem) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	path := keyPath(item)
+	if _, ok := rs.getLocked(path); !ok || path[0] == "" {
+		return fmt.Errorf("runsummary: cannot pin %v: not in summary", path)
+	}
+
+	if !slices.ContainsFunc(rs.pinned, func(p []string) bool {
+		return slices.Equal(p, path)
+	}) {
+		rs.pinned = append(rs.pinned, slices.Clone(path))
+	}
+	return nil
+}
+
+// Unpin undoes Pin.
+func (rs *RunSummary) Unpin(item *service.SummaryItem) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	path := keyPath(item)
+	rs.pinned = slices.DeleteFunc(rs.pinned, func(p []string) bool {
+		return slices.Equal(p, path)
+	})
+}
+
+// PinnedItems returns the current values of the pinned keys.
+//
+// Pinned keys that were removed from the summary are skipped.
+func (rs *RunSummary) PinnedItems() ([]*service.SummaryItem, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	items := make([]*service.SummaryItem, 0, len(rs.pinned))
+	for _, path := range rs.pinned {
+		value, ok := rs.getLocked(path)
+		if !ok {
+			continue
+		}
+
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"runsummary: failed to marshal value for %v: %v",
+				path, err,
+			)
+		}
+
+		item := &service.SummaryItem{ValueJson: string(valueJSON)}
+		if len(path) == 1 {
+			item.Key = path[0]
+		} else {
+			item.NestedKey = slices.Clone(path)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
 }
 
 // Serializes the object to send to the backend.
-func (rs *RunSummary) Serialize() ([]byte, error) {
-	return json.Marshal(rs.Tree())
+//
+// By default, this is just the summary values. Options can add metadata
+// for other consumers.
+func (rs *RunSummary) Serialize(opts ...SerializeOption) ([]byte, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	var options serializeOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.includeAggregations {
+		return rs.serializeWithAggregationsLocked()
+	}
+	return json.Marshal(rs.pathTree.Tree())
 }
 
 // keyPath returns the key path for the given config item.
@@ -123,3 +254,51 @@ func keyPath(item *service.SummaryItem) []string {
 	}
 	return []string{item.GetKey()}
 }
+
+// MergeDuplicateKeys resolves summary items that set the same key more
+// than once, such as a nested "a": {"b": ...} and a literal "a.b".
+//
+// The last value for each key wins. Returns the items and the duplicated
+// keys. If there are no duplicates, the items are returned unchanged.
+func MergeDuplicateKeys(
+	items []*service.SummaryItem,
+) ([]*service.SummaryItem, []string, error) {
+	updates := make([]*pathtree.PathItem, 0, len(items))
+	for _, item := range items {
+		value, err := json.Unmarshal([]byte(item.GetValueJson()))
+		if err != nil {
+			return items, nil, err
+		}
+		updates = append(updates,
+			&pathtree.PathItem{
+				Path:  keyPath(item),
+				Value: value,
+			})
+	}
+
+	merged, duplicates := pathtree.MergeDuplicateLeaves(updates)
+	if len(duplicates) == 0 {
+		return items, nil, nil
+	}
+
+	result := make([]*service.SummaryItem, 0, len(merged))
+	for _, leaf := range merged {
+		value, err := json.Marshal(leaf.Value)
+		if err != nil {
+			return items, nil, err
+		}
+
+		if len(leaf.Path) == 1 {
+			result = append(result, &service.SummaryItem{
+				Key:       leaf.Path[0],
+				ValueJson: string(value),
+			})
+		} else {
+			result = append(result, &service.SummaryItem{
+				NestedKey: leaf.Path,
+				ValueJson: string(value),
+			})
+		}
+	}
+	return result, duplicates, nil
+}
@@ -1,9 +1,12 @@
 package runsummary_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/wandb/wandb/core/internal/pathtree"
@@ -41,8 +44,41 @@ func TestApplyUpdate(t *testing.T) {
 		},
 	}
 
-	if !reflect.DeepEqual(rh.Tree(), expectedTree) {
-		t.Errorf("Expected %v, got %v", expectedTree, rh.Tree())
+	tree, err := rh.CloneTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tree, expectedTree) {
+		t.Errorf("Expected %v, got %v", expectedTree, tree)
+	}
+}
+
+func TestApplyUpdate_SetsListElement(t *testing.T) {
+	rs := runsummary.NewFrom(pathtree.TreeData{
+		"metrics": []any{1.0, 2.0},
+	})
+
+	var errs []error
+	rs.ApplyChangeRecord(
+		&service.SummaryRecord{
+			Update: []*service.SummaryItem{
+				{NestedKey: []string{"metrics", "0"}, ValueJson: "5"},
+				{NestedKey: []string{"metrics", "2"}, ValueJson: "6"},
+			},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	expectedTree := pathtree.TreeData{"metrics": []any{int64(5), 2.0}}
+	tree, err := rs.CloneTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tree, expectedTree) {
+		t.Errorf("Expected %v, got %v", expectedTree, tree)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected an out-of-range error, got %v", errs)
 	}
 }
 
@@ -75,8 +111,12 @@ func TestApplyRemove(t *testing.T) {
 		},
 	}
 
-	if !reflect.DeepEqual(rs.Tree(), expectedTree) {
-		t.Errorf("Expected %v, got %v", expectedTree, rs.Tree())
+	tree, err := rs.CloneTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tree, expectedTree) {
+		t.Errorf("Expected %v, got %v", expectedTree, tree)
 	}
 }
 
@@ -156,3 +196,250 @@ func TestSerialize(t *testing.T) {
 	}
 
 }
+
+func TestSerialize_WithAggregations(t *testing.T) {
+	rs := runsummary.NewFrom(pathtree.TreeData{
+		"loss":   0.5,
+		"acc":    0.9,
+		"nested": pathtree.TreeData{"lr": 0.1},
+		"note":   "set by the user",
+	})
+	rs.SetAggregation([]string{"loss"}, runsummary.Aggregation{
+		Kind: runsummary.AggregationMin,
+	})
+	rs.SetAggregation([]string{"acc"}, runsummary.Aggregation{
+		Kind:  runsummary.AggregationMean,
+		Count: 10,
+	})
+	rs.SetAggregation([]string{"nested", "lr"}, runsummary.Aggregation{
+		Kind: runsummary.AggregationLast,
+	})
+
+	plainJson, err := rs.Serialize()
+	if err != nil {
+		t.Fatal("Serialize failed:", err)
+	}
+	actualJson, err := rs.Serialize(runsummary.WithAggregations())
+	if err != nil {
+		t.Fatal("Serialize failed:", err)
+	}
+
+	if strings.Contains(string(plainJson), runsummary.MetaKey) {
+		t.Errorf("Expected no metadata by default, got %v", string(plainJson))
+	}
+	expectedJson := `{"_summary_meta":{` +
+		`"acc":{"aggregation":"mean","count":10},` +
+		`"loss":{"aggregation":"min"},` +
+		`"nested":{"lr":{"aggregation":"last"}}},` +
+		`"acc":0.9,"loss":0.5,"nested":{"lr":0.1},"note":"set by the user"}`
+	assertJSONEqual(t, expectedJson, string(actualJson))
+}
+
+func TestApplyUpdate_ForgetsAggregation(t *testing.T) {
+	rs := runsummary.New()
+	rs.ApplyChangeRecord(
+		&service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: "0.5"}},
+		},
+		func(err error) { t.Error("onError should not be called", err) },
+	)
+	rs.SetAggregation([]string{"loss"}, runsummary.Aggregation{
+		Kind: runsummary.AggregationMin,
+	})
+	rs.ApplyChangeRecord(
+		&service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: "0.7"}},
+		},
+		func(err error) { t.Error("onError should not be called", err) },
+	)
+
+	actualJson, err := rs.Serialize(runsummary.WithAggregations())
+	if err != nil {
+		t.Fatal("Serialize failed:", err)
+	}
+
+	assertJSONEqual(t, `{"_summary_meta":{},"loss":0.7}`, string(actualJson))
+}
+
+// assertJSONEqual checks that two JSON documents have the same content,
+// ignoring the order of object keys.
+func assertJSONEqual(t *testing.T, expected, actual string) {
+	t.Helper()
+
+	var expectedValue, actualValue any
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		t.Fatal("invalid expected JSON:", err)
+	}
+	if err := json.Unmarshal([]byte(actual), &actualValue); err != nil {
+		t.Fatal("invalid JSON:", err)
+	}
+
+	if !reflect.DeepEqual(expectedValue, actualValue) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+}
+
+func TestApplyUpdate_CompactsMedia(t *testing.T) {
+	rs := runsummary.New()
+	summary := &service.SummaryRecord{
+		Update: []*service.SummaryItem{
+			{
+				Key: "image",
+				ValueJson: `{"_type": "image-file", "path": "media/images/a.png",
+					"sha256": "abc", "size": 123, "width": 28, "height": 28}`,
+			},
+			{
+				Key: "samples",
+				ValueJson: `{"audio": {"_type": "audio-file",
+					"path": "media/audio/b.wav", "sha256": "def"}}`,
+			},
+			{
+				Key:       "table",
+				ValueJson: `{"_type": "table", "path": "media/table.json"}`,
+			},
+		},
+	}
+
+	rs.ApplyChangeRecord(summary,
+		func(err error) {
+			t.Error("onError should not be called", err)
+		})
+
+	expectedTree := pathtree.TreeData{
+		"image": pathtree.TreeData{
+			"_type": "image-file",
+			"path":  "media/images/a.png",
+		},
+		"samples": pathtree.TreeData{
+			"audio": pathtree.TreeData{
+				"_type": "audio-file",
+				"path":  "media/audio/b.wav",
+			},
+		},
+		"table": pathtree.TreeData{
+			"_type": "table",
+			"path":  "media/table.json",
+		},
+	}
+
+	tree, err := rs.CloneTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tree, expectedTree) {
+		t.Errorf("Expected %v, got %v", expectedTree, tree)
+	}
+}
+
+func TestGet(t *testing.T) {
+	rs := runsummary.NewFrom(pathtree.TreeData{
+		"loss":   0.5,
+		"nested": pathtree.TreeData{"acc": 0.9},
+	})
+
+	loss, ok := rs.Get("loss")
+	if !ok || loss != 0.5 {
+		t.Errorf("Expected 0.5, got %v (%v)", loss, ok)
+	}
+	acc, ok := rs.Get("nested", "acc")
+	if !ok || acc != 0.9 {
+		t.Errorf("Expected 0.9, got %v (%v)", acc, ok)
+	}
+	if _, ok := rs.Get("loss", "acc"); ok {
+		t.Error("Expected no value under a leaf")
+	}
+	if _, ok := rs.Get("missing"); ok {
+		t.Error("Expected no value for a missing key")
+	}
+}
+
+// Run with the race detector to check that concurrent reads and writes
+// are synchronized.
+func TestConcurrentApplyAndRead(t *testing.T) {
+	rs := runsummary.New()
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				rs.ApplyChangeRecord(
+					&service.SummaryRecord{
+						Update: []*service.SummaryItem{{
+							NestedKey: []string{"nested", fmt.Sprint(i)},
+							ValueJson: fmt.Sprint(j),
+						}},
+					},
+					func(err error) { t.Error(err) },
+				)
+			}
+		}(i)
+	}
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := rs.Serialize(); err != nil {
+					t.Error(err)
+				}
+				if _, err := rs.Flatten(); err != nil {
+					t.Error(err)
+				}
+				if _, err := rs.CloneTree(); err != nil {
+					t.Error(err)
+				}
+				rs.Get("nested", "0")
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	items, err := rs.Flatten()
+	if err != nil || len(items) != 4 {
+		t.Errorf("Expected 4 items, got %v (%v)", items, err)
+	}
+}
+
+func TestPin(t *testing.T) {
+	rs := runsummary.NewFrom(pathtree.TreeData{
+		"loss": int64(1),
+		"eval": pathtree.TreeData{"acc": int64(2)},
+	})
+
+	if err := rs.Pin(&service.SummaryItem{Key: "loss"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.Pin(&service.SummaryItem{NestedKey: []string{"eval", "acc"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.Pin(&service.SummaryItem{Key: "missing"}); err == nil {
+		t.Error("expected error pinning a missing key")
+	}
+	rs.ApplyChangeRecord(
+		&service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: "3"}},
+		},
+		func(err error) { t.Error(err) },
+	)
+
+	items, err := rs.PinnedItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 ||
+		items[0].GetKey() != "loss" || items[0].GetValueJson() != "3" ||
+		!reflect.DeepEqual(items[1].GetNestedKey(), []string{"eval", "acc"}) ||
+		items[1].GetValueJson() != "2" {
+		t.Errorf("unexpected pinned items: %v", items)
+	}
+
+	rs.Unpin(&service.SummaryItem{Key: "loss"})
+	items, _ = rs.PinnedItems()
+	if len(items) != 1 {
+		t.Errorf("expected 1 pinned item after unpinning, got %v", items)
+	}
+}
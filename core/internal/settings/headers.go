@@ -0,0 +1,156 @@
+package settings
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// protectedHTTPHeaders are set by the client itself and may not be set
+// through `_extra_http_headers`.
+var protectedHTTPHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Connection":          {},
+	"Content-Encoding":    {},
+	"Content-Length":      {},
+	"Content-Type":        {},
+	"Cookie":              {},
+	"Host":                {},
+	"Proxy-Authorization": {},
+	"Transfer-Encoding":   {},
+	"User-Agent":          {},
+}
+
+// Extra HTTP headers to add to every request to the backend.
+//
+// These are sent with GraphQL, filestream and file transfer requests.
+// Headers set by the client take precedence over these.
+func (s *Settings) GetExtraHTTPHeaders() map[string]string {
+	return s.Proto.XExtraHttpHeaders.GetValue()
+}
+
+// Removes invalid and protected headers from the extra HTTP headers.
+//
+// Header names are canonicalized. Returns an error describing the headers
+// that were removed, if any.
+func (s *Settings) SanitizeExtraHTTPHeaders() error {
+	headers := s.GetExtraHTTPHeaders()
+	if len(headers) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	valid := make(map[string]string, len(headers))
+	var problems []string
+	for _, name := range names {
+		value := headers[name]
+		canonicalName := http.CanonicalHeaderKey(name)
+
+		switch {
+		case !isValidHeaderName(name):
+			problems = append(problems, fmt.Sprintf("invalid header name %q", name))
+		case !isValidHeaderValue(value):
+			problems = append(problems, fmt.Sprintf("invalid value for header %q", name))
+		default:
+			if _, isProtected := protectedHTTPHeaders[canonicalName]; isProtected {
+				problems = append(problems, fmt.Sprintf("header %q cannot be overridden", name))
+			} else {
+				valid[canonicalName] = value
+			}
+		}
+	}
+
+	s.Proto.XExtraHttpHeaders = &service.MapStringKeyStringValue{Value: valid}
+
+	if len(problems) > 0 {
+		return fmt.Errorf(
+			"settings: ignoring extra HTTP headers: %s",
+			strings.Join(problems, "; "),
+		)
+	}
+	return nil
+}
+
+// maxUserAgentTokenLength is the longest allowed `_user_agent_token`.
+const maxUserAgentTokenLength = 256
+
+// The product token to append to the User-Agent, like "my-tool/1.2".
+func (s *Settings) GetUserAgentToken() string {
+	return s.Proto.XUserAgentToken.GetValue()
+}
+
+// Clears the User-Agent token if it's invalid.
+//
+// A valid token is one or more space-separated products, each a name with
+// an optional "/version", as in RFC 7231. Anything else, in particular
+// control characters that could inject headers, is rejected.
+func (s *Settings) ValidateUserAgentToken() error {
+	token := s.GetUserAgentToken()
+	if token == "" || isValidUserAgentToken(token) {
+		return nil
+	}
+
+	s.Proto.XUserAgentToken = nil
+	return fmt.Errorf("settings: ignoring invalid User-Agent token %q", token)
+}
+
+// isValidUserAgentToken reports whether the token is a list of products.
+func isValidUserAgentToken(token string) bool {
+	if len(token) > maxUserAgentTokenLength {
+		return false
+	}
+
+	for _, product := range strings.Split(token, " ") {
+		name, version, hasVersion := strings.Cut(product, "/")
+		if !isValidHeaderName(name) ||
+			hasVersion && !isValidHeaderName(version) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isValidHeaderName reports whether the name is a token as defined
+// in RFC 7230.
+func isValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		isTokenChar := c < 0x7f &&
+			(c >= 'a' && c <= 'z' ||
+				c >= 'A' && c <= 'Z' ||
+				c >= '0' && c <= '9' ||
+				strings.ContainsRune("!#$%&'*+-.^_`|~", c))
+		if !isTokenChar {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isValidHeaderValue reports whether the value contains no control
+// characters other than horizontal tabs.
+func isValidHeaderValue(value string) bool {
+	for _, c := range value {
+		if c == '\t' {
+			continue
+		}
+		if c < ' ' || c == 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSanitizeExtraHTTPHeaders(t *testing.T) {
+	s := settings.From(&service.Settings{
+		XExtraHttpHeaders: &service.MapStringKeyStringValue{
+			Value: map[string]string{
+				"x-route-to":    "team-a",
+				"Authorization": "Bearer xyz",
+				"Bad Header":    "value",
+				"X-Injected":    "a\r\nHost: evil",
+			},
+		},
+	})
+
+	err := s.SanitizeExtraHTTPHeaders()
+
+	assert.ErrorContains(t, err, `"Authorization" cannot be overridden`)
+	assert.ErrorContains(t, err, `invalid header name "Bad Header"`)
+	assert.ErrorContains(t, err, `invalid value for header "X-Injected"`)
+	assert.Equal(t,
+		map[string]string{"X-Route-To": "team-a"},
+		s.GetExtraHTTPHeaders())
+}
+
+func TestSanitizeExtraHTTPHeaders_Valid(t *testing.T) {
+	s := settings.From(&service.Settings{
+		XExtraHttpHeaders: &service.MapStringKeyStringValue{
+			Value: map[string]string{"X-Route-To": "team-a\tb"},
+		},
+	})
+
+	assert.NoError(t, s.SanitizeExtraHTTPHeaders())
+	assert.Equal(t,
+		map[string]string{"X-Route-To": "team-a\tb"},
+		s.GetExtraHTTPHeaders())
+}
+
+func TestValidateUserAgentToken(t *testing.T) {
+	for _, token := range []string{"", "my-tool", "my-tool/1.2", "a/1 b/2.0"} {
+		s := settings.From(&service.Settings{
+			XUserAgentToken: wrapperspb.String(token),
+		})
+		assert.NoError(t, s.ValidateUserAgentToken(), token)
+		assert.Equal(t, token, s.GetUserAgentToken())
+	}
+
+	for _, token := range []string{
+		"tool\r\nX-Injected: yes",
+		"my tool(linux)",
+		"a//1",
+		"a  b",
+	} {
+		s := settings.From(&service.Settings{
+			XUserAgentToken: wrapperspb.String(token),
+		})
+		assert.ErrorContains(t, s.ValidateUserAgentToken(), "invalid User-Agent token", token)
+		assert.Empty(t, s.GetUserAgentToken())
+	}
+}
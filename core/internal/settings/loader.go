@@ -0,0 +1,310 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// Source is the layer that a setting's value came from.
+type Source string
+
+const (
+	SourceDefault  Source = "default"
+	SourceFile     Source = "file"
+	SourceEnv      Source = "env"
+	SourceOverride Source = "override"
+)
+
+// envPrefix is prepended to the upper-cased setting name to get its
+// environment variable, so "project" is read from WANDB_PROJECT and
+// "_offline" from WANDB__OFFLINE.
+//
+// This matches how the Python client reads settings from the environment.
+const envPrefix = "WANDB_"
+
+// specialEnvNames maps environment variables that don't follow the
+// envPrefix convention to the settings they set.
+//
+// It must match the Python client's Settings._apply_env_vars.
+var specialEnvNames = map[string]string{
+	"WANDB_TRACELOG":            "_tracelog",
+	"WANDB_DISABLE_SERVICE":     "_disable_service",
+	"WANDB_SERVICE_TRANSPORT":   "_service_transport",
+	"WANDB_DIR":                 "root_dir",
+	"WANDB_NAME":                "run_name",
+	"WANDB_NOTES":               "run_notes",
+	"WANDB_TAGS":                "run_tags",
+	"WANDB_JOB_TYPE":            "run_job_type",
+	"WANDB_HTTP_TIMEOUT":        "_graphql_timeout_seconds",
+	"WANDB_FILE_PUSHER_TIMEOUT": "_file_transfer_timeout_seconds",
+	"WANDB_USER_EMAIL":          "email",
+}
+
+// settingForEnv returns the name of the setting that an environment
+// variable sets, or false if it doesn't set one.
+func settingForEnv(key string) (string, bool) {
+	if name, ok := specialEnvNames[key]; ok {
+		return name, true
+	}
+	if !strings.HasPrefix(key, envPrefix) {
+		return "", false
+	}
+
+	name := strings.ToLower(strings.TrimPrefix(key, envPrefix))
+	for _, special := range specialEnvNames {
+		// The setting is only read from its special variable, so that
+		// WANDB_RUN_NAME doesn't set run_name like WANDB_NAME does.
+		if name == special {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+// Loader builds Settings from layers of configuration.
+//
+// Layers are applied from lowest to highest precedence: Defaults, the
+// config File, the environment, then Overrides. A setting set in a layer
+// replaces its value from all lower layers; values are never merged.
+type Loader struct {
+	// Defaults are the settings before any layer is applied; may be nil.
+	Defaults *service.Settings
+
+	// File is the path of a YAML or JSON config file, or empty.
+	//
+	// It must contain an object mapping setting names, like "project" or
+	// "_offline", to their values. The format is chosen by the extension:
+	// ".json" is parsed as JSON and anything else as YAML.
+	File string
+
+	// Environ is the environment as "KEY=value" pairs, like os.Environ().
+	Environ []string
+
+	// Overrides are explicitly set settings; may be nil.
+	Overrides *service.Settings
+}
+
+// Provenance maps each set setting's name to the layer it came from.
+type Provenance map[string]Source
+
+// String lists settings and their sources, sorted by name.
+func (p Provenance) String() string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sb, "%s: %s\n", name, p[name])
+	}
+	return sb.String()
+}
+
+// Load merges the layers into Settings.
+//
+// It also returns where each set value came from.
+func (l *Loader) Load() (*Settings, Provenance, error) {
+	result := &service.Settings{}
+	provenance := make(Provenance)
+
+	fileLayer, err := readSettingsFile(l.File)
+	if err != nil {
+		return nil, nil, err
+	}
+	envLayer, err := settingsFromEnv(l.Environ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	applyLayer(result, l.Defaults, SourceDefault, provenance)
+	applyLayer(result, fileLayer, SourceFile, provenance)
+	applyLayer(result, envLayer, SourceEnv, provenance)
+	applyLayer(result, l.Overrides, SourceOverride, provenance)
+
+	return From(result), provenance, nil
+}
+
+// applyLayer copies the fields set in layer into result.
+func applyLayer(
+	result *service.Settings,
+	layer *service.Settings,
+	source Source,
+	provenance Provenance,
+) {
+	if layer == nil {
+		return
+	}
+
+	resultMsg := result.ProtoReflect()
+	layer.ProtoReflect().Range(
+		func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			if field.Message() != nil {
+				value = protoreflect.ValueOfMessage(
+					proto.Clone(value.Message().Interface()).ProtoReflect())
+			}
+			resultMsg.Set(field, value)
+			provenance[string(field.Name())] = source
+			return true
+		})
+}
+
+// readSettingsFile parses a config file into Settings.
+//
+// Returns nil if path is empty.
+func readSettingsFile(path string) (*service.Settings, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("settings: failed to read config file: %v", err)
+	}
+
+	var values map[string]any
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &values)
+	} else {
+		err = yaml.Unmarshal(data, &values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("settings: failed to parse %s: %v", path, err)
+	}
+
+	settings, err := settingsFromValues(values)
+	if err != nil {
+		return nil, fmt.Errorf("settings: invalid config file %s: %v", path, err)
+	}
+	return settings, nil
+}
+
+// settingsFromEnv reads settings from WANDB_ environment variables.
+//
+// Variables that don't name a setting, or name one whose type can't be
+// written as a string, are ignored.
+func settingsFromEnv(environ []string) (*service.Settings, error) {
+	fields := (&service.Settings{}).ProtoReflect().Descriptor().Fields()
+	values := make(map[string]any)
+
+	for _, entry := range environ {
+		key, raw, ok := strings.Cut(entry, "=")
+		if !ok {
+			continue
+		}
+
+		name, ok := settingForEnv(key)
+		if !ok {
+			continue
+		}
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil || wrappedValueField(field) == nil {
+			continue
+		}
+
+		value, err := parseEnvValue(field, raw)
+		if err != nil {
+			return nil, fmt.Errorf("settings: invalid %s: %v", key, err)
+		}
+		values[name] = value
+	}
+
+	return settingsFromValues(values)
+}
+
+// parseEnvValue converts an environment variable to the setting's type.
+//
+// Lists are comma-separated, and maps are JSON objects.
+func parseEnvValue(field protoreflect.FieldDescriptor, raw string) (any, error) {
+	valueField := wrappedValueField(field)
+
+	switch {
+	case valueField.IsList():
+		return strings.Split(raw, ","), nil
+	case valueField.IsMap():
+		var value map[string]any
+		err := json.Unmarshal([]byte(raw), &value)
+		return value, err
+	}
+
+	switch valueField.Kind() {
+	case protoreflect.BoolKind:
+		return parseBool(raw)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return strconv.ParseInt(raw, 10, 64)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.ParseFloat(raw, 64)
+	case protoreflect.StringKind:
+		return raw, nil
+	default:
+		return nil, fmt.Errorf("unsupported setting type")
+	}
+}
+
+// parseBool parses a boolean like Python's strtobool, which the Python
+// client uses for boolean environment variables.
+func parseBool(raw string) (bool, error) {
+	switch strings.ToLower(raw) {
+	case "y", "yes", "t", "true", "on", "1":
+		return true, nil
+	case "n", "no", "f", "false", "off", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean %q", raw)
+	}
+}
+
+// settingsFromValues converts a map of setting names to plain values into
+// Settings.
+func settingsFromValues(values map[string]any) (*service.Settings, error) {
+	fields := (&service.Settings{}).ProtoReflect().Descriptor().Fields()
+	message := make(map[string]any, len(values))
+
+	for name, value := range values {
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("unknown setting %q", name)
+		}
+
+		// Well-known wrappers like StringValue are written as plain values
+		// in JSON, but our own wrappers are objects with a "value" field.
+		if wrappedValueField(field) != nil &&
+			field.Message().FullName().Parent() != "google.protobuf" {
+			value = map[string]any{"value": value}
+		}
+		message[name] = value
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &service.Settings{}
+	if err := protojson.Unmarshal(data, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// wrappedValueField returns the "value" field of a setting's wrapper
+// message, or nil if the setting isn't a single wrapped value.
+func wrappedValueField(field protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if field.Message() == nil || field.Message().Fields().Len() != 1 {
+		return nil
+	}
+	return field.Message().Fields().ByName("value")
+}
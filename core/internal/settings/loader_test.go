@@ -0,0 +1,145 @@
+package settings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoader_Precedence(t *testing.T) {
+	loader := &settings.Loader{
+		Defaults: &service.Settings{
+			Project: wrapperspb.String("default-project"),
+			Entity:  wrapperspb.String("default-entity"),
+			BaseUrl: wrapperspb.String("https://api.wandb.ai"),
+			RunName: wrapperspb.String("default-name"),
+		},
+		File: writeConfigFile(t, "settings.yaml",
+			"project: file-project\nentity: file-entity\nrun_name: file-name\n"),
+		Environ: []string{
+			"WANDB_ENTITY=env-entity",
+			"WANDB_NAME=env-name",
+			"UNRELATED=1",
+		},
+		Overrides: &service.Settings{
+			RunName: wrapperspb.String("override-name"),
+		},
+	}
+
+	s, provenance, err := loader.Load()
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.wandb.ai", s.Proto.GetBaseUrl().GetValue())
+	assert.Equal(t, "file-project", s.GetProject())
+	assert.Equal(t, "env-entity", s.GetEntity())
+	assert.Equal(t, "override-name", s.Proto.GetRunName().GetValue())
+	assert.Equal(t,
+		settings.Provenance{
+			"base_url": settings.SourceDefault,
+			"project":  settings.SourceFile,
+			"entity":   settings.SourceEnv,
+			"run_name": settings.SourceOverride,
+		},
+		provenance)
+	assert.Equal(t,
+		"base_url: default\nentity: env\nproject: file\nrun_name: override\n",
+		provenance.String())
+}
+
+func TestLoader_JSONFileTypes(t *testing.T) {
+	loader := &settings.Loader{
+		File: writeConfigFile(t, "settings.json", `{
+			"_offline": true,
+			"_stats_samples_to_average": 5,
+			"_file_stream_timeout_seconds": 1.5,
+			"ignore_globs": ["*.ckpt", "*.tmp"],
+			"_extra_http_headers": {"X-Team": "research"}
+		}`),
+	}
+
+	s, _, err := loader.Load()
+
+	require.NoError(t, err)
+	assert.True(t, s.IsOffline())
+	assert.EqualValues(t, 5, s.Proto.GetXStatsSamplesToAverage().GetValue())
+	assert.Equal(t, 1.5, s.Proto.GetXFileStreamTimeoutSeconds().GetValue())
+	assert.Equal(t, []string{"*.ckpt", "*.tmp"}, s.GetIgnoreGlobs())
+	assert.Equal(t,
+		map[string]string{"X-Team": "research"},
+		s.Proto.GetXExtraHttpHeaders().GetValue())
+}
+
+func TestLoader_EnvTypes(t *testing.T) {
+	loader := &settings.Loader{
+		Environ: []string{
+			"WANDB__OFFLINE=yes",
+			"WANDB__STATS_SAMPLES_TO_AVERAGE=5",
+			"WANDB_IGNORE_GLOBS=*.ckpt,*.tmp",
+		},
+	}
+
+	s, _, err := loader.Load()
+
+	require.NoError(t, err)
+	assert.True(t, s.IsOffline())
+	assert.EqualValues(t, 5, s.Proto.GetXStatsSamplesToAverage().GetValue())
+	assert.Equal(t, []string{"*.ckpt", "*.tmp"}, s.GetIgnoreGlobs())
+}
+
+func TestLoader_EnvNamesMatchPythonClient(t *testing.T) {
+	loader := &settings.Loader{
+		Environ: []string{
+			"WANDB_TAGS=a,b",
+			"WANDB_DIR=/root-dir",
+			"WANDB_RUN_NAME=not-the-name-variable",
+		},
+	}
+
+	s, provenance, err := loader.Load()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, s.Proto.GetRunTags().GetValue())
+	assert.Equal(t, "/root-dir", s.Proto.GetRootDir().GetValue())
+	assert.NotContains(t, provenance, "run_name")
+}
+
+func TestLoader_InvalidEnvValue(t *testing.T) {
+	loader := &settings.Loader{Environ: []string{"WANDB__OFFLINE=maybe"}}
+
+	_, _, err := loader.Load()
+
+	assert.ErrorContains(t, err, "WANDB__OFFLINE")
+}
+
+func TestLoader_UnknownFileSetting(t *testing.T) {
+	loader := &settings.Loader{
+		File: writeConfigFile(t, "settings.yml", "not_a_setting: 1\n"),
+	}
+
+	_, _, err := loader.Load()
+
+	assert.ErrorContains(t, err, `unknown setting "not_a_setting"`)
+}
+
+func TestLoader_DoesNotModifyLayers(t *testing.T) {
+	defaults := &service.Settings{Project: wrapperspb.String("default")}
+	loader := &settings.Loader{Defaults: defaults}
+
+	s, _, err := loader.Load()
+	require.NoError(t, err)
+	s.Proto.Project.Value = "changed"
+
+	assert.Equal(t, "default", defaults.GetProject().GetValue())
+}
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"fmt"
+	"os"
+)
+
+// The directory where the run's data is written, like the transaction log,
+// its files and its logs.
+func (s *Settings) GetSyncDir() string {
+	return s.Proto.SyncDir.GetValue()
+}
+
+// Checks that the run directory is writable, creating it if necessary.
+//
+// The client picks the run directory, falling back to _run_dir_fallback
+// if the usual one isn't writable, so by the time the settings get here
+// there's nowhere else to put it. Returns an error explaining how to fix
+// the problem instead. Nothing is checked when syncing an existing run.
+func (s *Settings) CheckRunDirWritable() error {
+	runDir := s.GetSyncDir()
+	if runDir == "" || s.IsSync() {
+		return nil
+	}
+
+	if err := checkWritable(runDir); err != nil {
+		return fmt.Errorf(
+			"settings: run directory %s is not writable (%v);"+
+				" set WANDB_DIR to a writable directory,"+
+				" or set _run_dir_fallback to use one when it isn't writable",
+			runDir, err)
+	}
+
+	return nil
+}
+
+// checkWritable creates the directory if needed and checks that files
+// can be created in it.
+func checkWritable(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, ".write-check-*")
+	if err != nil {
+		return err
+	}
+	_ = f.Close()
+	return os.Remove(f.Name())
+}
@@ -0,0 +1,56 @@
+package settings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// unwritableDir returns a directory path that can't be created, even as root,
+// because its parent is a regular file.
+func unwritableDir(t *testing.T) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "not-a-dir")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+	return filepath.Join(file, "wandb")
+}
+
+func runDirSettings(wandbDir string) *settings.Settings {
+	return settings.From(&service.Settings{
+		SyncDir: wrapperspb.String(filepath.Join(wandbDir, "run-123")),
+	})
+}
+
+func TestCheckRunDirWritable_Writable(t *testing.T) {
+	wandbDir := t.TempDir()
+	s := runDirSettings(wandbDir)
+
+	err := s.CheckRunDirWritable()
+
+	assert.NoError(t, err)
+	assert.DirExists(t, filepath.Join(wandbDir, "run-123"))
+}
+
+func TestCheckRunDirWritable_NotWritable(t *testing.T) {
+	s := runDirSettings(unwritableDir(t))
+
+	err := s.CheckRunDirWritable()
+
+	assert.ErrorContains(t, err, "set WANDB_DIR")
+	assert.ErrorContains(t, err, "_run_dir_fallback")
+}
+
+func TestCheckRunDirWritable_Sync(t *testing.T) {
+	s := settings.From(&service.Settings{
+		SyncDir: wrapperspb.String(filepath.Join(unwritableDir(t), "run-123")),
+		XSync:   wrapperspb.Bool(true),
+	})
+
+	assert.NoError(t, s.CheckRunDirWritable())
+}
@@ -2,9 +2,13 @@
 package settings
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
+	"github.com/wandb/wandb/core/internal/faultinject"
+	"github.com/wandb/wandb/core/internal/summaryenv"
 	"github.com/wandb/wandb/core/pkg/auth"
 	"github.com/wandb/wandb/core/pkg/service"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -34,22 +38,59 @@ func (s *Settings) EnsureAPIKey() error {
 		return nil
 	}
 
-	baseUrl := s.Proto.GetBaseUrl().GetValue()
-	u, err := url.Parse(baseUrl)
-	if err != nil {
-		return fmt.Errorf("settings: failed to parse base URL: %v", err)
+	anonymous := s.Proto.Anonymous.GetValue()
+	if anonymous == AnonymousMust {
+		return nil
 	}
 
-	host := u.Hostname()
-	_, password, err := auth.GetNetrcLogin(host)
+	password, err := s.readNetrcAPIKey()
 	if err != nil {
-		return fmt.Errorf("settings: failed to get API key from netrc: %v", err)
+		// Without credentials, we can still log as an anonymous user.
+		if anonymous == AnonymousAllow {
+			return nil
+		}
+		return err
 	}
 	s.Proto.ApiKey = &wrapperspb.StringValue{Value: password}
 
 	return nil
 }
 
+// Whether the API key is the one in .netrc for the base URL.
+//
+// Only such keys can be refreshed by reading .netrc again: a key given
+// some other way may be for a different account.
+func (s *Settings) IsAPIKeyFromNetrc() bool {
+	if s.GetAPIKey() == "" {
+		return false
+	}
+
+	key, err := s.readNetrcAPIKey()
+	return err == nil && key == s.GetAPIKey()
+}
+
+// ReadNetrcAPIKey reads the API key for the base URL from .netrc again.
+//
+// This picks up a new key if the user logs in again after the one the run
+// started with expires.
+func (s *Settings) ReadNetrcAPIKey() (string, error) {
+	return s.readNetrcAPIKey()
+}
+
+// readNetrcAPIKey reads the API key for the base URL from .netrc.
+func (s *Settings) readNetrcAPIKey() (string, error) {
+	u, err := url.Parse(s.Proto.GetBaseUrl().GetValue())
+	if err != nil {
+		return "", fmt.Errorf("settings: failed to parse base URL: %v", err)
+	}
+
+	_, password, err := auth.GetNetrcLogin(u.Hostname())
+	if err != nil {
+		return "", fmt.Errorf("settings: failed to get API key from netrc: %v", err)
+	}
+	return password, nil
+}
+
 // The W&B API key.
 //
 // This can be empty if we're in offline mode.
@@ -57,6 +98,37 @@ func (s *Settings) GetAPIKey() string {
 	return s.Proto.ApiKey.GetValue()
 }
 
+// Values of the anonymous setting.
+const (
+	// Use an anonymous account if the user is not logged in.
+	AnonymousAllow = "allow"
+
+	// Always use an anonymous account.
+	AnonymousMust = "must"
+
+	// Never use an anonymous account.
+	AnonymousNever = "never"
+)
+
+// Whether to log to a temporary anonymous W&B account.
+//
+// Anonymous runs are created on the W&B server, so this is always false
+// in offline mode.
+func (s *Settings) IsAnonymous() bool {
+	if s.IsOffline() {
+		return false
+	}
+
+	switch s.Proto.Anonymous.GetValue() {
+	case AnonymousMust:
+		return true
+	case AnonymousAllow:
+		return s.GetAPIKey() == ""
+	default:
+		return false
+	}
+}
+
 // Whether we are in sync mode.
 func (s *Settings) IsSync() bool {
 	return s.Proto.XSync.GetValue()
@@ -67,6 +139,125 @@ func (s *Settings) IsOffline() bool {
 	return s.Proto.XOffline.GetValue()
 }
 
+// Command that prints backend and proxy credentials.
+func (s *Settings) GetCredentialHelper() string {
+	return s.Proto.XCredentialHelper.GetValue()
+}
+
+// Proxy URLs by URL scheme, used instead of the proxy environment variables.
+func (s *Settings) GetProxies() map[string]string {
+	return s.Proto.XProxies.GetValue()
+}
+
+// Path of a file that pauses network requests while it exists.
+func (s *Settings) GetNetworkPauseFile() string {
+	return s.Proto.XNetworkPauseFile.GetValue()
+}
+
+// Whether to send GraphQL queries as automatic persisted queries.
+func (s *Settings) IsGraphQLPersistedQueriesEnabled() bool {
+	return s.Proto.XGraphqlPersistedQueries.GetValue()
+}
+
+// Whether to mirror history to a JSONL file in the run directory.
+func (s *Settings) IsHistoryJSONLEnabled() bool {
+	return s.Proto.XHistoryJsonl.GetValue()
+}
+
+// Path of the file to export the summary to at the end of the run, if any.
+func (s *Settings) GetSummaryEnvFile() string {
+	return s.Proto.XSummaryEnvFile.GetValue()
+}
+
+// How to export the summary to the summary env file.
+func (s *Settings) GetSummaryEnvOptions() summaryenv.Options {
+	prefix := summaryenv.DefaultPrefix
+	if s.Proto.XSummaryEnvPrefix != nil {
+		prefix = s.Proto.XSummaryEnvPrefix.GetValue()
+	}
+
+	// The action is validated by ValidateSummaryEnvNonScalar.
+	nonScalar, _ := summaryenv.ParseNonScalarAction(
+		s.Proto.XSummaryEnvNonScalar.GetValue())
+
+	return summaryenv.Options{
+		Prefix:       prefix,
+		PreserveCase: s.Proto.XSummaryEnvPreserveCase.GetValue(),
+		NonScalar:    nonScalar,
+	}
+}
+
+// Checks how non-scalar values are exported to the summary env file.
+//
+// An unknown action is reset, so that such values are skipped.
+func (s *Settings) ValidateSummaryEnvNonScalar() error {
+	_, err := summaryenv.ParseNonScalarAction(
+		s.Proto.XSummaryEnvNonScalar.GetValue())
+	if err != nil {
+		s.Proto.XSummaryEnvNonScalar = nil
+		return fmt.Errorf("settings: %v", err)
+	}
+	return nil
+}
+
+// The fixed correction to add to timestamps from the local clock.
+func (s *Settings) GetClockOffset() time.Duration {
+	return time.Duration(s.Proto.XClockOffsetSeconds.GetValue() * float64(time.Second))
+}
+
+// The NTP server to measure the clock offset against, if any.
+func (s *Settings) GetClockNTPServer() string {
+	return s.Proto.XClockNtpServer.GetValue()
+}
+
+// The measured clock offset above which to warn about clock skew.
+func (s *Settings) GetClockSkewWarningThreshold() time.Duration {
+	if s.Proto.XClockSkewWarningSeconds == nil {
+		return time.Second
+	}
+	return time.Duration(s.Proto.XClockSkewWarningSeconds.GetValue() * float64(time.Second))
+}
+
+// Whether to serve the health probe endpoint.
+func (s *Settings) IsHealthProbeEnabled() bool {
+	return s.Proto.XHealthProbe.GetValue()
+}
+
+// Whether to skip writing the transaction log.
+func (s *Settings) IsTransactionLogDisabled() bool {
+	return s.Proto.XDisableTransactionLog.GetValue()
+}
+
+// Re-enables the transaction log if it can't be disabled.
+//
+// Offline runs are only saved to the transaction log, so it can't be
+// disabled in offline mode. Returns an error if the setting was rejected.
+func (s *Settings) ValidateTransactionLog() error {
+	if !s.IsTransactionLogDisabled() || !s.IsOffline() {
+		return nil
+	}
+
+	s.Proto.XDisableTransactionLog = wrapperspb.Bool(false)
+	return errors.New(
+		"settings: the transaction log cannot be disabled in offline mode")
+}
+
+// The specification of network faults to inject for testing, if any.
+func (s *Settings) GetFaultInjection() string {
+	return s.Proto.XFaultInjection.GetValue()
+}
+
+// Clears the fault injection setting if it's invalid.
+//
+// Returns an error describing the problem with the specification.
+func (s *Settings) ValidateFaultInjection() error {
+	if _, err := faultinject.Parse(s.GetFaultInjection()); err != nil {
+		s.Proto.XFaultInjection = nil
+		return fmt.Errorf("settings: invalid fault injection: %v", err)
+	}
+	return nil
+}
+
 // The ID of the run.
 func (s *Settings) GetRunID() string {
 	return s.Proto.RunId.GetValue()
@@ -97,6 +288,19 @@ func (s *Settings) GetInternalLogFile() string {
 	return s.Proto.LogInternal.GetValue()
 }
 
+// Size in bytes at which to rotate the internal log, or 0 to never rotate.
+func (s *Settings) GetInternalLogMaxBytes() int64 {
+	return max(0, int64(s.Proto.XInternalLogMaxMegabytes.GetValue())) * 1024 * 1024
+}
+
+// Number of rotated internal logs to keep.
+func (s *Settings) GetInternalLogMaxFiles() int {
+	if s.Proto.XInternalLogMaxFiles == nil {
+		return 5
+	}
+	return max(0, int(s.Proto.XInternalLogMaxFiles.GetValue()))
+}
+
 // The local directory where the run's files are stored.
 func (s *Settings) GetFilesDir() string {
 	return s.Proto.FilesDir.GetValue()
@@ -106,3 +310,163 @@ func (s *Settings) GetFilesDir() string {
 func (s *Settings) GetIgnoreGlobs() []string {
 	return s.Proto.IgnoreGlobs.GetValue()
 }
+
+// Upload policies for files that appear in `files_dir`.
+//
+// Each entry is "<policy>:<glob>"; see runfiles.ParseFilesPolicy.
+func (s *Settings) GetFilesPolicy() []string {
+	return s.Proto.XFilesPolicy.GetValue()
+}
+
+// How long a file in `files_dir` must be unmodified before the files policy
+// uploads it.
+func (s *Settings) GetFilesMinStableAge() time.Duration {
+	seconds := max(0, s.Proto.XFilesMinStableSeconds.GetValue())
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// The maximum wall-clock time for a run.
+//
+// Returns zero if there is no limit.
+func (s *Settings) GetMaxRunDuration() time.Duration {
+	return time.Duration(s.Proto.MaxRunDuration.GetValue() * float64(time.Second))
+}
+
+// The capacity of the queues between a stream's stages.
+//
+// Returns zero if unset, in which case a default should be used.
+func (s *Settings) GetRecordQueueSize() int {
+	return max(0, int(s.Proto.XRecordQueueSize.GetValue()))
+}
+
+// Default timeouts for requests to each kind of endpoint.
+//
+// These match the defaults of the Python settings.
+const (
+	DefaultGraphQLTimeout      = 30 * time.Second
+	DefaultFileStreamTimeout   = 180 * time.Second
+	DefaultFileTransferTimeout = time.Duration(0)
+
+	DefaultFileTransferStallTimeout = time.Duration(0)
+)
+
+// The timeout for a single GraphQL request attempt.
+//
+// Returns zero if there is no timeout.
+func (s *Settings) GetGraphQLTimeout() time.Duration {
+	return timeoutOrDefault(s.Proto.XGraphqlTimeoutSeconds, DefaultGraphQLTimeout)
+}
+
+// The timeout for a single filestream request attempt.
+//
+// Returns zero if there is no timeout.
+func (s *Settings) GetFileStreamTimeout() time.Duration {
+	return timeoutOrDefault(s.Proto.XFileStreamTimeoutSeconds, DefaultFileStreamTimeout)
+}
+
+// The timeout for a single upload or download attempt.
+//
+// Returns zero if there is no timeout.
+func (s *Settings) GetFileTransferTimeout() time.Duration {
+	return timeoutOrDefault(s.Proto.XFileTransferTimeoutSeconds, DefaultFileTransferTimeout)
+}
+
+// How long an upload or download attempt may go without progress.
+//
+// This does not limit the total time of a transfer, so that large files
+// can be uploaded over slow connections. Returns zero if there is no
+// timeout.
+func (s *Settings) GetFileTransferStallTimeout() time.Duration {
+	return timeoutOrDefault(
+		s.Proto.XFileTransferStallTimeoutSeconds,
+		DefaultFileTransferStallTimeout,
+	)
+}
+
+// How many times an upload may request a new URL after its URL expires.
+func (s *Settings) GetFileTransferURLRefreshMax() int {
+	if s.Proto.XFileTransferUrlRefreshMax == nil {
+		return 3
+	}
+	return max(0, int(s.Proto.XFileTransferUrlRefreshMax.GetValue()))
+}
+
+// The maximum number of file transfers in progress at once.
+//
+// Returns zero if unset, in which case a default should be used.
+func (s *Settings) GetFileTransferConcurrency() int {
+	return max(0, int(s.Proto.XFileTransferConcurrency.GetValue()))
+}
+
+// The maximum number of file transfer requests per second.
+//
+// Returns zero if there is no limit.
+func (s *Settings) GetFileTransferRateLimit() float64 {
+	return max(0, s.Proto.XFileTransferRateLimit.GetValue())
+}
+
+// The base URL for GraphQL queries.
+//
+// Defaults to the base URL.
+func (s *Settings) GetGraphQLQueryBaseURL() string {
+	if url := s.Proto.GetXGraphqlQueryBaseUrl().GetValue(); url != "" {
+		return url
+	}
+	return s.Proto.GetBaseUrl().GetValue()
+}
+
+// The base URL for GraphQL mutations and other non-query operations.
+//
+// Defaults to the base URL.
+func (s *Settings) GetGraphQLMutationBaseURL() string {
+	if url := s.Proto.GetXGraphqlMutationBaseUrl().GetValue(); url != "" {
+		return url
+	}
+	return s.Proto.GetBaseUrl().GetValue()
+}
+
+// How long after startup to silently retry requests that fail to connect.
+//
+// Returns zero if there is no warmup period.
+func (s *Settings) GetNetworkWarmup() time.Duration {
+	seconds := s.Proto.GetXNetworkWarmupSeconds().GetValue()
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// The minimum time between summary updates sent to the backend.
+//
+// Returns zero if every update should be sent.
+func (s *Settings) GetSummaryUpdateInterval() time.Duration {
+	if s.Proto.XSummaryUpdateIntervalMs == nil {
+		return 30 * time.Second
+	}
+	ms := max(0, s.Proto.XSummaryUpdateIntervalMs.GetValue())
+	return time.Duration(ms) * time.Millisecond
+}
+
+// The address to serve the health probe on.
+//
+// The default port is 0 so that each run gets a free port.
+func (s *Settings) GetHealthProbeAddr() string {
+	if addr := s.Proto.XHealthProbeAddr.GetValue(); addr != "" {
+		return addr
+	}
+	return "127.0.0.1:0"
+}
+
+// timeoutOrDefault converts a timeout setting in seconds to a duration.
+//
+// Unset timeouts use the default, and non-positive timeouts are disabled.
+func timeoutOrDefault(
+	seconds *wrapperspb.DoubleValue,
+	defaultTimeout time.Duration,
+) time.Duration {
+	if seconds == nil {
+		return defaultTimeout
+	}
+
+	return max(0, time.Duration(seconds.GetValue()*float64(time.Second)))
+}
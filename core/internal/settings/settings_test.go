@@ -0,0 +1,110 @@
+package settings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/summaryenv"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestValidateTransactionLog_Online(t *testing.T) {
+	s := settings.From(&service.Settings{
+		XDisableTransactionLog: wrapperspb.Bool(true),
+	})
+
+	assert.NoError(t, s.ValidateTransactionLog())
+	assert.True(t, s.IsTransactionLogDisabled())
+}
+
+func TestValidateTransactionLog_RejectedOffline(t *testing.T) {
+	s := settings.From(&service.Settings{
+		XDisableTransactionLog: wrapperspb.Bool(true),
+		XOffline:               wrapperspb.Bool(true),
+	})
+
+	assert.ErrorContains(t, s.ValidateTransactionLog(), "offline mode")
+	assert.False(t, s.IsTransactionLogDisabled())
+}
+
+func TestIsAPIKeyFromNetrc(t *testing.T) {
+	netrc := filepath.Join(t.TempDir(), ".netrc")
+	require.NoError(t, os.WriteFile(netrc,
+		[]byte("machine api.wandb.ai\n  login user\n  password netrc-key\n"),
+		0o600))
+	t.Setenv("NETRC", netrc)
+
+	fromNetrc := settings.From(&service.Settings{
+		BaseUrl: wrapperspb.String("https://api.wandb.ai"),
+		ApiKey:  wrapperspb.String("netrc-key"),
+	})
+	assert.True(t, fromNetrc.IsAPIKeyFromNetrc())
+
+	given := settings.From(&service.Settings{
+		BaseUrl: wrapperspb.String("https://api.wandb.ai"),
+		ApiKey:  wrapperspb.String("other-key"),
+	})
+	assert.False(t, given.IsAPIKeyFromNetrc())
+}
+
+func TestGetSummaryUpdateInterval(t *testing.T) {
+	assert.Equal(t,
+		30*time.Second,
+		settings.From(&service.Settings{}).GetSummaryUpdateInterval())
+	assert.Equal(t,
+		250*time.Millisecond,
+		settings.From(&service.Settings{
+			XSummaryUpdateIntervalMs: wrapperspb.Int32(250),
+		}).GetSummaryUpdateInterval())
+	assert.Zero(t,
+		settings.From(&service.Settings{
+			XSummaryUpdateIntervalMs: wrapperspb.Int32(-1),
+		}).GetSummaryUpdateInterval())
+}
+
+func TestGetSummaryEnvOptions(t *testing.T) {
+	assert.Equal(t,
+		summaryenv.Options{Prefix: summaryenv.DefaultPrefix},
+		settings.From(&service.Settings{}).GetSummaryEnvOptions())
+	assert.Equal(t,
+		summaryenv.Options{
+			Prefix:       "",
+			PreserveCase: true,
+			NonScalar:    summaryenv.NonScalarJSON,
+		},
+		settings.From(&service.Settings{
+			XSummaryEnvPrefix:       wrapperspb.String(""),
+			XSummaryEnvPreserveCase: wrapperspb.Bool(true),
+			XSummaryEnvNonScalar:    wrapperspb.String("json"),
+		}).GetSummaryEnvOptions())
+}
+
+func TestValidateSummaryEnvNonScalar(t *testing.T) {
+	invalid := settings.From(&service.Settings{
+		XSummaryEnvNonScalar: wrapperspb.String("xml"),
+	})
+
+	assert.ErrorContains(t, invalid.ValidateSummaryEnvNonScalar(), `"xml"`)
+	assert.Equal(t,
+		summaryenv.NonScalarSkip,
+		invalid.GetSummaryEnvOptions().NonScalar)
+}
+
+func TestGetFileTransferTimeouts(t *testing.T) {
+	s := settings.From(&service.Settings{
+		XFileTransferTimeoutSeconds:      wrapperspb.Double(600),
+		XFileTransferStallTimeoutSeconds: wrapperspb.Double(30),
+	})
+
+	assert.Equal(t, 600*time.Second, s.GetFileTransferTimeout())
+	assert.Equal(t, 30*time.Second, s.GetFileTransferStallTimeout())
+	assert.Zero(t, settings.From(&service.Settings{}).GetFileTransferTimeout())
+	assert.Zero(t,
+		settings.From(&service.Settings{}).GetFileTransferStallTimeout())
+}
@@ -0,0 +1,115 @@
+// Package stepsync coordinates history steps between processes that log to
+// the same run.
+package stepsync
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// stepWidth is the size of the step written to the file.
+//
+// Steps are padded with spaces to a fixed width so that each write
+// replaces the file's whole contents without truncating it. It fits any
+// int64.
+const stepWidth = 20
+
+// Counter is a history step counter shared through a file.
+//
+// Processes on one machine that log to the same run can share a file to
+// give each history row a distinct step, with steps increasing in the order
+// rows are logged. The file holds the last claimed step and is locked while
+// it's updated.
+//
+// The file is opened once and kept open until Close, so that claiming a
+// step only locks, reads and writes it.
+type Counter struct {
+	// mu serializes claims within the process.
+	mu sync.Mutex
+
+	path string
+	file *os.File
+}
+
+// New returns a counter using the file at the path.
+//
+// The file and its directory are created if they don't exist.
+func New(path string) (*Counter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("stepsync: %v", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("stepsync: %v", err)
+	}
+
+	return &Counter{path: path, file: file}, nil
+}
+
+// Close closes the step file.
+//
+// It is safe to call on a nil Counter.
+func (c *Counter) Close() error {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// Claim returns the step to use for a history row.
+//
+// A row keeps its requested step unless that step or a later one was
+// already claimed, in which case it gets the step after the last claimed
+// one. So when two processes log the same step, the first to log it keeps
+// it and the other's row moves to the next free step; rows are never
+// merged or overwritten.
+func (c *Counter) Claim(requested int64) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return 0, fmt.Errorf("stepsync: %s is closed", c.path)
+	}
+
+	if err := lockFile(c.file); err != nil {
+		return 0, fmt.Errorf("stepsync: failed to lock %s: %v", c.path, err)
+	}
+	defer func() { _ = unlockFile(c.file) }()
+
+	buf := make([]byte, stepWidth)
+	n, err := c.file.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return 0, fmt.Errorf("stepsync: %v", err)
+	}
+
+	step := requested
+	if text := strings.TrimSpace(string(buf[:n])); text != "" {
+		last, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("stepsync: invalid step file %s: %v", c.path, err)
+		}
+		step = max(step, last+1)
+	}
+
+	encoded := fmt.Sprintf("%-*d", stepWidth, step)
+	if _, err := c.file.WriteAt([]byte(encoded), 0); err != nil {
+		return 0, fmt.Errorf("stepsync: %v", err)
+	}
+
+	return step, nil
+}
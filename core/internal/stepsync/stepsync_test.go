@@ -0,0 +1,87 @@
+package stepsync_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/stepsync"
+)
+
+func TestClaim_FirstClaimKeepsStep(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.step")
+	process1, err := stepsync.New(path)
+	require.NoError(t, err)
+	defer process1.Close()
+	process2, err := stepsync.New(path)
+	require.NoError(t, err)
+	defer process2.Close()
+
+	claim := func(c *stepsync.Counter, step int64) int64 {
+		claimed, err := c.Claim(step)
+		require.NoError(t, err)
+		return claimed
+	}
+
+	assert.EqualValues(t, 0, claim(process1, 0))
+	assert.EqualValues(t, 1, claim(process2, 0))
+	assert.EqualValues(t, 5, claim(process2, 5))
+	assert.EqualValues(t, 6, claim(process1, 3))
+}
+
+func TestClaim_ConcurrentClaimsAreDistinct(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.step")
+	var wg sync.WaitGroup
+	steps := make(chan int64, 100)
+
+	for i := 0; i < 4; i++ {
+		counter, err := stepsync.New(path)
+		require.NoError(t, err)
+		defer counter.Close()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 25; j++ {
+				step, err := counter.Claim(0)
+				assert.NoError(t, err)
+				steps <- step
+			}
+		}()
+	}
+	wg.Wait()
+	close(steps)
+
+	seen := make(map[int64]bool)
+	for step := range steps {
+		assert.False(t, seen[step], "step %d claimed twice", step)
+		seen[step] = true
+	}
+	assert.Len(t, seen, 100)
+}
+
+func TestClaim_ContinuesFromExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.step")
+	require.NoError(t, os.WriteFile(path, []byte("41\n"), 0o644))
+	counter, err := stepsync.New(path)
+	require.NoError(t, err)
+	defer counter.Close()
+
+	step, err := counter.Claim(0)
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 42, step)
+}
+
+func TestClaim_FailsAfterClose(t *testing.T) {
+	counter, err := stepsync.New(filepath.Join(t.TempDir(), "run.step"))
+	require.NoError(t, err)
+	require.NoError(t, counter.Close())
+
+	_, err = counter.Claim(0)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,193 @@
+// Package summaryenv exports a run's summary as an env file.
+//
+// Each summary value is written as a KEY='value' line, so that later steps
+// of a shell-based CI pipeline can load the run's final metrics as
+// environment variables by sourcing the file.
+package summaryenv
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	json "github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// DefaultPrefix is prepended to variable names if no prefix is configured.
+const DefaultPrefix = "WANDB_SUMMARY_"
+
+// NonScalarAction is what to do with values that aren't numbers, booleans
+// or single-line strings.
+type NonScalarAction int
+
+const (
+	// NonScalarSkip leaves the value out of the file.
+	NonScalarSkip NonScalarAction = iota
+
+	// NonScalarJSON writes the value as JSON.
+	NonScalarJSON
+)
+
+// ParseNonScalarAction parses "skip" or "json".
+//
+// The empty string is NonScalarSkip.
+func ParseNonScalarAction(action string) (NonScalarAction, error) {
+	switch action {
+	case "", "skip":
+		return NonScalarSkip, nil
+	case "json":
+		return NonScalarJSON, nil
+	default:
+		return NonScalarSkip, fmt.Errorf(
+			"summaryenv: unknown action %q for non-scalar values,"+
+				` expected "skip" or "json"`, action)
+	}
+}
+
+// Options configures how the summary is exported.
+type Options struct {
+	// Prefix is prepended to each variable name.
+	Prefix string
+
+	// PreserveCase keeps the case of summary keys in variable names
+	// instead of making them uppercase.
+	PreserveCase bool
+
+	// NonScalar is what to do with values that can't be written as is.
+	NonScalar NonScalarAction
+}
+
+// VariableName returns the environment variable name for a summary key.
+//
+// The parts of a nested key are joined by underscores, characters other
+// than ASCII letters, digits and underscores are replaced by underscores,
+// and an underscore is added before a leading digit.
+func VariableName(path []string, opts Options) string {
+	var name strings.Builder
+	name.WriteString(opts.Prefix)
+
+	for i, part := range path {
+		if i > 0 {
+			name.WriteByte('_')
+		}
+
+		for _, r := range part {
+			switch {
+			case r >= 'a' && r <= 'z' && !opts.PreserveCase:
+				name.WriteRune(r - 'a' + 'A')
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z',
+				r >= '0' && r <= '9', r == '_':
+				name.WriteRune(r)
+			default:
+				name.WriteByte('_')
+			}
+		}
+	}
+
+	if name.Len() == 0 {
+		return "_"
+	}
+	if first := name.String()[0]; first >= '0' && first <= '9' {
+		return "_" + name.String()
+	}
+	return name.String()
+}
+
+// Format returns the env file contents for the summary items.
+//
+// Lines are sorted by variable name. If several keys map to the same
+// variable name, only the value of the smallest key is written.
+func Format(items []*service.SummaryItem, opts Options) []byte {
+	type variable struct {
+		path  []string
+		name  string
+		value string
+	}
+
+	variables := make([]variable, 0, len(items))
+	for _, item := range items {
+		path := item.GetNestedKey()
+		if len(path) == 0 {
+			path = []string{item.GetKey()}
+		}
+
+		value, ok := formatValue(item.GetValueJson(), opts.NonScalar)
+		if !ok {
+			continue
+		}
+
+		variables = append(variables, variable{
+			path:  path,
+			name:  VariableName(path, opts),
+			value: value,
+		})
+	}
+
+	slices.SortFunc(variables, func(a, b variable) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		return slices.Compare(a.path, b.path)
+	})
+
+	var buf bytes.Buffer
+	for i, v := range variables {
+		if i > 0 && variables[i-1].name == v.name {
+			continue
+		}
+		fmt.Fprintf(&buf, "%s=%s\n", v.name, shellQuote(v.value))
+	}
+	return buf.Bytes()
+}
+
+// Write writes the env file for the summary items to the path.
+func Write(path string, items []*service.SummaryItem, opts Options) error {
+	return os.WriteFile(path, Format(items, opts), 0o644)
+}
+
+// shellQuote single-quotes the value for a POSIX shell.
+//
+// Nothing is special inside single quotes, so a single quote in the value
+// is written by closing the quotes, adding an escaped quote and reopening
+// them.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// formatValue returns the env file representation of a JSON value.
+//
+// Returns false if the value should be skipped.
+func formatValue(valueJSON string, nonScalar NonScalarAction) (string, bool) {
+	value, err := json.Unmarshal([]byte(valueJSON))
+	if err != nil {
+		return "", false
+	}
+
+	switch x := value.(type) {
+	case string:
+		if !strings.ContainsAny(x, "\r\n\x00") {
+			return x, true
+		}
+	case int64, float64, bool:
+		encoded, err := json.Marshal(x)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	}
+
+	if nonScalar != NonScalarJSON {
+		return "", false
+	}
+
+	// Strings are re-encoded so that the value is on one line.
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
@@ -0,0 +1,127 @@
+package summaryenv_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/summaryenv"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func testSummary() []*service.SummaryItem {
+	return []*service.SummaryItem{
+		{Key: "val/acc", ValueJson: "0.9"},
+		{Key: "epoch", ValueJson: "10"},
+		{Key: "done", ValueJson: "true"},
+		{Key: "model", ValueJson: `"resnet-50"`},
+		{Key: "notes", ValueJson: `"line 1\nline 2"`},
+		{Key: "scores", ValueJson: "[1, 2]"},
+		{NestedKey: []string{"eval", "f1"}, ValueJson: "0.5"},
+		{Key: "1st", ValueJson: "1"},
+	}
+}
+
+func TestFormat_SkipsNonScalars(t *testing.T) {
+	result := summaryenv.Format(testSummary(), summaryenv.Options{Prefix: "RUN_"})
+
+	assert.Equal(t,
+		"RUN_1ST='1'\n"+
+			"RUN_DONE='true'\n"+
+			"RUN_EPOCH='10'\n"+
+			"RUN_EVAL_F1='0.5'\n"+
+			"RUN_MODEL='resnet-50'\n"+
+			"RUN_VAL_ACC='0.9'\n",
+		string(result))
+}
+
+func TestFormat_EncodesNonScalarsAsJSON(t *testing.T) {
+	result := summaryenv.Format(
+		[]*service.SummaryItem{
+			{Key: "notes", ValueJson: `"line 1\nline 2"`},
+			{Key: "scores", ValueJson: "[1, 2]"},
+		},
+		summaryenv.Options{NonScalar: summaryenv.NonScalarJSON},
+	)
+
+	assert.Equal(t,
+		`NOTES='"line 1\nline 2"'`+"\n"+
+			"SCORES='[1,2]'\n",
+		string(result))
+}
+
+func TestFormat_DuplicateNames(t *testing.T) {
+	result := summaryenv.Format(
+		[]*service.SummaryItem{
+			{Key: "a_b", ValueJson: "2"},
+			{Key: "a.b", ValueJson: "1"},
+		},
+		summaryenv.Options{},
+	)
+
+	assert.Equal(t, "A_B='1'\n", string(result))
+}
+
+func TestFormat_QuotesValues(t *testing.T) {
+	result := summaryenv.Format(
+		[]*service.SummaryItem{
+			{Key: "cmd", ValueJson: `"echo $HOME; rm -rf x"`},
+			{Key: "quote", ValueJson: `"it's"`},
+		},
+		summaryenv.Options{},
+	)
+
+	assert.Equal(t,
+		"CMD='echo $HOME; rm -rf x'\n"+
+			"QUOTE='it'\\''s'\n",
+		string(result))
+}
+
+func TestVariableName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     []string
+		opts     summaryenv.Options
+		expected string
+	}{
+		{"uppercase", []string{"loss"}, summaryenv.Options{}, "LOSS"},
+		{"preserve case", []string{"Loss"},
+			summaryenv.Options{PreserveCase: true}, "Loss"},
+		{"nested", []string{"a", "b-c"}, summaryenv.Options{}, "A_B_C"},
+		{"leading digit", []string{"1x"}, summaryenv.Options{}, "_1X"},
+		{"prefix", []string{"1x"},
+			summaryenv.Options{Prefix: "P_"}, "P_1X"},
+		{"non-ASCII", []string{"μ"}, summaryenv.Options{}, "_"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, summaryenv.VariableName(tc.path, tc.opts))
+		})
+	}
+}
+
+func TestParseNonScalarAction(t *testing.T) {
+	action, err := summaryenv.ParseNonScalarAction("json")
+	require.NoError(t, err)
+	assert.Equal(t, summaryenv.NonScalarJSON, action)
+
+	_, err = summaryenv.ParseNonScalarAction("xml")
+	assert.Error(t, err)
+}
+
+func TestWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.env")
+
+	err := summaryenv.Write(path,
+		[]*service.SummaryItem{{Key: "loss", ValueJson: "0.25"}},
+		summaryenv.Options{Prefix: summaryenv.DefaultPrefix})
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "WANDB_SUMMARY_LOSS='0.25'\n", string(content))
+}
@@ -3,6 +3,9 @@ package timer
 import "time"
 
 // Timer is used to track the run start and execution times
+//
+// Elapsed times are measured with the monotonic clock, so they are not
+// affected by adjustments to the system clock after the timer starts.
 type Timer struct {
 	startTime   time.Time
 	resumeTime  time.Time
@@ -19,13 +22,22 @@ func (t *Timer) GetStartTimeMicro() float64 {
 	return float64(t.startTime.UnixMicro()) / 1e6
 }
 
+// Start starts the timer.
+//
+// If startTime is given, the time since then counts as elapsed. It is
+// usually a wall-clock time from another process, so it's converted to an
+// offset once and the monotonic clock is used from then on.
 func (t *Timer) Start(startTime *time.Time) {
+	now := time.Now()
+	t.startTime = now
+	t.resumeTime = now
+	t.accumulated = 0
+
 	if startTime != nil {
 		t.startTime = *startTime
-	} else {
-		t.startTime = time.Now()
+		t.accumulated = max(0, now.Sub(*startTime))
 	}
-	t.resumeTime = t.startTime
+
 	t.isStarted = true
 }
 
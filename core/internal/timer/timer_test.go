@@ -0,0 +1,29 @@
+package timer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/timer"
+)
+
+func TestElapsed_IncludesTimeSinceStart(t *testing.T) {
+	timer := timer.New()
+	startTime := time.Now().Add(-time.Hour)
+
+	timer.Start(&startTime)
+
+	assert.GreaterOrEqual(t, timer.Elapsed(), time.Hour)
+	assert.Less(t, timer.Elapsed(), time.Hour+time.Minute)
+}
+
+func TestElapsed_FutureStartIsZero(t *testing.T) {
+	timer := timer.New()
+	startTime := time.Now().Add(time.Hour)
+
+	timer.Start(&startTime)
+	timer.Pause()
+
+	assert.Less(t, timer.Elapsed(), time.Minute)
+}
@@ -0,0 +1,201 @@
+// Package valuelimit limits the size of individual history and summary
+// values.
+package valuelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"unicode/utf8"
+)
+
+// Action is what to do with a value that is over the size limit.
+type Action int
+
+const (
+	// Reject drops the value.
+	Reject Action = iota
+
+	// Truncate replaces the value by a string containing its beginning.
+	Truncate
+
+	// Offload saves the value to a file and replaces it by a reference to
+	// the file.
+	Offload
+)
+
+// OffloadedValueType is the "_type" of the reference to an offloaded value.
+const OffloadedValueType = "oversized-value"
+
+// OffloadDir is the subdirectory of the files directory that offloaded
+// values are saved to.
+const OffloadDir = "oversized"
+
+// truncationMarker is appended to truncated values.
+const truncationMarker = "...[truncated %d bytes]"
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9_\-.]`)
+
+// ParseAction parses the `_oversized_value_action` setting.
+//
+// The empty string means Reject.
+func ParseAction(s string) (Action, error) {
+	switch s {
+	case "", "reject":
+		return Reject, nil
+	case "truncate":
+		return Truncate, nil
+	case "offload":
+		return Offload, nil
+	default:
+		return Reject, fmt.Errorf("valuelimit: unknown action %q", s)
+	}
+}
+
+// Limiter enforces a maximum size for individual values.
+//
+// A nil Limiter allows everything.
+type Limiter struct {
+	// maxBytes is the largest allowed size of a value's JSON encoding.
+	maxBytes int
+
+	// action is what to do with values over the limit.
+	action Action
+
+	// filesDir is the run's files directory, used by Offload.
+	filesDir string
+}
+
+// Result describes what happened to an oversized value.
+type Result struct {
+	// ValueJSON is the replacement value, or empty if the value was
+	// rejected.
+	ValueJSON string
+
+	// OffloadPath is the path of the file the value was saved to,
+	// relative to the files directory, if it was offloaded.
+	OffloadPath string
+}
+
+// New returns a Limiter for values larger than maxBytes.
+//
+// Returns nil if maxBytes is not positive, which means there is no limit.
+func New(maxBytes int, action Action, filesDir string) *Limiter {
+	if maxBytes <= 0 {
+		return nil
+	}
+
+	return &Limiter{
+		maxBytes: maxBytes,
+		action:   action,
+		filesDir: filesDir,
+	}
+}
+
+// MaxBytes is the size limit.
+func (l *Limiter) MaxBytes() int {
+	return l.maxBytes
+}
+
+// IsOversized reports whether a JSON-encoded value is over the limit.
+func (l *Limiter) IsOversized(valueJSON string) bool {
+	return l != nil && len(valueJSON) > l.maxBytes
+}
+
+// Apply handles an oversized value for the given key.
+//
+// Returns an error describing the problem if the value is rejected or
+// could not be handled, in which case it should be dropped.
+func (l *Limiter) Apply(key string, valueJSON string) (Result, error) {
+	tooLarge := fmt.Errorf(
+		"valuelimit: value of %q is %d bytes, over the limit of %d bytes",
+		key, len(valueJSON), l.maxBytes,
+	)
+
+	switch l.action {
+	case Truncate:
+		return Result{ValueJSON: l.truncate(valueJSON)}, nil
+
+	case Offload:
+		path, err := l.offload(key, valueJSON)
+		if err != nil {
+			return Result{}, fmt.Errorf("%v; failed to save it to a file: %v", tooLarge, err)
+		}
+
+		reference, err := json.Marshal(map[string]any{
+			"_type":  OffloadedValueType,
+			"path":   filepath.ToSlash(path),
+			"size":   len(valueJSON),
+			"sha256": sha256Hex(valueJSON),
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		return Result{ValueJSON: string(reference), OffloadPath: path}, nil
+
+	default:
+		return Result{}, tooLarge
+	}
+}
+
+// truncate returns a JSON string with the beginning of the value.
+//
+// If the value is a string, its contents are kept; otherwise, its JSON
+// encoding is. The result fits in the limit.
+func (l *Limiter) truncate(valueJSON string) string {
+	text := valueJSON
+	var s string
+	if err := json.Unmarshal([]byte(valueJSON), &s); err == nil {
+		text = s
+	}
+
+	// Shrink until the encoded result fits, since encoding may escape
+	// characters. Each step keeps at least one fewer byte.
+	keep := min(len(text), l.maxBytes)
+	for {
+		for keep > 0 && keep < len(text) && !utf8.RuneStart(text[keep]) {
+			keep--
+		}
+
+		truncated := text[:keep] + fmt.Sprintf(truncationMarker, len(text)-keep)
+		encoded, _ := json.Marshal(truncated)
+		if len(encoded) <= l.maxBytes || keep == 0 {
+			return string(encoded)
+		}
+
+		keep -= max(1, len(encoded)-l.maxBytes)
+		keep = max(0, keep)
+	}
+}
+
+// offload writes the value to a file in the files directory.
+//
+// Returns the path relative to the files directory.
+func (l *Limiter) offload(key string, valueJSON string) (string, error) {
+	digest := sha256Hex(valueJSON)
+	name := fmt.Sprintf(
+		"%s_%s.json",
+		unsafeFileNameChars.ReplaceAllString(key, "_"),
+		digest[:16],
+	)
+	path := filepath.Join(OffloadDir, name)
+
+	absPath := filepath.Join(l.filesDir, path)
+	if err := os.MkdirAll(filepath.Dir(absPath), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(absPath, []byte(valueJSON), 0o644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
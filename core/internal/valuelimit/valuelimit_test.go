@@ -0,0 +1,79 @@
+package valuelimit_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/valuelimit"
+)
+
+func TestNilLimiterAllowsEverything(t *testing.T) {
+	limiter := valuelimit.New(0, valuelimit.Reject, "")
+
+	assert.Nil(t, limiter)
+	assert.False(t, limiter.IsOversized(strings.Repeat("x", 1000)))
+}
+
+func TestReject(t *testing.T) {
+	limiter := valuelimit.New(5, valuelimit.Reject, "")
+
+	_, err := limiter.Apply("key", `"too long"`)
+
+	assert.ErrorContains(t, err, `"key" is 10 bytes, over the limit of 5 bytes`)
+}
+
+func TestTruncate_String(t *testing.T) {
+	limiter := valuelimit.New(40, valuelimit.Truncate, "")
+	value, _ := json.Marshal(strings.Repeat("é", 100))
+
+	result, err := limiter.Apply("key", string(value))
+
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(result.ValueJSON), 40)
+	var s string
+	require.NoError(t, json.Unmarshal([]byte(result.ValueJSON), &s))
+	assert.True(t, strings.HasPrefix(s, "éé"))
+	assert.Contains(t, s, "...[truncated")
+}
+
+func TestTruncate_NonString(t *testing.T) {
+	limiter := valuelimit.New(40, valuelimit.Truncate, "")
+
+	result, err := limiter.Apply("key", `[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13]`)
+
+	require.NoError(t, err)
+	var s string
+	require.NoError(t, json.Unmarshal([]byte(result.ValueJSON), &s))
+	assert.True(t, strings.HasPrefix(s, "[1, 2"))
+}
+
+func TestOffload(t *testing.T) {
+	filesDir := t.TempDir()
+	limiter := valuelimit.New(5, valuelimit.Offload, filesDir)
+
+	result, err := limiter.Apply("a/b", `"too long"`)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result.OffloadPath, valuelimit.OffloadDir))
+	content, err := os.ReadFile(filepath.Join(filesDir, result.OffloadPath))
+	require.NoError(t, err)
+	assert.Equal(t, `"too long"`, string(content))
+	var reference map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.ValueJSON), &reference))
+	assert.Equal(t, valuelimit.OffloadedValueType, reference["_type"])
+	assert.Equal(t, filepath.ToSlash(result.OffloadPath), reference["path"])
+}
+
+func TestParseAction(t *testing.T) {
+	action, err := valuelimit.ParseAction("offload")
+	require.NoError(t, err)
+	assert.Equal(t, valuelimit.Offload, action)
+
+	_, err = valuelimit.ParseAction("explode")
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package watcher
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// appendScanChunkBytes is how much of a file is read at a time when
+// looking for the end of its last complete line.
+const appendScanChunkBytes = 4096
+
+// AppendRange is a range of bytes appended to a file.
+type AppendRange struct {
+	// Start is the offset of the first appended byte.
+	Start int64
+
+	// End is the offset just past the last appended byte.
+	End int64
+
+	// Truncated is whether the file was truncated since the last reported
+	// range, in which case Start is 0 and earlier offsets are invalid.
+	Truncated bool
+}
+
+// appendWatch tracks how much of an append-only file was reported.
+//
+// It is only used from the goroutine that dispatches events, so it needs
+// no locking.
+type appendWatch struct {
+	path     string
+	onAppend func(AppendRange)
+	onError  func(error)
+
+	// offset is the end of the last reported range.
+	offset int64
+
+	// truncated is whether the file was truncated since the last
+	// reported range.
+	truncated bool
+}
+
+func (w *watcher) WatchAppends(path string, onAppend func(AppendRange)) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	watch := &appendWatch{
+		path:     path,
+		onAppend: onAppend,
+		offset:   info.Size(),
+		onError: func(err error) {
+			if w.logger != nil {
+				w.logger.CaptureError("watcher: failed to check appends", err)
+			}
+		},
+	}
+	return w.watchFileOrDir(path, func(string) { watch.check() })
+}
+
+// check reports the complete lines appended since the last check.
+func (a *appendWatch) check() {
+	appended, ok, err := a.next()
+	if err != nil {
+		a.onError(err)
+		return
+	}
+
+	if ok {
+		a.onAppend(appended)
+	}
+}
+
+// next returns the complete lines appended since the last reported range
+// and advances past them.
+//
+// It returns false if no complete line was appended. A file smaller than
+// the current offset is assumed to have been truncated.
+func (a *appendWatch) next() (AppendRange, bool, error) {
+	file, err := os.Open(a.path)
+	if err != nil {
+		return AppendRange{}, false, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return AppendRange{}, false, err
+	}
+
+	if info.Size() < a.offset {
+		a.offset = 0
+		a.truncated = true
+	}
+
+	end, err := lastLineEnd(file, a.offset, info.Size())
+	if err != nil {
+		return AppendRange{}, false, fmt.Errorf("reading %s: %v", a.path, err)
+	}
+	if end <= a.offset {
+		return AppendRange{}, false, nil
+	}
+
+	appended := AppendRange{Start: a.offset, End: end, Truncated: a.truncated}
+	a.offset = end
+	a.truncated = false
+	return appended, true, nil
+}
+
+// lastLineEnd returns the offset just past the last newline in the file
+// between start and end, or start if there is none.
+func lastLineEnd(file io.ReaderAt, start, end int64) (int64, error) {
+	buf := make([]byte, appendScanChunkBytes)
+
+	for end > start {
+		chunk := buf[:min(int64(len(buf)), end-start)]
+		chunkStart := end - int64(len(chunk))
+
+		if _, err := file.ReadAt(chunk, chunkStart); err != nil {
+			return start, err
+		}
+
+		if i := bytes.LastIndexByte(chunk, '\n'); i >= 0 {
+			return chunkStart + int64(i) + 1, nil
+		}
+
+		end = chunkStart
+	}
+
+	return start, nil
+}
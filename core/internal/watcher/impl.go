@@ -3,7 +3,10 @@ package watcher
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +23,20 @@ type watcher struct {
 	handlers   map[string]func(string)
 	isFinished bool
 
+	// trees are the directories watched with WatchTree by absolute path.
+	trees map[string]*treeWatch
+
+	// treeOrder is the trees in the order they were added.
+	//
+	// Callbacks are invoked in this order starting at nextTree, which
+	// rotates so that no tree consistently waits for the others.
+	treeOrder []*treeWatch
+	nextTree  int
+
+	// flushTimer invokes the pending tree callbacks; it is nil if none
+	// are pending. A single timer is shared by all trees.
+	flushTimer *time.Timer
+
 	pollingPeriod time.Duration
 }
 
@@ -32,6 +49,7 @@ func newWatcher(params Params) *watcher {
 		logger:   params.Logger,
 		wg:       &sync.WaitGroup{},
 		handlers: make(map[string]func(string)),
+		trees:    make(map[string]*treeWatch),
 
 		pollingPeriod: params.PollingPeriod,
 	}
@@ -45,20 +63,106 @@ func (w *watcher) WatchDir(path string, onChange func(string)) error {
 	return w.watchFileOrDir(path, onChange)
 }
 
-func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
+func (w *watcher) WatchDirRecursive(path string, onChange func(string)) error {
+	return w.watchTree(path, func(tree *treeWatch) {
+		tree.onFile = onChange
+	})
+}
+
+func (w *watcher) WatchTree(path string, onChange func([]string)) error {
+	return w.watchTree(path, func(tree *treeWatch) {
+		tree.onChange = onChange
+	})
+}
+
+func (w *watcher) SetTreeOptions(path string, opts TreeOptions) error {
+	return w.watchTree(path, func(tree *treeWatch) {
+		tree.minStableAge = opts.MinStableAge
+	})
+}
+
+func (w *watcher) WatchTreeRenames(
+	path string,
+	onRename func(oldPath, newPath string),
+) error {
+	return w.watchTree(path, func(tree *treeWatch) {
+		tree.onRename = onRename
+	})
+}
+
+// watchTree starts watching the directory recursively if it isn't yet
+// and sets its callbacks using `configure`.
+func (w *watcher) watchTree(path string, configure func(*treeWatch)) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
 	w.Lock()
 	defer w.Unlock()
 
-	if w.isFinished {
-		return fmt.Errorf("watcher: tried to call Watch() after Finish()")
+	if err := w.ensureStartedLocked(); err != nil {
+		return err
 	}
 
-	if w.delegate == nil {
-		if err := w.startWatcher(); err != nil {
-			return err
+	if err := w.delegate.AddRecursive(path); err != nil {
+		return err
+	}
+
+	if tree := w.trees[absPath]; tree != nil {
+		configure(tree)
+		return nil
+	}
+
+	tree := &treeWatch{
+		path:         path,
+		changed:      make(map[string]struct{}),
+		changedFiles: make(map[string]struct{}),
+		unstable:     make(map[string]string),
+		removed:      make(map[string]removedFile),
+	}
+	configure(tree)
+	w.trees[absPath] = tree
+	w.treeOrder = append(w.treeOrder, tree)
+
+	return nil
+}
+
+func (w *watcher) TreeStats() map[string]TreeStats {
+	w.Lock()
+	defer w.Unlock()
+
+	stats := make(map[string]TreeStats, len(w.trees))
+	files := make(map[*treeWatch]int, len(w.trees))
+
+	if w.delegate != nil && len(w.trees) > 0 {
+		for path, info := range w.delegate.WatchedFiles() {
+			if info.IsDir() {
+				continue
+			}
+			w.forEachTreeLocked(path, func(tree *treeWatch, _ string) {
+				files[tree]++
+			})
 		}
 	}
 
+	for _, tree := range w.treeOrder {
+		treeStats := tree.stats
+		treeStats.Files = files[tree]
+		stats[tree.path] = treeStats
+	}
+
+	return stats
+}
+
+func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if err := w.ensureStartedLocked(); err != nil {
+		return err
+	}
+
 	if err := w.delegate.Add(path); err != nil {
 		return err
 	}
@@ -67,12 +171,41 @@ func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
 	return nil
 }
 
+// ensureStartedLocked starts polling if it hasn't started yet.
+//
+// It fails after Finish. The mutex must be held.
+func (w *watcher) ensureStartedLocked() error {
+	if w.isFinished {
+		return fmt.Errorf("watcher: tried to call Watch() after Finish()")
+	}
+
+	if w.delegate == nil {
+		return w.startWatcher()
+	}
+
+	return nil
+}
+
 func (w *watcher) Finish() {
 	var delegate *poller.Watcher
 
 	w.Lock()
+	if w.isFinished {
+		// The delegate may only be closed once.
+		w.Unlock()
+		w.wg.Wait()
+		return
+	}
 	w.isFinished = true
 	delegate = w.delegate
+	if w.flushTimer != nil {
+		w.flushTimer.Stop()
+	}
+	for _, tree := range w.treeOrder {
+		if tree.stableTimer != nil {
+			tree.stableTimer.Stop()
+		}
+	}
 	w.Unlock()
 
 	if delegate != nil {
@@ -89,13 +222,29 @@ func (w *watcher) startWatcher() error {
 	}
 
 	w.delegate = poller.New()
+	//
+	// Rename and Move events are emitted instead of a Remove and a Create
+	// when a removed file has the same identity (the inode on Unix) as a
+	// created one.
+	//
+	// If a poll lists a directory while a file in it is being renamed, the
+	// file is missing under both names, and the Remove and the Create come
+	// in different polls. Remove events are kept so that trees can match
+	// them up; see onRemove.
+	//
 	// NOTE: The "radovskyb/watcher" dependency has a bug where it sometimes
 	// emits 'Create' events for files that already exist because of a race
 	// condition between Add() and the polling loop in Start().
 	//
 	// In other words, we cannot distinguish between Write and Create events,
 	// which is why that's not part of this package's public interface.
-	w.delegate.FilterOps(poller.Write, poller.Create)
+	w.delegate.FilterOps(
+		poller.Write,
+		poller.Create,
+		poller.Rename,
+		poller.Move,
+		poller.Remove,
+	)
 
 	grp, ctx := errgroup.WithContext(context.Background())
 	w.wg.Add(2)
@@ -151,12 +300,13 @@ func (w *watcher) loopWatchFiles(ctx context.Context) {
 				continue
 			}
 
-			if event.Op != poller.Write && event.Op != poller.Create {
-				continue
+			switch event.Op {
+			case poller.Write, poller.Create, poller.Rename, poller.Move:
+				w.onChange(event)
+			case poller.Remove:
+				w.onRemove(event)
 			}
 
-			w.onChange(event)
-
 		case err := <-w.delegate.Error:
 			w.logger.CaptureError(
 				"watcher: error in file watcher",
@@ -172,12 +322,35 @@ func (w *watcher) loopWatchFiles(ctx context.Context) {
 	}
 }
 
+// onChange dispatches an event for a written, created or renamed file.
+//
+// Renames are reported to trees that watch for them and contain both
+// paths. Everywhere else, a renamed file is treated as newly created.
 func (w *watcher) onChange(evt poller.Event) {
 	w.Lock()
 	handler := w.handlers[evt.Path]
 	parentHandler := w.handlers[filepath.Dir(evt.Path)]
+	var renamedIn []*treeWatch
+	switch evt.Op {
+	case poller.Rename, poller.Move:
+		renamedIn = w.recordTreeRenameLocked(evt.OldPath, evt.Path)
+	case poller.Create:
+		if oldPath := w.matchTreeRemovalLocked(evt); oldPath != "" {
+			evt.OldPath = oldPath
+			renamedIn = w.recordTreeRenameLocked(oldPath, evt.Path)
+		}
+	}
+	w.recordTreeChangeLocked(evt.Path, evt.ModTime(), renamedIn)
+	onRenames := make([]func(string, string), len(renamedIn))
+	for i, tree := range renamedIn {
+		onRenames[i] = tree.onRename
+	}
 	w.Unlock()
 
+	for _, onRename := range onRenames {
+		onRename(evt.OldPath, evt.Path)
+	}
+
 	if handler != nil {
 		handler(evt.Path)
 	} else if parentHandler != nil {
@@ -187,3 +360,342 @@ func (w *watcher) onChange(evt poller.Event) {
 	// This shouldn't happen since we don't remove handlers,
 	// but we should fail gracefully just in case.
 }
+
+// onRemove remembers a removed file in the trees that report renames,
+// in case the file reappears under a new name in the next poll.
+func (w *watcher) onRemove(evt poller.Event) {
+	w.Lock()
+	defer w.Unlock()
+
+	now := time.Now()
+	w.forEachTreeLocked(evt.Path, func(tree *treeWatch, _ string) {
+		if tree.onRename == nil {
+			return
+		}
+
+		w.pruneTreeRemovalsLocked(tree, now)
+		tree.removed[evt.Path] = removedFile{info: evt.FileInfo, time: now}
+	})
+}
+
+// matchTreeRemovalLocked returns the path of a recently removed file that
+// is the same file as the created one, or "" if there is none.
+//
+// The match is forgotten in every tree. The mutex must be held.
+func (w *watcher) matchTreeRemovalLocked(evt poller.Event) string {
+	var oldPath string
+
+	now := time.Now()
+	w.forEachTreeLocked(evt.Path, func(tree *treeWatch, _ string) {
+		if tree.onRename == nil {
+			return
+		}
+
+		w.pruneTreeRemovalsLocked(tree, now)
+		for path, removed := range tree.removed {
+			// A file can also seem to disappear for a poll and come back.
+			if path != evt.Path && os.SameFile(removed.info, evt.FileInfo) {
+				oldPath = path
+				break
+			}
+		}
+	})
+
+	if oldPath != "" {
+		w.forEachTreeLocked(oldPath, func(tree *treeWatch, _ string) {
+			delete(tree.removed, oldPath)
+		})
+	}
+
+	return oldPath
+}
+
+// pruneTreeRemovalsLocked forgets removed files that are too old to be
+// half of a rename.
+//
+// The two halves of a rename are one poll apart, so a removal older than a
+// few polling periods is a real removal. The mutex must be held.
+func (w *watcher) pruneTreeRemovalsLocked(tree *treeWatch, now time.Time) {
+	for path, removed := range tree.removed {
+		if now.Sub(removed.time) > 3*w.pollingPeriod {
+			delete(tree.removed, path)
+		}
+	}
+}
+
+// treeWatch coalesces changes in a recursively watched directory.
+type treeWatch struct {
+	// path is the directory as given to WatchTree.
+	path string
+
+	// onChange, onFile and onRename are the tree's callbacks; any may
+	// be nil.
+	onChange func([]string)
+	onFile   func(string)
+	onRename func(oldPath, newPath string)
+
+	// changed are the top-level subdirectories changed since the last
+	// callback.
+	changed map[string]struct{}
+
+	// changedFiles are the files changed since the last callback; they
+	// are only recorded if onFile is set.
+	changedFiles map[string]struct{}
+
+	// minStableAge is how long a file's mtime must be unchanged before
+	// its change is reported.
+	minStableAge time.Duration
+
+	// unstable are changed files that were modified too recently to
+	// report, mapped to the top-level subdirectory to report them in.
+	unstable map[string]string
+
+	// stableTimer checks whether unstable files have become stable; it is
+	// nil if no check is scheduled.
+	stableTimer *time.Timer
+
+	// removed are recently removed files by path, kept to detect renames
+	// whose halves were reported in different polls.
+	removed map[string]removedFile
+
+	stats TreeStats
+}
+
+// removedFile is a file that was removed from a tree.
+type removedFile struct {
+	info os.FileInfo
+	time time.Time
+}
+
+// recordTreeChangeLocked notes a change for the trees containing the path,
+// other than those in `except`.
+//
+// The mutex must be held.
+func (w *watcher) recordTreeChangeLocked(
+	path string,
+	modTime time.Time,
+	except []*treeWatch,
+) {
+	w.forEachTreeLocked(path, func(tree *treeWatch, rel string) {
+		if (tree.onChange == nil && tree.onFile == nil) ||
+			slices.Contains(except, tree) {
+			return
+		}
+
+		top, _, found := strings.Cut(rel, string(filepath.Separator))
+		if !found {
+			top = "."
+		}
+		tree.stats.Changes++
+		tree.stats.LastChange = time.Now()
+
+		wait := time.Until(modTime.Add(tree.minStableAge))
+		if tree.minStableAge > 0 && wait > 0 {
+			tree.unstable[path] = top
+			if tree.stableTimer == nil {
+				tree.stableTimer = time.AfterFunc(wait, func() {
+					w.checkStable(tree)
+				})
+			}
+			return
+		}
+
+		delete(tree.unstable, path)
+		w.markTreeChangedLocked(tree, path, top)
+	})
+}
+
+// markTreeChangedLocked schedules a callback for the tree reporting the
+// changed file and its top-level subdirectory.
+//
+// The mutex must be held.
+func (w *watcher) markTreeChangedLocked(tree *treeWatch, path, top string) {
+	tree.changed[top] = struct{}{}
+	if tree.onFile != nil {
+		tree.changedFiles[path] = struct{}{}
+	}
+
+	// Events from one poll arrive together, so waiting for a polling
+	// period collects all of them into one callback.
+	if w.flushTimer == nil {
+		w.flushTimer = time.AfterFunc(w.pollingPeriod, w.flushTrees)
+	}
+}
+
+// checkStable reports the tree's unstable files whose mtime hasn't changed
+// for long enough, and schedules another check for the rest.
+func (w *watcher) checkStable(tree *treeWatch) {
+	w.Lock()
+	tree.stableTimer = nil
+	if w.isFinished {
+		w.Unlock()
+		return
+	}
+	paths := make([]string, 0, len(tree.unstable))
+	for path := range tree.unstable {
+		paths = append(paths, path)
+	}
+	w.Unlock()
+
+	// Stat files without holding the lock, as it may be slow.
+	modTimes := make(map[string]time.Time, len(paths))
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime()
+		}
+	}
+
+	w.Lock()
+	defer w.Unlock()
+	if w.isFinished {
+		return
+	}
+
+	var nextCheck time.Duration
+	for _, path := range paths {
+		top, ok := tree.unstable[path]
+		if !ok {
+			continue
+		}
+
+		modTime, exists := modTimes[path]
+		if !exists {
+			// Files deleted while being written are never reported.
+			delete(tree.unstable, path)
+			continue
+		}
+
+		wait := time.Until(modTime.Add(tree.minStableAge))
+		if wait <= 0 {
+			delete(tree.unstable, path)
+			w.markTreeChangedLocked(tree, path, top)
+			continue
+		}
+
+		if nextCheck == 0 || wait < nextCheck {
+			nextCheck = wait
+		}
+	}
+
+	if nextCheck > 0 && tree.stableTimer == nil {
+		tree.stableTimer = time.AfterFunc(nextCheck, func() {
+			w.checkStable(tree)
+		})
+	}
+}
+
+// recordTreeRenameLocked returns the trees that report renames and
+// contain both paths, and counts the rename in their stats.
+//
+// The mutex must be held.
+func (w *watcher) recordTreeRenameLocked(
+	oldPath, newPath string,
+) []*treeWatch {
+	var renamedIn []*treeWatch
+
+	w.forEachTreeLocked(newPath, func(tree *treeWatch, _ string) {
+		if tree.onRename == nil {
+			return
+		}
+
+		w.forEachTreeLocked(oldPath, func(oldTree *treeWatch, _ string) {
+			if oldTree == tree {
+				renamedIn = append(renamedIn, tree)
+				tree.stats.Renames++
+				tree.stats.LastChange = time.Now()
+			}
+		})
+	})
+
+	return renamedIn
+}
+
+// forEachTreeLocked invokes fn for each tree containing the path, with
+// the path relative to the tree.
+//
+// Only the path's ancestors are looked up, so the cost doesn't depend on
+// the number of trees. The mutex must be held.
+func (w *watcher) forEachTreeLocked(
+	path string,
+	fn func(tree *treeWatch, rel string),
+) {
+	if len(w.trees) == 0 {
+		return
+	}
+
+	for dir := filepath.Dir(path); ; {
+		if tree := w.trees[dir]; tree != nil {
+			if rel, err := filepath.Rel(dir, path); err == nil {
+				fn(tree, rel)
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return
+		}
+		dir = parent
+	}
+}
+
+// flushTrees invokes the callbacks of trees with pending changes.
+func (w *watcher) flushTrees() {
+	type pendingCallback struct {
+		onChange    func([]string)
+		changedDirs []string
+
+		onFile       func(string)
+		changedFiles []string
+	}
+
+	w.Lock()
+	if w.isFinished {
+		w.Unlock()
+		return
+	}
+	w.flushTimer = nil
+
+	var pending []pendingCallback
+	for i := range w.treeOrder {
+		tree := w.treeOrder[(w.nextTree+i)%len(w.treeOrder)]
+		if len(tree.changed) == 0 {
+			continue
+		}
+
+		callback := pendingCallback{onChange: tree.onChange, onFile: tree.onFile}
+		if tree.onChange != nil {
+			callback.changedDirs = sortedKeys(tree.changed)
+		}
+		if tree.onFile != nil {
+			callback.changedFiles = sortedKeys(tree.changedFiles)
+		}
+		clear(tree.changed)
+		clear(tree.changedFiles)
+		tree.stats.Callbacks++
+
+		pending = append(pending, callback)
+	}
+	if len(w.treeOrder) > 0 {
+		w.nextTree = (w.nextTree + 1) % len(w.treeOrder)
+	}
+	w.Unlock()
+
+	for _, callback := range pending {
+		if callback.onChange != nil {
+			callback.onChange(callback.changedDirs)
+		}
+		for _, path := range callback.changedFiles {
+			callback.onFile(path)
+		}
+	}
+}
+
+// sortedKeys returns the keys of the set in sorted order.
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
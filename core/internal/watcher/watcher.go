@@ -30,10 +30,106 @@ type Watcher interface {
 	// The directory must exist, or an error is returned.
 	WatchDir(path string, onChange func(string)) error
 
+	// WatchDirRecursive begins watching the directory at the path and
+	// all of its subdirectories.
+	//
+	// `onChange` is invoked with a file path if any file under the
+	// directory is changed or created, including in subdirectories
+	// created after watching starts. The directory is watched like with
+	// WatchTree, so changes are reported after a polling period and
+	// SetTreeOptions applies to them.
+	//
+	// The directory must exist, or an error is returned.
+	WatchDirRecursive(path string, onChange func(string)) error
+
+	// WatchAppends begins watching an append-only file at the path,
+	// reporting the ranges of bytes appended to it.
+	//
+	// `onAppend` is invoked with the range of complete lines appended since
+	// the previous range, so that they can be read without re-scanning the
+	// file. A partial last line is reported once its newline is written.
+	// Content present when watching starts is not reported.
+	//
+	// If the file becomes smaller than what was reported, it is assumed to
+	// have been truncated and offsets restart at 0. Like with Watch, some
+	// changes may be noticed late, but none are skipped: each range starts
+	// where the previous one ended.
+	//
+	// The file must exist, or an error is returned.
+	WatchAppends(path string, onAppend func(AppendRange)) error
+
+	// WatchTree begins watching the directory at the path recursively,
+	// reporting changes by directory rather than by file.
+	//
+	// `onChange` is invoked at most once per polling period with the
+	// sorted names of the top-level subdirectories that contain a changed
+	// or created file. Files directly in the directory are reported as ".".
+	//
+	// The directory must exist, or an error is returned.
+	//
+	// Any number of trees can be watched. They share one polling loop and
+	// one timer, and their callbacks are invoked in rotating order so that
+	// a busy tree doesn't delay the others.
+	WatchTree(path string, onChange func(changedDirs []string)) error
+
+	// WatchTreeRenames begins watching the directory at the path
+	// recursively, like WatchTree, and reports files renamed or moved
+	// within it.
+	//
+	// A rename is inferred when a file disappears between polls and a file
+	// with the same identity (the inode on Unix) appears elsewhere.
+	// `onRename` is invoked with both paths of a file moved within the
+	// tree, and the move is not also reported to the tree's WatchTree
+	// callback. The file may also have been modified. Moves into or out of
+	// the tree, and renames that can't be inferred, are reported as changes
+	// at the new path, like newly created files.
+	//
+	// The directory must exist, or an error is returned.
+	WatchTreeRenames(path string, onRename func(oldPath, newPath string)) error
+
+	// SetTreeOptions configures how changes are reported for the directory
+	// at the path, watching it recursively like WatchTree if it isn't yet.
+	//
+	// The directory must exist, or an error is returned.
+	SetTreeOptions(path string, opts TreeOptions) error
+
+	// TreeStats returns statistics for each directory watched with
+	// WatchTree, keyed by the path passed to WatchTree.
+	TreeStats() map[string]TreeStats
+
 	// Finish stops the watcher from emitting any more change events.
 	Finish()
 }
 
+// TreeOptions configures a directory watched with WatchTree.
+type TreeOptions struct {
+	// MinStableAge is how long a file's mtime must stay unchanged before
+	// a change to it is reported.
+	//
+	// It avoids reporting files that are still being written, which
+	// would otherwise be uploaded half-written. Zero reports changes at
+	// the next poll.
+	MinStableAge time.Duration
+}
+
+// TreeStats describes a directory watched with WatchTree.
+type TreeStats struct {
+	// Files is the number of files currently watched in the tree.
+	Files int
+
+	// Changes is the number of file changes detected in the tree.
+	Changes int
+
+	// Callbacks is the number of times the tree's callback was invoked.
+	Callbacks int
+
+	// Renames is the number of renames reported within the tree.
+	Renames int
+
+	// LastChange is when a change was last detected, or the zero time.
+	LastChange time.Time
+}
+
 type Params struct {
 	Logger *observability.CoreLogger
 
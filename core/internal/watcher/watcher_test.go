@@ -3,6 +3,7 @@ package watcher_test
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -129,6 +130,211 @@ func TestWatcher(t *testing.T) {
 		assert.Equal(t, result, file)
 	})
 
+	t.Run("runs callback on new file in subdirectory", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan string)
+		dir := filepath.Join(t.TempDir(), "dir")
+		file := filepath.Join(dir, "new", "file.txt")
+		mkdir(t, dir)
+
+		watcher := newTestWatcher()
+		defer finishWithDeadline(t, watcher)
+		require.NoError(t,
+			watcher.WatchDirRecursive(dir, func(s string) { onChangeChan <- s }))
+		writeFile(t, file, "")
+
+		result := waitWithDeadline(t, onChangeChan,
+			"expected file callback to be called")
+		assert.Equal(t, result, file)
+	})
+
+	t.Run("reports changed top-level directories in tree", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan []string, 10)
+		root := t.TempDir()
+		mkdir(t, filepath.Join(root, "a", "nested"))
+		mkdir(t, filepath.Join(root, "b"))
+		mkdir(t, filepath.Join(root, "c"))
+
+		watcher := newTestWatcher()
+		defer finishWithDeadline(t, watcher)
+		require.NoError(t,
+			watcher.WatchTree(root, func(dirs []string) { onChangeChan <- dirs }))
+		writeFile(t, filepath.Join(root, "a", "nested", "file.txt"), "")
+		writeFile(t, filepath.Join(root, "b", "file.txt"), "")
+		writeFile(t, filepath.Join(root, "file.txt"), "")
+
+		changed := make(map[string]bool)
+		for len(changed) < 3 {
+			dirs := waitWithDeadline(t, onChangeChan,
+				"expected tree callback to be called")
+			assert.IsIncreasing(t, dirs)
+			for _, dir := range dirs {
+				changed[dir] = true
+			}
+		}
+		assert.Equal(t,
+			map[string]bool{".": true, "a": true, "b": true},
+			changed)
+	})
+
+	t.Run("waits for files in tree to be stable", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan []string, 10)
+		root := t.TempDir()
+		minStableAge := 300 * time.Millisecond
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		require.NoError(t,
+			w.WatchTree(root, func(dirs []string) { onChangeChan <- dirs }))
+		require.NoError(t,
+			w.SetTreeOptions(root, watcher.TreeOptions{
+				MinStableAge: minStableAge,
+			}))
+		file := filepath.Join(root, "file.txt")
+		writeFile(t, file, "partial")
+		time.Sleep(100 * time.Millisecond)
+		lastWrite := writeFileAndGetModTime(t, file, "partial, then complete")
+
+		dirs := waitWithDeadline(t, onChangeChan,
+			"expected tree callback to be called")
+		assert.Equal(t, []string{"."}, dirs)
+		assert.GreaterOrEqual(t, time.Since(lastWrite), minStableAge)
+	})
+
+	t.Run("waits for files in recursive directory to be stable", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan string, 10)
+		root := t.TempDir()
+		minStableAge := 300 * time.Millisecond
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		require.NoError(t,
+			w.WatchDirRecursive(root, func(path string) { onChangeChan <- path }))
+		require.NoError(t,
+			w.SetTreeOptions(root, watcher.TreeOptions{
+				MinStableAge: minStableAge,
+			}))
+		file := filepath.Join(root, "sub", "file.txt")
+		writeFile(t, file, "partial")
+		time.Sleep(100 * time.Millisecond)
+		lastWrite := writeFileAndGetModTime(t, file, "partial, then complete")
+
+		path := waitWithDeadline(t, onChangeChan,
+			"expected file callback to be called")
+		assert.Equal(t, file, path)
+		assert.GreaterOrEqual(t, time.Since(lastWrite), minStableAge)
+	})
+
+	t.Run("watches many trees and reports their stats", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan string, 100)
+		var roots []string
+		for i := 0; i < 5; i++ {
+			roots = append(roots, t.TempDir())
+		}
+		writeFile(t, filepath.Join(roots[0], "existing.txt"), "")
+
+		watcher := newTestWatcher()
+		defer finishWithDeadline(t, watcher)
+		for _, root := range roots {
+			root := root
+			require.NoError(t,
+				watcher.WatchTree(root, func([]string) { onChangeChan <- root }))
+		}
+		for _, root := range roots {
+			writeFile(t, filepath.Join(root, "file.txt"), "")
+		}
+
+		changed := make(map[string]bool)
+		for len(changed) < len(roots) {
+			changed[waitWithDeadline(t, onChangeChan,
+				"expected tree callback to be called")] = true
+		}
+		stats := watcher.TreeStats()
+		assert.Len(t, stats, len(roots))
+		assert.Equal(t, 2, stats[roots[0]].Files)
+		assert.Equal(t, 1, stats[roots[1]].Files)
+		for _, root := range roots {
+			assert.Positive(t, stats[root].Changes)
+			assert.Positive(t, stats[root].Callbacks)
+			assert.False(t, stats[root].LastChange.IsZero())
+		}
+	})
+
+	t.Run("reports renames in tree", func(t *testing.T) {
+		t.Parallel()
+
+		onChangeChan := make(chan []string, 10)
+		onRenameChan := make(chan [2]string, 10)
+		root := t.TempDir()
+		tmpFile := filepath.Join(root, "file.txt.tmp")
+		file := filepath.Join(root, "file.txt")
+
+		watcher := newTestWatcher()
+		defer finishWithDeadline(t, watcher)
+		require.NoError(t,
+			watcher.WatchTree(root, func(dirs []string) { onChangeChan <- dirs }))
+		require.NoError(t,
+			watcher.WatchTreeRenames(root, func(oldPath, newPath string) {
+				onRenameChan <- [2]string{oldPath, newPath}
+			}))
+		writeFile(t, tmpFile, "")
+		waitWithDeadline(t, onChangeChan, "expected tree callback to be called")
+		require.NoError(t, os.Rename(tmpFile, file))
+
+		rename := waitWithDeadline(t, onRenameChan,
+			"expected rename callback to be called")
+		assert.Equal(t, [2]string{tmpFile, file}, rename)
+		assert.Equal(t, 1, watcher.TreeStats()[root].Renames)
+	})
+
+	t.Run("reports appended lines and truncation", func(t *testing.T) {
+		t.Parallel()
+
+		onAppendChan := make(chan watcher.AppendRange, 10)
+		file := filepath.Join(t.TempDir(), "output.log")
+		writeFile(t, file, "existing\n")
+
+		w := newTestWatcher()
+		defer finishWithDeadline(t, w)
+		require.NoError(t,
+			w.WatchAppends(file, func(r watcher.AppendRange) { onAppendChan <- r }))
+		appendAndWait := func(content string) watcher.AppendRange {
+			// Give the mtime a chance to change; see "runs callback on
+			// file write".
+			time.Sleep(20 * time.Millisecond)
+			f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0)
+			require.NoError(t, err)
+			_, err = f.WriteString(content)
+			require.NoError(t, err)
+			require.NoError(t, f.Close())
+			return waitWithDeadline(t, onAppendChan,
+				"expected append callback to be called")
+		}
+
+		// The partial line "b" spans several read chunks.
+		assert.Equal(t,
+			watcher.AppendRange{Start: 9, End: 11},
+			appendAndWait("a\n"+strings.Repeat("b", 10000)))
+		assert.Equal(t,
+			watcher.AppendRange{Start: 11, End: 10015},
+			appendAndWait("c\nd\n"))
+		time.Sleep(20 * time.Millisecond)
+		writeFile(t, file, "x\n")
+		assert.Equal(t,
+			watcher.AppendRange{Start: 0, End: 2, Truncated: true},
+			waitWithDeadline(t, onAppendChan,
+				"expected append callback after truncation"))
+	})
+
 	t.Run("fails if file does not exist", func(t *testing.T) {
 		t.Parallel()
 
@@ -153,4 +359,16 @@ func TestWatcher(t *testing.T) {
 
 		require.ErrorContains(t, err, "tried to call Watch() after Finish()")
 	})
+
+	t.Run("can call Finish more than once", func(t *testing.T) {
+		t.Parallel()
+
+		file := filepath.Join(t.TempDir(), "file.txt")
+		writeFile(t, file, "")
+
+		watcher := newTestWatcher()
+		require.NoError(t, watcher.Watch(file, func() {}))
+		finishWithDeadline(t, watcher)
+		finishWithDeadline(t, watcher)
+	})
 }
@@ -14,6 +14,12 @@ type FakeWatcher struct {
 	sync.Mutex
 
 	handlers map[string]func(string)
+	dirs     map[string]func(string)
+	trees    map[string]func([]string)
+	renames  map[string]func(string, string)
+	appends  map[string]func(watcher.AppendRange)
+	options  map[string]watcher.TreeOptions
+	stats    map[string]watcher.TreeStats
 }
 
 var _ watcher.Watcher = &FakeWatcher{}
@@ -21,6 +27,12 @@ var _ watcher.Watcher = &FakeWatcher{}
 func NewFakeWatcher() *FakeWatcher {
 	return &FakeWatcher{
 		handlers: make(map[string]func(string)),
+		dirs:     make(map[string]func(string)),
+		trees:    make(map[string]func([]string)),
+		renames:  make(map[string]func(string, string)),
+		appends:  make(map[string]func(watcher.AppendRange)),
+		options:  make(map[string]watcher.TreeOptions),
+		stats:    make(map[string]watcher.TreeStats),
 	}
 }
 
@@ -29,15 +41,76 @@ func (w *FakeWatcher) OnChange(path string) {
 	w.Lock()
 	handler := w.handlers[path]
 	parentHandler := w.handlers[filepath.Dir(path)]
+	ancestorHandler := w.recursiveHandlerLocked(path)
 	w.Unlock()
 
 	if handler != nil {
 		handler(path)
 	} else if parentHandler != nil {
 		parentHandler(path)
+	} else if ancestorHandler != nil {
+		ancestorHandler(path)
 	}
 }
 
+// OnAppend invokes the callback registered with WatchAppends for the
+// file, if any.
+func (w *FakeWatcher) OnAppend(path string, appended watcher.AppendRange) {
+	w.Lock()
+	handler := w.appends[w.toAbs(path)]
+	w.Unlock()
+
+	if handler != nil {
+		handler(appended)
+	}
+}
+
+// OnTreeChange invokes the callback registered with WatchTree for the
+// directory, if any.
+func (w *FakeWatcher) OnTreeChange(path string, changedDirs []string) {
+	w.Lock()
+	absPath := w.toAbs(path)
+	handler := w.trees[absPath]
+	if handler != nil {
+		stats := w.stats[absPath]
+		stats.Changes += len(changedDirs)
+		stats.Callbacks++
+		w.stats[absPath] = stats
+	}
+	w.Unlock()
+
+	if handler != nil {
+		handler(changedDirs)
+	}
+}
+
+// OnTreeRename invokes the callback registered with WatchTreeRenames for
+// the directory, if any.
+func (w *FakeWatcher) OnTreeRename(path string, oldPath, newPath string) {
+	w.Lock()
+	absPath := w.toAbs(path)
+	handler := w.renames[absPath]
+	if handler != nil {
+		stats := w.stats[absPath]
+		stats.Renames++
+		w.stats[absPath] = stats
+	}
+	w.Unlock()
+
+	if handler != nil {
+		handler(oldPath, newPath)
+	}
+}
+
+// TreeOptions returns the options set for the directory with
+// SetTreeOptions.
+func (w *FakeWatcher) TreeOptions(path string) watcher.TreeOptions {
+	w.Lock()
+	defer w.Unlock()
+
+	return w.options[w.toAbs(path)]
+}
+
 // IsWatching reports whether a callback is registered for the path.
 func (w *FakeWatcher) IsWatching(path string) bool {
 	w.Lock()
@@ -54,6 +127,112 @@ func (w *FakeWatcher) WatchDir(path string, callback func(string)) error {
 	return w.watchFileOrDir(path, callback)
 }
 
+func (w *FakeWatcher) WatchDirRecursive(path string, callback func(string)) error {
+	w.Lock()
+	defer w.Unlock()
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	w.dirs[w.toAbs(path)] = callback
+	return nil
+}
+
+// recursiveHandlerLocked returns the callback of the nearest directory
+// containing the path that is watched with WatchDirRecursive, or nil.
+func (w *FakeWatcher) recursiveHandlerLocked(path string) func(string) {
+	for dir := filepath.Dir(w.toAbs(path)); ; {
+		if handler := w.dirs[dir]; handler != nil {
+			return handler
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil
+		}
+		dir = parent
+	}
+}
+
+func (w *FakeWatcher) WatchAppends(
+	path string,
+	callback func(watcher.AppendRange),
+) error {
+	w.Lock()
+	defer w.Unlock()
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	w.appends[w.toAbs(path)] = callback
+	return nil
+}
+
+func (w *FakeWatcher) WatchTree(path string, callback func([]string)) error {
+	w.Lock()
+	defer w.Unlock()
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	w.trees[w.toAbs(path)] = callback
+	return nil
+}
+
+func (w *FakeWatcher) WatchTreeRenames(
+	path string,
+	callback func(oldPath, newPath string),
+) error {
+	w.Lock()
+	defer w.Unlock()
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	w.renames[w.toAbs(path)] = callback
+	return nil
+}
+
+func (w *FakeWatcher) SetTreeOptions(path string, opts watcher.TreeOptions) error {
+	w.Lock()
+	defer w.Unlock()
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	w.options[w.toAbs(path)] = opts
+	return nil
+}
+
+// TreeStats returns the number of OnTreeChange and OnTreeRename calls for
+// each tree.
+//
+// Changes counts the directories passed to OnTreeChange. Keys are
+// absolute paths.
+func (w *FakeWatcher) TreeStats() map[string]watcher.TreeStats {
+	w.Lock()
+	defer w.Unlock()
+
+	stats := make(map[string]watcher.TreeStats, len(w.trees))
+	for path := range w.trees {
+		stats[path] = w.stats[path]
+	}
+	for path := range w.renames {
+		stats[path] = w.stats[path]
+	}
+	return stats
+}
+
 func (w *FakeWatcher) watchFileOrDir(path string, callback func(string)) error {
 	w.Lock()
 	defer w.Unlock()
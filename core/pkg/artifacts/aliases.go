@@ -0,0 +1,55 @@
+package artifacts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+)
+
+// invalidAliasChars are characters not allowed in artifact aliases.
+//
+// They separate the parts of an artifact path like "entity/project/name:alias".
+const invalidAliasChars = "/:"
+
+// NormalizeAliases validates artifact aliases and removes duplicates.
+//
+// The order of first occurrences is kept.
+func NormalizeAliases(aliases []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(aliases))
+	normalized := make([]string, 0, len(aliases))
+
+	for _, alias := range aliases {
+		if alias == "" {
+			return nil, fmt.Errorf("artifacts: alias must not be empty")
+		}
+		if strings.ContainsAny(alias, invalidAliasChars) {
+			return nil, fmt.Errorf(
+				"artifacts: alias %q must not contain any of %q",
+				alias, invalidAliasChars)
+		}
+
+		if _, ok := seen[alias]; ok {
+			continue
+		}
+		seen[alias] = struct{}{}
+		normalized = append(normalized, alias)
+	}
+
+	return normalized, nil
+}
+
+// ValidateMetadata checks that artifact metadata is a JSON object.
+//
+// Empty metadata is valid.
+func ValidateMetadata(metadata string) error {
+	if metadata == "" {
+		return nil
+	}
+
+	var value map[string]any
+	if err := json.Unmarshal([]byte(metadata), &value); err != nil {
+		return fmt.Errorf("artifacts: metadata must be a JSON object: %v", err)
+	}
+	return nil
+}
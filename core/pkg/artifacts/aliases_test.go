@@ -0,0 +1,37 @@
+package artifacts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/artifacts"
+)
+
+func TestNormalizeAliases_Dedups(t *testing.T) {
+	aliases, err := artifacts.NormalizeAliases(
+		[]string{"latest", "prod", "latest", "v2", "prod"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"latest", "prod", "v2"}, aliases)
+}
+
+func TestNormalizeAliases_InvalidCharacters(t *testing.T) {
+	_, err := artifacts.NormalizeAliases([]string{"latest", "team/prod"})
+	assert.ErrorContains(t, err, `alias "team/prod"`)
+
+	_, err = artifacts.NormalizeAliases([]string{"name:v1"})
+	assert.ErrorContains(t, err, `alias "name:v1"`)
+}
+
+func TestNormalizeAliases_Empty(t *testing.T) {
+	_, err := artifacts.NormalizeAliases([]string{""})
+	assert.ErrorContains(t, err, "must not be empty")
+}
+
+func TestValidateMetadata(t *testing.T) {
+	assert.NoError(t, artifacts.ValidateMetadata(""))
+	assert.NoError(t, artifacts.ValidateMetadata(`{"rows": 10}`))
+	assert.Error(t, artifacts.ValidateMetadata(`[1, 2]`))
+	assert.Error(t, artifacts.ValidateMetadata(`{`))
+}
@@ -17,6 +17,9 @@ import (
 type ArtifactBuilder struct {
 	artifactRecord   *service.ArtifactRecord
 	isDigestUpToDate bool
+
+	// digestAlgorithm is the algorithm for new entries' digests.
+	digestAlgorithm DigestAlgorithm
 }
 
 func NewArtifactBuilder(artifactRecord *service.ArtifactRecord) *ArtifactBuilder {
@@ -28,6 +31,15 @@ func NewArtifactBuilder(artifactRecord *service.ArtifactRecord) *ArtifactBuilder
 	return builder
 }
 
+// SetDigestAlgorithm sets an algorithm used to hash added files in
+// addition to MD5.
+//
+// Entries record the additional digest in an extra unless the algorithm
+// is the default, MD5.
+func (b *ArtifactBuilder) SetDigestAlgorithm(algorithm DigestAlgorithm) {
+	b.digestAlgorithm = algorithm
+}
+
 func (b *ArtifactBuilder) initDefaultManifest() {
 	if b.artifactRecord.Manifest != nil {
 		return
@@ -47,12 +59,17 @@ func (b *ArtifactBuilder) AddData(name string, dataMap map[string]interface{}) e
 	if err != nil {
 		return err
 	}
+	extra, err := b.digestAlgorithm.extraFileDigest(filename)
+	if err != nil {
+		return err
+	}
 	b.artifactRecord.Manifest.Contents = append(b.artifactRecord.Manifest.Contents,
 		&service.ArtifactManifestEntry{
 			Path:      name,
 			Digest:    digest,
 			LocalPath: filename,
 			Size:      size,
+			Extra:     extra,
 		})
 	b.isDigestUpToDate = false
 	return nil
@@ -77,18 +94,46 @@ func (b *ArtifactBuilder) AddFile(path string, name string) error {
 	if err != nil {
 		return err
 	}
-	digest := utils.ComputeB64MD5(data)
+	digest := DigestMD5.ComputeDigest(data)
 	b.artifactRecord.Manifest.Contents = append(b.artifactRecord.Manifest.Contents,
 		&service.ArtifactManifestEntry{
 			Path:      name,
 			Digest:    digest,
 			LocalPath: path,
 			Size:      size,
+			Extra:     b.digestAlgorithm.extraDigest(data),
 		})
 	b.isDigestUpToDate = false
 	return nil
 }
 
+// AddFileWithMetadata adds a file like AddFile and attaches the metadata
+// to its manifest entry.
+//
+// The metadata is validated with ValidateEntryMetadata. It is saved in the
+// manifest and can be read back from the downloaded entry's Metadata.
+func (b *ArtifactBuilder) AddFileWithMetadata(
+	path string,
+	name string,
+	metadata map[string]any,
+) error {
+	extra, err := entryMetadataExtra(metadata)
+	if err != nil {
+		return err
+	}
+
+	if err := b.AddFile(path, name); err != nil {
+		return err
+	}
+
+	if extra != nil {
+		contents := b.artifactRecord.Manifest.Contents
+		entry := contents[len(contents)-1]
+		entry.Extra = append(entry.Extra, extra)
+	}
+	return nil
+}
+
 func (b *ArtifactBuilder) updateManifestDigest() {
 	if b.isDigestUpToDate {
 		return
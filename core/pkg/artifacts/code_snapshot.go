@@ -0,0 +1,286 @@
+package artifacts
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// CodeArtifactType is the artifact type of code snapshots.
+const CodeArtifactType = "code"
+
+// pythonImportRe matches "import x.y, z" and "from x.y import a, b".
+var pythonImportRe = regexp.MustCompile(
+	`^\s*(?:from\s+(\.*[\w.]*)\s+import\s+\(?([\w\s,]+)|import\s+([\w.]+(?:\s*,\s*[\w.]+)*))`)
+
+var invalidArtifactNameChars = regexp.MustCompile(`[^a-zA-Z0-9_\-.]`)
+
+// CodeSnapshot collects a run's source code into an artifact.
+type CodeSnapshot struct {
+	// Root is the directory that paths in the artifact are relative to.
+	Root string
+
+	// Include are globs relative to Root of files to snapshot.
+	//
+	// If empty, all collected files are included.
+	Include []string
+
+	// Exclude are globs relative to Root of files not to snapshot.
+	//
+	// Exclusions take precedence over inclusions.
+	Exclude []string
+
+	// MaxBytes is the largest total size of the snapshot.
+	//
+	// Files that would go over the limit are skipped. Zero means no limit.
+	MaxBytes int64
+
+	// DigestAlgorithm is an extra hash function for the manifest entries.
+	DigestAlgorithm DigestAlgorithm
+}
+
+// CodeArtifactName returns the name of the code artifact for a program.
+func CodeArtifactName(project, program string) string {
+	return invalidArtifactNameChars.ReplaceAllString(
+		fmt.Sprintf("source-%s-%s", project, filepath.ToSlash(program)),
+		"_",
+	)
+}
+
+// PythonLocalModules returns the entry point and the local modules it
+// imports, directly or indirectly.
+//
+// Modules are looked up relative to the entry point's directory. Imports
+// that don't resolve to a file there, like the standard library and
+// installed packages, are ignored. Paths are absolute.
+func PythonLocalModules(entryPoint string) ([]string, error) {
+	entryPoint, err := filepath.Abs(entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	baseDir := filepath.Dir(entryPoint)
+
+	seen := map[string]struct{}{entryPoint: {}}
+	queue := []string{entryPoint}
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+
+		modules, err := pythonImports(path)
+		if err != nil {
+			if path == entryPoint {
+				return nil, err
+			}
+			continue
+		}
+
+		for _, module := range modules {
+			for _, modulePath := range resolvePythonModule(baseDir, path, module) {
+				if _, ok := seen[modulePath]; !ok {
+					seen[modulePath] = struct{}{}
+					queue = append(queue, modulePath)
+				}
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// pythonImports returns the modules imported by a Python file.
+func pythonImports(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var modules []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		match := pythonImportRe.FindStringSubmatch(scanner.Text())
+		switch {
+		case match == nil:
+		case match[1] != "":
+			// The imported names may be submodules.
+			modules = append(modules, match[1])
+			for _, name := range strings.Split(match[2], ",") {
+				name = strings.TrimSpace(name)
+				if name == "" {
+					continue
+				}
+				if strings.HasSuffix(match[1], ".") {
+					modules = append(modules, match[1]+name)
+				} else {
+					modules = append(modules, match[1]+"."+name)
+				}
+			}
+		default:
+			for _, module := range strings.Split(match[3], ",") {
+				modules = append(modules, strings.TrimSpace(module))
+			}
+		}
+	}
+
+	return modules, scanner.Err()
+}
+
+// resolvePythonModule returns the files for a module imported by a file.
+//
+// Both the module and its parent packages' "__init__.py" files are
+// returned, if they exist.
+func resolvePythonModule(baseDir, importer, module string) []string {
+	dir := baseDir
+	if strings.HasPrefix(module, ".") {
+		dir = filepath.Dir(importer)
+		module = module[1:]
+		for strings.HasPrefix(module, ".") {
+			dir = filepath.Dir(dir)
+			module = module[1:]
+		}
+	}
+
+	var paths []string
+	for _, part := range strings.Split(module, ".") {
+		if part == "" {
+			continue
+		}
+
+		if isFile(filepath.Join(dir, part+".py")) {
+			paths = append(paths, filepath.Join(dir, part+".py"))
+			break
+		}
+
+		dir = filepath.Join(dir, part)
+		if isFile(filepath.Join(dir, "__init__.py")) {
+			paths = append(paths, filepath.Join(dir, "__init__.py"))
+		} else {
+			break
+		}
+	}
+
+	return paths
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// Filter returns the paths to snapshot and the paths skipped because of the
+// size limit.
+//
+// Paths may be absolute or relative to Root. Returned paths are relative to
+// Root, and paths outside of Root or not matching the patterns are dropped.
+func (c *CodeSnapshot) Filter(paths []string) (included, skipped []string) {
+	var totalBytes int64
+	seen := make(map[string]struct{})
+
+	for _, path := range paths {
+		relPath := path
+		if filepath.IsAbs(path) {
+			var err error
+			relPath, err = filepath.Rel(c.Root, path)
+			if err != nil {
+				continue
+			}
+		}
+		relPath = filepath.Clean(relPath)
+		if relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		if _, ok := seen[relPath]; ok {
+			continue
+		}
+		seen[relPath] = struct{}{}
+
+		if !c.matches(relPath) {
+			continue
+		}
+
+		info, err := os.Stat(filepath.Join(c.Root, relPath))
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+
+		if c.MaxBytes > 0 && totalBytes+info.Size() > c.MaxBytes {
+			skipped = append(skipped, relPath)
+			continue
+		}
+
+		totalBytes += info.Size()
+		included = append(included, relPath)
+	}
+
+	return included, skipped
+}
+
+// matches reports whether a path relative to Root passes the include and
+// exclude patterns.
+func (c *CodeSnapshot) matches(relPath string) bool {
+	slashPath := filepath.ToSlash(relPath)
+
+	for _, glob := range c.Exclude {
+		if matched, _ := filepath.Match(glob, slashPath); matched {
+			return false
+		}
+	}
+
+	if len(c.Include) == 0 {
+		return true
+	}
+	for _, glob := range c.Include {
+		if matched, _ := filepath.Match(glob, slashPath); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Build stages copies of the files and returns an artifact with them.
+//
+// Copying keeps the snapshot consistent if the code changes before the
+// upload finishes. Paths are relative to Root.
+func (c *CodeSnapshot) Build(
+	artifact *service.ArtifactRecord,
+	relPaths []string,
+	staging *StagingArea,
+) (_ *service.ArtifactRecord, rerr error) {
+	builder := NewArtifactBuilder(artifact)
+	builder.SetDigestAlgorithm(c.DigestAlgorithm)
+
+	var stagedPaths []string
+	defer func() {
+		if rerr != nil {
+			for _, stagedPath := range stagedPaths {
+				staging.Release(stagedPath)
+			}
+		}
+	}()
+
+	for _, relPath := range relPaths {
+		stagedPath, err := staging.Stage(filepath.Join(c.Root, relPath))
+		if err != nil {
+			return nil, err
+		}
+		stagedPaths = append(stagedPaths, stagedPath)
+
+		if err := builder.AddFile(stagedPath, filepath.ToSlash(relPath)); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.GetArtifact(), nil
+}
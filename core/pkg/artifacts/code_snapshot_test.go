@@ -0,0 +1,110 @@
+package artifacts_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestPythonLocalModules(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"train.py":          "import os, model\nfrom data.loader import load\n",
+		"model.py":          "from . import layers\n",
+		"layers.py":         "import torch\n",
+		"data/__init__.py":  "",
+		"data/loader.py":    "",
+		"unused.py":         "",
+		"data/unrelated.py": "",
+	})
+
+	paths, err := artifacts.PythonLocalModules(filepath.Join(dir, "train.py"))
+
+	require.NoError(t, err)
+	var relPaths []string
+	for _, path := range paths {
+		relPath, _ := filepath.Rel(dir, path)
+		relPaths = append(relPaths, filepath.ToSlash(relPath))
+	}
+	assert.ElementsMatch(t,
+		[]string{
+			"train.py",
+			"model.py",
+			"layers.py",
+			"data/__init__.py",
+			"data/loader.py",
+		},
+		relPaths)
+}
+
+func TestCodeSnapshot_Filter(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.py":       "aaaa",
+		"b.py":       "bbbb",
+		"c.py":       "cccc",
+		"secrets.py": "s",
+		"notes.txt":  "n",
+	})
+	snapshot := &artifacts.CodeSnapshot{
+		Root:     dir,
+		Include:  []string{"*.py"},
+		Exclude:  []string{"secrets.py"},
+		MaxBytes: 9,
+	}
+
+	included, skipped := snapshot.Filter([]string{
+		"a.py",
+		filepath.Join(dir, "b.py"),
+		"c.py",
+		"secrets.py",
+		"notes.txt",
+		filepath.Join(dir, "..", "other.py"),
+		"missing.py",
+	})
+
+	assert.Equal(t, []string{"a.py", "b.py"}, included)
+	assert.Equal(t, []string{"c.py"}, skipped)
+}
+
+func TestCodeSnapshot_Build(t *testing.T) {
+	dir := t.TempDir()
+	staging := artifacts.NewStagingArea(t.TempDir())
+	writeFiles(t, dir, map[string]string{"pkg/a.py": "original"})
+	snapshot := &artifacts.CodeSnapshot{Root: dir}
+
+	artifact, err := snapshot.Build(
+		&service.ArtifactRecord{Name: "source", Type: artifacts.CodeArtifactType},
+		[]string{filepath.Join("pkg", "a.py")},
+		staging,
+	)
+	writeFiles(t, dir, map[string]string{"pkg/a.py": "modified"})
+
+	require.NoError(t, err)
+	require.Len(t, artifact.Manifest.Contents, 1)
+	entry := artifact.Manifest.Contents[0]
+	assert.Equal(t, "pkg/a.py", entry.Path)
+	staged, err := os.ReadFile(entry.LocalPath)
+	require.NoError(t, err)
+	assert.Equal(t, "original", string(staged))
+	assert.NotEmpty(t, artifact.Digest)
+}
+
+func TestCodeArtifactName(t *testing.T) {
+	assert.Equal(t,
+		"source-my_project-scripts_train.py",
+		artifacts.CodeArtifactName("my project", "scripts/train.py"))
+}
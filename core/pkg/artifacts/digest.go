@@ -0,0 +1,131 @@
+package artifacts
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// DigestAlgorithm is a hash function for manifest entry digests.
+//
+// Entry digests are always base64-encoded MD5s, which is what the backend
+// and the Python client use to locate and verify files. Other algorithms
+// are recorded in an additional manifest entry extra named after the
+// algorithm, such as "sha256".
+type DigestAlgorithm string
+
+const (
+	DigestMD5    DigestAlgorithm = "md5"
+	DigestSHA256 DigestAlgorithm = "sha256"
+)
+
+// ParseDigestAlgorithm returns the algorithm with the name.
+//
+// An empty name means the default, MD5.
+func ParseDigestAlgorithm(name string) (DigestAlgorithm, error) {
+	switch DigestAlgorithm(name) {
+	case "", DigestMD5:
+		return DigestMD5, nil
+	case DigestSHA256:
+		return DigestSHA256, nil
+	default:
+		return "", fmt.Errorf("artifacts: unknown digest algorithm %q", name)
+	}
+}
+
+func (a DigestAlgorithm) newHash() hash.Hash {
+	switch a {
+	case DigestSHA256:
+		return sha256.New()
+	default:
+		return md5.New()
+	}
+}
+
+// ComputeDigest returns the digest of the data.
+func (a DigestAlgorithm) ComputeDigest(data []byte) string {
+	hasher := a.newHash()
+	_, _ = hasher.Write(data)
+	return base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+}
+
+// ComputeFileDigest returns the digest of the file at the path.
+func (a DigestAlgorithm) ComputeFileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hasher := a.newHash()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// extraDigest returns the manifest entry extras recording the data's
+// digest for this algorithm.
+//
+// It returns nil for MD5, whose digest is the entry's Digest.
+func (a DigestAlgorithm) extraDigest(data []byte) []*service.ExtraItem {
+	if a == DigestMD5 || a == "" {
+		return nil
+	}
+	return a.manifestExtra(a.ComputeDigest(data))
+}
+
+// extraFileDigest is like extraDigest for the file at the path.
+func (a DigestAlgorithm) extraFileDigest(path string) ([]*service.ExtraItem, error) {
+	if a == DigestMD5 || a == "" {
+		return nil, nil
+	}
+	digest, err := a.ComputeFileDigest(path)
+	if err != nil {
+		return nil, err
+	}
+	return a.manifestExtra(digest), nil
+}
+
+func (a DigestAlgorithm) manifestExtra(digest string) []*service.ExtraItem {
+	return []*service.ExtraItem{{
+		Key:       string(a),
+		ValueJson: fmt.Sprintf("%q", digest),
+	}}
+}
+
+// extraDigests returns the entry's digests recorded in addition to its
+// MD5 Digest, by algorithm.
+func (e ManifestEntry) extraDigests() map[DigestAlgorithm]string {
+	digests := make(map[DigestAlgorithm]string)
+	for _, algorithm := range []DigestAlgorithm{DigestSHA256} {
+		if digest, ok := e.Extra[string(algorithm)].(string); ok && digest != "" {
+			digests[algorithm] = digest
+		}
+	}
+	return digests
+}
+
+// addFileToCache copies a file into the cache, verifying it against the
+// entry's MD5 digest if it is not empty and against its extra digests.
+func addFileToCache(cache Cache, path string, digest string, entry ManifestEntry) error {
+	for algorithm, expected := range entry.extraDigests() {
+		actual, err := algorithm.ComputeFileDigest(path)
+		if err != nil {
+			return err
+		}
+		if actual != expected {
+			return fmt.Errorf(
+				"file hash mismatch: expected %s %s, actual %s",
+				algorithm, expected, actual)
+		}
+	}
+
+	return cache.AddFileAndCheckDigest(path, digest)
+}
@@ -0,0 +1,73 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// Base64 SHA-256 of "content".
+const contentSHA256 = "7XACtDnprIRfIjV9giusFERzD722AW0+yUMil7nsn3M="
+
+func TestParseDigestAlgorithm(t *testing.T) {
+	algorithm, err := ParseDigestAlgorithm("")
+	require.NoError(t, err)
+	assert.Equal(t, DigestMD5, algorithm)
+
+	algorithm, err = ParseDigestAlgorithm("sha256")
+	require.NoError(t, err)
+	assert.Equal(t, DigestSHA256, algorithm)
+
+	_, err = ParseDigestAlgorithm("crc32")
+	assert.ErrorContains(t, err, `unknown digest algorithm "crc32"`)
+}
+
+func TestArtifactBuilder_SHA256(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+	builder := NewArtifactBuilder(&service.ArtifactRecord{})
+	builder.SetDigestAlgorithm(DigestSHA256)
+
+	require.NoError(t, builder.AddFile(path, "file.txt"))
+	manifest, err := NewManifestFromProto(builder.GetArtifact().Manifest)
+	require.NoError(t, err)
+
+	// The digest stays an MD5 so that other clients can verify the file.
+	entry := manifest.Contents["file.txt"]
+	assert.Equal(t, utils.ComputeB64MD5([]byte("content")), entry.Digest)
+	assert.Equal(t, contentSHA256, entry.Extra["sha256"])
+}
+
+func TestArtifactBuilder_MD5HasNoExtra(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+	builder := NewArtifactBuilder(&service.ArtifactRecord{})
+
+	require.NoError(t, builder.AddFile(path, "file.txt"))
+
+	entry := builder.GetArtifact().Manifest.Contents[0]
+	assert.Empty(t, entry.Extra)
+}
+
+func TestAddFileToCache_VerifiesSHA256Extra(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+	md5 := utils.ComputeB64MD5([]byte("content"))
+	cache := NewHashOnlyCache()
+
+	assert.NoError(t,
+		addFileToCache(cache, path, md5, ManifestEntry{
+			Extra: map[string]interface{}{"sha256": contentSHA256},
+		}))
+	assert.ErrorContains(t,
+		addFileToCache(cache, path, md5, ManifestEntry{
+			Extra: map[string]interface{}{"sha256": "wrong"},
+		}),
+		"file hash mismatch")
+}
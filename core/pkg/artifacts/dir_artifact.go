@@ -0,0 +1,168 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// DirArtifact is an artifact made of the files written to a directory
+// during a run.
+//
+// Files are hashed as they're reported through Update so that most of the
+// work is done by the time the run finishes. Build rescans the directory
+// to pick up changes the watcher missed.
+type DirArtifact struct {
+	mu sync.Mutex
+
+	// artifact is the artifact to log, without a manifest.
+	artifact *service.ArtifactRecord
+
+	// root is the watched directory.
+	root string
+
+	// algorithm is the hash function for extra file digests.
+	algorithm DigestAlgorithm
+
+	// entries are the files seen so far, by path relative to root.
+	entries map[string]*dirArtifactEntry
+}
+
+// dirArtifactEntry is a hashed file and the stat info at the time.
+type dirArtifactEntry struct {
+	digest  string
+	extra   []*service.ExtraItem
+	size    int64
+	modTime time.Time
+}
+
+func NewDirArtifact(
+	artifact *service.ArtifactRecord,
+	root string,
+	algorithm DigestAlgorithm,
+) *DirArtifact {
+	return &DirArtifact{
+		artifact:  artifact,
+		root:      root,
+		algorithm: algorithm,
+		entries:   make(map[string]*dirArtifactEntry),
+	}
+}
+
+// Root returns the watched directory.
+func (a *DirArtifact) Root() string {
+	return a.root
+}
+
+// Update adds or rehashes the file at the path, which must be a direct
+// child of the root directory.
+//
+// Files that no longer exist are removed from the artifact.
+func (a *DirArtifact) Update(path string) error {
+	name, err := filepath.Rel(a.root, path)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.updateLocked(name)
+}
+
+func (a *DirArtifact) updateLocked(name string) error {
+	path := filepath.Join(a.root, name)
+
+	info, err := os.Stat(path)
+	switch {
+	case os.IsNotExist(err):
+		delete(a.entries, name)
+		return nil
+	case err != nil:
+		return err
+	case !info.Mode().IsRegular():
+		delete(a.entries, name)
+		return nil
+	}
+
+	prev := a.entries[name]
+	if prev != nil &&
+		prev.size == info.Size() &&
+		prev.modTime.Equal(info.ModTime()) {
+		return nil
+	}
+
+	digest, err := DigestMD5.ComputeFileDigest(path)
+	var extra []*service.ExtraItem
+	if err == nil {
+		extra, err = a.algorithm.extraFileDigest(path)
+	}
+	if os.IsNotExist(err) {
+		delete(a.entries, name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	a.entries[name] = &dirArtifactEntry{
+		digest:  digest,
+		extra:   extra,
+		size:    info.Size(),
+		modTime: info.ModTime(),
+	}
+	return nil
+}
+
+// Build returns the artifact with a manifest of the directory's files.
+//
+// Files deleted since they were added are excluded, and files that were
+// never reported are added.
+func (a *DirArtifact) Build() (*service.ArtifactRecord, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	dirEntries, err := os.ReadDir(a.root)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	names := make(map[string]struct{})
+	for name := range a.entries {
+		names[name] = struct{}{}
+	}
+	for _, entry := range dirEntries {
+		names[entry.Name()] = struct{}{}
+	}
+	for name := range names {
+		if err := a.updateLocked(name); err != nil {
+			return nil, err
+		}
+	}
+
+	sortedNames := make([]string, 0, len(a.entries))
+	for name := range a.entries {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	builder := NewArtifactBuilder(a.artifact)
+	for _, name := range sortedNames {
+		entry := a.entries[name]
+		builder.artifactRecord.Manifest.Contents = append(
+			builder.artifactRecord.Manifest.Contents,
+			&service.ArtifactManifestEntry{
+				Path:      filepath.ToSlash(name),
+				Digest:    entry.digest,
+				LocalPath: filepath.Join(a.root, name),
+				Size:      entry.size,
+				Extra:     entry.extra,
+			})
+	}
+	builder.isDigestUpToDate = false
+
+	return builder.GetArtifact(), nil
+}
@@ -0,0 +1,59 @@
+package artifacts_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func manifestPaths(artifact *service.ArtifactRecord) []string {
+	var paths []string
+	for _, entry := range artifact.GetManifest().GetContents() {
+		paths = append(paths, entry.Path)
+	}
+	return paths
+}
+
+func TestDirArtifact_ExcludesDeletedFiles(t *testing.T) {
+	dir := t.TempDir()
+	a := artifacts.NewDirArtifact(&service.ArtifactRecord{Name: "outputs"}, dir, artifacts.DigestMD5)
+
+	for _, name := range []string{"a.txt", "b.txt"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+		require.NoError(t, a.Update(path))
+	}
+	require.NoError(t, os.Remove(filepath.Join(dir, "b.txt")))
+
+	artifact, err := a.Build()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt"}, manifestPaths(artifact))
+	assert.NotEmpty(t, artifact.Digest)
+}
+
+func TestDirArtifact_PicksUpMissedChanges(t *testing.T) {
+	dir := t.TempDir()
+	a := artifacts.NewDirArtifact(&service.ArtifactRecord{Name: "outputs"}, dir, artifacts.DigestMD5)
+	path := filepath.Join(dir, "a.txt")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o644))
+	require.NoError(t, a.Update(path))
+
+	require.NoError(t, os.WriteFile(path, []byte("new contents"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("b"), 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0o755))
+	artifact, err := a.Build()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt", "b.txt"}, manifestPaths(artifact))
+	entry := artifact.Manifest.Contents[0]
+	assert.Equal(t, utils.ComputeB64MD5([]byte("new contents")), entry.Digest)
+	assert.EqualValues(t, len("new contents"), entry.Size)
+	assert.Equal(t, path, entry.LocalPath)
+}
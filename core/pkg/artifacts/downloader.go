@@ -83,6 +83,21 @@ func (ad *ArtifactDownloader) getArtifactManifest(artifactID string) (manifest M
 	return manifest, nil
 }
 
+// FileMetadata returns the metadata attached to the artifact's file at
+// the path when it was logged, or nil if it has none.
+func (ad *ArtifactDownloader) FileMetadata(path string) (map[string]any, error) {
+	manifest, err := ad.getArtifactManifest(ad.ArtifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := manifest.GetManifestEntryFromArtifactFilePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return entry.Metadata(), nil
+}
+
 func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest) error {
 	// retrieve from "WANDB_ARTIFACT_FETCH_FILE_URL_BATCH_SIZE"?
 	batchSize := BATCH_SIZE
@@ -154,10 +169,11 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 						continue
 					}
 					task := &filetransfer.Task{
-						FileKind: filetransfer.RunFileKindArtifact,
-						Type:     filetransfer.DownloadTask,
-						Path:     downloadLocalPath,
-						Url:      *entry.DownloadURL,
+						FileKind:       filetransfer.RunFileKindArtifact,
+						Type:           filetransfer.DownloadTask,
+						Path:           downloadLocalPath,
+						Url:            *entry.DownloadURL,
+						StoredEncoding: entry.StoredEncoding(),
 					}
 					task.SetCompletionCallback(
 						func(t *filetransfer.Task) {
@@ -182,9 +198,14 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 					continue
 				}
 				numDone++
-				digest := manifest.Contents[result.Name].Digest
+				entry := manifest.Contents[result.Name]
 				go func() {
-					err := ad.FileCache.AddFileAndCheckDigest(result.Task.Path, digest)
+					err := addFileToCache(
+						ad.FileCache,
+						result.Task.Path,
+						entry.LocalDigest(),
+						entry,
+					)
 					if err != nil {
 						slog.Error("Error adding file to cache", "err", err)
 					}
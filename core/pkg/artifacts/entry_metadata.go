@@ -0,0 +1,80 @@
+package artifacts
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// MaxEntryMetadataBytes is the largest allowed JSON encoding of a single
+// manifest entry's metadata.
+//
+// Metadata is stored in the manifest, which is downloaded in full whenever
+// any file of the artifact is used, so it must stay small.
+const MaxEntryMetadataBytes = 4096
+
+// entryMetadataKey is the manifest entry extra holding the file's metadata.
+const entryMetadataKey = "metadata"
+
+// Metadata returns the metadata attached to the file, like its label or
+// dataset split, or nil if it has none.
+//
+// It is recorded in the entry's "metadata" extra.
+func (e ManifestEntry) Metadata() map[string]any {
+	metadata, _ := e.Extra[entryMetadataKey].(map[string]any)
+	return metadata
+}
+
+// ValidateEntryMetadata returns an error if the metadata can't be attached
+// to a manifest entry.
+//
+// Metadata must have non-empty keys, be encodable as JSON, and encode to at
+// most MaxEntryMetadataBytes.
+func ValidateEntryMetadata(metadata map[string]any) error {
+	_, err := encodeEntryMetadata(metadata)
+	return err
+}
+
+// encodeEntryMetadata validates the metadata and returns its JSON encoding.
+func encodeEntryMetadata(metadata map[string]any) ([]byte, error) {
+	for key := range metadata {
+		if key == "" {
+			return nil, errors.New("artifacts: entry metadata has an empty key")
+		}
+	}
+
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("artifacts: cannot encode entry metadata: %v", err)
+	}
+
+	if len(encoded) > MaxEntryMetadataBytes {
+		return nil, fmt.Errorf(
+			"artifacts: entry metadata is %d bytes, more than the limit of %d",
+			len(encoded), MaxEntryMetadataBytes)
+	}
+
+	return encoded, nil
+}
+
+// entryMetadataExtra returns the extra item recording the metadata.
+//
+// Returns nil if the metadata is empty.
+func entryMetadataExtra(metadata map[string]any) (*service.ExtraItem, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	encoded, err := encodeEntryMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.ExtraItem{
+		Key:       entryMetadataKey,
+		ValueJson: string(encoded),
+	}, nil
+}
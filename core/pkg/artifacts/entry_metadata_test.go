@@ -0,0 +1,81 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestValidateEntryMetadata(t *testing.T) {
+	assert.NoError(t, ValidateEntryMetadata(map[string]any{"split": "train"}))
+	assert.NoError(t, ValidateEntryMetadata(nil))
+
+	assert.ErrorContains(t,
+		ValidateEntryMetadata(map[string]any{"": 1}),
+		"empty key")
+	assert.ErrorContains(t,
+		ValidateEntryMetadata(map[string]any{"f": func() {}}),
+		"cannot encode")
+	assert.ErrorContains(t,
+		ValidateEntryMetadata(map[string]any{
+			"source": strings.Repeat("x", MaxEntryMetadataBytes),
+		}),
+		"more than the limit")
+}
+
+func TestAddFileWithMetadata_RoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cat.png")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+	builder := NewArtifactBuilder(&service.ArtifactRecord{})
+
+	require.NoError(t, builder.AddFileWithMetadata(path, "cat.png",
+		map[string]any{"label": "cat", "split": "train"}))
+	manifest, err := NewManifestFromProto(builder.GetArtifact().Manifest)
+	require.NoError(t, err)
+
+	// Simulate committing the manifest and downloading it again.
+	manifestFile, _, _, err := manifest.WriteToFile()
+	require.NoError(t, err)
+	defer os.Remove(manifestFile)
+	data, err := os.ReadFile(manifestFile)
+	require.NoError(t, err)
+	var downloaded Manifest
+	require.NoError(t, json.Unmarshal(data, &downloaded))
+
+	assert.Equal(t,
+		map[string]any{"label": "cat", "split": "train"},
+		downloaded.Contents["cat.png"].Metadata())
+}
+
+func TestAddFileWithMetadata_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cat.png")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+	builder := NewArtifactBuilder(&service.ArtifactRecord{})
+
+	err := builder.AddFileWithMetadata(path, "cat.png", map[string]any{"": 1})
+
+	assert.ErrorContains(t, err, "empty key")
+	assert.Empty(t, builder.GetArtifact().Manifest.Contents)
+}
+
+func TestNewManifestFromProto_AcceptsExistingMetadata(t *testing.T) {
+	// Manifests logged before per-file metadata may have a "metadata"
+	// extra of any shape; only metadata added by AddFileWithMetadata is
+	// validated.
+	manifest, err := NewManifestFromProto(&service.ArtifactManifest{
+		Contents: []*service.ArtifactManifestEntry{{
+			Path:  "cat.png",
+			Extra: []*service.ExtraItem{{Key: "metadata", ValueJson: `"cat"`}},
+		}},
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, manifest.Contents["cat.png"].Metadata())
+}
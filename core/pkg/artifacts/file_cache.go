@@ -3,8 +3,10 @@ package artifacts
 import (
 	"crypto/md5"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -19,6 +21,16 @@ type Cache interface {
 	AddFileAndCheckDigest(path string, digest string) error
 	RestoreTo(entry ManifestEntry, dst string) bool
 	Write(src io.Reader) (string, error)
+	VerifyCache() (CacheVerifyResult, error)
+}
+
+// CacheVerifyResult summarizes a scan of the cache by VerifyCache.
+type CacheVerifyResult struct {
+	// Checked is the number of cached files that were hashed.
+	Checked int
+
+	// Evicted are the B64MD5 keys of corrupt files that were removed.
+	Evicted []string
 }
 
 type FileCache struct {
@@ -98,15 +110,28 @@ func addFileAndCheckDigest(c Cache, path string, digest string) error {
 //
 // If the file exists, it will be hashed and overwritten if the hash is different; if
 // the hash is correct, RestoreTo leaves it alone and returns true.
+//
+// The cached file is verified before it's used. If it's corrupt, it is evicted and
+// RestoreTo returns false so that the file is downloaded again.
 func (c *FileCache) RestoreTo(entry ManifestEntry, dst string) bool {
+	digest := entry.LocalDigest()
+	if digest == "" {
+		return false
+	}
 	b64md5, err := utils.ComputeFileB64MD5(dst)
-	if err == nil && b64md5 == entry.Digest {
+	if err == nil && b64md5 == digest {
 		return true
 	}
-	cachePath, err := c.md5Path(entry.Digest)
+	cachePath, err := c.md5Path(digest)
 	if err != nil {
 		return false
 	}
+	if !utils.VerifyFileHash(cachePath, digest) {
+		if err := os.Remove(cachePath); err == nil {
+			slog.Warn("Evicted corrupt file from artifact cache", "path", cachePath)
+		}
+		return false
+	}
 	// TODO (hugh): should we set the LocalPath in the entry to the dst?
 	return utils.CopyFile(cachePath, dst) == nil
 }
@@ -114,8 +139,12 @@ func (c *FileCache) RestoreTo(entry ManifestEntry, dst string) bool {
 // RestoreTo is the same as the FileCache version, but it doesn't copy the file, so it
 // always returns false if the file is missing.
 func (c *HashOnlyCache) RestoreTo(entry ManifestEntry, dst string) bool {
+	digest := entry.LocalDigest()
+	if digest == "" {
+		return false
+	}
 	b64md5, err := utils.ComputeFileB64MD5(dst)
-	return err == nil && b64md5 == entry.Digest
+	return err == nil && b64md5 == digest
 }
 
 func (c *FileCache) md5Path(b64md5 string) (string, error) {
@@ -149,7 +178,9 @@ func (c *FileCache) Write(src io.Reader) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if exists, _ := utils.FileExists(dstPath); exists {
+	// Keep an existing copy only if it's intact, so that writing a file
+	// again repairs a corrupted cache entry.
+	if utils.VerifyFileHash(dstPath, b64md5) {
 		return b64md5, nil
 	}
 	if err := os.MkdirAll(filepath.Dir(dstPath), defaultDirPermissions); err != nil {
@@ -162,6 +193,50 @@ func (c *FileCache) Write(src io.Reader) (string, error) {
 	return b64md5, nil
 }
 
+// VerifyCache hashes every file in the cache and evicts those that don't
+// match their key.
+//
+// Evicted files are downloaded again the next time they're needed.
+func (c *FileCache) VerifyCache() (CacheVerifyResult, error) {
+	var result CacheVerifyResult
+	objDir := filepath.Join(c.root, "obj", "md5")
+
+	err := filepath.WalkDir(objDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == objDir && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		// Files are stored at obj/md5/<first two hex digits>/<rest>.
+		b64md5, err := utils.HexToB64(filepath.Base(filepath.Dir(path)) + d.Name())
+		if err != nil {
+			return nil
+		}
+
+		result.Checked++
+		if utils.VerifyFileHash(path, b64md5) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		result.Evicted = append(result.Evicted, b64md5)
+		return nil
+	})
+
+	return result, err
+}
+
+// VerifyCache does nothing since nothing is cached.
+func (c *HashOnlyCache) VerifyCache() (CacheVerifyResult, error) {
+	return CacheVerifyResult{}, nil
+}
+
 // Write computes and returns the B64MD5 cache key. It doesn't write any data.
 func (c *HashOnlyCache) Write(src io.Reader) (string, error) {
 	return copyWithHash(src, io.Discard)
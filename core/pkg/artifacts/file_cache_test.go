@@ -176,3 +176,98 @@ func TestFileCache_RestoreTo(t *testing.T) {
 	// And if we give it an invalid manifest entry, it should fail.
 	assert.False(t, cache.RestoreTo(ManifestEntry{Digest: "invalid"}, localPath))
 }
+
+func TestFileCache_RestoreTo_EvictsCorruptFile(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	data := []byte("restore data")
+	cacheKey, err := cache.Write(bytes.NewReader(data))
+	require.NoError(t, err)
+	internalPath, err := cache.md5Path(cacheKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(internalPath, []byte("corrupt"), 0o600))
+	localPath := filepath.Join(t.TempDir(), "restore_target.test")
+
+	assert.False(t, cache.RestoreTo(ManifestEntry{Digest: cacheKey}, localPath))
+	assert.NoFileExists(t, localPath)
+	assert.NoFileExists(t, internalPath)
+
+	// Writing the file again repairs the cache.
+	_, err = cache.Write(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.True(t, cache.RestoreTo(ManifestEntry{Digest: cacheKey}, localPath))
+}
+
+func TestFileCache_Write_RepairsCorruptFile(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	data := []byte("test data")
+	cacheKey, err := cache.Write(bytes.NewReader(data))
+	require.NoError(t, err)
+	internalPath, err := cache.md5Path(cacheKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(internalPath, []byte("corrupt"), 0o600))
+
+	_, err = cache.Write(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	readData, err := os.ReadFile(internalPath)
+	require.NoError(t, err)
+	assert.Equal(t, data, readData)
+}
+
+func TestFileCache_VerifyCache(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	goodKey, err := cache.Write(bytes.NewReader([]byte("good")))
+	require.NoError(t, err)
+	badKey, err := cache.Write(bytes.NewReader([]byte("bad")))
+	require.NoError(t, err)
+	badPath, err := cache.md5Path(badKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(badPath, []byte("corrupt"), 0o600))
+
+	result, err := cache.VerifyCache()
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Checked)
+	assert.Equal(t, []string{badKey}, result.Evicted)
+	assert.NoFileExists(t, badPath)
+	goodPath, err := cache.md5Path(goodKey)
+	require.NoError(t, err)
+	assert.FileExists(t, goodPath)
+}
+
+func TestFileCache_VerifyCache_Empty(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	result, err := cache.VerifyCache()
+
+	require.NoError(t, err)
+	assert.Zero(t, result.Checked)
+}
+
+func TestManifestEntry_LocalDigest(t *testing.T) {
+	plain := ManifestEntry{Digest: "stored"}
+	compressed := ManifestEntry{
+		Digest: "stored",
+		Extra: map[string]interface{}{
+			"storedEncoding":     "gzip",
+			"decompressedDigest": "decompressed",
+		},
+	}
+	unverifiable := ManifestEntry{
+		Digest: "stored",
+		Extra:  map[string]interface{}{"storedEncoding": "gzip"},
+	}
+
+	assert.Equal(t, "stored", plain.LocalDigest())
+	assert.Equal(t, "decompressed", compressed.LocalDigest())
+	assert.Equal(t, "gzip", compressed.StoredEncoding())
+	assert.Empty(t, unverifiable.LocalDigest())
+	assert.False(t, NewHashOnlyCache().RestoreTo(unverifiable, "missing"))
+}
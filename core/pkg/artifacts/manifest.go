@@ -36,6 +36,30 @@ type ManifestEntry struct {
 	DownloadURL *string `json:"-"`
 }
 
+// StoredEncoding returns how the file is compressed in storage, like
+// "gzip", or an empty string if it's stored as is.
+//
+// It is recorded in the entry's "storedEncoding" extra.
+func (e ManifestEntry) StoredEncoding() string {
+	encoding, _ := e.Extra["storedEncoding"].(string)
+	return encoding
+}
+
+// LocalDigest returns the B64MD5 digest of the file after downloading.
+//
+// The entry's Digest is of the file as stored. For compressed files, the
+// digest of the decompressed contents is recorded in the
+// "decompressedDigest" extra; if it's missing, LocalDigest returns an empty
+// string and the downloaded file can't be verified.
+func (e ManifestEntry) LocalDigest() string {
+	if e.StoredEncoding() == "" {
+		return e.Digest
+	}
+
+	digest, _ := e.Extra["decompressedDigest"].(string)
+	return digest
+}
+
 func NewManifestFromProto(proto *service.ArtifactManifest) (Manifest, error) {
 	manifest := Manifest{
 		Version:             proto.Version,
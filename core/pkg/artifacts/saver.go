@@ -11,6 +11,8 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/capabilities"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -27,6 +29,19 @@ type ArtifactSaver struct {
 	Artifact    *service.ArtifactRecord
 	HistoryStep int64
 	StagingDir  string
+
+	// Staging is where files staged by core are kept, if any.
+	//
+	// Its files are released rather than deleted after the upload.
+	Staging *StagingArea
+
+	// Capabilities are the backend's optional features, if known.
+	Capabilities *capabilities.Capabilities
+
+	// UploadResult is the outcome of uploading the artifact's files.
+	//
+	// It is set by Save once the files are uploaded, even if some failed.
+	UploadResult *UploadResult
 }
 
 func NewArtifactSaver(
@@ -52,16 +67,6 @@ func (as *ArtifactSaver) createArtifact() (
 	attrs gql.CreateArtifactCreateArtifactCreateArtifactPayloadArtifact,
 	rerr error,
 ) {
-	var aliases []gql.ArtifactAliasInput
-	for _, alias := range as.Artifact.Aliases {
-		aliases = append(aliases,
-			gql.ArtifactAliasInput{
-				ArtifactCollectionName: as.Artifact.Name,
-				Alias:                  alias,
-			},
-		)
-	}
-
 	var runId *string
 	if !as.Artifact.UserCreated {
 		runId = &as.Artifact.RunId
@@ -77,7 +82,7 @@ func (as *ArtifactSaver) createArtifact() (
 		runId,
 		as.Artifact.Digest,
 		utils.NilIfZero(as.Artifact.Description),
-		aliases,
+		as.aliasInputs(),
 		utils.NilIfZero(as.Artifact.Metadata),
 		utils.NilIfZero(as.Artifact.TtlDurationSeconds),
 		utils.NilIfZero(as.HistoryStep),
@@ -91,6 +96,57 @@ func (as *ArtifactSaver) createArtifact() (
 	return response.GetCreateArtifact().GetArtifact(), nil
 }
 
+// aliasInputs returns the artifact's aliases for GraphQL mutations.
+func (as *ArtifactSaver) aliasInputs() []gql.ArtifactAliasInput {
+	var aliases []gql.ArtifactAliasInput
+	for _, alias := range as.Artifact.Aliases {
+		aliases = append(aliases,
+			gql.ArtifactAliasInput{
+				ArtifactCollectionName: as.Artifact.Name,
+				Alias:                  alias,
+			},
+		)
+	}
+	return aliases
+}
+
+// updateCommittedArtifact applies the aliases and metadata to an artifact
+// version that already existed.
+//
+// Creating an artifact with the digest of an existing version returns that
+// version without applying the new aliases and metadata. The aliases are
+// added to the version's existing aliases, which are kept.
+func (as *ArtifactSaver) updateCommittedArtifact(artifactID string) error {
+	if as.Artifact.Metadata != "" {
+		_, err := gql.UpdateArtifact(
+			as.Ctx,
+			as.GraphqlClient,
+			artifactID,
+			&as.Artifact.Metadata,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(as.Artifact.Aliases) == 0 ||
+		!as.Capabilities.Supports(as.Ctx, capabilities.ArtifactAliasAdditions) {
+		return nil
+	}
+
+	var aliases []gql.ArtifactCollectionAliasInput
+	for _, alias := range as.aliasInputs() {
+		aliases = append(aliases, gql.ArtifactCollectionAliasInput{
+			EntityName:             as.Artifact.Entity,
+			ProjectName:            as.Artifact.Project,
+			ArtifactCollectionName: alias.ArtifactCollectionName,
+			Alias:                  alias.Alias,
+		})
+	}
+	_, err := gql.AddAliases(as.Ctx, as.GraphqlClient, artifactID, aliases)
+	return err
+}
+
 func (as *ArtifactSaver) createManifest(
 	artifactId string, baseArtifactId *string, manifestDigest string, includeUpload bool,
 ) (attrs gql.CreateArtifactManifestCreateArtifactManifestCreateArtifactManifestPayloadArtifactManifest, rerr error) {
@@ -123,7 +179,17 @@ func (as *ArtifactSaver) createManifest(
 	return response.GetCreateArtifactManifest().ArtifactManifest, nil
 }
 
-func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, manifestID string, _ chan<- *service.Record) error {
+// uploadFiles uploads the manifest's local files.
+//
+// A file failing to upload doesn't stop the others; the outcome of each is
+// in the returned result. The error is for failures of the whole batch,
+// like not being able to get upload URLs.
+func (as *ArtifactSaver) uploadFiles(
+	artifactID string,
+	manifest *Manifest,
+	manifestID string,
+	_ chan<- *service.Record,
+) (*UploadResult, error) {
 	const batchSize int = 10000
 	const maxBacklog int = 10000
 
@@ -148,6 +214,7 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 	}
 
 	// Upload in batches.
+	result := newUploadResult()
 	numInProgress, numDone := 0, 0
 	nameToScheduledTime := map[string]time.Time{}
 	taskResultsChan := make(chan TaskResult)
@@ -175,10 +242,10 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 				gql.ArtifactStorageLayoutV2,
 			)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if len(fileSpecsBatch) != len(response.CreateArtifactFiles.Files.Edges) {
-				return fmt.Errorf(
+				return nil, fmt.Errorf(
 					"expected %v upload URLs, got %v",
 					len(fileSpecsBatch),
 					len(response.CreateArtifactFiles.Files.Edges),
@@ -191,6 +258,7 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 				entry.BirthArtifactID = &edge.Node.Artifact.Id
 				manifest.Contents[name] = entry
 				if edge.Node.UploadUrl == nil {
+					result.file(name).Status = FileUploadSkipped
 					numDone++
 					continue
 				}
@@ -198,6 +266,7 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 				task := &filetransfer.Task{
 					FileKind: filetransfer.RunFileKindArtifact,
 					Type:     filetransfer.UploadTask,
+					Priority: filetransfer.PriorityHigh,
 					Path:     *entry.LocalPath,
 					Url:      *edge.Node.UploadUrl,
 					Headers:  edge.Node.UploadHeaders,
@@ -213,22 +282,33 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 		// Wait for filetransfer to catch up. If there's nothing more to schedule, wait for all in progress tasks.
 		for numInProgress > maxBacklog || (len(fileSpecsBatch) == 0 && numInProgress > 0) {
 			numInProgress--
-			result := <-taskResultsChan
-			if result.Task.Err != nil {
+			taskResult := <-taskResultsChan
+			fileResult := result.file(taskResult.Name)
+			fileResult.Retries += taskResult.Task.Retries
+			if taskResult.Task.Err != nil {
 				// We want to retry when the signed URL expires. However, distinguishing that error from others is not
 				// trivial. As a heuristic, we retry if the request failed more than an hour after we fetched the URL.
-				if time.Since(nameToScheduledTime[result.Name]) < 1*time.Hour {
-					return result.Task.Err
+				if time.Since(nameToScheduledTime[taskResult.Name]) < 1*time.Hour {
+					fileResult.Status = FileUploadFailed
+					fileResult.Err = taskResult.Task.Err
+					numDone++
+					continue
 				}
-				delete(nameToScheduledTime, result.Name) // retry
+				fileResult.Retries++
+				delete(nameToScheduledTime, taskResult.Name) // retry
 				continue
 			}
+			fileResult.Status = FileUploadSucceeded
 			numDone++
-			entry := manifest.Contents[result.Name]
+			entry := manifest.Contents[taskResult.Name]
 			if !entry.SkipCache {
-				digest := entry.Digest
 				go func() {
-					err := as.FileCache.AddFileAndCheckDigest(result.Task.Path, digest)
+					err := addFileToCache(
+						as.FileCache,
+						taskResult.Task.Path,
+						entry.Digest,
+						entry,
+					)
 					if err != nil {
 						slog.Error("error adding file to cache", "err", err)
 					}
@@ -236,7 +316,7 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 			}
 		}
 	}
-	return nil
+	return result, nil
 }
 
 func (as *ArtifactSaver) resolveClientIDReferences(manifest *Manifest) error {
@@ -277,6 +357,7 @@ func (as *ArtifactSaver) uploadManifest(manifestFile string, uploadUrl *string,
 	task := &filetransfer.Task{
 		FileKind: filetransfer.RunFileKindArtifact,
 		Type:     filetransfer.UploadTask,
+		Priority: filetransfer.PriorityHigh,
 		Path:     manifestFile,
 		Url:      *uploadUrl,
 		Headers:  uploadHeaders,
@@ -293,8 +374,11 @@ func (as *ArtifactSaver) uploadManifest(manifestFile string, uploadUrl *string,
 }
 
 func (as *ArtifactSaver) commitArtifact(artifactID string) error {
+	// Retries of the mutation reuse the key, so the backend can tell
+	// them apart from a second commit.
+	ctx := api.WithIdempotencyKey(as.Ctx, api.NewIdempotencyKey())
 	_, err := gql.CommitArtifact(
-		as.Ctx,
+		ctx,
 		as.GraphqlClient,
 		artifactID,
 	)
@@ -303,6 +387,9 @@ func (as *ArtifactSaver) commitArtifact(artifactID string) error {
 
 func (as *ArtifactSaver) deleteStagingFiles(manifest *Manifest) {
 	for _, entry := range manifest.Contents {
+		if entry.LocalPath != nil && as.Staging.Release(*entry.LocalPath) {
+			continue
+		}
 		if entry.LocalPath != nil && strings.HasPrefix(*entry.LocalPath, as.StagingDir) {
 			// We intentionally ignore errors below.
 			_ = os.Chmod(*entry.LocalPath, 0600)
@@ -311,6 +398,10 @@ func (as *ArtifactSaver) deleteStagingFiles(manifest *Manifest) {
 	}
 }
 
+// Save uploads and commits the artifact, returning its ID.
+//
+// If only some of its files fail to upload, the artifact is not committed
+// and the error wraps an *UploadError with the outcome of every file.
 func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rerr error) {
 	manifest, err := NewManifestFromProto(as.Artifact.Manifest)
 	if err != nil {
@@ -319,6 +410,15 @@ func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rer
 
 	defer as.deleteStagingFiles(&manifest)
 
+	aliases, err := NormalizeAliases(as.Artifact.Aliases)
+	if err != nil {
+		return "", err
+	}
+	as.Artifact.Aliases = aliases
+	if err := ValidateMetadata(as.Artifact.Metadata); err != nil {
+		return "", err
+	}
+
 	artifactAttrs, err := as.createArtifact()
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.createArtifact: %w", err)
@@ -331,6 +431,9 @@ func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rer
 		baseArtifactId = &artifactAttrs.ArtifactSequence.LatestArtifact.Id
 	}
 	if artifactAttrs.State == gql.ArtifactStateCommitted {
+		if err := as.updateCommittedArtifact(artifactID); err != nil {
+			return "", fmt.Errorf("ArtifactSaver.updateCommittedArtifact: %w", err)
+		}
 		if as.Artifact.UseAfterCommit {
 			_, err := gql.UseArtifact(
 				as.Ctx,
@@ -358,7 +461,11 @@ func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rer
 		return "", fmt.Errorf("ArtifactSaver.createManifest: %w", err)
 	}
 
-	err = as.uploadFiles(artifactID, &manifest, manifestAttrs.Id, ch)
+	uploadResult, err := as.uploadFiles(artifactID, &manifest, manifestAttrs.Id, ch)
+	if err == nil {
+		as.UploadResult = uploadResult
+		err = uploadResult.Err()
+	}
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.uploadFiles: %w", err)
 	}
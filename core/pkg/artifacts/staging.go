@@ -0,0 +1,160 @@
+package artifacts
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// StagingArea holds copies of files waiting to be uploaded.
+//
+// Staged files are named by the hex MD5 digest of their content, so staging
+// the same content twice reuses the existing copy. Each Stage call must be
+// matched by a Release call; a staged file is deleted once nothing
+// references it.
+//
+// Release and RemoveAll do nothing on a nil StagingArea.
+type StagingArea struct {
+	mu sync.Mutex
+
+	// dir is the directory containing staged files.
+	dir string
+
+	// refs is the number of unreleased Stage calls for each staged path.
+	refs map[string]int
+}
+
+func NewStagingArea(dir string) *StagingArea {
+	return &StagingArea{dir: dir, refs: make(map[string]int)}
+}
+
+// Stage copies the file at the path into the staging area and returns the
+// path of the copy.
+//
+// If a file with the same content is already staged, it is reused.
+func (s *StagingArea) Stage(path string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if digest, err := hexMD5File(path); err == nil {
+		stagedPath := s.stagedPath(digest)
+		if s.refs[stagedPath] > 0 {
+			s.refs[stagedPath]++
+			return stagedPath, nil
+		}
+	}
+
+	// The file may change after it's hashed, so name the copy by what was
+	// actually copied.
+	tmpPath, digest, err := s.copyToTemp(path)
+	if err != nil {
+		return "", err
+	}
+
+	stagedPath := s.stagedPath(digest)
+	if s.refs[stagedPath] > 0 {
+		_ = os.Remove(tmpPath)
+	} else if err := os.Rename(tmpPath, stagedPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return "", err
+	}
+
+	s.refs[stagedPath]++
+	return stagedPath, nil
+}
+
+// Release drops a reference to a staged file, deleting it if it was the
+// last one.
+//
+// Returns false if the path isn't a file in this staging area.
+func (s *StagingArea) Release(stagedPath string) bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	refs, ok := s.refs[stagedPath]
+	if !ok {
+		return false
+	}
+
+	if refs > 1 {
+		s.refs[stagedPath] = refs - 1
+	} else {
+		delete(s.refs, stagedPath)
+		_ = os.Remove(stagedPath)
+	}
+	return true
+}
+
+// RemoveAll deletes all staged files, including ones still referenced.
+//
+// It is for cleaning up after uploads that were abandoned.
+func (s *StagingArea) RemoveAll() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for stagedPath := range s.refs {
+		_ = os.Remove(stagedPath)
+	}
+	clear(s.refs)
+}
+
+func (s *StagingArea) stagedPath(digest string) string {
+	return filepath.Join(s.dir, digest)
+}
+
+// copyToTemp copies the file into a temporary file in the staging
+// directory and returns its path and the hex MD5 digest of its content.
+func (s *StagingArea) copyToTemp(path string) (string, string, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", "", err
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(s.dir, ".staging-*")
+	if err != nil {
+		return "", "", err
+	}
+
+	hasher := md5.New()
+	_, err = io.Copy(dst, io.TeeReader(src, hasher))
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", "", err
+	}
+
+	return dst.Name(), hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// hexMD5File returns the hex MD5 digest of the file's content.
+func hexMD5File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hasher := md5.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
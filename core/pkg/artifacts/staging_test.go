@@ -0,0 +1,69 @@
+package artifacts_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/artifacts"
+)
+
+func TestStagingArea_SharesIdenticalContent(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.txt": "same",
+		"b.txt": "same",
+		"c.txt": "different",
+	})
+	staging := artifacts.NewStagingArea(filepath.Join(dir, "staging"))
+
+	stagedA, err := staging.Stage(filepath.Join(dir, "a.txt"))
+	require.NoError(t, err)
+	stagedB, err := staging.Stage(filepath.Join(dir, "b.txt"))
+	require.NoError(t, err)
+	stagedC, err := staging.Stage(filepath.Join(dir, "c.txt"))
+	require.NoError(t, err)
+
+	assert.Equal(t, stagedA, stagedB)
+	assert.NotEqual(t, stagedA, stagedC)
+	// Hex MD5 of "same".
+	assert.Equal(t, "51037a4a37730f52c8732586d3aaa316", filepath.Base(stagedA))
+	entries, err := os.ReadDir(filepath.Join(dir, "staging"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestStagingArea_DeletesAfterLastRelease(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"a.txt": "content"})
+	staging := artifacts.NewStagingArea(filepath.Join(dir, "staging"))
+	staged1, _ := staging.Stage(filepath.Join(dir, "a.txt"))
+	staged2, _ := staging.Stage(filepath.Join(dir, "a.txt"))
+
+	assert.True(t, staging.Release(staged1))
+	assert.FileExists(t, staged2)
+	assert.True(t, staging.Release(staged2))
+	assert.NoFileExists(t, staged2)
+	assert.False(t, staging.Release(staged2))
+}
+
+func TestStagingArea_RemoveAll(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"a.txt": "content"})
+	staging := artifacts.NewStagingArea(filepath.Join(dir, "staging"))
+	staged, _ := staging.Stage(filepath.Join(dir, "a.txt"))
+
+	staging.RemoveAll()
+
+	assert.NoFileExists(t, staged)
+	assert.False(t, staging.Release(staged))
+}
+
+func TestStagingArea_NilRelease(t *testing.T) {
+	var staging *artifacts.StagingArea
+
+	assert.False(t, staging.Release("path"))
+	staging.RemoveAll()
+}
@@ -0,0 +1,154 @@
+package artifacts
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// StreamFile writes the contents of the artifact's file at the path to w
+// without saving it to disk.
+//
+// The streamed bytes are checked against the file's digest. If the download
+// is interrupted, it is restarted only if w implements io.Seeker; pass a
+// writer that can't seek to opt out of retrying.
+func (ad *ArtifactDownloader) StreamFile(path string, w io.Writer) error {
+	manifest, err := ad.getArtifactManifest(ad.ArtifactID)
+	if err != nil {
+		return err
+	}
+
+	entry, err := manifest.GetManifestEntryFromArtifactFilePath(path)
+	if err != nil {
+		return err
+	}
+	if entry.Ref != nil {
+		return fmt.Errorf("artifacts: cannot stream reference %s", path)
+	}
+
+	url, err := ad.fileURL(path)
+	if err != nil {
+		return err
+	}
+
+	return StreamEntry(ad.DownloadManager, entry, url, w)
+}
+
+// fileURL returns the URL to download the artifact's file at the path.
+func (ad *ArtifactDownloader) fileURL(path string) (string, error) {
+	response, err := gql.ArtifactFileURL(
+		ad.Ctx,
+		ad.GraphqlClient,
+		ad.ArtifactID,
+		path,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, edge := range response.GetArtifact().GetFiles().Edges {
+		if node := edge.GetNode(); node != nil && node.Name == path {
+			return node.DirectUrl, nil
+		}
+	}
+	return "", fmt.Errorf("artifacts: no download URL for %s", path)
+}
+
+// StreamEntry downloads the entry's file from the URL to w using the
+// download manager, and checks the streamed bytes against its digest.
+//
+// See StreamFile for how interrupted downloads are retried.
+func StreamEntry(
+	downloadManager filetransfer.FileTransferManager,
+	entry ManifestEntry,
+	url string,
+	w io.Writer,
+) error {
+	digester := newDigestWriter(w)
+
+	done := make(chan error, 1)
+	task := &filetransfer.Task{
+		FileKind:       filetransfer.RunFileKindArtifact,
+		Type:           filetransfer.DownloadTask,
+		Url:            url,
+		StoredEncoding: entry.StoredEncoding(),
+		Writer:         digester,
+	}
+	task.SetCompletionCallback(func(t *filetransfer.Task) { done <- t.Err })
+	downloadManager.AddTask(task)
+
+	if err := <-done; err != nil {
+		return err
+	}
+
+	expected := entry.LocalDigest()
+	if expected == "" {
+		return nil
+	}
+	if actual := digester.digest(); actual != expected {
+		return fmt.Errorf(
+			"artifacts: file hash mismatch: expected %s, actual %s",
+			expected, actual)
+	}
+	return nil
+}
+
+// digestWriter computes the B64MD5 of the bytes written through it.
+type digestWriter struct {
+	w      io.Writer
+	hasher hash.Hash
+}
+
+// seekableDigestWriter is a digestWriter for a writer that can be rewound.
+type seekableDigestWriter struct {
+	*digestWriter
+	seeker io.Seeker
+}
+
+// newDigestWriter returns a writer that passes bytes to w and computes
+// their MD5.
+//
+// The result implements io.Seeker if w does, so that downloads through it
+// can be restarted.
+func newDigestWriter(w io.Writer) interface {
+	io.Writer
+	digest() string
+} {
+	dw := &digestWriter{w: w, hasher: md5.New()}
+	if seeker, ok := w.(io.Seeker); ok {
+		return &seekableDigestWriter{digestWriter: dw, seeker: seeker}
+	}
+	return dw
+}
+
+func (dw *digestWriter) Write(p []byte) (int, error) {
+	n, err := dw.w.Write(p)
+	_, _ = dw.hasher.Write(p[:n])
+	return n, err
+}
+
+func (dw *digestWriter) digest() string {
+	return base64.StdEncoding.EncodeToString(dw.hasher.Sum(nil))
+}
+
+// Seek rewinds the underlying writer and resets the digest.
+//
+// Only seeking to the start is supported.
+func (sw *seekableDigestWriter) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, errors.New("artifacts: can only seek to the start")
+	}
+
+	pos, err := sw.seeker.Seek(0, io.SeekStart)
+	if err != nil {
+		return pos, err
+	}
+	sw.hasher.Reset()
+	return pos, nil
+}
@@ -0,0 +1,109 @@
+package artifacts_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// rewindableBuffer is a buffer that can be seeked back to the start.
+type rewindableBuffer struct {
+	bytes.Buffer
+}
+
+func (b *rewindableBuffer) Seek(offset int64, whence int) (int64, error) {
+	b.Reset()
+	return 0, nil
+}
+
+func newDownloadManager(t *testing.T) filetransfer.FileTransferManager {
+	t.Helper()
+	manager := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(observability.NewNoOpLogger()),
+		filetransfer.WithFileTransfer(filetransfer.NewDefaultFileTransfer(
+			retryablehttp.NewClient(),
+			observability.NewNoOpLogger(),
+			filetransfer.NewFileTransferStats(),
+			0,
+		)),
+		filetransfer.WithFileTransferStats(filetransfer.NewFileTransferStats()),
+	)
+	manager.Start()
+	t.Cleanup(manager.Close)
+	return manager
+}
+
+// serveInterrupted serves the content, cutting off the first response
+// halfway through.
+func serveInterrupted(t *testing.T, content []byte) *httptest.Server {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			if requests.Add(1) == 1 {
+				_, _ = w.Write(content[:len(content)/2])
+				return
+			}
+			_, _ = w.Write(content)
+		}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestStreamEntry_VerifiesDigest(t *testing.T) {
+	content := []byte("streamed artifact content")
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(content)
+		}))
+	defer server.Close()
+	manager := newDownloadManager(t)
+
+	var out bytes.Buffer
+	err := artifacts.StreamEntry(manager,
+		artifacts.ManifestEntry{Digest: artifacts.DigestMD5.ComputeDigest(content)},
+		server.URL, &out)
+	assert.NoError(t, err)
+	assert.Equal(t, content, out.Bytes())
+
+	err = artifacts.StreamEntry(manager,
+		artifacts.ManifestEntry{Digest: artifacts.DigestMD5.ComputeDigest([]byte("other"))},
+		server.URL, io.Discard)
+	assert.ErrorContains(t, err, "file hash mismatch")
+}
+
+func TestStreamEntry_RestartsSeekableWriter(t *testing.T) {
+	content := []byte("content that is interrupted the first time")
+	server := serveInterrupted(t, content)
+
+	var out rewindableBuffer
+	err := artifacts.StreamEntry(newDownloadManager(t),
+		artifacts.ManifestEntry{Digest: artifacts.DigestMD5.ComputeDigest(content)},
+		server.URL, &out)
+
+	assert.NoError(t, err)
+	assert.Equal(t, content, out.Bytes())
+}
+
+func TestStreamEntry_NoRestartWithoutSeek(t *testing.T) {
+	content := []byte("content that is interrupted the first time")
+	server := serveInterrupted(t, content)
+
+	var out bytes.Buffer
+	err := artifacts.StreamEntry(newDownloadManager(t),
+		artifacts.ManifestEntry{Digest: artifacts.DigestMD5.ComputeDigest(content)},
+		server.URL, &out)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package artifacts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// maxReportedFailures is how many failed files an UploadError names.
+const maxReportedFailures = 5
+
+// FileUploadStatus is the outcome of uploading one of an artifact's files.
+type FileUploadStatus int
+
+const (
+	// FileUploadSucceeded means the file was uploaded.
+	FileUploadSucceeded FileUploadStatus = iota
+
+	// FileUploadSkipped means the backend already had the file's contents,
+	// so it didn't need to be uploaded.
+	FileUploadSkipped
+
+	// FileUploadFailed means the file could not be uploaded.
+	FileUploadFailed
+)
+
+func (s FileUploadStatus) String() string {
+	switch s {
+	case FileUploadSucceeded:
+		return "succeeded"
+	case FileUploadSkipped:
+		return "skipped"
+	case FileUploadFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("FileUploadStatus(%d)", int(s))
+	}
+}
+
+// FileUploadResult is the outcome of uploading one file.
+type FileUploadResult struct {
+	// Name is the file's path in the artifact.
+	Name string
+
+	Status FileUploadStatus
+
+	// Retries is how many times the upload was retried, whether after a
+	// transient error or with a new upload URL.
+	Retries int
+
+	// Err is why the upload failed, if it did.
+	Err error
+}
+
+// UploadResult is the outcome of uploading an artifact's files.
+//
+// Some files may fail while the rest succeed; callers decide whether
+// partial success is acceptable.
+type UploadResult struct {
+	// Files is the result for each file, by its path in the artifact.
+	Files map[string]*FileUploadResult
+}
+
+func newUploadResult() *UploadResult {
+	return &UploadResult{Files: make(map[string]*FileUploadResult)}
+}
+
+// file returns the result for the file, creating it if necessary.
+func (r *UploadResult) file(name string) *FileUploadResult {
+	result, ok := r.Files[name]
+	if !ok {
+		result = &FileUploadResult{Name: name}
+		r.Files[name] = result
+	}
+	return result
+}
+
+// Failed returns the results of the files that failed, sorted by name.
+func (r *UploadResult) Failed() []*FileUploadResult {
+	var failed []*FileUploadResult
+	for _, result := range r.Files {
+		if result.Status == FileUploadFailed {
+			failed = append(failed, result)
+		}
+	}
+
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].Name < failed[j].Name
+	})
+	return failed
+}
+
+// Err returns an *UploadError if any file failed, and nil otherwise.
+func (r *UploadResult) Err() error {
+	if len(r.Failed()) == 0 {
+		return nil
+	}
+	return &UploadError{Result: r}
+}
+
+// ToProto returns the result of each file, sorted by name.
+func (r *UploadResult) ToProto() []*service.ArtifactFileUploadResult {
+	results := make([]*service.ArtifactFileUploadResult, 0, len(r.Files))
+	for _, result := range r.Files {
+		resultProto := &service.ArtifactFileUploadResult{
+			Name:    result.Name,
+			Retries: int32(result.Retries),
+		}
+
+		switch result.Status {
+		case FileUploadSucceeded:
+			resultProto.Status = service.ArtifactFileUploadResult_SUCCEEDED
+		case FileUploadSkipped:
+			resultProto.Status = service.ArtifactFileUploadResult_SKIPPED
+		case FileUploadFailed:
+			resultProto.Status = service.ArtifactFileUploadResult_FAILED
+		}
+		if result.Err != nil {
+			resultProto.ErrorMessage = result.Err.Error()
+		}
+
+		results = append(results, resultProto)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+	return results
+}
+
+// UploadError is returned when some of an artifact's files fail to upload.
+//
+// Its Result describes what happened to every file.
+type UploadError struct {
+	Result *UploadResult
+}
+
+func (e *UploadError) Error() string {
+	failed := e.Result.Failed()
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "failed to upload %d of %d files",
+		len(failed), len(e.Result.Files))
+
+	for i, result := range failed {
+		if i == maxReportedFailures {
+			fmt.Fprintf(&message, "; and %d more", len(failed)-i)
+			break
+		}
+		fmt.Fprintf(&message, "; %s: %v", result.Name, result.Err)
+	}
+
+	return message.String()
+}
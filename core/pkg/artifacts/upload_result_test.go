@@ -0,0 +1,72 @@
+package artifacts
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestUploadResult_AllSucceeded(t *testing.T) {
+	result := newUploadResult()
+	result.file("a.txt").Status = FileUploadSucceeded
+	result.file("b.txt").Status = FileUploadSkipped
+
+	assert.Empty(t, result.Failed())
+	assert.NoError(t, result.Err())
+}
+
+func TestUploadResult_PartialFailure(t *testing.T) {
+	result := newUploadResult()
+	result.file("ok.txt").Status = FileUploadSucceeded
+	retried := result.file("retried.txt")
+	retried.Retries = 2
+	retried.Status = FileUploadSucceeded
+	failed := result.file("bad.txt")
+	failed.Status = FileUploadFailed
+	failed.Err = errors.New("403 Forbidden")
+
+	err := fmt.Errorf("ArtifactSaver.uploadFiles: %w", result.Err())
+
+	var uploadErr *UploadError
+	require.ErrorAs(t, err, &uploadErr)
+	assert.Equal(t, []*FileUploadResult{failed}, uploadErr.Result.Failed())
+	assert.Equal(t, 2, uploadErr.Result.Files["retried.txt"].Retries)
+	assert.ErrorContains(t, err, "failed to upload 1 of 3 files; bad.txt: 403 Forbidden")
+}
+
+func TestUploadError_LimitsReportedFiles(t *testing.T) {
+	result := newUploadResult()
+	for i := range maxReportedFailures + 2 {
+		file := result.file(fmt.Sprintf("file%d.txt", i))
+		file.Status = FileUploadFailed
+		file.Err = errors.New("error")
+	}
+
+	assert.ErrorContains(t, result.Err(), "; and 2 more")
+	assert.NotContains(t, result.Err().Error(), "file6.txt")
+}
+
+func TestUploadResult_ToProto(t *testing.T) {
+	result := newUploadResult()
+	retried := result.file("b.txt")
+	retried.Status = FileUploadSucceeded
+	retried.Retries = 3
+	failed := result.file("a.txt")
+	failed.Status = FileUploadFailed
+	failed.Err = errors.New("403 Forbidden")
+
+	files := result.ToProto()
+
+	require.Len(t, files, 2)
+	assert.Equal(t, "a.txt", files[0].Name)
+	assert.Equal(t, service.ArtifactFileUploadResult_FAILED, files[0].Status)
+	assert.Equal(t, "403 Forbidden", files[0].ErrorMessage)
+	assert.Equal(t, "b.txt", files[1].Name)
+	assert.Equal(t, service.ArtifactFileUploadResult_SUCCEEDED, files[1].Status)
+	assert.EqualValues(t, 3, files[1].Retries)
+}
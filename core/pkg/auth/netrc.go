@@ -123,5 +123,5 @@ func GetNetrcLogin(machine string) (string, string, error) {
 			return l.Login, l.Password, nil
 		}
 	}
-	return "", "", fmt.Errorf("no entry for %s in %s", machine, netrcLines)
+	return "", "", fmt.Errorf("no entry for %s in netrc", machine)
 }
@@ -14,6 +14,9 @@ type CollectorState struct {
 	//
 	// This is sent with the final transmission.
 	Complete *bool
+
+	// OnSent are callbacks to run after the next request succeeds.
+	OnSent []func()
 }
 
 // CollectorStateUpdate is a mutation to a CollectorState.
@@ -38,7 +41,15 @@ func (s *CollectorState) Consume(
 		hasData = true
 	}
 
+	// Send a request even if there's no data so that callbacks run after
+	// earlier requests are acknowledged.
+	if len(s.OnSent) > 0 {
+		transmitData.onSent = s.OnSent
+		hasData = true
+	}
+
 	s.Buffer = TransmitChunk{}
+	s.OnSent = nil
 
 	return &transmitData, hasData
 }
@@ -2,12 +2,15 @@
 package filestream
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"sync"
 	"time"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/droppeddata"
+	"github.com/wandb/wandb/core/internal/healthprobe"
 	"github.com/wandb/wandb/core/internal/waiting"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -26,7 +29,7 @@ const (
 	// Maximum line length for filestream jsonl files, imposed by the back-end.
 	//
 	// See https://github.com/wandb/core/pull/7339 for history.
-	maxFileLineBytes = (10 << 20) - (100 << 10)
+	MaxFileLineBytes = (10 << 20) - (100 << 10)
 )
 
 type ChunkTypeEnum int8
@@ -64,6 +67,11 @@ type FileStream interface {
 	// Close waits for all work to be completed.
 	Close()
 
+	// Abort stops sending data, abandoning any that is not yet sent.
+	//
+	// Requests in progress are canceled. Close must still be called.
+	Abort()
+
 	// StreamUpdate uploads information through the filestream API.
 	StreamUpdate(update Update)
 }
@@ -98,15 +106,29 @@ type fileStream struct {
 	maxItemsPerPush int
 	delayProcess    waiting.Delay
 
+	// flushEveryNPoints ends a batch once it has this many history
+	// points; it is zero if batches aren't limited by points.
+	flushEveryNPoints int
+
 	// A schedule on which to send heartbeats to the backend
 	// to prove the run is still alive.
 	heartbeatStopwatch waiting.Stopwatch
 
 	clientId string
 
+	// healthStatus records successful requests; it may be nil.
+	healthStatus *healthprobe.Status
+
+	// droppedData counts data that is not sent; it may be nil.
+	droppedData *droppeddata.Tracker
+
 	// A channel that is closed if there is a fatal error.
 	deadChan     chan struct{}
 	deadChanOnce *sync.Once
+
+	// ctx is canceled by Abort to cancel requests in progress.
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 type FileStreamParams struct {
@@ -118,6 +140,22 @@ type FileStreamParams struct {
 	ClientId           string
 	DelayProcess       waiting.Delay
 	HeartbeatStopwatch waiting.Stopwatch
+
+	// HealthStatus, if not nil, records successful requests.
+	HealthStatus *healthprobe.Status
+
+	// DroppedData, if not nil, counts data that is dropped instead of sent.
+	DroppedData *droppeddata.Tracker
+
+	// FlushEveryNPoints, if positive, sends a batch as soon as it has this
+	// many history points, without waiting for DelayProcess.
+	FlushEveryNPoints int
+
+	// Connections is the number of concurrent filestream connections.
+	//
+	// Each file is sent through a single connection, so at most one
+	// connection per file is used. Values below 2 use one connection.
+	Connections int
 }
 
 func NewFileStream(params FileStreamParams) FileStream {
@@ -129,11 +167,23 @@ func NewFileStream(params FileStreamParams) FileStream {
 		panic("filestream: nil printer")
 	}
 
+	if connections := min(params.Connections, len(chunkFilename)); connections > 1 {
+		shards := make([]FileStream, connections)
+		for i := range shards {
+			shardParams := params
+			shardParams.Connections = 1
+			shards[i] = NewFileStream(shardParams)
+		}
+		return newShardedFileStream(shards)
+	}
+
 	fs := &fileStream{
 		settings:        params.Settings,
 		logger:          params.Logger,
 		printer:         params.Printer,
 		apiClient:       params.ApiClient,
+		healthStatus:    params.HealthStatus,
+		droppedData:     params.DroppedData,
 		processChan:     make(chan Update, BufferSize),
 		transmitChan:    make(chan CollectorStateUpdate, BufferSize),
 		feedbackChan:    make(chan map[string]interface{}, BufferSize),
@@ -143,6 +193,7 @@ func NewFileStream(params FileStreamParams) FileStream {
 		deadChanOnce:    &sync.Once{},
 		deadChan:        make(chan struct{}),
 	}
+	fs.ctx, fs.cancel = context.WithCancel(context.Background())
 
 	fs.delayProcess = params.DelayProcess
 	if fs.delayProcess == nil {
@@ -158,6 +209,10 @@ func NewFileStream(params FileStreamParams) FileStream {
 		fs.maxItemsPerPush = params.MaxItemsPerPush
 	}
 
+	if params.FlushEveryNPoints > 0 {
+		fs.flushEveryNPoints = params.FlushEveryNPoints
+	}
+
 	// TODO: this should become the default
 	if fs.settings.GetXShared().GetValue() && params.ClientId != "" {
 		fs.clientId = params.ClientId
@@ -203,9 +258,16 @@ func (fs *fileStream) StreamUpdate(update Update) {
 func (fs *fileStream) Close() {
 	close(fs.processChan)
 	fs.feedbackWait.Wait()
+	fs.cancel()
 	fs.logger.Debug("filestream: closed")
 }
 
+func (fs *fileStream) Abort() {
+	fs.logger.Info("filestream: aborting")
+	fs.cancel()
+	fs.deadChanOnce.Do(func() { close(fs.deadChan) })
+}
+
 // logFatalAndStopWorking logs a fatal error and kills the filestream.
 //
 // After this, most filestream operations are no-ops. This is meant for
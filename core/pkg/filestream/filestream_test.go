@@ -42,6 +42,7 @@ func TestFileStream(t *testing.T) {
 	var printer *observability.Printer
 	var heartbeatStopwatch waiting.Stopwatch
 	var processDelay waiting.Delay
+	var flushEveryNPoints int
 
 	setup := func(configure func()) filestream.FileStream {
 		fakeClient = apitest.NewFakeClient("test-url")
@@ -49,6 +50,7 @@ func TestFileStream(t *testing.T) {
 		// By default, chunk everything and prevent heartbeats.
 		heartbeatStopwatch = waitingtest.NewFakeStopwatch()
 		processDelay = waitingtest.NewFakeDelay()
+		flushEveryNPoints = 0
 
 		// Allow tests to override the above objects.
 		configure()
@@ -60,6 +62,7 @@ func TestFileStream(t *testing.T) {
 			ApiClient:          fakeClient,
 			DelayProcess:       processDelay,
 			HeartbeatStopwatch: heartbeatStopwatch,
+			FlushEveryNPoints:  flushEveryNPoints,
 		})
 	}
 
@@ -90,6 +93,27 @@ func TestFileStream(t *testing.T) {
 			string(req.Body))
 	})
 
+	t.Run("sends batch after N history points", func(t *testing.T) {
+		fs := setup(func() {
+			flushEveryNPoints = 2
+		})
+
+		fakeClient.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+		fs.Start("entity", "project", "run", filestream.FileStreamOffsetMap{})
+		fs.StreamUpdate(NewHistoryRecord())
+		fs.StreamUpdate(NewHistoryRecord())
+		fakeClient.WaitUntilRequestCount(t, 1, 5*time.Second)
+		fs.StreamUpdate(NewHistoryRecord())
+		fs.Close()
+
+		requests := fakeClient.GetRequests()
+		assert.Len(t, requests, 3) // 2 batches + 1 final transmission
+		assert.Equal(t, 2,
+			bytes.Count(requests[0].Body, []byte("test_key")))
+		assert.Equal(t, 1,
+			bytes.Count(requests[1].Body, []byte("test_key")))
+	})
+
 	t.Run("sends heartbeat", func(t *testing.T) {
 		fakeHeartbeat := waitingtest.NewFakeStopwatch()
 		fs := setup(func() {
@@ -133,6 +157,28 @@ func TestFileStream(t *testing.T) {
 			string(fakeClient.GetRequests()[0].Body))
 	})
 
+	t.Run("runs flush callback after sending earlier updates", func(t *testing.T) {
+		fs := setup(func() {
+			processDelay = waiting.NoDelay()
+		})
+		sent := make(chan []byte, 1)
+
+		fs.Start("entity", "project", "run", filestream.FileStreamOffsetMap{})
+		fakeClient.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+		fs.StreamUpdate(NewHistoryRecord())
+		fs.StreamUpdate(&filestream.FlushUpdate{
+			OnSent: func() { sent <- fakeClient.GetRequests()[0].Body },
+		})
+
+		select {
+		case firstRequest := <-sent:
+			assert.Contains(t, string(firstRequest), "test_key")
+		case <-time.After(5 * time.Second):
+			t.Fatal("flush callback did not run")
+		}
+		fs.Close()
+	})
+
 	t.Run("shuts down on HTTP failure", func(t *testing.T) {
 		fakeBatchDelay := waitingtest.NewFakeDelay()
 		fs := setup(func() {
@@ -152,4 +198,67 @@ func TestFileStream(t *testing.T) {
 		assert.Len(t, messages, 1)
 		assert.Contains(t, messages[0], "Fatal error")
 	})
+
+	t.Run("abandons data after abort", func(t *testing.T) {
+		fs := setup(func() {})
+
+		fakeClient.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+		fs.Start("entity", "project", "run", filestream.FileStreamOffsetMap{})
+		fs.Abort()
+		fs.StreamUpdate(NewHistoryRecord())
+		fs.Close()
+
+		assert.Empty(t, fakeClient.GetRequests())
+		assert.Empty(t, printer.Read())
+	})
+}
+
+func TestFileStream_Sharded(t *testing.T) {
+	fakeClient := apitest.NewFakeClient("test-url")
+	fakeClient.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+	fs := filestream.NewFileStream(filestream.FileStreamParams{
+		Settings:    &service.Settings{},
+		Logger:      observability.NewNoOpLogger(),
+		Printer:     observability.NewPrinter(),
+		ApiClient:   fakeClient,
+		Connections: 2,
+	})
+	flushed := make(chan struct{})
+
+	fs.Start("entity", "project", "run", filestream.FileStreamOffsetMap{})
+	fs.StreamUpdate(NewHistoryRecord())
+	fs.StreamUpdate(&filestream.LogsUpdate{
+		Record: &service.OutputRawRecord{Line: "line\n"},
+	})
+	fs.StreamUpdate(&filestream.ExitUpdate{
+		Record: &service.RunExitRecord{ExitCode: 1},
+	})
+	fs.StreamUpdate(&filestream.FlushUpdate{
+		OnSent: func() { close(flushed) },
+	})
+	<-flushed
+	fs.Close()
+
+	requests := fakeClient.GetRequests()
+	var historyRequests, outputRequests, exitRequests []int
+	for i, req := range requests {
+		var data map[string]any
+		assert.NoError(t, json.Unmarshal(req.Body, &data))
+		files, _ := data["files"].(map[string]any)
+		if _, ok := files[filestream.HistoryFileName]; ok {
+			historyRequests = append(historyRequests, i)
+		}
+		if _, ok := files[filestream.OutputFileName]; ok {
+			outputRequests = append(outputRequests, i)
+		}
+		if data["complete"] == true {
+			exitRequests = append(exitRequests, i)
+		}
+	}
+
+	assert.Len(t, historyRequests, 1)
+	assert.Len(t, outputRequests, 1)
+	assert.NotContains(t, outputRequests, historyRequests[0])
+	// The run is marked complete last, after all shards are done.
+	assert.Equal(t, []int{len(requests) - 1}, exitRequests)
 }
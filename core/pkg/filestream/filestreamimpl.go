@@ -18,6 +18,9 @@ type FsTransmitData struct {
 	Preempting bool                          `json:"preempting,omitempty"`
 	Dropped    int32                         `json:"dropped,omitempty"`
 	Uploaded   []string                      `json:"uploaded,omitempty"`
+
+	// onSent are callbacks to run after the request succeeds.
+	onSent []func()
 }
 
 // FsServerFileData (part of FsTransmitData) is serialized and sent to a W&B server
@@ -45,6 +48,8 @@ func (fs *fileStream) loopProcess() {
 
 			Logger:  fs.logger,
 			Printer: fs.printer,
+
+			DroppedData: fs.droppedData,
 		})
 
 		if err != nil {
@@ -146,6 +151,10 @@ func (fs *fileStream) collectBatch(
 	maxChunkWait := fs.delayProcess.Wait()
 
 	for keepGoing := true; keepGoing; {
+		if fs.isBatchFull(state) {
+			break
+		}
+
 		select {
 		case update, ok := <-updates:
 			if ok {
@@ -159,6 +168,13 @@ func (fs *fileStream) collectBatch(
 	}
 }
 
+// isBatchFull reports whether the batch has enough history points to send
+// without waiting for more updates.
+func (fs *fileStream) isBatchFull(state *CollectorState) bool {
+	return fs.flushEveryNPoints > 0 &&
+		len(state.Buffer.HistoryLines) >= fs.flushEveryNPoints
+}
+
 func (fs *fileStream) sendAll(
 	data <-chan *FsTransmitData,
 	feedbackChan chan<- map[string]any,
@@ -167,7 +183,10 @@ func (fs *fileStream) sendAll(
 		err := fs.send(x, feedbackChan)
 
 		if err != nil {
-			fs.logFatalAndStopWorking(err)
+			// Errors after Abort are expected.
+			if fs.ctx.Err() == nil {
+				fs.logFatalAndStopWorking(err)
+			}
 			break
 		}
 	}
@@ -193,11 +212,16 @@ func (fs *fileStream) send(
 	fs.logger.Debug("filestream: post request", "request", string(jsonData))
 
 	req := &api.Request{
-		Method: http.MethodPost,
-		Path:   fs.path,
-		Body:   jsonData,
+		Method:  http.MethodPost,
+		Path:    fs.path,
+		Body:    jsonData,
+		Context: fs.ctx,
 		Headers: map[string]string{
 			"Content-Type": "application/json",
+
+			// The API client reuses the key when retrying, so the backend
+			// can drop the batch if it was already processed.
+			api.IdempotencyKeyHeader: api.NewIdempotencyKey(),
 		},
 	}
 
@@ -228,5 +252,10 @@ func (fs *fileStream) send(
 	}
 	feedbackChan <- res
 	fs.logger.Debug("filestream: post response", "response", res)
+	fs.healthStatus.FileStreamSynced()
+
+	for _, onSent := range data.onSent {
+		onSent()
+	}
 	return nil
 }
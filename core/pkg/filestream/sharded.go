@@ -0,0 +1,110 @@
+package filestream
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// shardedFileStream spreads updates across several filestream connections.
+//
+// Each file is always sent through the same shard so that its lines stay
+// in order and its offsets stay consistent. Partitioning is by file rather
+// than by metric key because the backend requires the lines of a file to
+// be appended in order, so there are at most as many useful shards as
+// files.
+//
+// The exit code is sent by the primary shard after all other shards are
+// closed, so the run is not marked complete before its data is uploaded.
+type shardedFileStream struct {
+	// shards are the underlying filestreams; the first is the primary.
+	shards []FileStream
+
+	mu sync.Mutex
+
+	// exit is the exit update, held back until Close.
+	exit *ExitUpdate
+}
+
+func newShardedFileStream(shards []FileStream) *shardedFileStream {
+	return &shardedFileStream{shards: shards}
+}
+
+func (fs *shardedFileStream) Start(
+	entity string,
+	project string,
+	runID string,
+	offsetMap FileStreamOffsetMap,
+) {
+	for _, shard := range fs.shards {
+		shard.Start(entity, project, runID, offsetMap)
+	}
+}
+
+func (fs *shardedFileStream) StreamUpdate(update Update) {
+	switch x := update.(type) {
+	case *HistoryUpdate:
+		fs.shardFor(HistoryChunk).StreamUpdate(update)
+	case *LogsUpdate:
+		fs.shardFor(OutputChunk).StreamUpdate(update)
+	case *StatsUpdate:
+		fs.shardFor(EventsChunk).StreamUpdate(update)
+	case *SummaryUpdate:
+		fs.shardFor(SummaryChunk).StreamUpdate(update)
+	case *ExitUpdate:
+		fs.mu.Lock()
+		fs.exit = x
+		fs.mu.Unlock()
+	case *FlushUpdate:
+		fs.flushAll(x)
+	default:
+		fs.shards[0].StreamUpdate(update)
+	}
+}
+
+// flushAll runs the flush callback once every shard has sent its data.
+func (fs *shardedFileStream) flushAll(update *FlushUpdate) {
+	remaining := &atomic.Int32{}
+	remaining.Store(int32(len(fs.shards)))
+
+	for _, shard := range fs.shards {
+		shard.StreamUpdate(&FlushUpdate{
+			OnSent: func() {
+				if remaining.Add(-1) == 0 && update.OnSent != nil {
+					update.OnSent()
+				}
+			},
+		})
+	}
+}
+
+func (fs *shardedFileStream) Abort() {
+	for _, shard := range fs.shards {
+		shard.Abort()
+	}
+}
+
+func (fs *shardedFileStream) Close() {
+	wg := &sync.WaitGroup{}
+	for _, shard := range fs.shards[1:] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shard.Close()
+		}()
+	}
+	wg.Wait()
+
+	fs.mu.Lock()
+	exit := fs.exit
+	fs.mu.Unlock()
+
+	if exit != nil {
+		fs.shards[0].StreamUpdate(exit)
+	}
+	fs.shards[0].Close()
+}
+
+// shardFor returns the shard that sends the given file.
+func (fs *shardedFileStream) shardFor(chunk ChunkTypeEnum) FileStream {
+	return fs.shards[int(chunk)%len(fs.shards)]
+}
@@ -1,6 +1,7 @@
 package filestream
 
 import (
+	"github.com/wandb/wandb/core/internal/droppeddata"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -22,4 +23,9 @@ type UpdateContext struct {
 
 	Logger  *observability.CoreLogger
 	Printer *observability.Printer
+
+	// DroppedData counts data that is dropped instead of sent.
+	//
+	// It may be nil.
+	DroppedData *droppeddata.Tracker
 }
@@ -0,0 +1,23 @@
+package filestream
+
+// FlushUpdate requests a callback once all earlier updates are sent.
+//
+// The callback runs after the backend acknowledges the request containing
+// the data of all updates before this one. It never runs if the filestream
+// fails.
+type FlushUpdate struct {
+	OnSent func()
+}
+
+func (u *FlushUpdate) Apply(ctx UpdateContext) error {
+	ctx.ModifyRequest(&collectorFlushUpdate{onSent: u.OnSent})
+	return nil
+}
+
+type collectorFlushUpdate struct {
+	onSent func()
+}
+
+func (u *collectorFlushUpdate) Apply(state *CollectorState) {
+	state.OnSent = append(state.OnSent, u.onSent)
+}
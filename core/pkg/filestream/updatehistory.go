@@ -1,8 +1,10 @@
 package filestream
 
 import (
+	"bytes"
 	"fmt"
 	"slices"
+	"sync"
 	"time"
 
 	"github.com/wandb/wandb/core/internal/runhistory"
@@ -26,8 +28,11 @@ func (u *HistoryUpdate) Apply(ctx UpdateContext) error {
 		})
 	}
 
-	rh := runhistory.New()
-	rh.ApplyChangeRecord(
+	encoder := historyEncoders.Get().(*historyEncoder)
+	defer historyEncoders.Put(encoder)
+	encoder.buf.Reset()
+
+	err := encoder.Encode(
 		items,
 		func(err error) {
 			// TODO: maybe we should shut down filestream if this fails?
@@ -35,20 +40,20 @@ func (u *HistoryUpdate) Apply(ctx UpdateContext) error {
 				"filestream: failed to apply history record", err)
 		},
 	)
-	line, err := rh.Serialize()
 	if err != nil {
 		return fmt.Errorf(
 			"filestream: failed to serialize history: %v", err)
 	}
+	line := encoder.buf.Bytes()
 
-	if len(line) > maxFileLineBytes {
+	if len(line) > MaxFileLineBytes {
 		// We consider this non-blocking. We'll upload a run with some missing
 		// data, but it's better than not uploading anything at all, as long
 		// as we inform the user.
 		ctx.Logger.CaptureWarn(
 			"filestream: run history line too long, skipping",
 			"len", len(line),
-			"max", maxFileLineBytes,
+			"max", MaxFileLineBytes,
 		)
 		ctx.Printer.
 			AtMostEvery(time.Minute).
@@ -62,6 +67,20 @@ func (u *HistoryUpdate) Apply(ctx UpdateContext) error {
 	return nil
 }
 
+// historyEncoder is a history row encoder with its own buffer.
+type historyEncoder struct {
+	*runhistory.Encoder
+	buf *bytes.Buffer
+}
+
+// historyEncoders reuses encoders and their buffers across history rows.
+var historyEncoders = sync.Pool{
+	New: func() any {
+		buf := &bytes.Buffer{}
+		return &historyEncoder{Encoder: runhistory.NewEncoder(buf), buf: buf}
+	},
+}
+
 type collectorHistoryUpdate struct {
 	lines []string
 }
@@ -17,8 +17,15 @@ type LogsUpdate struct {
 }
 
 func (u *LogsUpdate) Apply(ctx UpdateContext) error {
+	// use the time the line was written if known, since it may be logged
+	// after the fact
+	timestamp := time.Now()
+	if ts := u.Record.GetTimestamp(); ts.IsValid() && ts.GetSeconds() >= 0 {
+		timestamp = ts.AsTime()
+	}
+
 	// generate compatible timestamp to python iso-format (microseconds without Z)
-	t := strings.TrimSuffix(time.Now().UTC().Format(rfc3339Micro), "Z")
+	t := strings.TrimSuffix(timestamp.UTC().Format(rfc3339Micro), "Z")
 
 	var line string
 	switch u.Record.OutputType {
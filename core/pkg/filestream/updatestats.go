@@ -4,9 +4,14 @@ import (
 	"fmt"
 
 	"github.com/segmentio/encoding/json"
+	"github.com/wandb/wandb/core/internal/droppeddata"
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
+// defaultMaxPendingStatsLines is the default number of system metrics
+// samples kept while waiting to be sent.
+const defaultMaxPendingStatsLines = 10000
+
 // StatsUpdate contains system metrics during the run, e.g. memory usage.
 type StatsUpdate struct {
 	Record *service.StatsRecord
@@ -46,16 +51,27 @@ func (u *StatsUpdate) Apply(ctx UpdateContext) error {
 			"filestream: failed to marshal system metrics",
 			err,
 		)
-	case len(line) > maxFileLineBytes:
+	case len(line) > MaxFileLineBytes:
 		// This is a non-blocking failure as well.
 		ctx.Logger.CaptureWarn(
 			"filestream: system metrics line too long, skipping",
 			"len", len(line),
-			"max", maxFileLineBytes,
+			"max", MaxFileLineBytes,
 		)
 	default:
+		maxLines := int(ctx.Settings.GetXStatsPendingSamplesMax().GetValue())
+		if maxLines <= 0 {
+			maxLines = defaultMaxPendingStatsLines
+		}
+
 		ctx.ModifyRequest(&collectorStatsUpdate{
-			lines: []string{string(line)},
+			lines:    []string{string(line)},
+			maxLines: maxLines,
+			onDrop: func(n int) {
+				for range n {
+					ctx.DroppedData.Record(droppeddata.SystemMetricsDropped, "system")
+				}
+			},
 		})
 	}
 
@@ -64,9 +80,33 @@ func (u *StatsUpdate) Apply(ctx UpdateContext) error {
 
 type collectorStatsUpdate struct {
 	lines []string
+
+	// maxLines is how many lines may be buffered, or 0 for no limit.
+	//
+	// The oldest lines are dropped past this, since lines accumulate while
+	// requests fail, such as during a network outage.
+	maxLines int
+
+	// onDrop is called with the number of dropped lines, if any.
+	onDrop func(int)
 }
 
 func (u *collectorStatsUpdate) Apply(state *CollectorState) {
 	state.Buffer.EventsLines =
 		append(state.Buffer.EventsLines, u.lines...)
+
+	if u.maxLines <= 0 || len(state.Buffer.EventsLines) <= u.maxLines {
+		return
+	}
+
+	// Shift lines down rather than reslicing so that the buffer's memory
+	// stays bounded.
+	lines := state.Buffer.EventsLines
+	dropped := len(lines) - u.maxLines
+	copy(lines, lines[dropped:])
+	clear(lines[u.maxLines:])
+	state.Buffer.EventsLines = lines[:u.maxLines]
+	if u.onDrop != nil {
+		u.onDrop(dropped)
+	}
 }
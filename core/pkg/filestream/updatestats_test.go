@@ -0,0 +1,46 @@
+package filestream_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/droppeddata"
+	"github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestStatsUpdate_DropsOldestPastLimit(t *testing.T) {
+	state := &filestream.CollectorState{}
+	droppedData := droppeddata.New()
+	ctx := filestream.UpdateContext{
+		ModifyRequest: func(update filestream.CollectorStateUpdate) {
+			update.Apply(state)
+		},
+		Settings: &service.Settings{
+			XStatsPendingSamplesMax: wrapperspb.Int32(2),
+		},
+		Logger:      observability.NewNoOpLogger(),
+		DroppedData: droppedData,
+	}
+
+	for i := int64(1); i <= 3; i++ {
+		update := &filestream.StatsUpdate{
+			Record: &service.StatsRecord{
+				Timestamp: &timestamppb.Timestamp{Seconds: i},
+			},
+		}
+		require.NoError(t, update.Apply(ctx))
+	}
+
+	require.Len(t, state.Buffer.EventsLines, 2)
+	assert.Contains(t, state.Buffer.EventsLines[0], `"_timestamp":2`)
+	assert.Contains(t, state.Buffer.EventsLines[1], `"_timestamp":3`)
+	categories := droppedData.Summary().GetCategories()
+	require.Len(t, categories, 1)
+	assert.Equal(t, "system_metrics_dropped", categories[0].GetName())
+	assert.EqualValues(t, 1, categories[0].GetCount())
+}
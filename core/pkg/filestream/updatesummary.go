@@ -31,12 +31,12 @@ func (u *SummaryUpdate) Apply(ctx UpdateContext) error {
 		)
 	}
 
-	if len(line) > maxFileLineBytes {
+	if len(line) > MaxFileLineBytes {
 		// Failing to upload the summary is non-blocking.
 		ctx.Logger.CaptureWarn(
 			"filestream: run summary line too long, skipping",
 			"len", len(line),
-			"max", maxFileLineBytes,
+			"max", MaxFileLineBytes,
 		)
 		ctx.Printer.
 			AtMostEvery(time.Minute).
@@ -39,6 +39,8 @@ func (fs *FakeFileStream) Start(
 
 func (fs *FakeFileStream) Close() {}
 
+func (fs *FakeFileStream) Abort() {}
+
 func (fs *FakeFileStream) StreamUpdate(update filestream.Update) {
 	fs.Lock()
 	defer fs.Unlock()
@@ -625,8 +625,15 @@ func (j *JobBuilder) Build(
 
 func (j *JobBuilder) buildArtifact(baseArtifact *service.ArtifactRecord, sourceInfo JobSourceMetadata, fileDir string, sourceType SourceType) (*service.ArtifactRecord, error) {
 	artifactBuilder := artifacts.NewArtifactBuilder(baseArtifact)
+	algorithm, err := artifacts.ParseDigestAlgorithm(
+		j.settings.GetXArtifactDigestAlgorithm().GetValue())
+	if err != nil {
+		j.logger.Warn("jobBuilder: using MD5 for artifact digests", "error", err)
+		algorithm = artifacts.DigestMD5
+	}
+	artifactBuilder.SetDigestAlgorithm(algorithm)
 
-	err := artifactBuilder.AddFile(filepath.Join(fileDir, REQUIREMENTS_FNAME), FROZEN_REQUIREMENTS_FNAME)
+	err = artifactBuilder.AddFile(filepath.Join(fileDir, REQUIREMENTS_FNAME), FROZEN_REQUIREMENTS_FNAME)
 	if err != nil {
 		return nil, err
 	}
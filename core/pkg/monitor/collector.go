@@ -0,0 +1,212 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Metric is a value sampled by a Collector.
+type Metric struct {
+	// Name identifies the metric within its collector, like "temp".
+	Name string
+
+	Value float64
+}
+
+// Collector samples custom system metrics, such as telemetry from hardware
+// that has no built-in asset.
+//
+// Collectors are sampled by the same loop as built-in assets, at the same
+// interval. Their metrics are recorded under the collector's name, so that
+// the metric "temp" of a collector named "accel" becomes "accel.temp".
+type Collector interface {
+	// Name is the collector's namespace for its metrics.
+	//
+	// It must be non-empty, must not contain dots, and must differ from
+	// the names of built-in assets and of other collectors.
+	Name() string
+
+	// Sample returns the current values of the collector's metrics.
+	//
+	// It should return promptly once the context is done. Errors and
+	// panics are logged and only skip the sample.
+	Sample(ctx context.Context) ([]Metric, error)
+}
+
+// AddCollectors registers custom collectors to sample along with the
+// built-in assets.
+//
+// It is safe to call concurrently, but returns an error once Do has run.
+// Returns an error for each collector with an invalid or duplicate name,
+// which is not registered. Collectors are not sampled if system metrics
+// are disabled.
+func (sm *SystemMonitor) AddCollectors(collectors ...Collector) error {
+	if sm == nil || sm.settings.XDisableStats.GetValue() {
+		return nil
+	}
+
+	sm.assetsMu.Lock()
+	defer sm.assetsMu.Unlock()
+
+	if sm.started {
+		return errors.New("monitor: cannot add collectors after starting")
+	}
+
+	var errs []error
+	for _, collector := range collectors {
+		if err := sm.validateCollectorName(collector.Name()); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		sm.assets = append(sm.assets,
+			newCollectorAsset(collector, sm.samplingInterval(), sm.logger))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateCollectorName returns an error if a collector can't use the name.
+//
+// The caller must hold assetsMu.
+func (sm *SystemMonitor) validateCollectorName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("monitor: collector name is empty")
+	case strings.Contains(name, "."):
+		return fmt.Errorf("monitor: collector name %q contains a dot", name)
+	case slices.Contains(assetNames, name):
+		return fmt.Errorf(
+			"monitor: collector name %q is used by a built-in asset", name)
+	}
+
+	for _, asset := range sm.assets {
+		if _, ok := asset.(*collectorAsset); ok && asset.Name() == name {
+			return fmt.Errorf("monitor: duplicate collector name %q", name)
+		}
+	}
+
+	return nil
+}
+
+// collectorAsset adapts a Collector to the Asset interface.
+type collectorAsset struct {
+	collector Collector
+
+	// sampleTimeout is how long a sample may take before its context is
+	// canceled.
+	sampleTimeout time.Duration
+
+	logger *observability.CoreLogger
+
+	// warned is whether a failed sample was logged as a warning; later
+	// failures are logged at the debug level.
+	warned bool
+
+	mu sync.Mutex
+
+	// samples are the values sampled since the last ClearMetrics,
+	// by metric key.
+	samples map[string][]float64
+}
+
+func newCollectorAsset(
+	collector Collector,
+	sampleTimeout time.Duration,
+	logger *observability.CoreLogger,
+) *collectorAsset {
+	if sampleTimeout <= 0 {
+		sampleTimeout = 10 * time.Second
+	}
+
+	return &collectorAsset{
+		collector:     collector,
+		sampleTimeout: sampleTimeout,
+		logger:        logger,
+		samples:       make(map[string][]float64),
+	}
+}
+
+func (c *collectorAsset) Name() string { return c.collector.Name() }
+
+func (c *collectorAsset) SampleMetrics() {
+	metrics, err := c.sample()
+	if err != nil {
+		c.logSampleError(err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, metric := range metrics {
+		key := c.Name() + "." + metric.Name
+		c.samples[key] = append(c.samples[key], metric.Value)
+	}
+}
+
+// sample calls the collector, turning panics into errors.
+func (c *collectorAsset) sample() (metrics []Metric, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.sampleTimeout)
+	defer cancel()
+	return c.collector.Sample(ctx)
+}
+
+// logSampleError logs a failed sample, as a warning only the first time.
+func (c *collectorAsset) logSampleError(err error) {
+	if c.logger == nil {
+		return
+	}
+
+	if !c.warned {
+		c.warned = true
+		c.logger.CaptureWarn(
+			"monitor: custom collector failed",
+			"collector", c.Name(),
+			"error", err,
+		)
+	} else {
+		c.logger.Debug(
+			"monitor: custom collector failed",
+			"collector", c.Name(),
+			"error", err,
+		)
+	}
+}
+
+func (c *collectorAsset) AggregateMetrics() map[string]float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	aggregates := make(map[string]float64, len(c.samples))
+	for key, values := range c.samples {
+		if len(values) > 0 {
+			aggregates[key] = Average(values)
+		}
+	}
+	return aggregates
+}
+
+func (c *collectorAsset) ClearMetrics() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.samples)
+}
+
+func (c *collectorAsset) IsAvailable() bool { return true }
+
+func (c *collectorAsset) Probe() *service.MetadataRequest { return nil }
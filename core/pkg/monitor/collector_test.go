@@ -0,0 +1,186 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+type testCollector struct {
+	name   string
+	sample func() ([]Metric, error)
+}
+
+func (c *testCollector) Name() string { return c.name }
+
+func (c *testCollector) Sample(context.Context) ([]Metric, error) {
+	return c.sample()
+}
+
+func constantCollector(name string, metrics ...Metric) *testCollector {
+	return &testCollector{
+		name:   name,
+		sample: func() ([]Metric, error) { return metrics, nil },
+	}
+}
+
+// collectorsOnlyMonitor returns a SystemMonitor without built-in assets.
+func collectorsOnlyMonitor(outChan chan *service.Record) *SystemMonitor {
+	return NewSystemMonitor(
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			XStatsSampleRateSeconds: wrapperspb.Double(0.01),
+			XStatsSamplesToAverage:  wrapperspb.Int32(1),
+			XStatsDisabledAssets: &service.ListStringValue{
+				Value: assetNames,
+			},
+		},
+		outChan,
+	)
+}
+
+func TestAddCollectors_RejectsInvalidNames(t *testing.T) {
+	sm := collectorsOnlyMonitor(make(chan *service.Record))
+
+	err := sm.AddCollectors(
+		constantCollector("accel"),
+		constantCollector(""),
+		constantCollector("a.b"),
+		constantCollector("gpu"),
+		constantCollector("accel"),
+	)
+
+	assert.ErrorContains(t, err, "name is empty")
+	assert.ErrorContains(t, err, `"a.b" contains a dot`)
+	assert.ErrorContains(t, err, `"gpu" is used by a built-in asset`)
+	assert.ErrorContains(t, err, `duplicate collector name "accel"`)
+	require.Len(t, sm.assets, 1)
+	assert.Equal(t, "accel", sm.assets[0].Name())
+}
+
+func TestAddCollectors_StatsDisabled(t *testing.T) {
+	sm := NewSystemMonitor(
+		observability.NewNoOpLogger(),
+		&service.Settings{XDisableStats: wrapperspb.Bool(true)},
+		make(chan *service.Record),
+	)
+
+	assert.NoError(t, sm.AddCollectors(constantCollector("accel")))
+	assert.Empty(t, sm.assets)
+}
+
+func TestCollectorAsset_AveragesNamespacedMetrics(t *testing.T) {
+	values := []float64{1, 3}
+	asset := newCollectorAsset(
+		&testCollector{
+			name: "accel",
+			sample: func() ([]Metric, error) {
+				value := values[0]
+				values = values[1:]
+				return []Metric{{Name: "temp", Value: value}}, nil
+			},
+		},
+		time.Second,
+		nil,
+	)
+
+	asset.SampleMetrics()
+	asset.SampleMetrics()
+	assert.Equal(t,
+		map[string]float64{"accel.temp": 2},
+		asset.AggregateMetrics())
+
+	asset.ClearMetrics()
+	assert.Empty(t, asset.AggregateMetrics())
+}
+
+func TestCollectorAsset_SkipsFailedSamples(t *testing.T) {
+	calls := 0
+	asset := newCollectorAsset(
+		&testCollector{
+			name: "accel",
+			sample: func() ([]Metric, error) {
+				calls++
+				switch calls {
+				case 1:
+					return nil, errors.New("device busy")
+				case 2:
+					panic("driver crashed")
+				default:
+					return []Metric{{Name: "temp", Value: 5}}, nil
+				}
+			},
+		},
+		time.Second,
+		observability.NewNoOpLogger(),
+	)
+
+	asset.SampleMetrics()
+	asset.SampleMetrics()
+	asset.SampleMetrics()
+
+	assert.Equal(t,
+		map[string]float64{"accel.temp": 5},
+		asset.AggregateMetrics())
+}
+
+func TestSystemMonitor_FailingCollectorDoesNotStopOthers(t *testing.T) {
+	outChan := make(chan *service.Record, 1000)
+	sm := collectorsOnlyMonitor(outChan)
+	require.NoError(t, sm.AddCollectors(
+		&testCollector{
+			name:   "broken",
+			sample: func() ([]Metric, error) { panic("always fails") },
+		},
+		constantCollector("accel", Metric{Name: "temp", Value: 42}),
+	))
+
+	sm.Do()
+	defer sm.Stop()
+
+	select {
+	case record := <-outChan:
+		items := record.GetStats().GetItem()
+		require.Len(t, items, 1)
+		assert.Equal(t, "accel.temp", items[0].GetKey())
+		assert.Equal(t, "42", items[0].GetValueJson())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for stats")
+	}
+}
+
+func TestAddCollectors_AfterDo(t *testing.T) {
+	sm := collectorsOnlyMonitor(make(chan *service.Record, 1000))
+	sm.Do()
+	defer sm.Stop()
+
+	err := sm.AddCollectors(constantCollector("accel"))
+
+	assert.ErrorContains(t, err, "after starting")
+}
+
+func TestAddCollectors_ConcurrentWithDo(t *testing.T) {
+	sm := collectorsOnlyMonitor(make(chan *service.Record, 1000))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sm.AddCollectors(constantCollector(fmt.Sprintf("accel%d", i)))
+		}()
+	}
+	sm.Do()
+	wg.Wait()
+	sm.Stop()
+}
@@ -0,0 +1,111 @@
+package monitor
+
+import (
+	"math"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// defaultCompactionMaxGap is how long a compacted metric may go unsent
+// if _stats_compaction_max_gap_seconds isn't set.
+const defaultCompactionMaxGap = 5 * time.Minute
+
+// compactor drops system metric samples that barely differ from the
+// last value sent, which is most of them on an idle machine.
+//
+// A metric is sent when it changes by more than the tolerance or when the
+// max gap has passed since it was last sent. When a metric changes after
+// some of its samples were dropped, the last dropped sample is sent too,
+// so that the series reconstructed from the sent points is flat up to the
+// change instead of sloping across the gap.
+//
+// A compactor is used by a single asset and is not safe for concurrent use.
+type compactor struct {
+	// tolerance is the relative change below which values are dropped.
+	tolerance float64
+
+	// maxGap is the longest time to go without sending a metric.
+	maxGap time.Duration
+
+	// sent is the last sent sample of each metric.
+	sent map[string]sample
+
+	// held is the last dropped sample of each metric, if the metric's
+	// latest sample was dropped.
+	held map[string]sample
+}
+
+// sample is a metric's value at a time.
+type sample struct {
+	value float64
+	at    time.Time
+}
+
+// newCompactorOrNil returns a compactor if the settings enable compaction
+// and nil otherwise.
+func newCompactorOrNil(settings *service.Settings) *compactor {
+	if settings.XStatsCompactionTolerance == nil {
+		return nil
+	}
+
+	maxGap := defaultCompactionMaxGap
+	if seconds := settings.XStatsCompactionMaxGapSeconds.GetValue(); seconds > 0 {
+		maxGap = time.Duration(seconds * float64(time.Second))
+	}
+
+	return &compactor{
+		tolerance: max(0, settings.XStatsCompactionTolerance.GetValue()),
+		maxGap:    maxGap,
+		sent:      make(map[string]sample),
+		held:      make(map[string]sample),
+	}
+}
+
+// Compact returns the metrics sampled at the time that should be sent.
+//
+// It also returns the dropped samples that must be sent first because
+// their metric changed, along with the time they were sampled. All held
+// samples are from the asset's previous sample, so they share a time.
+//
+// A nil compactor returns the metrics unchanged.
+func (c *compactor) Compact(
+	metrics map[string]float64,
+	at time.Time,
+) (current map[string]float64, held map[string]float64, heldAt time.Time) {
+	if c == nil {
+		return metrics, nil, time.Time{}
+	}
+
+	current = make(map[string]float64, len(metrics))
+	for name, value := range metrics {
+		last, wasSent := c.sent[name]
+		changed := !wasSent || !c.isWithinTolerance(last.value, value)
+		if !changed && at.Sub(last.at) < c.maxGap {
+			c.held[name] = sample{value: value, at: at}
+			continue
+		}
+
+		// Held samples only matter to the series' shape if the value
+		// changed; after a max gap, the line up to now is already flat.
+		if dropped, ok := c.held[name]; ok && changed {
+			if held == nil {
+				held = make(map[string]float64)
+			}
+			held[name] = dropped.value
+			heldAt = dropped.at
+		}
+		delete(c.held, name)
+
+		current[name] = value
+		c.sent[name] = sample{value: value, at: at}
+	}
+
+	return current, held, heldAt
+}
+
+// isWithinTolerance reports whether the value is close enough to the last
+// sent value to be dropped.
+func (c *compactor) isWithinTolerance(last, value float64) bool {
+	return math.Abs(value-last) <= c.tolerance*math.Abs(last)
+}
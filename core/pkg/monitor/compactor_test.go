@@ -0,0 +1,84 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func newTestCompactor(tolerance float64, maxGap time.Duration) *compactor {
+	return newCompactorOrNil(&service.Settings{
+		XStatsCompactionTolerance:     wrapperspb.Double(tolerance),
+		XStatsCompactionMaxGapSeconds: wrapperspb.Double(maxGap.Seconds()),
+	})
+}
+
+func TestCompactor_DisabledByDefault(t *testing.T) {
+	c := newCompactorOrNil(&service.Settings{})
+	metrics := map[string]float64{"cpu": 1}
+
+	current, held, _ := c.Compact(metrics, time.Now())
+
+	assert.Nil(t, c)
+	assert.Equal(t, metrics, current)
+	assert.Empty(t, held)
+}
+
+func TestCompactor_DropsValuesWithinTolerance(t *testing.T) {
+	c := newTestCompactor(0.1, time.Hour)
+	start := time.Unix(1000, 0)
+
+	current, _, _ := c.Compact(map[string]float64{"cpu": 50, "mem": 10}, start)
+	assert.Equal(t, map[string]float64{"cpu": 50, "mem": 10}, current)
+
+	current, held, _ := c.Compact(
+		map[string]float64{"cpu": 54, "mem": 20},
+		start.Add(time.Second))
+	assert.Equal(t, map[string]float64{"mem": 20}, current)
+	assert.Empty(t, held)
+}
+
+func TestCompactor_SendsHeldSampleOnChange(t *testing.T) {
+	c := newTestCompactor(0, time.Hour)
+	start := time.Unix(1000, 0)
+
+	c.Compact(map[string]float64{"cpu": 5}, start)
+	c.Compact(map[string]float64{"cpu": 5}, start.Add(1*time.Second))
+	c.Compact(map[string]float64{"cpu": 5}, start.Add(2*time.Second))
+	current, held, heldAt := c.Compact(
+		map[string]float64{"cpu": 90},
+		start.Add(3*time.Second))
+
+	assert.Equal(t, map[string]float64{"cpu": 90}, current)
+	assert.Equal(t, map[string]float64{"cpu": 5}, held)
+	assert.Equal(t, start.Add(2*time.Second), heldAt)
+}
+
+func TestCompactor_SendsAfterMaxGap(t *testing.T) {
+	c := newTestCompactor(0, 10*time.Second)
+	start := time.Unix(1000, 0)
+
+	c.Compact(map[string]float64{"cpu": 5}, start)
+	current, _, _ := c.Compact(map[string]float64{"cpu": 5}, start.Add(5*time.Second))
+	assert.Empty(t, current)
+
+	current, held, _ := c.Compact(
+		map[string]float64{"cpu": 5},
+		start.Add(10*time.Second))
+	assert.Equal(t, map[string]float64{"cpu": 5}, current)
+	assert.Empty(t, held)
+}
+
+func TestCompactor_ZeroOnlyMatchesZero(t *testing.T) {
+	c := newTestCompactor(0.5, time.Hour)
+	start := time.Unix(1000, 0)
+
+	c.Compact(map[string]float64{"gpu": 0}, start)
+	current, _, _ := c.Compact(map[string]float64{"gpu": 0.001}, start.Add(time.Second))
+
+	assert.Equal(t, map[string]float64{"gpu": 0.001}, current)
+}
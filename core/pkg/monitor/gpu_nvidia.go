@@ -274,6 +274,14 @@ func (g *GPUNvidia) Probe() *service.MetadataRequest {
 	info.GpuCount = uint32(count)
 	names := make([]string, count)
 
+	if version, ret := nvml.SystemGetDriverVersion(); ret == nvml.SUCCESS {
+		info.GpuDriverVersion = version
+	}
+	if version, ret := nvml.SystemGetCudaDriverVersion(); ret == nvml.SUCCESS {
+		// The version is encoded as 1000*major + 10*minor.
+		info.CudaDriverVersion = fmt.Sprintf("%d.%d", version/1000, version%1000/10)
+	}
+
 	for di := 0; di < count; di++ {
 		device, ret := nvml.DeviceGetHandleByIndex(di)
 		gpuInfo := &service.GpuNvidiaInfo{}
@@ -287,6 +295,7 @@ func (g *GPUNvidia) Probe() *service.MetadataRequest {
 			if ret == nvml.SUCCESS {
 				gpuInfo.MemoryTotal = memoryInfo.Total
 			}
+			probeDeviceDetails(device, gpuInfo)
 		}
 		info.GpuNvidia = append(info.GpuNvidia, gpuInfo)
 	}
@@ -295,3 +304,60 @@ func (g *GPUNvidia) Probe() *service.MetadataRequest {
 
 	return &info
 }
+
+// probeDeviceDetails records a device's hardware and interconnect info.
+//
+// Nodes may have different GPU models, so each device is probed. Details
+// that the device or driver doesn't report are left empty.
+func probeDeviceDetails(device nvml.Device, gpuInfo *service.GpuNvidiaInfo) {
+	if uuid, ret := device.GetUUID(); ret == nvml.SUCCESS {
+		gpuInfo.Uuid = uuid
+	}
+	if arch, ret := device.GetArchitecture(); ret == nvml.SUCCESS {
+		gpuInfo.Architecture = architectureName(arch)
+	}
+	if major, minor, ret := device.GetCudaComputeCapability(); ret == nvml.SUCCESS {
+		gpuInfo.CudaComputeCapability = fmt.Sprintf("%d.%d", major, minor)
+	}
+	if gen, ret := device.GetCurrPcieLinkGeneration(); ret == nvml.SUCCESS {
+		gpuInfo.PcieLinkGeneration = uint32(gen)
+	}
+	if width, ret := device.GetCurrPcieLinkWidth(); ret == nvml.SUCCESS {
+		gpuInfo.PcieLinkWidth = uint32(width)
+	}
+
+	for link := 0; link < nvml.NVLINK_MAX_LINKS; link++ {
+		state, ret := device.GetNvLinkState(link)
+		if ret != nvml.SUCCESS {
+			// Devices without NVLink, or with fewer links, return an error.
+			break
+		}
+		if state == nvml.FEATURE_ENABLED {
+			gpuInfo.NvlinkCount++
+		}
+	}
+}
+
+// architectureName returns the marketing name of a GPU architecture.
+func architectureName(arch nvml.DeviceArchitecture) string {
+	switch arch {
+	case nvml.DEVICE_ARCH_KEPLER:
+		return "Kepler"
+	case nvml.DEVICE_ARCH_MAXWELL:
+		return "Maxwell"
+	case nvml.DEVICE_ARCH_PASCAL:
+		return "Pascal"
+	case nvml.DEVICE_ARCH_VOLTA:
+		return "Volta"
+	case nvml.DEVICE_ARCH_TURING:
+		return "Turing"
+	case nvml.DEVICE_ARCH_AMPERE:
+		return "Ampere"
+	case nvml.DEVICE_ARCH_ADA:
+		return "Ada"
+	case nvml.DEVICE_ARCH_HOPPER:
+		return "Hopper"
+	default:
+		return ""
+	}
+}
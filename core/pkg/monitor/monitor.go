@@ -3,7 +3,9 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -107,6 +109,43 @@ func makeStatsRecord(stats map[string]float64, timeStamp *timestamppb.Timestamp)
 	return record
 }
 
+// assetNames are the names of the built-in assets.
+//
+// Several assets may share a name, such as the different GPU assets.
+var assetNames = []string{"cpu", "disk", "gpu", "memory", "network"}
+
+// ValidateDisabledAssets returns an error if the _stats_disabled_assets
+// setting names an unknown asset.
+//
+// Unknown names are otherwise ignored, so this is only for telling the user.
+func ValidateDisabledAssets(settings *service.Settings) error {
+	err := validateAssetNames(settings.GetXStatsDisabledAssets().GetValue())
+	if err != nil {
+		return fmt.Errorf("monitor: invalid _stats_disabled_assets: %v", err)
+	}
+	return nil
+}
+
+// validateAssetNames returns an error if any name is not a known asset name.
+func validateAssetNames(names []string) error {
+	var unknown []string
+	for _, name := range names {
+		if !slices.Contains(assetNames, name) {
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf(
+			"unknown asset name(s) %s, expected one of %s",
+			strings.Join(unknown, ", "),
+			strings.Join(assetNames, ", "),
+		)
+	}
+
+	return nil
+}
+
 type Asset interface {
 	Name() string
 	SampleMetrics()
@@ -118,15 +157,27 @@ type Asset interface {
 
 type SystemMonitor struct {
 	// ctx is the context for the system monitor
-	ctx    context.Context
-	cancel context.CancelFunc
+	ctx context.Context
+
+	// cancel stops monitoring; it is nil if the monitor is not running.
+	//
+	// It is guarded by cancelMu since Stop may be called concurrently,
+	// such as when a run is killed while it is finishing.
+	cancel   context.CancelFunc
+	cancelMu sync.Mutex
 
 	// wg is the wait group for the system monitor
 	wg sync.WaitGroup
 
+	// assetsMu guards assets and started.
+	assetsMu sync.Mutex
+
 	// assets is the list of assets to monitor
 	assets []Asset
 
+	// started is whether Do was called, after which no assets may be added.
+	started bool
+
 	//	outChan is the channel for outgoing messages
 	outChan chan *service.Record
 
@@ -168,6 +219,12 @@ func NewSystemMonitor(
 		return systemMonitor
 	}
 
+	disabledAssets := settings.XStatsDisabledAssets.GetValue()
+	disabled := make(map[string]bool, len(disabledAssets))
+	for _, name := range disabledAssets {
+		disabled[name] = true
+	}
+
 	assets := []Asset{
 		NewMemory(settings),
 		NewCPU(settings),
@@ -178,8 +235,15 @@ func NewSystemMonitor(
 		NewGPUApple(settings),
 	}
 
-	// if asset is available, add it to the list of assets to monitor
+	// if asset is available and not disabled, add it to the list of assets
+	// to monitor
+	//
+	// disabled assets are skipped before probing their availability, since
+	// that may already be slow or error-prone for problematic assets
 	for _, asset := range assets {
+		if disabled[asset.Name()] {
+			continue
+		}
 		if asset.IsAvailable() {
 			systemMonitor.assets = append(systemMonitor.assets, asset)
 		}
@@ -193,16 +257,60 @@ func (sm *SystemMonitor) Do() {
 		return
 	}
 	// reset context:
+	sm.cancelMu.Lock()
 	sm.ctx, sm.cancel = context.WithCancel(context.Background())
+	sm.cancelMu.Unlock()
 
 	sm.logger.Info("Starting system monitor")
 	// start monitoring the assets
-	for _, asset := range sm.assets {
+	assets := sm.startAssets()
+	samplingInterval := sm.samplingInterval()
+	for i, asset := range assets {
 		sm.wg.Add(1)
-		go sm.Monitor(asset)
+		go sm.Monitor(
+			asset,
+			startOffset(i, len(assets), samplingInterval, rand.Float64()),
+		)
 	}
 }
 
+// startAssets marks the monitor as started and returns its assets.
+func (sm *SystemMonitor) startAssets() []Asset {
+	sm.assetsMu.Lock()
+	defer sm.assetsMu.Unlock()
+	sm.started = true
+	return slices.Clone(sm.assets)
+}
+
+// assetList returns a copy of the monitor's assets.
+func (sm *SystemMonitor) assetList() []Asset {
+	sm.assetsMu.Lock()
+	defer sm.assetsMu.Unlock()
+	return slices.Clone(sm.assets)
+}
+
+// samplingInterval is the time between samples of an asset.
+func (sm *SystemMonitor) samplingInterval() time.Duration {
+	// todo: rename the setting...should be SamplingIntervalSeconds
+	return time.Duration(sm.settings.XStatsSampleRateSeconds.GetValue() * float64(time.Second))
+}
+
+// startOffset returns how long the i-th of n assets waits before its
+// first sample.
+//
+// Sampling all assets at once causes a burst of work every interval, so
+// assets are spread evenly across the interval. The jitter, in [0, 1),
+// shifts an asset by up to half the gap between assets so that monitors
+// in different processes don't line up either.
+func startOffset(i, n int, interval time.Duration, jitter float64) time.Duration {
+	if n <= 0 || interval <= 0 {
+		return 0
+	}
+
+	gap := interval / time.Duration(n)
+	return time.Duration(i)*gap + time.Duration(jitter*0.5*float64(gap))
+}
+
 func getSlurmEnvVars() map[string]string {
 	slurmVars := make(map[string]string)
 	for _, envVar := range os.Environ() {
@@ -223,7 +331,7 @@ func (sm *SystemMonitor) Probe() *service.MetadataRequest {
 		return nil
 	}
 	systemInfo := service.MetadataRequest{}
-	for _, asset := range sm.assets {
+	for _, asset := range sm.assetList() {
 		probeResponse := asset.Probe()
 		if probeResponse != nil {
 			proto.Merge(&systemInfo, probeResponse)
@@ -240,7 +348,10 @@ func (sm *SystemMonitor) Probe() *service.MetadataRequest {
 	return &systemInfo
 }
 
-func (sm *SystemMonitor) Monitor(asset Asset) {
+// Monitor samples the asset until the monitor is stopped.
+//
+// The first sample is taken after startDelay.
+func (sm *SystemMonitor) Monitor(asset Asset, startDelay time.Duration) {
 	// recover from panic and log the error
 	defer func() {
 		sm.wg.Done()
@@ -250,8 +361,7 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 		}
 	}()
 
-	// todo: rename the setting...should be SamplingIntervalSeconds
-	samplingInterval := time.Duration(sm.settings.XStatsSampleRateSeconds.GetValue() * float64(time.Second))
+	samplingInterval := sm.samplingInterval()
 	samplesToAverage := sm.settings.XStatsSamplesToAverage.GetValue()
 	sm.logger.Debug(
 		fmt.Sprintf(
@@ -261,6 +371,12 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 		),
 	)
 
+	select {
+	case <-sm.ctx.Done():
+		return
+	case <-time.After(startDelay):
+	}
+
 	// Create a ticker that fires every `samplingInterval` seconds
 	ticker := time.NewTicker(samplingInterval)
 	defer ticker.Stop()
@@ -277,19 +393,24 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 		}
 	}()
 
+	compaction := newCompactorOrNil(sm.settings)
+
 	samplesCollected := int32(0)
 	for {
 		select {
 		case <-sm.ctx.Done():
 			return
 		case <-tickChan:
+			// Timestamps are when the asset was sampled rather than when
+			// the tick fired, which may differ if sampling was delayed.
+			sampledAt := time.Now()
 			asset.SampleMetrics()
 			samplesCollected++
 
 			if samplesCollected == samplesToAverage {
 				aggregatedMetrics := asset.AggregateMetrics()
 				if len(aggregatedMetrics) > 0 {
-					ts := timestamppb.Now()
+					ts := timestamppb.New(sampledAt)
 					// store in buffer
 					for k, v := range aggregatedMetrics {
 						if sm.buffer != nil {
@@ -297,14 +418,25 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 						}
 					}
 
-					// publish metrics
-					record := makeStatsRecord(aggregatedMetrics, ts)
-					// ensure that the context is not done before sending the record
-					select {
-					case <-sm.ctx.Done():
-						return
-					default:
-						sm.outChan <- record
+					// publish metrics, skipping values that barely changed
+					// if compaction is enabled
+					current, held, heldAt := compaction.Compact(aggregatedMetrics, sampledAt)
+					var records []*service.Record
+					if len(held) > 0 {
+						records = append(records,
+							makeStatsRecord(held, timestamppb.New(heldAt)))
+					}
+					if len(current) > 0 {
+						records = append(records, makeStatsRecord(current, ts))
+					}
+					for _, record := range records {
+						// ensure that the context is not done before sending the record
+						select {
+						case <-sm.ctx.Done():
+							return
+						default:
+							sm.outChan <- record
+						}
 					}
 					asset.ClearMetrics()
 				}
@@ -327,16 +459,26 @@ func (sm *SystemMonitor) GetBuffer() map[string]List {
 }
 
 func (sm *SystemMonitor) Stop() {
-	if sm == nil || sm.cancel == nil {
+	if sm == nil {
 		return
 	}
+
+	// make later calls no-ops, since assets may only be closed once
+	sm.cancelMu.Lock()
+	cancel := sm.cancel
+	sm.cancel = nil
+	sm.cancelMu.Unlock()
+	if cancel == nil {
+		return
+	}
+
 	sm.logger.Info("Stopping system monitor")
 	// signal to stop monitoring the assets
-	sm.cancel()
+	cancel()
 	// wait for all assets to stop monitoring
 	sm.wg.Wait()
 	// close the assets, if they require any cleanup
-	for _, asset := range sm.assets {
+	for _, asset := range sm.assetList() {
 		if closer, ok := asset.(interface{ Close() }); ok {
 			closer.Close()
 		}
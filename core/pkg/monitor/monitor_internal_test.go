@@ -0,0 +1,92 @@
+package monitor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestValidateAssetNames(t *testing.T) {
+	assert.NoError(t, validateAssetNames(nil))
+	assert.NoError(t, validateAssetNames([]string{"cpu", "gpu", "network"}))
+
+	err := validateAssetNames([]string{"cpu", "tpu", "dsik"})
+	assert.ErrorContains(t, err, `"tpu", "dsik"`)
+}
+
+func TestValidateDisabledAssets(t *testing.T) {
+	assert.NoError(t, ValidateDisabledAssets(&service.Settings{}))
+
+	err := ValidateDisabledAssets(&service.Settings{
+		XStatsDisabledAssets: &service.ListStringValue{
+			Value: []string{"cpu", "tpu"},
+		},
+	})
+	assert.ErrorContains(t, err, `invalid _stats_disabled_assets: unknown asset name(s) "tpu"`)
+}
+
+func TestNewSystemMonitor_DisabledAssets(t *testing.T) {
+	sm := NewSystemMonitor(
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			XStatsDisabledAssets: &service.ListStringValue{
+				Value: []string{"cpu", "disk", "gpu", "network"},
+			},
+		},
+		make(chan *service.Record),
+	)
+
+	for _, asset := range sm.assets {
+		assert.Equal(t, "memory", asset.Name())
+	}
+}
+
+func TestStartOffset_SpreadsAssetsAcrossInterval(t *testing.T) {
+	interval := 10 * time.Second
+
+	assert.Equal(t, time.Duration(0), startOffset(0, 5, interval, 0))
+	assert.Equal(t, 2*time.Second, startOffset(1, 5, interval, 0))
+	assert.Equal(t, 8*time.Second, startOffset(4, 5, interval, 0))
+}
+
+func TestStartOffset_JitterStaysWithinGap(t *testing.T) {
+	interval := 10 * time.Second
+
+	assert.Equal(t, 3*time.Second, startOffset(1, 5, interval, 1))
+	assert.Less(t,
+		startOffset(1, 5, interval, 0.999),
+		startOffset(2, 5, interval, 0))
+}
+
+func TestStartOffset_NoInterval(t *testing.T) {
+	assert.Zero(t, startOffset(3, 5, 0, 0.5))
+}
+
+func TestStop_Concurrently(t *testing.T) {
+	sm := NewSystemMonitor(
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			XStatsDisabledAssets: &service.ListStringValue{
+				Value: []string{"cpu", "disk", "gpu", "network"},
+			},
+		},
+		make(chan *service.Record, 100),
+	)
+	sm.Do()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sm.Stop()
+		}()
+	}
+	wg.Wait()
+
+	assert.Nil(t, sm.cancel)
+}
@@ -0,0 +1,234 @@
+package observability
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// RotatingFile is a log file that is rotated when it grows too large.
+//
+// When a write would make the file larger than the maximum size, the file
+// is moved aside and a new one is started. The old file is then compressed
+// into "<path>.1.gz" in the background, so that logging doesn't wait for
+// it. Older rotated files are shifted to "<path>.2.gz" and so on, and the
+// oldest is deleted so that at most maxFiles rotated files are kept.
+type RotatingFile struct {
+	mu sync.Mutex
+
+	path     string
+	maxBytes int64
+	maxFiles int
+
+	file *os.File
+	size int64
+
+	// pending are rotated files waiting to be compressed, oldest first
+	pending []string
+
+	// nextSegment is used to name the next rotated file
+	nextSegment int
+
+	// compressing is whether a goroutine is compressing pending files
+	compressing bool
+
+	// compressed is done when no goroutine is compressing files
+	compressed sync.WaitGroup
+}
+
+// OpenRotatingFile opens or creates a log file for appending.
+//
+// If maxBytes is not positive, the file is never rotated.
+func OpenRotatingFile(
+	path string,
+	maxBytes int64,
+	maxFiles int,
+) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:     path,
+		maxBytes: maxBytes,
+		maxFiles: max(0, maxFiles),
+	}
+
+	if err := f.open(os.O_APPEND); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// RotatedLogPath is the path of the n-th most recent rotated log.
+func RotatedLogPath(path string, n int) string {
+	return fmt.Sprintf("%s.%d.gz", path, n)
+}
+
+// Write appends to the file, rotating it first if necessary.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.maxBytes > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxBytes {
+		if err := f.rotate(); err != nil {
+			// Keep logging to the current file rather than losing logs.
+			fmt.Fprintf(os.Stderr, "error rotating log file: %v\n", err)
+		}
+	}
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close closes the file and waits for rotated files to be compressed.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	var err error
+	if f.file != nil {
+		err = f.file.Close()
+		f.file = nil
+	}
+	f.mu.Unlock()
+
+	f.compressed.Wait()
+	return err
+}
+
+// open opens the log file with the given extra flags.
+func (f *RotatingFile) open(flag int) error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|flag, 0666)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate moves the current file aside to be compressed and starts a new
+// file.
+//
+// It must be called with the mutex held.
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+
+	var errs []error
+	if f.maxFiles > 0 {
+		segment := fmt.Sprintf("%s.rotating.%d", f.path, f.nextSegment)
+		f.nextSegment++
+
+		if err := os.Rename(f.path, segment); err != nil {
+			errs = append(errs, err)
+		} else {
+			f.pending = append(f.pending, segment)
+			if !f.compressing {
+				f.compressing = true
+				f.compressed.Add(1)
+				go f.compressPending()
+			}
+		}
+	}
+
+	// Truncating even if the file couldn't be moved keeps it bounded.
+	if err := f.open(os.O_TRUNC); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// compressPending compresses rotated files until there are none left.
+func (f *RotatingFile) compressPending() {
+	defer f.compressed.Done()
+
+	for {
+		f.mu.Lock()
+		if len(f.pending) == 0 {
+			f.compressing = false
+			f.mu.Unlock()
+			return
+		}
+		segment := f.pending[0]
+		f.pending = f.pending[1:]
+		f.mu.Unlock()
+
+		if err := f.shiftAndCompress(segment); err != nil {
+			fmt.Fprintf(os.Stderr, "error compressing rotated log file: %v\n", err)
+		}
+	}
+}
+
+// shiftAndCompress shifts older rotated files and compresses a rotated
+// file into the most recent slot.
+//
+// The rotated file is deleted afterward, even if compressing it failed,
+// so that the logs stay bounded.
+func (f *RotatingFile) shiftAndCompress(segment string) error {
+	var errs []error
+
+	err := os.Remove(RotatedLogPath(f.path, f.maxFiles))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = append(errs, err)
+	}
+
+	for i := f.maxFiles - 1; i >= 1; i-- {
+		err := os.Rename(RotatedLogPath(f.path, i), RotatedLogPath(f.path, i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := compressFile(segment, RotatedLogPath(f.path, 1)); err != nil {
+		errs = append(errs, err)
+	}
+	if err := os.Remove(segment); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// compressFile writes a gzip-compressed copy of src to dst.
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmpPath := dst + ".tmp"
+	out, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(out)
+	_, err = io.Copy(gz, in)
+	err = errors.Join(err, gz.Close(), out.Close())
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, dst)
+}
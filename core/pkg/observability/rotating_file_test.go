@@ -0,0 +1,100 @@
+package observability_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func readGzip(t *testing.T, path string) string {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestRotatingFile_RotatesAndCompresses(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	file, err := observability.OpenRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := file.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, file.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "fourth\n", string(current))
+	assert.Equal(t, "third\n", readGzip(t, observability.RotatedLogPath(path, 1)))
+	assert.Equal(t, "second\n", readGzip(t, observability.RotatedLogPath(path, 2)))
+	assert.NoFileExists(t, observability.RotatedLogPath(path, 3))
+}
+
+func TestRotatingFile_NoLimit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	require.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o644))
+	file, err := observability.OpenRotatingFile(path, 0, 2)
+	require.NoError(t, err)
+
+	_, err = file.Write([]byte("appended\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "existing\nappended\n", string(current))
+	assert.NoFileExists(t, observability.RotatedLogPath(path, 1))
+}
+
+func TestRotatingFile_NoRetainedFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	file, err := observability.OpenRotatingFile(path, 5, 0)
+	require.NoError(t, err)
+
+	_, _ = file.Write([]byte("12345"))
+	_, _ = file.Write([]byte("678"))
+	require.NoError(t, file.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "678", string(current))
+	assert.NoFileExists(t, observability.RotatedLogPath(path, 1))
+}
+
+func TestRotatingFile_CloseWaitsForCompression(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "debug.log")
+	file, err := observability.OpenRotatingFile(path, 5, 3)
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		_, err := file.Write([]byte("12345"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, file.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t,
+		[]string{"debug.log", "debug.log.1.gz", "debug.log.2.gz", "debug.log.3.gz"},
+		names)
+	assert.Equal(t, "12345", readGzip(t, observability.RotatedLogPath(path, 1)))
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// AnonymousAuth authenticates requests as an anonymous W&B user.
+//
+// An anonymous API key is requested from the backend the first time it's
+// needed and is reused for the rest of the session. It is never written
+// to disk, so anonymous runs must be claimed using the URL from ClaimURL.
+type AnonymousAuth struct {
+	mu sync.Mutex
+
+	// graphqlClient is an unauthenticated client for creating the key.
+	graphqlClient graphql.Client
+
+	// apiKey is the anonymous API key, or empty if not yet created.
+	apiKey string
+}
+
+func NewAnonymousAuth(graphqlClient graphql.Client) *AnonymousAuth {
+	return &AnonymousAuth{graphqlClient: graphqlClient}
+}
+
+// Token returns the anonymous API key, creating it if necessary.
+func (a *AnonymousAuth) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.apiKey != "" {
+		return a.apiKey, nil
+	}
+
+	// Each request creates a new anonymous user, so one that may have
+	// reached the backend isn't retried.
+	data, err := gql.CreateAnonymousApiKey(
+		api.WithRetryPredicate(ctx, api.NeverRetry),
+		a.graphqlClient,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create anonymous API key: %v", err)
+	}
+
+	var apiKey string
+	if payload := data.GetCreateAnonymousEntity(); payload != nil &&
+		payload.GetApiKey() != nil {
+		apiKey = payload.GetApiKey().GetName()
+	}
+	if apiKey == "" {
+		return "", errors.New("backend returned an empty anonymous API key")
+	}
+
+	a.apiKey = apiKey
+	return apiKey, nil
+}
+
+// APIKey returns the anonymous API key, or an empty string if it hasn't
+// been created yet.
+func (a *AnonymousAuth) APIKey() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.apiKey
+}
+
+// ClaimURL returns a link that lets a user associate the run with their
+// account.
+//
+// The link contains the anonymous API key and must not be shared. Returns
+// an empty string if no key has been created.
+func (a *AnonymousAuth) ClaimURL(
+	baseURL string,
+	entity, project, runID string,
+) string {
+	apiKey := a.APIKey()
+	if apiKey == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"%s/%s/%s/runs/%s?apiKey=%s",
+		appURL(baseURL),
+		url.PathEscape(entity),
+		url.PathEscape(project),
+		url.PathEscape(runID),
+		url.QueryEscape(apiKey),
+	)
+}
+
+// appURL returns the URL of the W&B web app given the API's base URL.
+func appURL(baseURL string) string {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	switch {
+	case strings.Contains(baseURL, "://api.wandb.test"):
+		return strings.Replace(baseURL, "://api.", "://app.", 1)
+	case strings.Contains(baseURL, "://api.wandb."):
+		return strings.Replace(baseURL, "://api.", "://", 1)
+	case strings.Contains(baseURL, "://api."):
+		return strings.Replace(baseURL, "://api.", "://app.", 1)
+	default:
+		return baseURL
+	}
+}
@@ -0,0 +1,100 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestAnonymousAuth_CreatesKeyOnce(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateAnonymousApiKey"),
+		`{"createAnonymousEntity": {"apiKey": {"name": "anon-key"}}}`,
+	)
+	auth := server.NewAnonymousAuth(mockGQL)
+
+	assert.Empty(t, auth.APIKey())
+	token1, err1 := auth.Token(context.Background())
+	token2, err2 := auth.Token(context.Background())
+
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.Equal(t, "anon-key", token1)
+	assert.Equal(t, "anon-key", token2)
+	assert.Equal(t, "anon-key", auth.APIKey())
+	assert.Len(t, mockGQL.AllRequests(), 1)
+}
+
+func TestAnonymousAuth_EmptyKeyIsError(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateAnonymousApiKey"),
+		`{"createAnonymousEntity": {"apiKey": null}}`,
+	)
+	auth := server.NewAnonymousAuth(mockGQL)
+
+	_, err := auth.Token(context.Background())
+
+	assert.ErrorContains(t, err, "empty anonymous API key")
+	assert.Empty(t, auth.ClaimURL("https://api.wandb.ai", "e", "p", "r"))
+}
+
+func TestAnonymousAuth_ClaimURL(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateAnonymousApiKey"),
+		`{"createAnonymousEntity": {"apiKey": {"name": "anon-key"}}}`,
+	)
+	auth := server.NewAnonymousAuth(mockGQL)
+	_, err := auth.Token(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"https://wandb.ai/anony-mouse/uncategorized/runs/abc123?apiKey=anon-key",
+		auth.ClaimURL(
+			"https://api.wandb.ai",
+			"anony-mouse",
+			"uncategorized",
+			"abc123",
+		))
+	assert.Equal(t,
+		"http://localhost:8080/e/p/runs/r?apiKey=anon-key",
+		auth.ClaimURL("http://localhost:8080/", "e", "p", "r"))
+}
+
+func TestNewAnonymousAuthOrNil_DoesNotRetryKeyCreation(t *testing.T) {
+	var requests atomic.Int32
+	backend := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+	defer backend.Close()
+	auth := server.NewAnonymousAuthOrNil(
+		observability.NewNoOpLogger(),
+		settings.From(&service.Settings{
+			Anonymous:                   wrapperspb.String(settings.AnonymousMust),
+			BaseUrl:                     wrapperspb.String(backend.URL),
+			XGraphqlRetryMax:            wrapperspb.Int32(3),
+			XGraphqlRetryWaitMinSeconds: wrapperspb.Double(0.001),
+			XGraphqlRetryWaitMaxSeconds: wrapperspb.Double(0.001),
+		}),
+	)
+
+	_, err := auth.Token(context.Background())
+
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+}
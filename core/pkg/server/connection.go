@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"os"
 	"sync"
 	"sync/atomic"
 
@@ -50,6 +51,11 @@ type Connection struct {
 	// however, a stream can have multiple connections
 	stream *Stream
 
+	// streamErr is why the stream couldn't be created, if it couldn't
+	//
+	// It is reported to the client in response to its run record.
+	streamErr error
+
 	// closed indicates if the outChan is closed
 	closed *atomic.Bool
 }
@@ -223,9 +229,22 @@ func (nc *Connection) handleServerRequest() {
 // handleInformInit is called when the client sends an InformInit message
 // to the server, to start a new stream
 func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
-	settings := settings.From(msg.GetSettings())
+	streamId := msg.GetXInfo().GetStreamId()
 
-	err := settings.EnsureAPIKey()
+	settings, err := nc.loadSettings(msg.GetSettings())
+	if err != nil {
+		slog.Error(
+			"connection init failed: invalid settings",
+			"err", err,
+			"streamId", streamId,
+			"id", nc.id,
+		)
+		nc.stream = nil
+		nc.streamErr = err
+		return
+	}
+
+	err = settings.EnsureAPIKey()
 	if err != nil {
 		slog.Error(
 			"connection: couldn't get API key",
@@ -235,10 +254,23 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 		panic(err)
 	}
 
-	streamId := msg.GetXInfo().GetStreamId()
 	slog.Info("connection init received", "streamId", streamId, "id", nc.id)
 
-	nc.stream = NewStream(settings, streamId)
+	stream, err := NewStream(settings, streamId)
+	if err != nil {
+		slog.Error(
+			"connection init failed",
+			"err", err,
+			"streamId", streamId,
+			"id", nc.id,
+		)
+		nc.stream = nil
+		nc.streamErr = err
+		return
+	}
+
+	nc.stream = stream
+	nc.streamErr = nil
 	nc.stream.AddResponders(ResponderEntry{nc, nc.id})
 	nc.stream.Start()
 	slog.Info("connection init completed", "streamId", streamId, "id", nc.id)
@@ -250,13 +282,49 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 	}
 }
 
+// loadSettings layers the client's settings over the settings file they
+// name and the WANDB_* environment variables of this process.
+//
+// Settings the client sets take precedence, so the file and environment
+// only fill in settings the client leaves unset.
+func (nc *Connection) loadSettings(
+	clientSettings *service.Settings,
+) (*settings.Settings, error) {
+	loader := &settings.Loader{
+		File:      clientSettings.GetXSettingsFile().GetValue(),
+		Environ:   os.Environ(),
+		Overrides: clientSettings,
+	}
+
+	loaded, provenance, err := loader.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Debug(
+		"connection: loaded settings",
+		"sources", provenance.String(),
+		"id", nc.id,
+	)
+	return loaded, nil
+}
+
 // handleInformStart is called when the client sends an InformStart message
 // TODO: probably can remove this, we should be able to update the settings
 // using the regular InformRecord messages
 func (nc *Connection) handleInformStart(msg *service.ServerInformStartRequest) {
 	// todo: if we keep this and end up updating the settings here
 	//       we should update the stream logger to use the new settings as well
-	nc.stream.settings = settings.From(msg.GetSettings())
+	settings, err := nc.loadSettings(msg.GetSettings())
+	if err != nil {
+		slog.Error(
+			"handleInformStart: invalid settings",
+			"err", err,
+			"id", nc.id,
+		)
+		return
+	}
+	nc.stream.settings = settings
 
 	// update sentry tags
 	// add attrs from settings:
@@ -302,7 +370,9 @@ func (nc *Connection) handleInformAttach(msg *service.ServerInformAttachRequest)
 func (nc *Connection) handleInformRecord(msg *service.Record) {
 	streamId := msg.GetXInfo().GetStreamId()
 	slog.Debug("handle record received", "streamId", streamId, "id", nc.id)
-	if nc.stream == nil {
+	if nc.stream == nil && nc.streamErr != nil {
+		nc.respondStreamErr(msg)
+	} else if nc.stream == nil {
 		slog.Error("handleInformRecord: stream not found", "streamId", streamId, "id", nc.id)
 	} else {
 		// add connection id to control message
@@ -317,6 +387,33 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 	}
 }
 
+// respondStreamErr fails the client's run record with the reason the
+// stream couldn't be created, so that wandb.init() can report it.
+//
+// Other records are dropped, as there's no stream to handle them.
+func (nc *Connection) respondStreamErr(msg *service.Record) {
+	if msg.GetRun() == nil {
+		return
+	}
+
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_ResultCommunicate{
+			ResultCommunicate: &service.Result{
+				ResultType: &service.Result_RunResult{
+					RunResult: &service.RunUpdateResult{
+						Error: &service.ErrorInfo{
+							Message: nc.streamErr.Error(),
+							Code:    service.ErrorInfo_USAGE,
+						},
+					},
+				},
+				Control: msg.Control,
+				Uuid:    msg.Uuid,
+			},
+		},
+	})
+}
+
 // handleInformFinish is called when the client sends a finish message
 // this should happen when the client want to close a specific stream
 func (nc *Connection) handleInformFinish(msg *service.ServerInformFinishRequest) {
@@ -326,6 +423,13 @@ func (nc *Connection) handleInformFinish(msg *service.ServerInformFinishRequest)
 		slog.Error("handleInformFinish:", "err", err, "streamId", streamId, "id", nc.id)
 	} else {
 		stream.Close()
+
+		// The client may start another run on this connection, possibly in
+		// a different project. Records before its InformInit must not reach
+		// the finished stream.
+		if nc.stream == stream {
+			nc.stream = nil
+		}
 	}
 }
 
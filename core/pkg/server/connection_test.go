@@ -0,0 +1,294 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeClient talks to a Connection like the wandb client does.
+type fakeClient struct {
+	t       *testing.T
+	conn    net.Conn
+	scanner *bufio.Scanner
+}
+
+func newFakeClient(t *testing.T) *fakeClient {
+	t.Helper()
+
+	clientConn, serverConn := net.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	connection := server.NewConnection(ctx, cancel, serverConn)
+
+	done := make(chan struct{})
+	go func() {
+		connection.HandleConnection()
+		close(done)
+	}()
+	t.Cleanup(func() {
+		// Disconnect first, like a client that exits, so that the
+		// connection stops reading before it is closed.
+		_ = clientConn.Close()
+		cancel()
+		<-done
+	})
+
+	scanner := bufio.NewScanner(clientConn)
+	scanner.Split((&server.Tokenizer{}).Split)
+	return &fakeClient{t: t, conn: clientConn, scanner: scanner}
+}
+
+func (c *fakeClient) send(request *service.ServerRequest) {
+	c.t.Helper()
+
+	data, err := proto.Marshal(request)
+	require.NoError(c.t, err)
+	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
+	require.NoError(c.t, binary.Write(c.conn, binary.LittleEndian, &header))
+	_, err = c.conn.Write(data)
+	require.NoError(c.t, err)
+}
+
+// communicate sends a record and returns the result for it.
+func (c *fakeClient) communicate(record *service.Record) *service.Result {
+	c.t.Helper()
+
+	record.Control = &service.Control{MailboxSlot: "slot"}
+	c.send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{
+			RecordCommunicate: record,
+		},
+	})
+
+	result := make(chan *service.Result, 1)
+	go func() {
+		for c.scanner.Scan() {
+			response := &service.ServerResponse{}
+			if proto.Unmarshal(c.scanner.Bytes(), response) != nil {
+				continue
+			}
+			if r := response.GetResultCommunicate(); r != nil {
+				result <- r
+				return
+			}
+		}
+	}()
+
+	select {
+	case r := <-result:
+		return r
+	case <-time.After(10 * time.Second):
+		c.t.Fatal("no response from the connection")
+		return nil
+	}
+}
+
+// fakeUpsertBackend is a backend that echoes the entity and project of
+// each run upsert and records them.
+type fakeUpsertBackend struct {
+	mu      sync.Mutex
+	upserts []string
+}
+
+func (b *fakeUpsertBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	var request struct {
+		Variables struct {
+			Entity  string `json:"entity"`
+			Project string `json:"project"`
+		} `json:"variables"`
+	}
+	_ = json.Unmarshal(body, &request)
+
+	switch {
+	case strings.Contains(string(body), "UpsertBucket"):
+		b.mu.Lock()
+		b.upserts = append(b.upserts,
+			request.Variables.Entity+"/"+request.Variables.Project)
+		b.mu.Unlock()
+
+		_, _ = fmt.Fprintf(w,
+			`{"data": {"upsertBucket": {"bucket": {"project":`+
+				` {"name": %q, "entity": {"name": %q}}}}}}`,
+			request.Variables.Project, request.Variables.Entity)
+	case strings.Contains(string(body), "CreateRunFiles"):
+		_, _ = w.Write([]byte(`{"data": {"createRunFiles": {"files": []}}}`))
+	default:
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+func (b *fakeUpsertBackend) Upserts() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.upserts...)
+}
+
+func TestConnection_InitAgainWithDifferentProject(t *testing.T) {
+	backend := &fakeUpsertBackend{}
+	backendServer := httptest.NewServer(backend)
+	defer backendServer.Close()
+	client := newFakeClient(t)
+
+	for _, run := range []struct{ id, entity, project string }{
+		{"conn-run-1", "entity-1", "project-1"},
+		{"conn-run-2", "entity-2", "project-2"},
+	} {
+		dir := t.TempDir()
+		client.send(&service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_InformInit{
+				InformInit: &service.ServerInformInitRequest{
+					Settings: &service.Settings{
+						RunId:       wrapperspb.String(run.id),
+						ApiKey:      wrapperspb.String("test-key"),
+						BaseUrl:     wrapperspb.String(backendServer.URL),
+						SyncDir:     wrapperspb.String(dir),
+						LogDir:      wrapperspb.String(dir),
+						LogInternal: wrapperspb.String(filepath.Join(dir, "debug-internal.log")),
+						FilesDir:    wrapperspb.String(dir),
+						SyncFile:    wrapperspb.String(filepath.Join(dir, "run.wandb")),
+					},
+					XInfo: &service.XRecordInfo{StreamId: run.id},
+				},
+			},
+		})
+
+		result := client.communicate(&service.Record{
+			RecordType: &service.Record_Run{
+				Run: &service.RunRecord{
+					RunId:   run.id,
+					Entity:  run.entity,
+					Project: run.project,
+				},
+			},
+			XInfo: &service.XRecordInfo{StreamId: run.id},
+		})
+		require.Nil(t, result.GetRunResult().GetError())
+		assert.Equal(t, run.entity, result.GetRunResult().GetRun().GetEntity())
+		assert.Equal(t, run.project, result.GetRunResult().GetRun().GetProject())
+
+		result = client.communicate(&service.Record{
+			RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+			XInfo:      &service.XRecordInfo{StreamId: run.id},
+		})
+		require.NotNil(t, result.GetExitResult())
+
+		client.send(&service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_InformFinish{
+				InformFinish: &service.ServerInformFinishRequest{
+					XInfo: &service.XRecordInfo{StreamId: run.id},
+				},
+			},
+		})
+	}
+
+	// Each run may be upserted several times, such as to update its config.
+	assert.Equal(t,
+		[]string{"entity-1/project-1", "entity-2/project-2"},
+		slices.Compact(backend.Upserts()))
+}
+
+func TestConnection_InvalidSettingsFileFailsInit(t *testing.T) {
+	client := newFakeClient(t)
+	dir := t.TempDir()
+	settingsFile := filepath.Join(dir, "settings.yaml")
+	require.NoError(t, os.WriteFile(settingsFile, []byte("not_a_setting: 1\n"), 0o644))
+
+	client.send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_InformInit{
+			InformInit: &service.ServerInformInitRequest{
+				Settings: &service.Settings{
+					RunId:         wrapperspb.String("conn-run"),
+					ApiKey:        wrapperspb.String("test-key"),
+					SyncDir:       wrapperspb.String(dir),
+					XSettingsFile: wrapperspb.String(settingsFile),
+				},
+				XInfo: &service.XRecordInfo{StreamId: "conn-run"},
+			},
+		},
+	})
+	result := client.communicate(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "conn-run"},
+		},
+		XInfo: &service.XRecordInfo{StreamId: "conn-run"},
+	})
+
+	assert.Contains(t,
+		result.GetRunResult().GetError().GetMessage(),
+		`unknown setting "not_a_setting"`)
+}
+
+func TestConnection_PreflightWithoutRun(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			switch {
+			case strings.Contains(string(body), "query Viewer"):
+				_, _ = w.Write([]byte(`{"data": {"viewer": {"id": "user", "entity": "me"}}}`))
+			case strings.Contains(string(body), "query Preflight"):
+				_, _ = w.Write([]byte(`{"data": {` +
+					`"entity": {"id": "entity"},` +
+					`"project": {"id": "project", "readOnly": true}}}`))
+			default:
+				_, _ = w.Write([]byte(`{}`))
+			}
+		}))
+	defer backend.Close()
+	client := newFakeClient(t)
+	dir := t.TempDir()
+
+	client.send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_InformInit{
+			InformInit: &service.ServerInformInitRequest{
+				Settings: &service.Settings{
+					RunId:                  wrapperspb.String("preflight"),
+					ApiKey:                 wrapperspb.String("test-key"),
+					BaseUrl:                wrapperspb.String(backend.URL),
+					SyncDir:                wrapperspb.String(dir),
+					LogDir:                 wrapperspb.String(dir),
+					LogInternal:            wrapperspb.String(filepath.Join(dir, "debug-internal.log")),
+					FilesDir:               wrapperspb.String(dir),
+					XDisableTransactionLog: wrapperspb.Bool(true),
+				},
+				XInfo: &service.XRecordInfo{StreamId: "preflight"},
+			},
+		},
+	})
+	result := client.communicate(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Preflight{
+					Preflight: &service.PreflightRequest{Project: "project"},
+				},
+			},
+		},
+		Control: &service.Control{Local: true},
+		XInfo:   &service.XRecordInfo{StreamId: "preflight"},
+	})
+
+	response := result.GetResponse().GetPreflightResponse()
+	assert.Equal(t, "permissions", response.GetFailedCheck())
+	assert.Contains(t, response.GetError().GetMessage(), "me/project")
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// FlushStage is how far records got by the time a Flush returned.
+type FlushStage int
+
+const (
+	// No stage completed.
+	FlushStageNone FlushStage = iota
+
+	// Records were written to the transaction log and synced to disk.
+	FlushStagePersisted
+
+	// Records were also acknowledged by the filestream API.
+	FlushStageSent
+)
+
+func (s FlushStage) String() string {
+	switch s {
+	case FlushStagePersisted:
+		return "persisted"
+	case FlushStageSent:
+		return "sent"
+	default:
+		return "none"
+	}
+}
+
+// Proto returns the stage as reported to clients.
+func (s FlushStage) Proto() service.FlushResponse_Stage {
+	switch s {
+	case FlushStagePersisted:
+		return service.FlushResponse_PERSISTED
+	case FlushStageSent:
+		return service.FlushResponse_SENT
+	default:
+		return service.FlushResponse_NONE
+	}
+}
+
+// flushBarrier is signaled as records before a FlushRequest complete each
+// stage.
+type flushBarrier struct {
+	persisted     chan struct{}
+	persistedOnce sync.Once
+
+	sent     chan struct{}
+	sentOnce sync.Once
+
+	// unsendable is closed if the records cannot be sent yet.
+	unsendable     chan struct{}
+	unsendableOnce sync.Once
+
+	// failed is closed if a stage cannot complete, with the reason in err.
+	failed     chan struct{}
+	failedOnce sync.Once
+	err        error
+}
+
+// FlushBarriers tracks FlushRequests that are in the pipeline.
+//
+// It is shared by the stream, which waits on barriers, and the writer and
+// sender, which signal them.
+type FlushBarriers struct {
+	mu       sync.Mutex
+	nextID   int64
+	barriers map[int64]*flushBarrier
+}
+
+func NewFlushBarriers() *FlushBarriers {
+	return &FlushBarriers{barriers: make(map[int64]*flushBarrier)}
+}
+
+// add creates a barrier and returns its ID.
+func (fb *FlushBarriers) add() (int64, *flushBarrier) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	fb.nextID++
+	barrier := &flushBarrier{
+		persisted:  make(chan struct{}),
+		sent:       make(chan struct{}),
+		unsendable: make(chan struct{}),
+		failed:     make(chan struct{}),
+	}
+	fb.barriers[fb.nextID] = barrier
+	return fb.nextID, barrier
+}
+
+// remove forgets a barrier once nobody is waiting on it.
+func (fb *FlushBarriers) remove(id int64) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	delete(fb.barriers, id)
+}
+
+func (fb *FlushBarriers) get(id int64) *flushBarrier {
+	if fb == nil {
+		return nil
+	}
+
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	return fb.barriers[id]
+}
+
+// MarkPersisted signals that records before the request are in the
+// transaction log.
+//
+// It is a no-op if the Flush call already returned.
+func (fb *FlushBarriers) MarkPersisted(request *service.FlushRequest) {
+	if barrier := fb.get(request.GetId()); barrier != nil {
+		barrier.persistedOnce.Do(func() { close(barrier.persisted) })
+	}
+}
+
+// MarkSent signals that records before the request were acknowledged by
+// the backend.
+//
+// It is a no-op if the Flush call already returned.
+func (fb *FlushBarriers) MarkSent(request *service.FlushRequest) {
+	if barrier := fb.get(request.GetId()); barrier != nil {
+		barrier.sentOnce.Do(func() { close(barrier.sent) })
+	}
+}
+
+// MarkUnsendable signals that records before the request cannot be sent
+// yet, which is the case until the run starts.
+//
+// It is a no-op if the Flush call already returned.
+func (fb *FlushBarriers) MarkUnsendable(request *service.FlushRequest) {
+	if barrier := fb.get(request.GetId()); barrier != nil {
+		barrier.unsendableOnce.Do(func() { close(barrier.unsendable) })
+	}
+}
+
+// MarkFailed signals that records before the request will not complete
+// the next stage.
+//
+// It is a no-op if the Flush call already returned.
+func (fb *FlushBarriers) MarkFailed(request *service.FlushRequest, err error) {
+	if barrier := fb.get(request.GetId()); barrier != nil {
+		barrier.failedOnce.Do(func() {
+			barrier.err = err
+			close(barrier.failed)
+		})
+	}
+}
@@ -81,3 +81,25 @@ func (g *Git) SavePatch(ref, output string) error {
 	}
 	return nil
 }
+
+// TrackedFiles returns the files in the repository that aren't ignored.
+//
+// This includes untracked files unless they're excluded by .gitignore.
+// Paths are relative to the repository path.
+func (g *Git) TrackedFiles() ([]string, error) {
+	cmd := exec.Command(
+		"git", "ls-files", "-z", "--cached", "--others", "--exclude-standard")
+	cmd.Dir = g.path
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, path := range strings.Split(string(output), "\x00") {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
@@ -6,21 +6,36 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/segmentio/encoding/json"
+	"github.com/wandb/wandb/core/pkg/artifacts"
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"github.com/wandb/wandb/core/pkg/utils"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/wandb/wandb/core/internal/clocksync"
+	"github.com/wandb/wandb/core/internal/droppeddata"
 	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/historylimit"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/metriclimit"
+	"github.com/wandb/wandb/core/internal/runenv"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/internal/runlock"
+	"github.com/wandb/wandb/core/internal/runname"
 	"github.com/wandb/wandb/core/internal/runsummary"
 	"github.com/wandb/wandb/core/internal/sampler"
+	"github.com/wandb/wandb/core/internal/stepsync"
 	"github.com/wandb/wandb/core/internal/timer"
+	"github.com/wandb/wandb/core/internal/valuelimit"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -35,6 +50,16 @@ const (
 	ConfigFileName       = "config.yaml"
 )
 
+// maxHistoryTimestampSkew is how far in the future an explicit history
+// timestamp can be before the user is warned.
+//
+// Timestamps in the past are expected when logging data after the fact.
+const maxHistoryTimestampSkew = 5 * time.Minute
+
+// maxRunNotesLength is the longest run description the backend accepts,
+// in characters.
+const maxRunNotesLength = 65535
+
 type HandlerParams struct {
 	Settings          *service.Settings
 	FwdChan           chan *service.Record
@@ -46,8 +71,21 @@ type HandlerParams struct {
 	FileTransferStats filetransfer.FileTransferStats
 	RunfilesUploader  runfiles.Uploader
 	TBHandler         *TBHandler
+	LogFiles          *LogFiles
 	SystemMonitor     *monitor.SystemMonitor
 	TerminalPrinter   *observability.Printer
+	DroppedData       *droppeddata.Tracker
+	Staging           *artifacts.StagingArea
+
+	// ClockCorrector shifts the timestamps of records to correct the
+	// local clock; nil if no correction is needed.
+	ClockCorrector *clocksync.Corrector
+
+	// RunLockDir is where locks are kept that detect other processes
+	// logging to the same run.
+	//
+	// If empty, no check is done.
+	RunLockDir string
 }
 
 // Handler is the handler for a stream it handles the incoming messages, processes them
@@ -77,6 +115,25 @@ type Handler struct {
 	// runHistory is the current active history entry being updated
 	runHistory *runhistory.RunHistory
 
+	// sharedStep assigns history steps shared with other processes
+	// logging to the run
+	//
+	// It is nil unless a shared step file is configured.
+	sharedStep *stepsync.Counter
+
+	// runLockDir is where the lock for the run is acquired
+	//
+	// It is empty if no lock should be acquired.
+	runLockDir string
+
+	// runLock is held while this process logs to the run
+	//
+	// It is nil until the first run record, and if no lock is used.
+	runLock *runlock.Lock
+
+	// checkedRunLock is whether the lock for the run was tried
+	checkedRunLock bool
+
 	// samplers is the map of samplers for all the history metrics that are
 	// being tracked, the result of the samplers will be used to display the
 	// the sparkline in the terminal
@@ -90,12 +147,83 @@ type Handler struct {
 	// runSummary keeps the complete up-to-date summary
 	runSummary *runsummary.RunSummary
 
+	// historyLimiter drops history points of metrics logged too often
+	//
+	// It is nil if there is no limit.
+	historyLimiter *historylimit.Limiter
+
+	// warnedHistoryRateLimit is whether the user was told about dropped
+	// history points
+	warnedHistoryRateLimit bool
+
+	// metricLimiter rejects new metrics once a run has too many
+	//
+	// It is nil if there is no limit.
+	metricLimiter *metriclimit.Limiter
+
+	// warnedMetricLimit is whether the user was told about rejected metrics
+	warnedMetricLimit bool
+
+	// finished is whether the run's exit record was handled
+	finished bool
+
+	// postFinishPolicy is what to do with data logged after finishing
+	postFinishPolicy PostFinishPolicy
+
+	// warnedPostFinish is whether the user was told about data logged
+	// after finishing
+	warnedPostFinish bool
+
+	// valueLimiter handles history and summary values that are too large
+	//
+	// It is nil if there is no limit.
+	valueLimiter *valuelimit.Limiter
+
+	// warnedOversizedValues are messages about oversized values that the
+	// user was already shown
+	warnedOversizedValues map[string]struct{}
+
+	// droppedData counts data that was dropped instead of uploaded
+	//
+	// It may be nil.
+	droppedData *droppeddata.Tracker
+
+	// reportedDroppedData is whether the user was told about dropped data
+	// at the end of the run
+	reportedDroppedData bool
+
+	// staging holds copies of files to upload, like the code snapshot
+	staging *artifacts.StagingArea
+
+	// codeSnapshots tracks code snapshots being saved in the background
+	codeSnapshots sync.WaitGroup
+
+	// historyTimestamp is the explicit timestamp for the partial history
+	// being collected, if any
+	historyTimestamp *timestamppb.Timestamp
+
+	// clockCorrector shifts the timestamps of records; it may be nil
+	clockCorrector *clocksync.Corrector
+
+	// warnedTimestampSkew is whether the user was told about history
+	// timestamps in the future
+	warnedTimestampSkew bool
+
+	// warnedDuplicateKeys are keys the user was told they set twice in
+	// one history or summary update
+	warnedDuplicateKeys map[string]struct{}
+
 	// systemMonitor is the system monitor for the stream
 	systemMonitor *monitor.SystemMonitor
 
 	// tbHandler is the tensorboard handler
 	tbHandler *TBHandler
 
+	// logFiles streams user log files to the console logs
+	//
+	// It may be nil.
+	logFiles *LogFiles
+
 	// runfilesUploaderOrNil manages uploading a run's files
 	//
 	// It may be nil when offline.
@@ -116,20 +244,57 @@ func NewHandler(
 	ctx context.Context,
 	params *HandlerParams,
 ) *Handler {
+	valueLimitAction, err := valuelimit.ParseAction(
+		params.Settings.GetXOversizedValueAction().GetValue())
+	if err != nil {
+		params.Logger.Warn(
+			"handler: using default oversized value action",
+			"error", err,
+		)
+	}
+
+	postFinishPolicy, err := ParsePostFinishPolicy(
+		params.Settings.GetXPostFinishPolicy().GetValue())
+	if err != nil {
+		params.Logger.CaptureError("handler: using default post-finish policy", err)
+	}
+
+	var sharedStep *stepsync.Counter
+	if path := params.Settings.GetXSharedStepFile().GetValue(); path != "" {
+		sharedStep, err = stepsync.New(path)
+		if err != nil {
+			params.Logger.CaptureError("handler: not sharing history steps", err)
+		}
+	}
+
 	return &Handler{
-		ctx:                   ctx,
-		runTimer:              timer.New(),
-		terminalPrinter:       params.TerminalPrinter,
-		logger:                params.Logger,
-		settings:              params.Settings,
-		fwdChan:               params.FwdChan,
-		outChan:               params.OutChan,
-		mailbox:               params.Mailbox,
-		runSummary:            params.RunSummary,
+		ctx:              ctx,
+		runTimer:         timer.New(),
+		sharedStep:       sharedStep,
+		runLockDir:       params.RunLockDir,
+		terminalPrinter:  params.TerminalPrinter,
+		logger:           params.Logger,
+		settings:         params.Settings,
+		fwdChan:          params.FwdChan,
+		outChan:          params.OutChan,
+		mailbox:          params.Mailbox,
+		runSummary:       params.RunSummary,
+		historyLimiter:   historylimit.New(params.Settings.GetXHistoryRateLimit().GetValue()),
+		metricLimiter:    metriclimit.New(int(params.Settings.GetXMaxMetrics().GetValue())),
+		postFinishPolicy: postFinishPolicy,
+		valueLimiter: valuelimit.New(
+			int(params.Settings.GetXMaxValueBytes().GetValue()),
+			valueLimitAction,
+			params.Settings.GetFilesDir().GetValue(),
+		),
+		droppedData:           params.DroppedData,
+		staging:               params.Staging,
+		clockCorrector:        params.ClockCorrector,
 		metricHandler:         params.MetricHandler,
 		fileTransferStats:     params.FileTransferStats,
 		runfilesUploaderOrNil: params.RunfilesUploader,
 		tbHandler:             params.TBHandler,
+		logFiles:              params.LogFiles,
 		systemMonitor:         params.SystemMonitor,
 	}
 }
@@ -146,6 +311,13 @@ func (h *Handler) Do(inChan <-chan *service.Record) {
 }
 
 func (h *Handler) Close() {
+	h.codeSnapshots.Wait()
+	if err := h.runLock.Release(); err != nil {
+		h.logger.CaptureError("handler: failed to release run lock", err)
+	}
+	if err := h.sharedStep.Close(); err != nil {
+		h.logger.Error("handler: failed to close shared step file", "error", err)
+	}
 	close(h.outChan)
 	close(h.fwdChan)
 	h.logger.Debug("handler: Close: closed", "stream_id", h.settings.RunId)
@@ -187,6 +359,12 @@ func (h *Handler) fwdRecordWithControl(record *service.Record, controlOptions ..
 
 //gocyclo:ignore
 func (h *Handler) handleRecord(record *service.Record) {
+	if h.handlePostFinish(record) {
+		return
+	}
+
+	h.clockCorrector.Correct(record)
+
 	switch x := record.RecordType.(type) {
 	case *service.Record_Alert:
 		h.handleAlert(record)
@@ -210,6 +388,8 @@ func (h *Handler) handleRecord(record *service.Record) {
 		h.handleLinkArtifact(record)
 	case *service.Record_Metric:
 		h.handleMetric(record, x.Metric)
+	case *service.Record_Notes:
+		h.handleNotes(record, x.Notes)
 	case *service.Record_Output:
 		h.handleOutput(record)
 	case *service.Record_OutputRaw:
@@ -273,10 +453,14 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleRequestDefer(record, x.Defer)
 	case *service.Request_GetSummary:
 		h.handleRequestGetSummary(record)
+	case *service.Request_GetConfig:
+		h.handleRequestGetConfig(record)
 	case *service.Request_NetworkStatus:
 		h.handleRequestNetworkStatus(record)
 	case *service.Request_PartialHistory:
 		h.handleRequestPartialHistory(record, x.PartialHistory)
+	case *service.Request_BatchHistory:
+		h.handleRequestBatchHistory(record, x.BatchHistory)
 	case *service.Request_PollExit:
 		h.handleRequestPollExit(record)
 	case *service.Request_RunStart:
@@ -285,12 +469,16 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleRequestSampledHistory(record)
 	case *service.Request_ServerInfo:
 		h.handleRequestServerInfo(record)
+	case *service.Request_Preflight:
+		h.handleRequestPreflight(record)
 	case *service.Request_PythonPackages:
 		h.handleRequestPythonPackages(record, x.PythonPackages)
 	case *service.Request_StopStatus:
 		h.handleRequestStopStatus(record)
 	case *service.Request_LogArtifact:
 		h.handleRequestLogArtifact(record)
+	case *service.Request_LogArtifactDir:
+		h.handleRequestLogArtifact(record)
 	case *service.Request_DownloadArtifact:
 		h.handleRequestDownloadArtifact(record)
 	case *service.Request_Attach:
@@ -311,6 +499,8 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleRequestSenderRead(record)
 	case *service.Request_JobInput:
 		h.handleRequestJobInput(record)
+	case *service.Request_Flush:
+		h.handleRequestFlush(record)
 	case nil:
 		err := fmt.Errorf("handler: handleRequest: request type is nil")
 		h.logger.CaptureFatalAndPanic("error handling request", err)
@@ -332,9 +522,27 @@ func (h *Handler) handleRequestCheckVersion(record *service.Record) {
 	h.respond(record, &service.Response{})
 }
 
+// handleRequestRunStatus reports the run's step and runtime.
+//
+// The step is the step of the next history row, so it accounts for every
+// history record the handler received before the request.
+//
+// TODO(flow-control): report sync progress
 func (h *Handler) handleRequestRunStatus(record *service.Record) {
-	// TODO(flow-control): implement run status
-	h.respond(record, &service.Response{})
+	step := h.runRecord.GetStartingStep()
+	if h.runHistory != nil {
+		step = h.runHistory.GetStep()
+	}
+
+	h.respond(record, &service.Response{
+		ResponseType: &service.Response_RunStatusResponse{
+			RunStatusResponse: &service.RunStatusResponse{
+				HistoryStep: step,
+				StartTime:   h.runRecord.GetStartTime(),
+				Runtime:     h.runTimer.Elapsed().Seconds(),
+			},
+		},
+	})
 }
 
 func (h *Handler) handleRequestStatus(record *service.Record) {
@@ -392,12 +600,14 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 			h.logger.CaptureError("error adding metric to map", err)
 			return
 		}
+		h.metricHandler.trackStepMetric(metric.GetStepMetric())
 		h.fwdRecord(record)
 	case metric.GetName() != "":
 		if _, err := addMetric(metric, metric.GetName(), &h.metricHandler.definedMetrics); err != nil {
 			h.logger.CaptureError("error adding metric to map", err)
 			return
 		}
+		h.metricHandler.trackStepMetric(metric.GetStepMetric())
 		h.handleStepMetric(metric.GetStepMetric())
 		h.fwdRecord(record)
 	default:
@@ -429,11 +639,16 @@ func (h *Handler) handleRequestDefer(record *service.Record, request *service.De
 				},
 			},
 		)
+
+		// Histograms include the values in the final flushed row, so
+		// they're only computed once all history has been handled.
+		h.handleHistogramSummary()
 	case service.DeferRequest_FLUSH_TB:
 		h.tbHandler.Close()
 	case service.DeferRequest_FLUSH_SUM:
 	case service.DeferRequest_FLUSH_DEBOUNCER:
 	case service.DeferRequest_FLUSH_OUTPUT:
+		h.flushLogFiles()
 	case service.DeferRequest_FLUSH_JOB:
 	case service.DeferRequest_FLUSH_DIR:
 	case service.DeferRequest_FLUSH_FP:
@@ -496,6 +711,15 @@ func (h *Handler) handleRequestPollExit(record *service.Record) {
 			Done: true,
 		}
 	}
+	pollExitResponse.DroppedData = h.droppedData.Summary()
+
+	if pollExitResponse.Done && !h.reportedDroppedData &&
+		len(pollExitResponse.DroppedData.GetCategories()) > 0 {
+		h.reportedDroppedData = true
+		h.terminalPrinter.Write(
+			"Some data was dropped or failed to upload:\n" +
+				droppeddata.Format(pollExitResponse.DroppedData))
+	}
 
 	response := &service.Response{
 		ResponseType: &service.Response_PollExitResponse{
@@ -564,6 +788,14 @@ func (h *Handler) handleRequestServerInfo(record *service.Record) {
 	)
 }
 
+func (h *Handler) handleRequestPreflight(record *service.Record) {
+	h.fwdRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
 func (h *Handler) handleRequestRunStart(record *service.Record, request *service.RunStartRequest) {
 	var ok bool
 	run := request.Run
@@ -583,12 +815,18 @@ func (h *Handler) handleRequestRunStart(record *service.Record, request *service
 		h.systemMonitor.Do()
 	}
 
+	h.tailLogFiles()
+
 	// save code and patch
 	if h.settings.GetSaveCode().GetValue() {
 		h.handleCodeSave()
 		h.handlePatchSave()
 	}
 
+	if h.settings.GetXCodeSnapshot().GetValue() {
+		h.handleCodeSnapshot(run)
+	}
+
 	// NOTE: once this request arrives in the sender,
 	// the latter will start its filestream and uploader
 	// initialize the run metadata from settings
@@ -619,6 +857,17 @@ func (h *Handler) handleRequestRunStart(record *service.Record, request *service
 		Git:           git,
 	}
 
+	// The core fills in what the client doesn't report, so that runs from
+	// all clients describe their environment the same way.
+	environment := runenv.Capture(h.settings.GetXMetadataEnvVars().GetValue())
+	if clientEnv := h.settings.GetXMetadataEnv(); clientEnv != nil {
+		// The client's environment is what the run sees, and it may differ
+		// from the core's, such as if the core is shared.
+		environment.Env = clientEnv.GetValue()
+	}
+	proto.Merge(environment, metadata)
+	metadata = environment
+
 	if !h.settings.GetXDisableStats().GetValue() {
 		systemInfo := h.systemMonitor.Probe()
 		if systemInfo != nil {
@@ -754,6 +1003,105 @@ func (h *Handler) handlePatchSave() {
 	h.handleFiles(record)
 }
 
+// handleCodeSnapshot starts saving the run's source code as an artifact.
+//
+// Listing and hashing the files can be slow for large repositories, so it
+// happens in the background. The artifact is forwarded before the run's
+// exit record.
+func (h *Handler) handleCodeSnapshot(run *service.RunRecord) {
+	h.codeSnapshots.Add(1)
+	go func() {
+		defer h.codeSnapshots.Done()
+		h.snapshotCode(run)
+	}()
+}
+
+// snapshotCode saves the run's source code as an artifact.
+func (h *Handler) snapshotCode(run *service.RunRecord) {
+	if h.staging == nil {
+		h.logger.Warn("handler: not snapshotting code because there is no staging area")
+		return
+	}
+
+	program := h.settings.GetProgramAbspath().GetValue()
+	root := h.settings.GetRootDir().GetValue()
+	if root == "" {
+		root = filepath.Dir(program)
+	}
+
+	var paths []string
+	var err error
+	if h.settings.GetXCodeSnapshotGit().GetValue() {
+		if h.settings.GetDisableGit().GetValue() {
+			h.logger.Warn("handler: not snapshotting code because git is disabled")
+			return
+		}
+		paths, err = NewGit(root, h.logger).TrackedFiles()
+	} else {
+		if program == "" {
+			h.logger.Warn("handler: not snapshotting code because the program is unknown")
+			return
+		}
+		paths, err = artifacts.PythonLocalModules(program)
+	}
+	if err != nil {
+		h.logger.CaptureError("handler: failed to collect code snapshot", err)
+		return
+	}
+
+	maxMegabytes := int64(10)
+	if value := h.settings.GetXCodeSnapshotMaxMegabytes(); value != nil {
+		maxMegabytes = max(0, int64(value.GetValue()))
+	}
+	snapshot := &artifacts.CodeSnapshot{
+		Root:     root,
+		Include:  h.settings.GetXCodeSnapshotInclude().GetValue(),
+		Exclude:  h.settings.GetXCodeSnapshotExclude().GetValue(),
+		MaxBytes: maxMegabytes * 1024 * 1024,
+
+		DigestAlgorithm: artifactDigestAlgorithm(h.settings, h.logger),
+	}
+
+	included, skipped := snapshot.Filter(paths)
+	if len(skipped) > 0 {
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"Code snapshot is over %d MB, skipped %d files",
+			maxMegabytes, len(skipped)))
+	}
+	if len(included) == 0 {
+		h.logger.Warn("handler: no files to include in code snapshot")
+		return
+	}
+
+	programName := h.settings.GetProgramRelpath().GetValue()
+	if programName == "" {
+		programName = filepath.Base(program)
+	}
+	artifact, err := snapshot.Build(
+		&service.ArtifactRecord{
+			Entity:           run.GetEntity(),
+			Project:          run.GetProject(),
+			RunId:            run.GetRunId(),
+			Name:             artifacts.CodeArtifactName(run.GetProject(), programName),
+			Type:             artifacts.CodeArtifactType,
+			Finalize:         true,
+			ClientId:         utils.GenerateAlphanumericSequence(128),
+			SequenceClientId: utils.GenerateAlphanumericSequence(128),
+			UserCreated:      true,
+		},
+		included,
+		h.staging,
+	)
+	if err != nil {
+		h.logger.CaptureError("handler: failed to build code snapshot", err)
+		return
+	}
+
+	h.fwdRecord(&service.Record{
+		RecordType: &service.Record_Artifact{Artifact: artifact},
+	})
+}
+
 func (h *Handler) handleMetadata(request *service.MetadataRequest) {
 	// TODO: Sending metadata as a request for now, eventually this should be turned into
 	//  a record and stored in the transaction log
@@ -829,6 +1177,38 @@ func (h *Handler) handleOutput(record *service.Record) {
 	h.fwdRecord(record)
 }
 
+// tailLogFiles starts streaming the log files listed in the settings.
+func (h *Handler) tailLogFiles() {
+	if h.logFiles == nil {
+		return
+	}
+
+	for _, path := range h.settings.GetXTailLogFiles().GetValue() {
+		records, err := h.logFiles.Tail(path)
+		if err != nil {
+			h.logger.CaptureError("handler: failed to tail log file", err, "path", path)
+			h.terminalPrinter.Write(
+				fmt.Sprintf("Cannot stream log file %s: %v", path, err))
+			continue
+		}
+
+		for _, record := range records {
+			h.fwdRecord(record)
+		}
+	}
+}
+
+// flushLogFiles forwards the remaining lines of tailed log files.
+func (h *Handler) flushLogFiles() {
+	if h.logFiles == nil {
+		return
+	}
+
+	for _, record := range h.logFiles.Finish() {
+		h.fwdRecord(record)
+	}
+}
+
 func (h *Handler) handleOutputRaw(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -837,7 +1217,33 @@ func (h *Handler) handlePreempting(record *service.Record) {
 	h.fwdRecord(record)
 }
 
+// handleNotes truncates overly long run notes and forwards them.
+func (h *Handler) handleNotes(record *service.Record, notes *service.RunNotesRecord) {
+	if utf8.RuneCountInString(notes.Notes) > maxRunNotesLength {
+		h.logger.Warn(
+			"handler: truncating run notes",
+			"length", utf8.RuneCountInString(notes.Notes),
+		)
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"Run notes are longer than %d characters and were truncated.",
+			maxRunNotesLength))
+		notes.Notes = string([]rune(notes.Notes)[:maxRunNotesLength])
+	}
+
+	h.fwdRecord(record)
+}
+
 func (h *Handler) handleRun(record *service.Record) {
+	if err := h.applyRunNameTemplate(record.GetRun()); err != nil {
+		h.respondRunUsageError(record, err)
+		return
+	}
+
+	if err := h.lockRun(record.GetRun()); err != nil {
+		h.respondRunUsageError(record, err)
+		return
+	}
+
 	h.fwdRecordWithControl(record,
 		func(control *service.Control) {
 			control.AlwaysSend = true
@@ -845,6 +1251,108 @@ func (h *Handler) handleRun(record *service.Record) {
 	)
 }
 
+// respondRunUsageError fails the run record because of how the run was
+// configured.
+func (h *Handler) respondRunUsageError(record *service.Record, err error) {
+	h.outChan <- &service.Result{
+		ResultType: &service.Result_RunResult{
+			RunResult: &service.RunUpdateResult{
+				Error: &service.ErrorInfo{
+					Message: err.Error(),
+					Code:    service.ErrorInfo_USAGE,
+				},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+}
+
+// applyRunNameTemplate names a new run that has no name using the
+// run name template setting.
+//
+// Resumed runs keep their existing names. Returns an error if the
+// template is invalid, which fails the run's initialization.
+func (h *Handler) applyRunNameTemplate(run *service.RunRecord) error {
+	templateString := h.settings.GetXRunNameTemplate().GetValue()
+	if templateString == "" {
+		return nil
+	}
+
+	template, err := runname.Parse(templateString)
+	if err != nil {
+		return fmt.Errorf("invalid run name template: %v", err)
+	}
+
+	if run == nil ||
+		run.GetDisplayName() != "" ||
+		h.settings.GetResume().GetValue() != "" {
+		return nil
+	}
+
+	startTime := time.Now()
+	if run.GetStartTime() != nil {
+		startTime = run.GetStartTime().AsTime()
+	}
+
+	run.DisplayName = template.Execute(runname.Fields{
+		Project: run.GetProject(),
+		RunID:   run.GetRunId(),
+		Group:   run.GetRunGroup(),
+		JobType: run.GetJobType(),
+		Time:    startTime.Local(),
+	})
+	return nil
+}
+
+// lockRun checks that no other process on the machine is logging to the run.
+//
+// Returns an error if another process is, unless the run is in shared mode.
+// Failing to use a lock is logged rather than returned, since the check
+// is best-effort.
+func (h *Handler) lockRun(run *service.RunRecord) error {
+	switch {
+	case h.checkedRunLock,
+		h.runLockDir == "",
+		run.GetRunId() == "",
+		h.settings.GetXShared().GetValue(),
+		h.settings.GetXSync().GetValue():
+		return nil
+	}
+	h.checkedRunLock = true
+
+	lock, err := runlock.Acquire(h.runLockDir, runlock.Key(
+		h.settings.GetBaseUrl().GetValue(),
+		run.GetEntity(),
+		run.GetProject(),
+		run.GetRunId(),
+	))
+
+	var held *runlock.HeldError
+	switch {
+	case errors.As(err, &held):
+		holder := held.Holder
+		if holder == "" {
+			holder = "another process"
+		}
+		return fmt.Errorf(
+			"run %q is already being logged by %s on this machine;"+
+				" use a different run ID, or enable shared mode"+
+				" to log to the same run from multiple processes",
+			run.GetRunId(),
+			holder,
+		)
+
+	case err != nil:
+		h.logger.CaptureError("handler: not checking for duplicate runs", err)
+
+	default:
+		h.runLock = lock
+	}
+
+	return nil
+}
+
 func (h *Handler) handleConfig(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -854,19 +1362,48 @@ func (h *Handler) handleAlert(record *service.Record) {
 }
 
 func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord) {
+	// The code snapshot must reach the sender before the run finishes.
+	h.codeSnapshots.Wait()
+
 	// stop the run timer and set the runtime
 	h.runTimer.Pause()
 	exit.Runtime = int32(h.runTimer.Elapsed().Seconds())
 
+	if dropped := h.historyLimiter.Dropped(); len(dropped) > 0 {
+		h.logger.Warn(
+			"handler: dropped history points over the rate limit",
+			"dropped", dropped,
+		)
+	}
+
+	if exit.GetKilled() {
+		h.logger.Warn("handler: run killed", "reason", exit.GetKillReason())
+		// Stop collecting system metrics right away rather than during
+		// the shutdown sequence, since a killed run should stop quickly.
+		h.systemMonitor.Stop()
+	}
+
 	if !h.settings.GetXSync().GetValue() {
 		summaryRecord := &service.Record{
 			RecordType: &service.Record_Summary{
 				Summary: &service.SummaryRecord{},
 			},
 		}
+		if exit.GetKilled() {
+			reasonJSON, _ := json.Marshal(exit.GetKillReason())
+			summaryRecord.GetSummary().Update = append(
+				summaryRecord.GetSummary().Update,
+				&service.SummaryItem{
+					NestedKey: []string{"_wandb", "kill_reason"},
+					ValueJson: string(reasonJSON),
+				},
+			)
+		}
 		h.handleSummary(summaryRecord, summaryRecord.GetSummary())
 	}
 
+	h.finished = true
+
 	// send the exit record
 	h.fwdRecordWithControl(record,
 		func(control *service.Control) {
@@ -879,6 +1416,31 @@ func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord
 	)
 }
 
+// handleHistogramSummary updates the summary with the histograms of the
+// metrics that have a histogram summary.
+func (h *Handler) handleHistogramSummary() {
+	if h.settings.GetXSync().GetValue() {
+		return
+	}
+
+	items := h.metricHandler.histogramSummary()
+	if len(items) == 0 {
+		return
+	}
+
+	aggregations := make(map[*service.SummaryItem]runsummary.Aggregation)
+	for _, item := range items {
+		aggregations[item] = runsummary.Aggregation{Kind: runsummary.AggregationHistogram}
+	}
+
+	summary := &service.SummaryRecord{Update: items}
+	h.handleAggregatedSummary(
+		&service.Record{RecordType: &service.Record_Summary{Summary: summary}},
+		summary,
+		aggregations,
+	)
+}
+
 func (h *Handler) handleFiles(record *service.Record) {
 	if record.GetFiles() == nil {
 		return
@@ -904,6 +1466,12 @@ func (h *Handler) handleRequestGetSummary(record *service.Record) {
 	h.respond(record, response)
 }
 
+// handleRequestGetConfig forwards the request to the sender, which has
+// the run's complete config, including config from a resumed run.
+func (h *Handler) handleRequestGetConfig(record *service.Record) {
+	h.fwdRecord(record)
+}
+
 func (h *Handler) handleRequestGetSystemMetrics(record *service.Record) {
 	sm := h.systemMonitor.GetBuffer()
 
@@ -952,6 +1520,10 @@ func (h *Handler) handleRequestSync(record *service.Record) {
 	h.fwdRecord(record)
 }
 
+func (h *Handler) handleRequestFlush(record *service.Record) {
+	h.fwdRecord(record)
+}
+
 func (h *Handler) handleRequestSenderRead(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -969,6 +1541,36 @@ func (h *Handler) handleRequestJobInput(record *service.Record) {
 }
 
 func (h *Handler) handleSummary(record *service.Record, summary *service.SummaryRecord) {
+	h.handleAggregatedSummary(record, summary, nil)
+}
+
+// handleAggregatedSummary updates the summary like handleSummary and
+// records how the given items were computed from history.
+//
+// Items that are replaced while handling the summary, like merged
+// duplicates, are left without an aggregation.
+func (h *Handler) handleAggregatedSummary(
+	record *service.Record,
+	summary *service.SummaryRecord,
+	aggregations map[*service.SummaryItem]runsummary.Aggregation,
+) {
+	update, duplicates, err := runsummary.MergeDuplicateKeys(summary.GetUpdate())
+	if err != nil {
+		h.logger.CaptureError("handler: failed to check summary keys", err)
+	}
+	summary.Update = update
+	h.warnDuplicateKeys(duplicates)
+
+	summary.Update = slices.DeleteFunc(summary.Update, func(item *service.SummaryItem) bool {
+		if !h.allowMetric(summaryItemKey(item)) {
+			return true
+		}
+
+		var keep bool
+		item.ValueJson, keep = h.limitValueSize(summaryItemKey(item), item.ValueJson)
+		return !keep
+	})
+
 	if !h.settings.GetXSync().GetValue() {
 		// if sync is enabled, we don't need to do all this
 		runtime := int32(h.runTimer.Elapsed().Seconds())
@@ -986,6 +1588,12 @@ func (h *Handler) handleSummary(record *service.Record, summary *service.Summary
 			h.logger.CaptureError("Error updating run summary", err)
 		},
 	)
+	for _, item := range summary.GetUpdate() {
+		if aggregation, ok := aggregations[item]; ok {
+			h.runSummary.SetAggregation(summaryItemPath(item), aggregation)
+		}
+	}
+	h.updatePinnedSummary(summary)
 
 	h.fwdRecordWithControl(record,
 		func(control *service.Control) {
@@ -998,6 +1606,38 @@ func (h *Handler) handleSummary(record *service.Record, summary *service.Summary
 	)
 }
 
+// updatePinnedSummary applies the record's pins and adds the pinned keys
+// that it doesn't already update, so that they're in every summary update.
+func (h *Handler) updatePinnedSummary(summary *service.SummaryRecord) {
+	for _, item := range summary.GetUnpin() {
+		h.runSummary.Unpin(item)
+	}
+	for _, item := range summary.GetPin() {
+		if err := h.runSummary.Pin(item); err != nil {
+			h.logger.Warn("handler: invalid summary pin", "error", err)
+			h.terminalPrinter.Write(fmt.Sprintf(
+				"Cannot pin summary key %q because it is not in the summary.",
+				summaryItemKey(item)))
+		}
+	}
+
+	pinned, err := h.runSummary.PinnedItems()
+	if err != nil {
+		h.logger.CaptureError("handler: failed to get pinned summary", err)
+		return
+	}
+
+	changed := make(map[string]struct{})
+	for _, item := range slices.Concat(summary.GetUpdate(), summary.GetRemove()) {
+		changed[summaryItemKey(item)] = struct{}{}
+	}
+	for _, item := range pinned {
+		if _, ok := changed[summaryItemKey(item)]; !ok {
+			summary.Update = append(summary.Update, item)
+		}
+	}
+}
+
 func (h *Handler) handleTBrecord(record *service.Record) {
 	err := h.tbHandler.Handle(record)
 	if err != nil {
@@ -1023,12 +1663,36 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 		return
 	}
 
-	runtime := h.runTimer.Elapsed().Seconds()
-	history.Item = append(history.Item, &service.HistoryItem{
-		Key:       "_runtime",
-		ValueJson: fmt.Sprintf("%f", runtime),
+	items, duplicates, err := runhistory.MergeDuplicateKeys(history.GetItem())
+	if err != nil {
+		h.logger.CaptureError("handler: failed to check history keys", err)
+	}
+	history.Item = items
+	h.warnDuplicateKeys(duplicates)
+
+	history.Item = slices.DeleteFunc(history.Item, func(item *service.HistoryItem) bool {
+		if !h.allowMetric(historyItemKey(item)) {
+			return true
+		}
+
+		var keep bool
+		item.ValueJson, keep = h.limitValueSize(historyItemKey(item), item.ValueJson)
+		return !keep
 	})
-	if !h.settings.GetXShared().GetValue() {
+	if len(history.GetItem()) == 0 {
+		return
+	}
+
+	if !h.settings.GetXDisableRuntime().GetValue() {
+		runtime := h.runTimer.Elapsed().Seconds()
+		history.Item = append(history.Item, &service.HistoryItem{
+			Key:       "_runtime",
+			ValueJson: fmt.Sprintf("%f", runtime),
+		})
+	}
+	h.applyHistoryTimestamp(history)
+	h.claimSharedStep(history)
+	if !h.settings.GetXShared().GetValue() || h.sharedStep != nil {
 		history.Item = append(history.Item, &service.HistoryItem{
 			Key:       "_step",
 			ValueJson: fmt.Sprintf("%d", history.GetStep().GetNum()),
@@ -1040,10 +1704,17 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 	// it needs to be synced, but not part of the history record.
 	// This means that there are metrics defined for this run
 	if h.metricHandler != nil {
+		h.metricHandler.markStepMetricsLogged(history.GetItem())
+
+		keys := make(map[string]struct{}, len(history.GetItem()))
+		for _, item := range history.GetItem() {
+			keys[item.GetKey()] = struct{}{}
+		}
+
 		items := make([]*service.HistoryItem, 0, len(history.GetItem()))
 		for _, item := range history.GetItem() {
 			// TODO: handle nested metric metrics (e.g. metric defined by another metric)
-			if metric := h.imputeStepMetric(item); metric != nil {
+			if metric := h.imputeStepMetric(item, keys); metric != nil {
 				items = append(items, metric)
 			}
 		}
@@ -1052,6 +1723,9 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 
 	h.sampleHistory(history)
 
+	// points dropped from history still update the summary
+	dropped := h.limitHistoryRate(history)
+
 	record := &service.Record{
 		RecordType: &service.Record_History{
 			History: history,
@@ -1064,14 +1738,52 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 		return
 	}
 
-	summary := make([]*service.SummaryItem, 0, len(history.GetItem()))
-	for _, item := range history.GetItem() {
+	disableAutoSummary := h.settings.GetXDisableAutoSummary().GetValue()
+	summary := make([]*service.SummaryItem, 0, len(history.GetItem())+len(dropped))
+	aggregations := make(map[*service.SummaryItem]runsummary.Aggregation)
+	for _, item := range slices.Concat(history.GetItem(), dropped) {
+		h.metricHandler.addToHistogram(item)
+
+		if disableAutoSummary &&
+			!strings.HasPrefix(item.GetKey(), "_") &&
+			!h.metricHandler.hasExplicitSummary(item.GetKey()) {
+			continue
+		}
+
+		if best, ok := h.metricHandler.bestSummary(item, history.GetStep().GetNum()); ok {
+			if len(best) > 0 {
+				// The best value is an aggregation, but not its step.
+				aggregations[best[0]] = runsummary.Aggregation{
+					Kind: h.metricHandler.bestAggregation(item.GetKey()),
+				}
+			}
+			summary = append(summary, best...)
+			continue
+		}
+
+		if stats, ok := h.metricHandler.statsSummary(item); ok {
+			for _, stat := range stats {
+				summary = append(summary, stat.item)
+				aggregations[stat.item] = stat.aggregation
+			}
+			continue
+		}
+
+		valueJSON, reduced := runsummary.ReduceArray(
+			item.GetValueJson(),
+			h.metricHandler.arraySummary(item.GetKey()),
+		)
 		summaryItem := &service.SummaryItem{
 			Key:       item.Key,
 			NestedKey: item.NestedKey,
-			ValueJson: item.ValueJson,
+			ValueJson: valueJSON,
 		}
 		summary = append(summary, summaryItem)
+		if !reduced {
+			aggregations[summaryItem] = runsummary.Aggregation{
+				Kind: runsummary.AggregationLast,
+			}
+		}
 	}
 
 	record = &service.Record{
@@ -1081,7 +1793,251 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 			},
 		},
 	}
-	h.handleSummary(record, record.GetSummary())
+	h.handleAggregatedSummary(record, record.GetSummary(), aggregations)
+}
+
+// claimSharedStep updates the history record's step to the one claimed in
+// the shared step file, if any.
+//
+// The step changes if another process already logged it, in which case
+// the row moves to the next unused step.
+func (h *Handler) claimSharedStep(history *service.HistoryRecord) {
+	if h.sharedStep == nil {
+		return
+	}
+
+	requested := history.GetStep().GetNum()
+	step, err := h.sharedStep.Claim(requested)
+	if err != nil {
+		h.logger.CaptureError("handler: failed to claim shared step", err)
+		return
+	}
+
+	if step != requested {
+		h.logger.Debug(
+			"handler: history step already used by another process",
+			"requested", requested,
+			"step", step,
+		)
+	}
+	history.Step = &service.HistoryStep{Num: step}
+}
+
+// applyHistoryTimestamp sets "_timestamp" from the record's explicit
+// timestamp, if it has a valid one.
+func (h *Handler) applyHistoryTimestamp(history *service.HistoryRecord) {
+	timestamp := history.GetTimestamp()
+	if timestamp == nil {
+		return
+	}
+
+	if err := timestamp.CheckValid(); err != nil || timestamp.GetSeconds() < 0 {
+		h.logger.CaptureWarn(
+			"handler: ignoring invalid history timestamp",
+			"timestamp", timestamp.String())
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"Ignoring invalid timestamp %v for history step %d.",
+			timestamp.String(), history.GetStep().GetNum()))
+		return
+	}
+
+	skew := timestamp.AsTime().Sub(h.clockCorrector.Now())
+	if skew > maxHistoryTimestampSkew && !h.warnedTimestampSkew {
+		h.warnedTimestampSkew = true
+		h.logger.Warn("handler: history timestamp is in the future", "skew", skew)
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"A history timestamp is %v in the future; check the clock of"+
+				" the machine that produced it.",
+			skew.Round(time.Second)))
+	}
+
+	seconds := float64(timestamp.AsTime().UnixMicro()) / 1e6
+	items := slices.DeleteFunc(history.GetItem(), func(item *service.HistoryItem) bool {
+		return item.GetKey() == "_timestamp"
+	})
+	history.Item = append(items, &service.HistoryItem{
+		Key:       "_timestamp",
+		ValueJson: fmt.Sprintf("%f", seconds),
+	})
+}
+
+// warnDuplicateKeys tells the user about keys that were set more than once
+// in a single update.
+//
+// Only the last value is kept; see runhistory.MergeDuplicateKeys. Each key
+// is reported once per run.
+func (h *Handler) warnDuplicateKeys(keys []string) {
+	for _, key := range keys {
+		if _, warned := h.warnedDuplicateKeys[key]; warned {
+			continue
+		}
+		if h.warnedDuplicateKeys == nil {
+			h.warnedDuplicateKeys = make(map[string]struct{})
+		}
+		h.warnedDuplicateKeys[key] = struct{}{}
+
+		h.logger.Warn("handler: key set more than once in an update", "key", key)
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"The key %q was set more than once in the same update,"+
+				" for example through both a nested and a dotted key."+
+				" Only the last value is used.",
+			key))
+	}
+}
+
+// limitValueSize applies the value size limit to a history or summary
+// value.
+//
+// Returns the value to use, and false if the value should be dropped.
+//
+// Internal keys like "_timestamp" are never limited.
+func (h *Handler) limitValueSize(key string, valueJSON string) (string, bool) {
+	if strings.HasPrefix(key, "_") || !h.valueLimiter.IsOversized(valueJSON) {
+		return valueJSON, true
+	}
+
+	result, err := h.valueLimiter.Apply(key, valueJSON)
+	if err != nil {
+		h.droppedData.Record(droppeddata.ValueTooLarge, key)
+		h.warnOversizedValue(fmt.Sprintf(
+			"Dropped the value of %q because it is over the limit of"+
+				" %d bytes. Log a smaller value, or change"+
+				" `_max_value_bytes` or `_oversized_value_action`.",
+			key, h.valueLimiter.MaxBytes()),
+			"error", err)
+		return "", false
+	}
+
+	h.warnOversizedValue(fmt.Sprintf(
+		"Replaced the value of %q because it is over the limit of"+
+			" %d bytes.",
+		key, h.valueLimiter.MaxBytes()),
+		"size", len(valueJSON))
+	if result.OffloadPath != "" {
+		h.handleFiles(&service.Record{
+			RecordType: &service.Record_Files{
+				Files: &service.FilesRecord{
+					Files: []*service.FilesItem{{
+						Path:   filepath.ToSlash(result.OffloadPath),
+						Policy: service.FilesItem_NOW,
+						Type:   service.FilesItem_OTHER,
+					}},
+				},
+			},
+		})
+	}
+
+	return result.ValueJSON, true
+}
+
+// warnOversizedValue logs a message about an oversized value and shows it
+// to the user.
+//
+// Values come from user code, which may log the same oversized value on
+// every step, so each distinct message is shown only once.
+func (h *Handler) warnOversizedValue(message string, args ...any) {
+	if _, warned := h.warnedOversizedValues[message]; warned {
+		return
+	}
+	if h.warnedOversizedValues == nil {
+		h.warnedOversizedValues = make(map[string]struct{})
+	}
+	h.warnedOversizedValues[message] = struct{}{}
+
+	h.logger.Warn(
+		"handler: oversized value",
+		append([]any{"message", message}, args...)...,
+	)
+	h.terminalPrinter.Write(message)
+}
+
+func historyItemKey(item *service.HistoryItem) string {
+	if len(item.GetNestedKey()) > 0 {
+		return strings.Join(item.GetNestedKey(), ".")
+	}
+	return item.GetKey()
+}
+
+func summaryItemPath(item *service.SummaryItem) []string {
+	if len(item.GetNestedKey()) > 0 {
+		return item.GetNestedKey()
+	}
+	return []string{item.GetKey()}
+}
+
+func summaryItemKey(item *service.SummaryItem) string {
+	if len(item.GetNestedKey()) > 0 {
+		return strings.Join(item.GetNestedKey(), ".")
+	}
+	return item.GetKey()
+}
+
+// allowMetric reports whether a history or summary value for the key is
+// within the limit on distinct metrics.
+//
+// Internal keys such as "_step" are always allowed. The first time a metric
+// is rejected, the user is warned; the rejected names are reported with the
+// rest of the dropped data at the end of the run.
+func (h *Handler) allowMetric(key string) bool {
+	if h.metricLimiter == nil ||
+		h.settings.GetXSync().GetValue() ||
+		strings.HasPrefix(key, "_") ||
+		h.metricLimiter.Allow(key) {
+		return true
+	}
+
+	h.droppedData.Record(droppeddata.MetricLimitExceeded, key)
+
+	if !h.warnedMetricLimit {
+		h.warnedMetricLimit = true
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"The run has reached the limit of %d distinct metrics."+
+				" Values of new metrics such as %q are dropped, while"+
+				" existing metrics continue to log. Change `_max_metrics`"+
+				" to raise the limit.",
+			h.metricLimiter.Max(),
+			key,
+		))
+	}
+
+	return false
+}
+
+// limitHistoryRate removes items of metrics logged faster than the
+// configured rate limit from the history record.
+//
+// Internal items such as "_step" are never removed. Returns the removed
+// items.
+func (h *Handler) limitHistoryRate(history *service.HistoryRecord) []*service.HistoryItem {
+	if h.historyLimiter == nil || h.settings.GetXSync().GetValue() {
+		return nil
+	}
+
+	now := time.Now()
+	var dropped []*service.HistoryItem
+	kept := make([]*service.HistoryItem, 0, len(history.GetItem()))
+	for _, item := range history.GetItem() {
+		key := historyItemKey(item)
+		if strings.HasPrefix(key, "_") || h.historyLimiter.Allow(key, now) {
+			kept = append(kept, item)
+		} else {
+			dropped = append(dropped, item)
+			h.droppedData.Record(droppeddata.HistoryRateLimited, key)
+		}
+	}
+
+	if len(dropped) > 0 && !h.warnedHistoryRateLimit {
+		h.warnedHistoryRateLimit = true
+		h.terminalPrinter.Write(fmt.Sprintf(
+			"Some metrics are logged more than %v times per second."+
+				" Excess points are dropped from history but still"+
+				" update the summary.",
+			h.settings.GetXHistoryRateLimit().GetValue(),
+		))
+	}
+
+	history.Item = kept
+	return dropped
 }
 
 func (h *Handler) handleRequestNetworkStatus(record *service.Record) {
@@ -1103,6 +2059,56 @@ func (h *Handler) handleRequestPartialHistory(_ *service.Record, request *servic
 	}
 }
 
+// handleRequestBatchHistory logs several history rows, each at its own step.
+//
+// The batch is validated before anything is logged, so either all rows are
+// logged or none are.
+func (h *Handler) handleRequestBatchHistory(
+	record *service.Record,
+	request *service.BatchHistoryRequest,
+) {
+	response := &service.BatchHistoryResponse{}
+	defer func() {
+		h.respond(record, &service.Response{
+			ResponseType: &service.Response_BatchHistoryResponse{
+				BatchHistoryResponse: response,
+			},
+		})
+	}()
+
+	if h.settings.GetXShared().GetValue() {
+		response.ErrorMessage = "batch logging is not supported in shared mode"
+		return
+	}
+
+	if h.runHistory == nil {
+		h.runHistory = runhistory.NewWithStep(h.runRecord.GetStartingStep())
+	}
+
+	previous := h.runHistory.GetStep() - 1
+	for i, row := range request.GetRows() {
+		if row.GetStep() <= previous {
+			response.ErrorMessage = fmt.Sprintf(
+				"steps must be increasing: row %d has step %d, after step %d",
+				i, row.GetStep(), previous)
+			response.Step = h.runHistory.GetStep()
+			return
+		}
+		previous = row.GetStep()
+	}
+
+	for _, row := range request.GetRows() {
+		h.handlePartialHistorySync(&service.PartialHistoryRequest{
+			Item:      row.GetItem(),
+			Step:      &service.HistoryStep{Num: row.GetStep()},
+			Action:    &service.HistoryAction{Flush: true},
+			Timestamp: row.GetTimestamp(),
+		})
+	}
+
+	response.Step = h.runHistory.GetStep()
+}
+
 // The main entry point for partial history records in the shared mode.
 //
 // This collects partial history records until a full history record is
@@ -1118,6 +2124,9 @@ func (h *Handler) handlePartialHistoryAsync(request *service.PartialHistoryReque
 		func(err error) {
 			h.logger.CaptureError("Error updating run history", err)
 		})
+	if request.GetTimestamp() != nil {
+		h.historyTimestamp = request.GetTimestamp()
+	}
 
 	// Flush the history record and start to collect a new one
 	if request.GetAction() == nil || request.GetAction().GetFlush() {
@@ -1129,8 +2138,10 @@ func (h *Handler) handlePartialHistoryAsync(request *service.PartialHistoryReque
 			return
 		}
 		h.handleHistory(&service.HistoryRecord{
-			Item: items,
+			Item:      items,
+			Timestamp: h.historyTimestamp,
 		})
+		h.historyTimestamp = nil
 	}
 }
 
@@ -1199,10 +2210,12 @@ func (h *Handler) handlePartialHistorySync(request *service.PartialHistoryReques
 				Step: &service.HistoryStep{
 					Num: h.runHistory.GetStep(),
 				},
-				Item: items,
+				Item:      items,
+				Timestamp: h.historyTimestamp,
 			}
 			h.handleHistory(history)
 			h.runHistory = runhistory.NewWithStep(step)
+			h.historyTimestamp = nil
 		} else if step < current {
 			h.logger.CaptureWarn("handlePartialHistorySync: ignoring history record", "step", step, "current", current)
 			msg := fmt.Sprintf("steps must be monotonically increasing, received history record for a step (%d) "+
@@ -1218,9 +2231,20 @@ func (h *Handler) handlePartialHistorySync(request *service.PartialHistoryReques
 		func(err error) {
 			h.logger.CaptureError("Error updating run history", err)
 		})
+	if request.GetTimestamp() != nil {
+		h.historyTimestamp = request.GetTimestamp()
+	}
 
 	// Flush the history record and start to collect a new one with
 	// the next step number.
+	//
+	// This is how `commit=False` works: requests without a flush flag
+	// accumulate into the current step, and the next request with the flag
+	// merges its items (overwriting earlier values of the same keys) and
+	// logs everything as one row. A flush with nothing accumulated logs no
+	// row but still advances the step, like `log({}, commit=True)` in
+	// Python. Anything still accumulated when the run ends is flushed by the
+	// FLUSH_PARTIAL_HISTORY defer state.
 	if (request.GetStep() == nil && request.GetAction() == nil) || request.GetAction().GetFlush() {
 		items, err := h.runHistory.Flatten()
 		if err != nil {
@@ -1236,11 +2260,14 @@ func (h *Handler) handlePartialHistorySync(request *service.PartialHistoryReques
 			Step: &service.HistoryStep{
 				Num: h.runHistory.GetStep(),
 			},
-			Item: items,
+			Item:      items,
+			Timestamp: h.historyTimestamp,
 		}
 		h.handleHistory(history)
-		step := h.runHistory.GetStep() + 1
+		// The step may have been changed to one unused by other processes.
+		step := history.GetStep().GetNum() + 1
 		h.runHistory = runhistory.NewWithStep(step)
+		h.historyTimestamp = nil
 	}
 }
 
@@ -1278,45 +2305,68 @@ func (h *Handler) matchHistoryItemMetric(item *service.HistoryItem) *service.Met
 // This function checks if a history item matches a defined metric or a glob
 // metric. If the step metric is not part of the history record, and it needs to
 // be synced, the function imputes the step metric and returns it.
-func (h *Handler) imputeStepMetric(item *service.HistoryItem) *service.HistoryItem {
+//
+// The keys argument is the set of keys in the history record, and is
+// updated if a step metric is imputed.
+//
+// A warning is emitted if the step metric hasn't been logged yet, since the
+// metric can't be plotted against it.
+func (h *Handler) imputeStepMetric(
+	item *service.HistoryItem,
+	keys map[string]struct{},
+) *service.HistoryItem {
 
 	// check if history item matches a defined metric or a glob metric
 	metric := h.matchHistoryItemMetric(item)
 
 	key := metric.GetStepMetric()
-	// check if step metric is defined and if it needs to be synced
-	if !(metric.GetOptions().GetStepSync() && key != "") {
+	if key == "" {
 		return nil
 	}
 
-	// check if step metric is already in history
-	// TODO: avoid using the Tree method
-	if _, ok := h.runHistory.Tree()[key]; ok {
+	// the value from the same log call takes precedence
+	if _, ok := keys[key]; ok {
+		return nil
+	}
+
+	// we use the summary value of the metric as the algorithm for imputing
+	// the step metric; it also knows about step metrics logged before they
+	// were defined as such or before the run was resumed
+	value, hasValue := h.runSummary.Get(key)
+
+	if !h.metricHandler.wasStepMetricLogged(key) && !hasValue {
+		if h.metricHandler.shouldWarnMissingStepMetric(item.GetKey()) {
+			h.logger.Warn(
+				"handler: metric logged before its step metric",
+				"metric", item.GetKey(),
+				"step_metric", key,
+			)
+			h.terminalPrinter.Write(fmt.Sprintf(
+				"Metric %q was logged before its step metric %q, so it"+
+					" can't be plotted against it until %q is logged.",
+				item.GetKey(), key, key,
+			))
+		}
+		return nil
+	}
+
+	// check if the step metric needs to be synced
+	if !metric.GetOptions().GetStepSync() {
 		return nil
 	}
 
-	// TODO: avoid using the tree representation of the summary
 	// TODO: avoid using json marshalling
-	// we use the summary value of the metric as the algorithm for imputing the step metric
-	if value, ok := h.runSummary.Tree()[key]; ok {
+	if hasValue {
 		v, err := json.Marshal(value)
 		if err != nil {
 			h.logger.CaptureError("error marshalling step metric value", err)
 			return nil
 		}
-		item := []*service.HistoryItem{
-			{
-				Key:       key,
-				ValueJson: string(v),
-			},
+		keys[key] = struct{}{}
+		return &service.HistoryItem{
+			Key:       key,
+			ValueJson: string(v),
 		}
-		h.runHistory.ApplyChangeRecord(
-			item,
-			func(err error) {
-				h.logger.CaptureError("Error updating run history", err)
-			},
-		)
-		return item[0]
 	}
 	return nil
 }
@@ -2,26 +2,58 @@ package server_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/droppeddata"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/internal/stepsync"
+	"github.com/wandb/wandb/core/pkg/artifacts"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// makeHandler starts a handler that reads from inChan.
+//
+// The handler is configured by params, if given. The channels are always
+// the ones passed in, and the logger, settings and printer default to
+// no-op or empty values when unset.
 func makeHandler(
 	inChan, fwdChan chan *service.Record,
 	outChan chan *service.Result,
+	params ...server.HandlerParams,
 ) *server.Handler {
-	h := server.NewHandler(context.Background(),
-		&server.HandlerParams{
-			Logger:          observability.NewNoOpLogger(),
-			Settings:        &service.Settings{},
-			FwdChan:         fwdChan,
-			OutChan:         outChan,
-			TerminalPrinter: observability.NewPrinter(),
-		},
-	)
+	var p server.HandlerParams
+	if len(params) > 0 {
+		p = params[0]
+	}
+
+	p.FwdChan = fwdChan
+	p.OutChan = outChan
+	if p.Logger == nil {
+		p.Logger = observability.NewNoOpLogger()
+	}
+	if p.Settings == nil {
+		p.Settings = &service.Settings{}
+	}
+	if p.TerminalPrinter == nil {
+		p.TerminalPrinter = observability.NewPrinter()
+	}
+
+	h := server.NewHandler(context.Background(), &p)
 
 	go h.Do(inChan)
 
@@ -701,6 +733,74 @@ func TestHandlePartialHistory(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "NilStepNoFlushTwiceNilStepFlush",
+			input: []data{
+				{
+					items: map[string]string{
+						"key1": "1",
+						"key2": "1",
+					},
+					stepNil: true,
+					flush:   false,
+				},
+				{
+					items: map[string]string{
+						"key2": "2",
+					},
+					stepNil: true,
+					flush:   false,
+				},
+				{
+					items: map[string]string{
+						"key3": "3",
+					},
+					stepNil: true,
+					flush:   true,
+				},
+			},
+			expected: []data{
+				{
+					items: map[string]string{
+						"key1": "1",
+						"key2": "2",
+						"key3": "3",
+					},
+					step: 0,
+				},
+				{
+					flush: true,
+				},
+			},
+		},
+		{
+			name: "NilStepEmptyFlushAdvancesStep",
+			input: []data{
+				{
+					items:   map[string]string{},
+					stepNil: true,
+					flush:   true,
+				},
+				{
+					items: map[string]string{
+						"key1": "1",
+					},
+					stepNil: true,
+					flush:   true,
+				},
+			},
+			expected: []data{
+				{
+					items: map[string]string{
+						"key1": "1",
+					},
+					step: 1,
+				},
+				{
+					flush: true,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -842,3 +942,1200 @@ func TestHandleHistory(t *testing.T) {
 	}
 
 }
+
+func TestHandleHistory_RateLimit(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XHistoryRateLimit: &wrapperspb.DoubleValue{Value: 0.001},
+		},
+		RunSummary: runsummary.New(),
+	})
+
+	for step := int64(0); step < 3; step++ {
+		inChan <- makeHistoryRecord(data{
+			items: map[string]string{"loss": fmt.Sprint(step)},
+			step:  step,
+		})
+	}
+
+	var historyLoss, summaryLoss []string
+	for len(summaryLoss) < 3 {
+		record := <-fwdChan
+		for _, item := range record.GetHistory().GetItem() {
+			if item.Key == "loss" {
+				historyLoss = append(historyLoss, item.ValueJson)
+			}
+		}
+		for _, item := range record.GetSummary().GetUpdate() {
+			if item.Key == "loss" {
+				summaryLoss = append(summaryLoss, item.ValueJson)
+			}
+		}
+	}
+
+	assert.Equal(t, []string{"0"}, historyLoss)
+	assert.Equal(t, []string{"0", "1", "2"}, summaryLoss)
+}
+
+func TestHandleHistory_MaxMetrics(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	droppedData := droppeddata.New()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XMaxMetrics: &wrapperspb.Int32Value{Value: 2},
+		},
+		RunSummary:  runsummary.New(),
+		DroppedData: droppedData,
+	})
+
+	inChan <- makeHistoryRecord(data{
+		items: map[string]string{"loss": "1", "acc": "2"},
+		step:  0,
+	})
+	inChan <- makeHistoryRecord(data{
+		items: map[string]string{"loss": "3", "t_1700000000": "4"},
+		step:  1,
+	})
+	inChan <- &service.Record{RecordType: &service.Record_Summary{
+		Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "t_1700000001", ValueJson: "5"}},
+		},
+	}}
+
+	var historyKeys []string
+	summaryKeys := make(map[string]struct{})
+	for i := 0; i < 5; i++ {
+		record := <-fwdChan
+		for _, item := range record.GetHistory().GetItem() {
+			if !strings.HasPrefix(item.Key, "_") {
+				historyKeys = append(historyKeys, item.Key)
+			}
+		}
+		for _, item := range record.GetSummary().GetUpdate() {
+			summaryKeys[item.Key] = struct{}{}
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"loss", "acc", "loss"}, historyKeys)
+	assert.NotContains(t, summaryKeys, "t_1700000000")
+	assert.NotContains(t, summaryKeys, "t_1700000001")
+	assert.Equal(t,
+		"metric_limit_exceeded: 2 (e.g. t_1700000000, t_1700000001)",
+		droppeddata.Format(droppedData.Summary()))
+}
+
+func TestHandleHistory_ExplicitTimestamp(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan)
+
+	timestamps := []*timestamppb.Timestamp{
+		{Seconds: 1700000000, Nanos: 500000000},
+		{Seconds: -1},
+	}
+	for step, timestamp := range timestamps {
+		inChan <- &service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Step: &service.HistoryStep{Num: int64(step)},
+					Item: []*service.HistoryItem{
+						{Key: "loss", ValueJson: "1"},
+						{Key: "_timestamp", ValueJson: "1800000000.0"},
+					},
+					Timestamp: timestamp,
+				},
+			},
+		}
+	}
+
+	var values []string
+	for len(values) < len(timestamps) {
+		record := <-fwdChan
+		for _, item := range record.GetHistory().GetItem() {
+			if item.Key == "_timestamp" {
+				values = append(values, item.ValueJson)
+			}
+		}
+	}
+
+	// The invalid timestamp is ignored.
+	assert.Equal(t, []string{"1700000000.500000", "1800000000.0"}, values)
+}
+
+func TestHandlePartialHistory_ExplicitTimestamp(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan)
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_PartialHistory{
+					PartialHistory: &service.PartialHistoryRequest{
+						Item:      []*service.HistoryItem{{Key: "loss", ValueJson: "1"}},
+						Timestamp: &timestamppb.Timestamp{Seconds: 1700000000},
+					},
+				},
+			},
+		},
+	}
+
+	record := <-fwdChan
+	assert.Contains(t,
+		record.GetHistory().GetItem(),
+		&service.HistoryItem{Key: "_timestamp", ValueJson: "1700000000.000000"},
+	)
+}
+
+func TestHandleHistory_StepMetric(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	printer := observability.NewPrinter()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		RunSummary:      runsummary.New(),
+		MetricHandler:   server.NewMetricHandler(),
+		TerminalPrinter: printer,
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Metric{
+		Metric: &service.MetricRecord{
+			Name:       "acc",
+			StepMetric: "epoch",
+			Options:    &service.MetricOptions{StepSync: true},
+		},
+	}}
+	inChan <- makeHistoryRecord(data{items: map[string]string{"acc": "0.1"}, step: 0})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"epoch": "1"}, step: 1})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"acc": "0.5"}, step: 2})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"acc": "0.9", "epoch": "2"}, step: 3})
+
+	var epochs [][]string
+	for len(epochs) < 4 {
+		record := <-fwdChan
+		if record.GetHistory() == nil {
+			continue
+		}
+
+		var values []string
+		for _, item := range record.GetHistory().GetItem() {
+			if item.Key == "epoch" {
+				values = append(values, item.ValueJson)
+			}
+		}
+		epochs = append(epochs, values)
+	}
+
+	assert.Equal(t,
+		[][]string{
+			nil,   // logged before its step metric
+			{"1"}, // the step metric itself
+			{"1"}, // imputed from the previous step
+			{"2"}, // from the same call, not imputed
+		},
+		epochs)
+	assert.Len(t, printer.Read(), 1)
+}
+
+func TestHandleHistory_StepMetricLoggedBeforeDefined(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	printer := observability.NewPrinter()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		RunSummary:      runsummary.New(),
+		MetricHandler:   server.NewMetricHandler(),
+		TerminalPrinter: printer,
+	})
+
+	inChan <- makeHistoryRecord(data{items: map[string]string{"epoch": "1"}, step: 0})
+	inChan <- &service.Record{RecordType: &service.Record_Metric{
+		Metric: &service.MetricRecord{
+			Name:       "acc",
+			StepMetric: "epoch",
+			Options:    &service.MetricOptions{StepSync: true},
+		},
+	}}
+	inChan <- makeHistoryRecord(data{items: map[string]string{"acc": "0.5"}, step: 1})
+
+	var epochs [][]string
+	for len(epochs) < 2 {
+		record := <-fwdChan
+		if record.GetHistory() == nil {
+			continue
+		}
+
+		var values []string
+		for _, item := range record.GetHistory().GetItem() {
+			if item.Key == "epoch" {
+				values = append(values, item.ValueJson)
+			}
+		}
+		epochs = append(epochs, values)
+	}
+
+	assert.Equal(t, [][]string{{"1"}, {"1"}}, epochs)
+	assert.Empty(t, printer.Read())
+}
+
+// summaryAfterHistory defines the "loss" metric, logs its values and
+// returns the resulting summary.
+//
+// Nested summary keys are joined by dots.
+func summaryAfterHistory(
+	t *testing.T,
+	metric *service.MetricRecord,
+	values ...string,
+) map[string]string {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings:      &service.Settings{XDisableRuntime: wrapperspb.Bool(true)},
+		RunSummary:    runsummary.New(),
+		MetricHandler: server.NewMetricHandler(),
+	})
+
+	metric.Name = "loss"
+	inChan <- &service.Record{RecordType: &service.Record_Metric{Metric: metric}}
+	for i, value := range values {
+		inChan <- makeHistoryRecord(data{
+			items: map[string]string{"loss": value},
+			step:  int64(i),
+		})
+	}
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_GetSummary{
+					GetSummary: &service.GetSummaryRequest{},
+				},
+			},
+		},
+	}
+
+	summary := make(map[string]string)
+	for _, item := range (<-outChan).GetResponse().GetGetSummaryResponse().GetItem() {
+		key := item.Key
+		if len(item.NestedKey) > 0 {
+			key = strings.Join(item.NestedKey, ".")
+		}
+		summary[key] = item.ValueJson
+	}
+	return summary
+}
+
+// bestLossMetric returns a metric with a "best" summary.
+func bestLossMetric(goal service.MetricRecord_MetricGoal) *service.MetricRecord {
+	return &service.MetricRecord{
+		Summary: &service.MetricSummary{Best: true},
+		Goal:    goal,
+	}
+}
+
+func TestHandleHistory_BestSummary(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		bestLossMetric(service.MetricRecord_GOAL_MINIMIZE),
+		"0.5", "0.3", "0.3", "NaN", "0.4")
+
+	assert.Equal(t, "0.3", summary["loss"])
+	assert.Equal(t, "1", summary["loss.step"])
+}
+
+func TestHandleHistory_BestSummaryMaximize(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		bestLossMetric(service.MetricRecord_GOAL_MAXIMIZE),
+		"0.5", "0.9", "0.7")
+
+	assert.Equal(t, "0.9", summary["loss"])
+	assert.Equal(t, "1", summary["loss.step"])
+}
+
+func TestHandleHistory_BestSummaryIgnoresNaN(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		bestLossMetric(service.MetricRecord_GOAL_MINIMIZE),
+		"NaN", "2", "NaN")
+
+	assert.Equal(t, "2", summary["loss"])
+	assert.Equal(t, "1", summary["loss.step"])
+}
+
+func TestHandleHistory_BestSummaryOnlyNaN(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		bestLossMetric(service.MetricRecord_GOAL_MINIMIZE),
+		"NaN")
+
+	assert.NotContains(t, summary, "loss")
+	assert.NotContains(t, summary, "loss.step")
+}
+
+func TestHandleHistory_ArraySummary(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		&service.MetricRecord{},
+		"[1, 2]", "[3, NaN, [5]]")
+
+	assert.Equal(t, "3", summary["loss.length"])
+	assert.Equal(t, "3", summary["loss.min"])
+	assert.Equal(t, "5", summary["loss.max"])
+	assert.Equal(t, "4", summary["loss.mean"])
+}
+
+func TestHandleHistory_ArraySummaryKeepsNonNumericArrays(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		&service.MetricRecord{},
+		"[1, 2]", "[3, \"x\"]")
+
+	assert.Equal(t, "[3,\"x\"]", summary["loss"])
+	assert.NotContains(t, summary, "loss.length")
+}
+
+func TestHandleHistory_ArraySummaryEnds(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		&service.MetricRecord{Summary: &service.MetricSummary{
+			Array: service.MetricSummary_ARRAY_ENDS,
+		}},
+		"[1, 2, 3]")
+
+	assert.Equal(t, "3", summary["loss.length"])
+	assert.Equal(t, "1", summary["loss.first"])
+	assert.Equal(t, "3", summary["loss.last"])
+	assert.NotContains(t, summary, "loss.min")
+}
+
+func TestHandleHistory_ArraySummaryFull(t *testing.T) {
+	summary := summaryAfterHistory(t,
+		&service.MetricRecord{Summary: &service.MetricSummary{
+			Array: service.MetricSummary_ARRAY_FULL,
+		}},
+		"[1, 2, 3]")
+
+	assert.Equal(t, "[1,2,3]", summary["loss"])
+}
+
+func TestHandleHistory_DisableAutoSummary(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XDisableAutoSummary: &wrapperspb.BoolValue{Value: true},
+		},
+		RunSummary:    runsummary.New(),
+		MetricHandler: server.NewMetricHandler(),
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Metric{
+		Metric: &service.MetricRecord{
+			Name:    "acc",
+			Summary: &service.MetricSummary{Last: true},
+		},
+	}}
+	inChan <- makeHistoryRecord(data{
+		items: map[string]string{"loss": "0.5", "acc": "0.9"},
+		step:  0,
+	})
+	inChan <- &service.Record{RecordType: &service.Record_Summary{
+		Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "final_loss", ValueJson: "0.4"}},
+		},
+	}}
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_GetSummary{
+					GetSummary: &service.GetSummaryRequest{},
+				},
+			},
+		},
+	}
+
+	summary := make(map[string]string)
+	for _, item := range (<-outChan).GetResponse().GetGetSummaryResponse().GetItem() {
+		summary[item.Key] = item.ValueJson
+	}
+	assert.NotContains(t, summary, "loss")
+	assert.Equal(t, "0.9", summary["acc"])
+	assert.Equal(t, "0.4", summary["final_loss"])
+	assert.Equal(t, "0", summary["_step"])
+}
+
+func TestHandleHistory_SummaryAggregations(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	runSummary := runsummary.New()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings:      &service.Settings{XDisableRuntime: &wrapperspb.BoolValue{Value: true}},
+		RunSummary:    runSummary,
+		MetricHandler: server.NewMetricHandler(),
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Metric{
+		Metric: &service.MetricRecord{
+			Name:    "loss",
+			Summary: &service.MetricSummary{Best: true},
+			Goal:    service.MetricRecord_GOAL_MINIMIZE,
+		},
+	}}
+	inChan <- makeHistoryRecord(data{
+		items: map[string]string{"loss": "0.5", "acc": "0.9"},
+		step:  0,
+	})
+	inChan <- &service.Record{RecordType: &service.Record_Summary{
+		Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "note", ValueJson: `"done"`}},
+		},
+	}}
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_GetSummary{
+					GetSummary: &service.GetSummaryRequest{},
+				},
+			},
+		},
+	}
+	<-outChan
+
+	serialized, err := runSummary.Serialize(runsummary.WithAggregations())
+	require.NoError(t, err)
+	var summary map[string]any
+	require.NoError(t, json.Unmarshal(serialized, &summary))
+	assert.Equal(t,
+		map[string]any{
+			"loss":  map[string]any{"aggregation": "min"},
+			"acc":   map[string]any{"aggregation": "last"},
+			"_step": map[string]any{"aggregation": "last"},
+		},
+		summary[runsummary.MetaKey])
+}
+
+func TestHandleHistory_MinMaxMeanSummary(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	runSummary := runsummary.New()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings:      &service.Settings{XDisableRuntime: &wrapperspb.BoolValue{Value: true}},
+		RunSummary:    runSummary,
+		MetricHandler: server.NewMetricHandler(),
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Metric{
+		Metric: &service.MetricRecord{
+			Name: "loss",
+			Summary: &service.MetricSummary{
+				Min: true, Max: true, Mean: true, Last: true,
+			},
+		},
+	}}
+	for step, loss := range []string{"0.5", "NaN", "0.1", "0.3"} {
+		inChan <- makeHistoryRecord(data{
+			items: map[string]string{"loss": loss},
+			step:  int64(step),
+		})
+	}
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_GetSummary{
+					GetSummary: &service.GetSummaryRequest{},
+				},
+			},
+		},
+	}
+	<-outChan
+
+	serialized, err := runSummary.Serialize(runsummary.WithAggregations())
+	require.NoError(t, err)
+	var summary map[string]any
+	require.NoError(t, json.Unmarshal(serialized, &summary))
+	assert.Equal(t,
+		map[string]any{"min": 0.1, "max": 0.5, "mean": 0.3, "last": 0.3},
+		summary["loss"])
+	assert.Equal(t,
+		map[string]any{
+			"min":  map[string]any{"aggregation": "min"},
+			"max":  map[string]any{"aggregation": "max"},
+			"mean": map[string]any{"aggregation": "mean", "count": float64(3)},
+			"last": map[string]any{"aggregation": "last"},
+		},
+		summary[runsummary.MetaKey].(map[string]any)["loss"])
+}
+
+func TestHandleRun_DuplicateRunID(t *testing.T) {
+	lockDir := t.TempDir()
+	startHandler := func() (chan *service.Record, chan *service.Record, chan *service.Result) {
+		inChan := make(chan *service.Record, server.BufferSize)
+		fwdChan := make(chan *service.Record, server.BufferSize)
+		outChan := make(chan *service.Result, server.BufferSize)
+		makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+			RunLockDir: lockDir,
+		})
+		return inChan, fwdChan, outChan
+	}
+	runRecord := func() *service.Record {
+		return &service.Record{RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "run1", Project: "project"},
+		}}
+	}
+
+	inChan1, fwdChan1, _ := startHandler()
+	inChan1 <- runRecord()
+	<-fwdChan1
+	inChan2, _, outChan2 := startHandler()
+	inChan2 <- runRecord()
+	result := <-outChan2
+
+	runError := result.GetRunResult().GetError()
+	assert.Equal(t, service.ErrorInfo_USAGE, runError.GetCode())
+	assert.Contains(t, runError.GetMessage(), `run "run1" is already being logged`)
+	assert.Contains(t, runError.GetMessage(),
+		fmt.Sprintf("process %d", os.Getpid()))
+
+	// The run is released when the first handler closes.
+	close(inChan1)
+	for range fwdChan1 {
+	}
+	inChan3, fwdChan3, _ := startHandler()
+	inChan3 <- runRecord()
+	assert.Equal(t, "run1", (<-fwdChan3).GetRun().GetRunId())
+}
+
+func TestHandleHistory_DisableRuntime(t *testing.T) {
+	for _, disabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("disabled=%v", disabled), func(t *testing.T) {
+			inChan := make(chan *service.Record, server.BufferSize)
+			fwdChan := make(chan *service.Record, server.BufferSize)
+			outChan := make(chan *service.Result, server.BufferSize)
+			makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+				Settings: &service.Settings{
+					XDisableRuntime: &wrapperspb.BoolValue{Value: disabled},
+				},
+			})
+
+			inChan <- makeHistoryRecord(data{items: map[string]string{"loss": "1"}})
+			record := <-fwdChan
+
+			var keys []string
+			for _, item := range record.GetHistory().GetItem() {
+				keys = append(keys, item.Key)
+			}
+			if disabled {
+				assert.NotContains(t, keys, "_runtime")
+			} else {
+				assert.Contains(t, keys, "_runtime")
+			}
+		})
+	}
+}
+
+func TestHandleHistory_DuplicateKeys(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	printer := observability.NewPrinter()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		TerminalPrinter: printer,
+	})
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_History{
+			History: &service.HistoryRecord{
+				Step: &service.HistoryStep{Num: 1},
+				Item: []*service.HistoryItem{
+					{Key: "a", ValueJson: `{"b": 1}`},
+					{Key: "a.b", ValueJson: "2"},
+				},
+			},
+		},
+	}
+	record := <-fwdChan
+
+	var values []string
+	for _, item := range record.GetHistory().GetItem() {
+		if item.Key == "a.b" || slices.Equal(item.NestedKey, []string{"a", "b"}) {
+			values = append(values, item.ValueJson)
+		}
+	}
+	assert.Equal(t, []string{"2"}, values)
+	messages := printer.Read()
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0], `"a.b"`)
+}
+
+func TestHandleHistory_OversizedValueRejected(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	printer := observability.NewPrinter()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XMaxValueBytes: &wrapperspb.Int32Value{Value: 10},
+		},
+		TerminalPrinter: printer,
+	})
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_History{
+			History: &service.HistoryRecord{
+				Step: &service.HistoryStep{Num: 1},
+				Item: []*service.HistoryItem{
+					{Key: "small", ValueJson: "1"},
+					{Key: "large", ValueJson: `"this string is too long"`},
+				},
+			},
+		},
+	}
+	record := <-fwdChan
+
+	var keys []string
+	for _, item := range record.GetHistory().GetItem() {
+		keys = append(keys, item.Key)
+	}
+	assert.Contains(t, keys, "small")
+	assert.NotContains(t, keys, "large")
+	messages := printer.Read()
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0], `"large"`)
+}
+
+func TestHandleHistory_OversizedValueWarnsOnce(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	printer := observability.NewPrinter()
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XMaxValueBytes: &wrapperspb.Int32Value{Value: 10},
+		},
+		TerminalPrinter: printer,
+	})
+
+	for step, value := range []string{
+		`"this string is too long"`,
+		`"this string is also too long"`,
+	} {
+		inChan <- &service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Step: &service.HistoryStep{Num: int64(step)},
+					Item: []*service.HistoryItem{
+						{Key: "small", ValueJson: "1"},
+						{Key: "large", ValueJson: value},
+					},
+				},
+			},
+		}
+		<-fwdChan
+	}
+
+	assert.Len(t, printer.Read(), 1)
+}
+
+func TestHandlePollExit_ReportsDroppedData(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XMaxValueBytes: &wrapperspb.Int32Value{Value: 10},
+		},
+		DroppedData: droppeddata.New(),
+	})
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_History{
+			History: &service.HistoryRecord{
+				Step: &service.HistoryStep{Num: 1},
+				Item: []*service.HistoryItem{
+					{Key: "large", ValueJson: `"this string is too long"`},
+				},
+			},
+		},
+	}
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_PollExit{
+					PollExit: &service.PollExitRequest{},
+				},
+			},
+		},
+	}
+	result := <-outChan
+
+	categories := result.GetResponse().GetPollExitResponse().
+		GetDroppedData().GetCategories()
+	require.Len(t, categories, 1)
+	assert.Equal(t, string(droppeddata.ValueTooLarge), categories[0].Name)
+	assert.EqualValues(t, 1, categories[0].Count)
+	assert.Equal(t, []string{"large"}, categories[0].Examples)
+}
+
+func TestHandleExit_Killed(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		RunSummary: runsummary.New(),
+	})
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_Exit{
+			Exit: &service.RunExitRecord{
+				ExitCode:   255,
+				Killed:     true,
+				KillReason: "out of budget",
+			},
+		},
+	}
+
+	var summary *service.SummaryRecord
+	for summary == nil {
+		summary = (<-fwdChan).GetSummary()
+	}
+	assert.Contains(t, summary.Update, &service.SummaryItem{
+		NestedKey: []string{"_wandb", "kill_reason"},
+		ValueJson: `"out of budget"`,
+	})
+
+	exit := (<-fwdChan).GetExit()
+	assert.True(t, exit.GetKilled())
+}
+
+func TestHandleExit_HistogramSummary(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings:      &service.Settings{XDisableRuntime: wrapperspb.Bool(true)},
+		RunSummary:    runsummary.New(),
+		MetricHandler: server.NewMetricHandler(),
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Metric{
+		Metric: &service.MetricRecord{
+			Name:    "loss",
+			Summary: &service.MetricSummary{HistogramBins: 2},
+		},
+	}}
+	for i, value := range []string{"1", "2", "NaN", "3"} {
+		inChan <- makeHistoryRecord(data{
+			items: map[string]string{"loss": value},
+			step:  int64(i),
+		})
+	}
+	// The last value is logged with commit=False, so it's only flushed
+	// after the run exits.
+	inChan <- makePartialHistoryRecord(data{
+		items:   map[string]string{"loss": "3"},
+		stepNil: true,
+		flush:   false,
+	})
+	inChan <- &service.Record{
+		RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+	}
+	inChan <- makeFlushRecord()
+
+	var histogram, last string
+	for record := range fwdChan {
+		if record.GetRequest().GetDefer() != nil {
+			break
+		}
+		for _, item := range record.GetSummary().GetUpdate() {
+			switch {
+			case slices.Equal(item.GetNestedKey(), []string{"loss", "histogram"}):
+				histogram = item.GetValueJson()
+			case slices.Equal(item.GetNestedKey(), []string{"loss", "last"}):
+				last = item.GetValueJson()
+			}
+		}
+	}
+	assert.JSONEq(t,
+		`{"_type": "histogram", "bins": [1, 2, 3], "values": [1, 3]}`,
+		histogram)
+	assert.Equal(t, "3", last)
+}
+
+func TestHandleNotes_TruncatesLongNotes(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan)
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_Notes{
+			Notes: &service.RunNotesRecord{Notes: strings.Repeat("é", 70000)},
+		},
+	}
+
+	notes := (<-fwdChan).GetNotes().GetNotes()
+	assert.Equal(t, 65535, utf8.RuneCountInString(notes))
+	assert.True(t, utf8.ValidString(notes))
+}
+
+func makeBatchHistoryRecord(steps ...int64) *service.Record {
+	var rows []*service.BatchHistoryRow
+	for _, step := range steps {
+		rows = append(rows, &service.BatchHistoryRow{
+			Step: step,
+			Item: []*service.HistoryItem{{Key: "loss", ValueJson: "1"}},
+		})
+	}
+
+	return &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_BatchHistory{
+					BatchHistory: &service.BatchHistoryRequest{Rows: rows},
+				},
+			},
+		},
+	}
+}
+
+func TestHandleBatchHistory(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan)
+
+	inChan <- makeBatchHistoryRecord(2, 5, 7)
+	response := (<-outChan).GetResponse().GetBatchHistoryResponse()
+
+	assert.Empty(t, response.GetErrorMessage())
+	assert.EqualValues(t, 8, response.GetStep())
+	var steps []int64
+	for len(steps) < 3 {
+		if history := (<-fwdChan).GetHistory(); history != nil {
+			steps = append(steps, history.GetStep().GetNum())
+		}
+	}
+	assert.Equal(t, []int64{2, 5, 7}, steps)
+}
+
+func TestHandleBatchHistory_NonIncreasingSteps(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan)
+
+	inChan <- makeBatchHistoryRecord(3, 3)
+	response := (<-outChan).GetResponse().GetBatchHistoryResponse()
+
+	assert.Contains(t, response.GetErrorMessage(), "row 1 has step 3")
+	assert.EqualValues(t, 0, response.GetStep())
+	assert.Empty(t, fwdChan)
+}
+
+func TestHandleRunStatus(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan)
+
+	for i := 0; i < 2; i++ {
+		inChan <- &service.Record{
+			RecordType: &service.Record_Request{
+				Request: &service.Request{
+					RequestType: &service.Request_PartialHistory{
+						PartialHistory: &service.PartialHistoryRequest{
+							Item: []*service.HistoryItem{
+								{Key: "loss", ValueJson: "1"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_RunStatus{
+					RunStatus: &service.RunStatusRequest{},
+				},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "status"},
+	}
+	response := (<-outChan).GetResponse().GetRunStatusResponse()
+
+	assert.EqualValues(t, 2, response.GetHistoryStep())
+	assert.GreaterOrEqual(t, response.GetRuntime(), 0.0)
+}
+
+func TestHandleHistory_SharedStep(t *testing.T) {
+	stepFile := filepath.Join(t.TempDir(), "run.step")
+	otherProcess, err := stepsync.New(stepFile)
+	require.NoError(t, err)
+	_, err = otherProcess.Claim(0)
+	require.NoError(t, err)
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XSharedStepFile: wrapperspb.String(stepFile),
+		},
+	})
+
+	for i := 0; i < 2; i++ {
+		inChan <- makePartialHistoryRecord(data{
+			items:    map[string]string{"loss": "1"},
+			stepNil:  true,
+			flushNil: true,
+		})
+	}
+
+	var steps []int64
+	for len(steps) < 2 {
+		if history := (<-fwdChan).GetHistory(); history != nil {
+			steps = append(steps, history.GetStep().GetNum())
+		}
+	}
+	assert.Equal(t, []int64{1, 2}, steps)
+}
+
+func TestHandleSummary_PinnedKeysAlwaysSent(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		RunSummary: runsummary.New(),
+	})
+	summaryRecord := func(summary *service.SummaryRecord) *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Summary{Summary: summary},
+		}
+	}
+
+	inChan <- summaryRecord(&service.SummaryRecord{
+		Update: []*service.SummaryItem{{Key: "best", ValueJson: "1"}},
+		Pin:    []*service.SummaryItem{{Key: "best"}},
+	})
+	inChan <- summaryRecord(&service.SummaryRecord{
+		Update: []*service.SummaryItem{{Key: "other", ValueJson: "2"}},
+	})
+	<-fwdChan
+	summary := (<-fwdChan).GetSummary()
+
+	values := make(map[string]string)
+	for _, item := range summary.GetUpdate() {
+		values[item.GetKey()] = item.GetValueJson()
+	}
+	assert.Equal(t, "1", values["best"])
+	assert.Equal(t, "2", values["other"])
+}
+
+func TestHandleRecord_AfterFinish(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy string
+	}{
+		{"rejects by default", ""},
+		{"rejects with error policy", "error"},
+		{"persists with warn policy", "warn"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inChan := make(chan *service.Record, server.BufferSize)
+			fwdChan := make(chan *service.Record, server.BufferSize)
+			outChan := make(chan *service.Result, server.BufferSize)
+			droppedData := droppeddata.New()
+			makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+				Settings: &service.Settings{
+					XPostFinishPolicy: &wrapperspb.StringValue{Value: tc.policy},
+				},
+				RunSummary:  runsummary.New(),
+				DroppedData: droppedData,
+			})
+
+			inChan <- &service.Record{RecordType: &service.Record_Exit{
+				Exit: &service.RunExitRecord{},
+			}}
+			history := makeHistoryRecord(data{
+				items: map[string]string{"loss": "1"},
+				step:  0,
+			})
+			history.Control = &service.Control{ReqResp: true}
+			inChan <- history
+
+			assert.NotNil(t, (<-fwdChan).GetSummary())
+			assert.NotNil(t, (<-fwdChan).GetExit())
+
+			if tc.policy == "warn" {
+				record := <-fwdChan
+				assert.NotNil(t, record.GetHistory())
+				assert.True(t, record.GetControl().GetPersistOnly())
+				assert.Empty(t, droppedData.Summary().GetCategories())
+			} else {
+				result := <-outChan
+				assert.Equal(t,
+					service.ErrorInfo_USAGE,
+					result.GetLogResult().GetError().GetCode())
+				assert.Equal(t,
+					"logged_after_finish: 1 (e.g. history)",
+					droppeddata.Format(droppedData.Summary()))
+				assert.Empty(t, fwdChan)
+			}
+		})
+	}
+}
+
+func TestHandleRun_RunNameTemplate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		displayName string
+		resume      string
+		expected    string
+	}{
+		{"names new run", "", "", "project-2024-05-06-ab12"},
+		{"keeps given name", "given", "", "given"},
+		{"keeps resumed run name", "", "allow", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inChan := make(chan *service.Record, server.BufferSize)
+			fwdChan := make(chan *service.Record, server.BufferSize)
+			outChan := make(chan *service.Result, server.BufferSize)
+			makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+				Settings: &service.Settings{
+					XRunNameTemplate: wrapperspb.String("{project}-{date}-{short_id}"),
+					Resume:           wrapperspb.String(tc.resume),
+				},
+			})
+
+			startTime := time.Date(2024, 5, 6, 12, 0, 0, 0, time.Local)
+			inChan <- &service.Record{RecordType: &service.Record_Run{
+				Run: &service.RunRecord{
+					RunId:       "ab12cd34",
+					Project:     "project",
+					DisplayName: tc.displayName,
+					StartTime:   timestamppb.New(startTime),
+				},
+			}}
+
+			assert.Equal(t, tc.expected, (<-fwdChan).GetRun().GetDisplayName())
+		})
+	}
+}
+
+func TestHandleRun_InvalidRunNameTemplate(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XRunNameTemplate: wrapperspb.String("{project}-{user}"),
+		},
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Run{
+		Run: &service.RunRecord{RunId: "ab12cd34", Project: "project"},
+	}}
+
+	runError := (<-outChan).GetRunResult().GetError()
+	assert.Equal(t, service.ErrorInfo_USAGE, runError.GetCode())
+	assert.Contains(t, runError.GetMessage(), "unknown placeholder {user}")
+	assert.Empty(t, fwdChan)
+}
+
+func TestHandleRunStart_CodeSnapshotBeforeExit(t *testing.T) {
+	dir := t.TempDir()
+	program := filepath.Join(dir, "train.py")
+	require.NoError(t, os.WriteFile(program, []byte("print(1)\n"), 0o644))
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XCodeSnapshot:  wrapperspb.Bool(true),
+			XDisableStats:  wrapperspb.Bool(true),
+			XDisableMeta:   wrapperspb.Bool(true),
+			ProgramAbspath: wrapperspb.String(program),
+			FilesDir:       wrapperspb.String(dir),
+		},
+		RunSummary: runsummary.New(),
+		Staging:    artifacts.NewStagingArea(t.TempDir()),
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Request{
+		Request: &service.Request{RequestType: &service.Request_RunStart{
+			RunStart: &service.RunStartRequest{
+				Run: &service.RunRecord{
+					RunId:     "run",
+					Project:   "project",
+					StartTime: timestamppb.Now(),
+				},
+			},
+		}},
+	}}
+	inChan <- &service.Record{RecordType: &service.Record_Exit{
+		Exit: &service.RunExitRecord{},
+	}}
+
+	var types []string
+	for record := range fwdChan {
+		switch {
+		case record.GetArtifact() != nil:
+			types = append(types, "artifact")
+		case record.GetExit() != nil:
+			types = append(types, "exit")
+		}
+		if record.GetExit() != nil {
+			break
+		}
+	}
+	assert.Equal(t, []string{"artifact", "exit"}, types)
+}
+
+func TestHandleRunStart_MetadataUsesClientEnv(t *testing.T) {
+	t.Setenv("RUNENV_HANDLER_TEST", "core")
+	dir := t.TempDir()
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	makeHandler(inChan, fwdChan, outChan, server.HandlerParams{
+		Settings: &service.Settings{
+			XDisableStats:    wrapperspb.Bool(true),
+			FilesDir:         wrapperspb.String(dir),
+			XMetadataEnvVars: &service.ListStringValue{Value: []string{"RUNENV_*"}},
+			XMetadataEnv: &service.MapStringKeyStringValue{
+				Value: map[string]string{"RUNENV_HANDLER_TEST": "client"},
+			},
+		},
+		RunSummary: runsummary.New(),
+	})
+
+	inChan <- &service.Record{RecordType: &service.Record_Request{
+		Request: &service.Request{RequestType: &service.Request_RunStart{
+			RunStart: &service.RunStartRequest{
+				Run: &service.RunRecord{RunId: "run", StartTime: timestamppb.Now()},
+			},
+		}},
+	}}
+	for record := range fwdChan {
+		if record.GetFiles() != nil {
+			break
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, server.MetaFileName))
+	require.NoError(t, err)
+	metadata := &service.MetadataRequest{}
+	require.NoError(t, protojson.Unmarshal(data, metadata))
+	assert.Equal(t,
+		map[string]string{"RUNENV_HANDLER_TEST": "client"},
+		metadata.GetEnv())
+}
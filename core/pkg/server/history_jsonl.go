@@ -0,0 +1,94 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// HistoryJSONLFileName is the name of the history mirror in the run
+// directory.
+const HistoryJSONLFileName = "history.jsonl"
+
+// historyJSONLSink writes each history row as a line of a JSONL file.
+//
+// It mirrors the history uploaded to W&B so that it can be inspected
+// locally during the run. Rows are encoded like the filestream encodes
+// them, and rows too long for the filestream are skipped.
+//
+// Writing is best-effort: if the file can't be opened or written, the
+// error is reported once and the sink stops writing.
+type historyJSONLSink struct {
+	path string
+
+	// file is the open mirror, or nil before the first row.
+	file *os.File
+
+	// failed is whether an error stopped the sink.
+	failed bool
+
+	buf     *bytes.Buffer
+	encoder *runhistory.Encoder
+}
+
+func newHistoryJSONLSink(path string) *historyJSONLSink {
+	buf := &bytes.Buffer{}
+	return &historyJSONLSink{
+		path:    path,
+		buf:     buf,
+		encoder: runhistory.NewEncoder(buf),
+	}
+}
+
+func (s *historyJSONLSink) Write(record *service.Record) error {
+	history := record.GetHistory()
+	if history == nil || s.failed {
+		return nil
+	}
+
+	s.buf.Reset()
+	// Values that can't be decoded are dropped from the row, like in the
+	// uploaded history, which reports them.
+	err := s.encoder.Encode(history.GetItem(), func(error) {})
+	if err != nil {
+		return fmt.Errorf("history mirror: cannot encode row: %v", err)
+	}
+
+	if s.buf.Len() > filestream.MaxFileLineBytes {
+		return fmt.Errorf(
+			"history mirror: skipping row of %d bytes, more than the limit of %d",
+			s.buf.Len(), filestream.MaxFileLineBytes)
+	}
+	s.buf.WriteByte('\n')
+
+	if s.file == nil {
+		file, err := os.OpenFile(
+			s.path,
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND,
+			0o644,
+		)
+		if err != nil {
+			s.failed = true
+			return fmt.Errorf("history mirror: cannot open file: %v", err)
+		}
+		s.file = file
+	}
+
+	if _, err := s.file.Write(s.buf.Bytes()); err != nil {
+		s.failed = true
+		return fmt.Errorf("history mirror: cannot write to %s: %v", s.path, err)
+	}
+
+	return nil
+}
+
+func (s *historyJSONLSink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/filetail"
+	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// LogFiles streams the contents of user log files to the run's console
+// logs.
+//
+// This is for programs that write their logs to a file, like "train.log",
+// instead of to stdout.
+type LogFiles struct {
+	watcher watcher.Watcher
+	logger  *observability.CoreLogger
+
+	// outChan receives OutputRaw records for lines appended to the files
+	outChan chan *service.Record
+
+	tailers []*filetail.Tailer
+}
+
+func NewLogFiles(
+	watcher watcher.Watcher,
+	logger *observability.CoreLogger,
+	outChan chan *service.Record,
+) *LogFiles {
+	return &LogFiles{
+		watcher: watcher,
+		logger:  logger,
+		outChan: outChan,
+	}
+}
+
+// Tail starts streaming lines appended to the file at the path.
+//
+// Records for the file's existing lines are returned rather than sent
+// to the output channel, since the caller may be the one draining it.
+// The file must exist.
+func (lf *LogFiles) Tail(path string) ([]*service.Record, error) {
+	tailer := filetail.New(path, lf.logger, func(lines []string) {
+		for _, line := range lines {
+			lf.outChan <- logLineRecord(line)
+		}
+	})
+
+	lines, err := tailer.Start(lf.watcher)
+	if err != nil {
+		return nil, err
+	}
+	lf.tailers = append(lf.tailers, tailer)
+
+	records := make([]*service.Record, 0, len(lines))
+	for _, line := range lines {
+		records = append(records, logLineRecord(line))
+	}
+	return records, nil
+}
+
+// Finish stops following the files and returns records for any lines not
+// yet sent.
+func (lf *LogFiles) Finish() []*service.Record {
+	var records []*service.Record
+	for _, tailer := range lf.tailers {
+		for _, line := range tailer.Finish() {
+			records = append(records, logLineRecord(line))
+		}
+	}
+	lf.tailers = nil
+	return records
+}
+
+// logLineRecord returns an OutputRaw record for a line from a log file.
+func logLineRecord(line string) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_OutputRaw{
+			OutputRaw: &service.OutputRawRecord{
+				OutputType: service.OutputRawRecord_STDOUT,
+				Line:       line,
+				Timestamp:  timestamppb.New(time.Now()),
+			},
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/watchertest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestLogFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "train.log")
+	require.NoError(t, os.WriteFile(path, []byte("epoch 1\n"), 0o644))
+	outChan := make(chan *service.Record, 10)
+	w := watchertest.NewFakeWatcher()
+	logFiles := server.NewLogFiles(w, observability.NewNoOpLogger(), outChan)
+
+	existing, err := logFiles.Tail(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte("epoch 1\nepoch 2\nepo"), 0o644))
+	w.OnChange(path)
+	remaining := logFiles.Finish()
+
+	require.Len(t, existing, 1)
+	assert.Equal(t, "epoch 1", existing[0].GetOutputRaw().GetLine())
+	require.Len(t, outChan, 1)
+	assert.Equal(t, "epoch 2", (<-outChan).GetOutputRaw().GetLine())
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "epo", remaining[0].GetOutputRaw().GetLine())
+	assert.Equal(t,
+		service.OutputRawRecord_STDOUT,
+		remaining[0].GetOutputRaw().GetOutputType())
+}
+
+func TestLogFiles_MissingFile(t *testing.T) {
+	logFiles := server.NewLogFiles(
+		watchertest.NewFakeWatcher(),
+		observability.NewNoOpLogger(),
+		make(chan *service.Record, 10),
+	)
+
+	_, err := logFiles.Tail(filepath.Join(t.TempDir(), "missing.log"))
+
+	assert.Error(t, err)
+}
+
+func TestLogFiles_MoreExistingLinesThanBuffer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "train.log")
+	content := strings.Repeat("epoch\n", 100)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	// Nothing drains the channel, like when Tail is called by the
+	// handler that reads from it.
+	outChan := make(chan *service.Record, 1)
+	logFiles := server.NewLogFiles(
+		watchertest.NewFakeWatcher(),
+		observability.NewNoOpLogger(),
+		outChan,
+	)
+
+	tailed := make(chan []*service.Record)
+	go func() {
+		existing, err := logFiles.Tail(path)
+		assert.NoError(t, err)
+		tailed <- existing
+	}()
+
+	select {
+	case existing := <-tailed:
+		assert.Len(t, existing, 100)
+		assert.Empty(t, outChan)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Tail blocked on the output channel")
+	}
+}
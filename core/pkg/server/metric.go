@@ -2,9 +2,13 @@ package server
 
 import (
 	"errors"
+	"math"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/wandb/wandb/core/internal/corelib"
+	"github.com/wandb/wandb/core/internal/runsummary"
 	"github.com/wandb/wandb/core/pkg/service"
 	"google.golang.org/protobuf/proto"
 )
@@ -12,13 +16,315 @@ import (
 type MetricHandler struct {
 	definedMetrics map[string]*service.MetricRecord
 	globMetrics    map[string]*service.MetricRecord
+
+	// stepMetrics maps each metric used as a custom x-axis to whether
+	// it has been logged.
+	stepMetrics map[string]bool
+
+	// warnedMissingStepMetric is the set of metrics that were logged
+	// before their step metric, to avoid repeating the warning.
+	warnedMissingStepMetric map[string]struct{}
+
+	// bestValues are the optimal values of metrics with a "best" summary.
+	bestValues map[string]bestValue
+
+	// stats are the running statistics of metrics with a "min", "max" or
+	// "mean" summary.
+	stats map[string]*metricStats
+
+	// histograms are the distributions of the logged values of metrics
+	// with a histogram summary.
+	histograms map[string]*runsummary.Histogram
+}
+
+// bestValue is the optimal value of a metric and the step it was logged at.
+type bestValue struct {
+	value     float64
+	valueJSON string
+	step      int64
+}
+
+// metricStats are the running statistics of a metric's logged values.
+type metricStats struct {
+	// min and max are the smallest and largest values, ignoring NaN,
+	// and minJSON and maxJSON are the values as they were logged.
+	min, max         float64
+	minJSON, maxJSON string
+	hasMinMax        bool
+
+	// sum and count are the sum and number of the finite values.
+	sum   float64
+	count int64
+
+	// lastJSON is the most recently logged value.
+	lastJSON string
+}
+
+// aggregatedItem is a summary item and how it was computed from history.
+type aggregatedItem struct {
+	item        *service.SummaryItem
+	aggregation runsummary.Aggregation
 }
 
 func NewMetricHandler() *MetricHandler {
 	return &MetricHandler{
-		definedMetrics: make(map[string]*service.MetricRecord),
-		globMetrics:    make(map[string]*service.MetricRecord),
+		definedMetrics:          make(map[string]*service.MetricRecord),
+		globMetrics:             make(map[string]*service.MetricRecord),
+		stepMetrics:             make(map[string]bool),
+		warnedMissingStepMetric: make(map[string]struct{}),
+		bestValues:              make(map[string]bestValue),
+		stats:                   make(map[string]*metricStats),
+		histograms:              make(map[string]*runsummary.Histogram),
+	}
+}
+
+// bestSummary returns the summary items for a history item of a metric
+// with a "best" summary.
+//
+// The items are the best value so far under the metric's key and the step
+// where it was logged under the key with a ".step" suffix. Ties keep the
+// earliest value, and NaN is never the best value. If the metric has no
+// "best" summary and goal, or the value is not a number, it returns false
+// and the value should be summarized as usual.
+func (mh *MetricHandler) bestSummary(
+	item *service.HistoryItem,
+	step int64,
+) ([]*service.SummaryItem, bool) {
+	if mh == nil || item.GetKey() == "" {
+		return nil, false
+	}
+
+	metric := mh.definedMetrics[item.GetKey()]
+	goal := metric.GetGoal()
+	if !metric.GetSummary().GetBest() || goal == service.MetricRecord_GOAL_UNSET {
+		return nil, false
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(item.GetValueJson()), 64)
+	if err != nil {
+		return nil, false
+	}
+
+	best, ok := mh.bestValues[item.GetKey()]
+	if !math.IsNaN(value) && (!ok ||
+		goal == service.MetricRecord_GOAL_MINIMIZE && value < best.value ||
+		goal == service.MetricRecord_GOAL_MAXIMIZE && value > best.value) {
+		best = bestValue{value: value, valueJSON: item.GetValueJson(), step: step}
+		mh.bestValues[item.GetKey()] = best
+		ok = true
 	}
+
+	if !ok {
+		return nil, true
+	}
+	return []*service.SummaryItem{
+		{Key: item.GetKey(), ValueJson: best.valueJSON},
+		{Key: item.GetKey() + ".step", ValueJson: strconv.FormatInt(best.step, 10)},
+	}, true
+}
+
+// statsSummary returns the summary items for a history item of a metric
+// with a "min", "max", "mean" or histogram summary.
+//
+// Each statistic is nested under the metric's key, like {"loss": {"min":
+// 0.1, "mean": 0.4}}, along with the last value if the metric also has a
+// "last" summary or only a histogram summary. NaN is ignored, and infinite
+// values don't count toward the mean. If the metric has none of these
+// summaries or the value is not a number, it returns false and the value
+// should be summarized as usual.
+func (mh *MetricHandler) statsSummary(
+	item *service.HistoryItem,
+) ([]aggregatedItem, bool) {
+	if mh == nil || item.GetKey() == "" {
+		return nil, false
+	}
+
+	summary := mh.definedMetrics[item.GetKey()].GetSummary()
+	hasStats := summary.GetMin() || summary.GetMax() || summary.GetMean()
+	if !hasStats && summary.GetHistogramBins() <= 0 {
+		return nil, false
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(item.GetValueJson()), 64)
+	if err != nil {
+		return nil, false
+	}
+
+	stats, ok := mh.stats[item.GetKey()]
+	if !ok {
+		stats = &metricStats{}
+		mh.stats[item.GetKey()] = stats
+	}
+	stats.lastJSON = item.GetValueJson()
+	if !math.IsNaN(value) {
+		if !stats.hasMinMax || value < stats.min {
+			stats.min, stats.minJSON = value, item.GetValueJson()
+		}
+		if !stats.hasMinMax || value > stats.max {
+			stats.max, stats.maxJSON = value, item.GetValueJson()
+		}
+		stats.hasMinMax = true
+	}
+	if !math.IsNaN(value) && !math.IsInf(value, 0) {
+		stats.sum += value
+		stats.count++
+	}
+
+	var items []aggregatedItem
+	add := func(name, valueJSON string, aggregation runsummary.Aggregation) {
+		items = append(items, aggregatedItem{
+			item: &service.SummaryItem{
+				NestedKey: []string{item.GetKey(), name},
+				ValueJson: valueJSON,
+			},
+			aggregation: aggregation,
+		})
+	}
+	if summary.GetMin() && stats.hasMinMax {
+		add("min", stats.minJSON,
+			runsummary.Aggregation{Kind: runsummary.AggregationMin})
+	}
+	if summary.GetMax() && stats.hasMinMax {
+		add("max", stats.maxJSON,
+			runsummary.Aggregation{Kind: runsummary.AggregationMax})
+	}
+	if summary.GetMean() && stats.count > 0 {
+		mean := stats.sum / float64(stats.count)
+		add("mean", strconv.FormatFloat(mean, 'g', -1, 64),
+			runsummary.Aggregation{
+				Kind:  runsummary.AggregationMean,
+				Count: stats.count,
+			})
+	}
+	if summary.GetLast() || !hasStats {
+		add("last", stats.lastJSON,
+			runsummary.Aggregation{Kind: runsummary.AggregationLast})
+	}
+	return items, true
+}
+
+// bestAggregation returns how the "best" summary of the key's metric is
+// computed from its history.
+func (mh *MetricHandler) bestAggregation(key string) runsummary.AggregationKind {
+	if mh.definedMetrics[key].GetGoal() == service.MetricRecord_GOAL_MAXIMIZE {
+		return runsummary.AggregationMax
+	}
+	return runsummary.AggregationMin
+}
+
+// arraySummary returns how array values of the key's metric are
+// summarized.
+func (mh *MetricHandler) arraySummary(key string) service.MetricSummary_ArrayMode {
+	if mh == nil || key == "" {
+		return service.MetricSummary_ARRAY_STATS
+	}
+	return mh.definedMetrics[key].GetSummary().GetArray()
+}
+
+// addToHistogram adds a history item to its metric's histogram, if the
+// metric has a histogram summary and the value is a number.
+//
+// Metrics with a "best" summary have no histogram, since their best value
+// takes the metric's key in the summary.
+func (mh *MetricHandler) addToHistogram(item *service.HistoryItem) {
+	if mh == nil || item.GetKey() == "" {
+		return
+	}
+
+	summary := mh.definedMetrics[item.GetKey()].GetSummary()
+	if summary.GetHistogramBins() <= 0 || summary.GetBest() {
+		return
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(item.GetValueJson()), 64)
+	if err != nil {
+		return
+	}
+
+	histogram, ok := mh.histograms[item.GetKey()]
+	if !ok {
+		histogram = &runsummary.Histogram{}
+		mh.histograms[item.GetKey()] = histogram
+	}
+	histogram.Add(value)
+}
+
+// histogramSummary returns the summary items for the histograms of all
+// metrics with a histogram summary that were logged at least once.
+//
+// Each histogram is nested under its metric's key, like {"loss":
+// {"histogram": ...}}, next to the metric's other summary values.
+func (mh *MetricHandler) histogramSummary() []*service.SummaryItem {
+	if mh == nil {
+		return nil
+	}
+
+	items := make([]*service.SummaryItem, 0, len(mh.histograms))
+	for key, histogram := range mh.histograms {
+		numBins := mh.definedMetrics[key].GetSummary().GetHistogramBins()
+		valueJSON, ok := histogram.SummaryJSON(int(numBins))
+		if !ok {
+			continue
+		}
+		items = append(items, &service.SummaryItem{
+			NestedKey: []string{key, "histogram"},
+			ValueJson: valueJSON,
+		})
+	}
+	return items
+}
+
+// hasExplicitSummary reports whether the key's metric was defined with a
+// summary mode other than "none".
+func (mh *MetricHandler) hasExplicitSummary(key string) bool {
+	if mh == nil || key == "" {
+		return false
+	}
+
+	summary := mh.definedMetrics[key].GetSummary()
+	if summary.GetNone() {
+		return false
+	}
+	return summary.GetMin() || summary.GetMax() || summary.GetMean() ||
+		summary.GetBest() || summary.GetLast() || summary.GetCopy()
+}
+
+// trackStepMetric records that the key is used as a custom x-axis.
+func (mh *MetricHandler) trackStepMetric(key string) {
+	if key == "" {
+		return
+	}
+
+	if _, ok := mh.stepMetrics[key]; !ok {
+		mh.stepMetrics[key] = false
+	}
+}
+
+// markStepMetricsLogged records which step metrics appear in a history
+// record.
+func (mh *MetricHandler) markStepMetricsLogged(items []*service.HistoryItem) {
+	for _, item := range items {
+		if _, ok := mh.stepMetrics[item.GetKey()]; ok {
+			mh.stepMetrics[item.GetKey()] = true
+		}
+	}
+}
+
+// wasStepMetricLogged reports whether the step metric was logged in the
+// current or a previous history record.
+func (mh *MetricHandler) wasStepMetricLogged(key string) bool {
+	return mh.stepMetrics[key]
+}
+
+// shouldWarnMissingStepMetric returns true the first time it's called for
+// a metric.
+func (mh *MetricHandler) shouldWarnMissingStepMetric(name string) bool {
+	if _, warned := mh.warnedMissingStepMetric[name]; warned {
+		return false
+	}
+
+	mh.warnedMissingStepMetric[name] = struct{}{}
+	return true
 }
 
 // addMetric adds a metric to the target map. If the metric already exists, it will be merged
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/wandb/wandb/core/internal/droppeddata"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// PostFinishPolicy is what the handler does with data logged after the
+// run finished.
+type PostFinishPolicy string
+
+const (
+	// PostFinishError rejects the data, responding with an error if the
+	// client waits for a result.
+	PostFinishError PostFinishPolicy = "error"
+
+	// PostFinishWarn keeps the data in the transaction log without
+	// uploading it.
+	PostFinishWarn PostFinishPolicy = "warn"
+)
+
+// ParsePostFinishPolicy returns the policy named by the setting.
+//
+// An empty name is the default policy.
+func ParsePostFinishPolicy(name string) (PostFinishPolicy, error) {
+	switch PostFinishPolicy(name) {
+	case "", PostFinishError:
+		return PostFinishError, nil
+	case PostFinishWarn:
+		return PostFinishWarn, nil
+	default:
+		return PostFinishError, fmt.Errorf(
+			"server: unknown post-finish policy %q", name)
+	}
+}
+
+// isLoggedData reports whether the record is history, summary or config
+// data logged by the user.
+func isLoggedData(record *service.Record) bool {
+	switch x := record.RecordType.(type) {
+	case *service.Record_History,
+		*service.Record_Summary,
+		*service.Record_Config:
+		return true
+	case *service.Record_Request:
+		switch x.Request.RequestType.(type) {
+		case *service.Request_PartialHistory,
+			*service.Request_BatchHistory:
+			return true
+		}
+	}
+	return false
+}
+
+// handlePostFinish applies the post-finish policy to data logged after
+// the run's exit record.
+//
+// It returns true if the record was handled and must not be processed
+// further. Logging after finishing is a common mistake, so the user is
+// warned the first time it happens regardless of the policy.
+func (h *Handler) handlePostFinish(record *service.Record) bool {
+	if !h.finished || !isLoggedData(record) {
+		return false
+	}
+
+	h.warnPostFinish()
+
+	switch h.postFinishPolicy {
+	case PostFinishWarn:
+		h.fwdRecordWithControl(record,
+			func(control *service.Control) {
+				control.PersistOnly = true
+			},
+		)
+	default:
+		h.droppedData.Record(droppeddata.LoggedAfterFinish, loggedDataKind(record))
+		h.rejectPostFinish(record)
+	}
+
+	return true
+}
+
+func (h *Handler) warnPostFinish() {
+	if h.warnedPostFinish {
+		return
+	}
+	h.warnedPostFinish = true
+
+	var outcome string
+	switch h.postFinishPolicy {
+	case PostFinishWarn:
+		outcome = "kept in the run's transaction log but not uploaded"
+	default:
+		outcome = "dropped"
+	}
+
+	h.logger.Warn("handler: data logged after the run finished",
+		"policy", string(h.postFinishPolicy))
+	h.terminalPrinter.Write(fmt.Sprintf(
+		"Data was logged after the run finished and is %s."+
+			" Log all data before calling finish().",
+		outcome,
+	))
+}
+
+// rejectPostFinish responds with an error to a rejected record, if the
+// client is waiting for a result.
+func (h *Handler) rejectPostFinish(record *service.Record) {
+	if !record.GetControl().GetReqResp() && record.GetControl().GetMailboxSlot() == "" {
+		return
+	}
+
+	errorInfo := &service.ErrorInfo{
+		Message: "cannot log data after the run finished",
+		Code:    service.ErrorInfo_USAGE,
+	}
+
+	result := &service.Result{Control: record.Control, Uuid: record.Uuid}
+	switch record.RecordType.(type) {
+	case *service.Record_History:
+		result.ResultType = &service.Result_LogResult{
+			LogResult: &service.HistoryResult{Error: errorInfo},
+		}
+	case *service.Record_Summary:
+		result.ResultType = &service.Result_SummaryResult{
+			SummaryResult: &service.SummaryResult{Error: errorInfo},
+		}
+	case *service.Record_Config:
+		result.ResultType = &service.Result_ConfigResult{
+			ConfigResult: &service.ConfigResult{Error: errorInfo},
+		}
+	default:
+		return
+	}
+	h.outChan <- result
+}
+
+// loggedDataKind names the kind of logged data for the dropped data report.
+func loggedDataKind(record *service.Record) string {
+	switch record.RecordType.(type) {
+	case *service.Record_Summary:
+		return "summary"
+	case *service.Record_Config:
+		return "config"
+	default:
+		return "history"
+	}
+}
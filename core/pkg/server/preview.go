@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// RunPreview is a snapshot of a run read from its transaction log.
+//
+// It is served over HTTP by its Handler so that users can inspect an
+// offline run before syncing it. Nothing is sent to the backend.
+type RunPreview struct {
+	// path is the transaction log the preview was read from.
+	path string
+
+	run     *service.RunRecord
+	config  json.RawMessage
+	summary json.RawMessage
+	history []json.RawMessage
+}
+
+// LoadRunPreview replays the `.wandb` file at path.
+func LoadRunPreview(
+	ctx context.Context,
+	path string,
+	logger *observability.CoreLogger,
+) (*RunPreview, error) {
+	store := NewStore(ctx, path, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, fmt.Errorf("preview: failed to open %s: %v", path, err)
+	}
+	defer store.Close()
+
+	preview := &RunPreview{path: path}
+	config := runconfig.New()
+	summary := runsummary.New()
+	onError := func(err error) {
+		logger.CaptureError("preview: failed to apply record", err)
+	}
+
+	for {
+		record, err := store.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("preview: failed to read %s: %v", path, err)
+		}
+
+		switch x := record.RecordType.(type) {
+		case *service.Record_Run:
+			if preview.run == nil {
+				preview.run = x.Run
+			}
+			if x.Run.GetConfig() != nil {
+				config.ApplyChangeRecord(x.Run.GetConfig(), onError)
+			}
+		case *service.Record_Config:
+			config.ApplyChangeRecord(x.Config, onError)
+		case *service.Record_Summary:
+			summary.ApplyChangeRecord(x.Summary, onError)
+		case *service.Record_History:
+			row := runhistory.New()
+			row.ApplyChangeRecord(x.History.GetItem(), onError)
+			data, err := row.Serialize()
+			if err != nil {
+				onError(err)
+				continue
+			}
+			preview.history = append(preview.history, data)
+		}
+	}
+
+	if preview.run == nil {
+		return nil, fmt.Errorf("preview: no run record in %s", path)
+	}
+
+	var err error
+	if preview.config, err = config.Serialize(runconfig.FormatJson); err != nil {
+		return nil, fmt.Errorf("preview: failed to serialize config: %v", err)
+	}
+	if preview.summary, err = summary.Serialize(); err != nil {
+		return nil, fmt.Errorf("preview: failed to serialize summary: %v", err)
+	}
+
+	return preview, nil
+}
+
+// Handler returns a read-only HTTP handler for the preview.
+//
+// It serves an HTML overview at "/" and JSON at "/api/run", "/api/config",
+// "/api/summary" and "/api/history".
+func (p *RunPreview) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", p.serveIndex)
+	mux.HandleFunc("GET /api/run", p.serveRun)
+	mux.HandleFunc("GET /api/config", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, p.config)
+	})
+	mux.HandleFunc("GET /api/summary", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, p.summary)
+	})
+	mux.HandleFunc("GET /api/history", p.serveHistory)
+	return mux
+}
+
+func (p *RunPreview) serveRun(w http.ResponseWriter, _ *http.Request) {
+	data, err := protojson.Marshal(p.run)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, data)
+}
+
+func (p *RunPreview) serveHistory(w http.ResponseWriter, _ *http.Request) {
+	rows := p.history
+	if rows == nil {
+		rows = []json.RawMessage{}
+	}
+
+	data, err := json.Marshal(rows)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, data)
+}
+
+var previewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head><title>{{.RunID}} - W&amp;B run preview</title></head>
+<body>
+<h1>Run {{.RunID}}</h1>
+<p>Read from <code>{{.Path}}</code>. This run has not been synced by this preview.</p>
+<table>
+<tr><th>Entity</th><td>{{.Entity}}</td></tr>
+<tr><th>Project</th><td>{{.Project}}</td></tr>
+<tr><th>Name</th><td>{{.DisplayName}}</td></tr>
+<tr><th>History rows</th><td><a href="api/history">{{.HistoryRows}}</a></td></tr>
+</table>
+<h2><a href="api/config">Config</a></h2>
+<pre>{{.Config}}</pre>
+<h2><a href="api/summary">Summary</a></h2>
+<pre>{{.Summary}}</pre>
+</body>
+</html>
+`))
+
+func (p *RunPreview) serveIndex(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	err := previewTemplate.Execute(w, struct {
+		Path        string
+		RunID       string
+		Entity      string
+		Project     string
+		DisplayName string
+		HistoryRows int
+		Config      string
+		Summary     string
+	}{
+		Path:        p.path,
+		RunID:       p.run.GetRunId(),
+		Entity:      p.run.GetEntity(),
+		Project:     p.run.GetProject(),
+		DisplayName: p.run.GetDisplayName(),
+		HistoryRows: len(p.history),
+		Config:      indentJSON(p.config),
+		Summary:     indentJSON(p.summary),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, data []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// indentJSON pretty-prints JSON for display, or returns it unchanged if
+// it's invalid.
+func indentJSON(data []byte) string {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return string(data)
+	}
+
+	indented, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return string(data)
+	}
+	return string(indented)
+}
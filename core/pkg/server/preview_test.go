@@ -0,0 +1,100 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func get(t *testing.T, handler http.Handler, path string) (int, string) {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	body, err := io.ReadAll(recorder.Result().Body)
+	require.NoError(t, err)
+	return recorder.Code, string(body)
+}
+
+func TestRunPreview(t *testing.T) {
+	path := writeTransactionLog(t,
+		&service.Record{RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "abc123", Project: "my-project"},
+		}},
+		&service.Record{RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{Update: []*service.ConfigItem{
+				{Key: "lr", ValueJson: "0.01"},
+			}},
+		}},
+		&service.Record{RecordType: &service.Record_History{
+			History: &service.HistoryRecord{Item: []*service.HistoryItem{
+				{Key: "loss", ValueJson: "1.5"},
+			}},
+		}},
+		&service.Record{RecordType: &service.Record_History{
+			History: &service.HistoryRecord{Item: []*service.HistoryItem{
+				{Key: "loss", ValueJson: "0.5"},
+			}},
+		}},
+		&service.Record{RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{Update: []*service.SummaryItem{
+				{Key: "loss", ValueJson: "0.5"},
+			}},
+		}},
+	)
+
+	preview, err := server.LoadRunPreview(
+		context.Background(), path, observability.NewNoOpLogger())
+	require.NoError(t, err)
+	handler := preview.Handler()
+
+	code, body := get(t, handler, "/api/config")
+	assert.Equal(t, http.StatusOK, code)
+	assert.JSONEq(t, `{"lr": {"value": 0.01}}`, body)
+
+	_, body = get(t, handler, "/api/summary")
+	assert.JSONEq(t, `{"loss": 0.5}`, body)
+
+	_, body = get(t, handler, "/api/history")
+	assert.JSONEq(t, `[{"loss": 1.5}, {"loss": 0.5}]`, body)
+
+	_, body = get(t, handler, "/api/run")
+	assert.Contains(t, body, "abc123")
+
+	_, body = get(t, handler, "/")
+	assert.Contains(t, body, "Run abc123")
+	assert.Contains(t, body, "my-project")
+}
+
+func TestRunPreview_ReadOnly(t *testing.T) {
+	path := writeTransactionLog(t,
+		&service.Record{RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "abc123"},
+		}},
+	)
+	preview, err := server.LoadRunPreview(
+		context.Background(), path, observability.NewNoOpLogger())
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	preview.Handler().ServeHTTP(recorder,
+		httptest.NewRequest(http.MethodPost, "/api/config", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestRunPreview_NoRun(t *testing.T) {
+	path := writeTransactionLog(t)
+
+	_, err := server.LoadRunPreview(
+		context.Background(), path, observability.NewNoOpLogger())
+
+	assert.ErrorContains(t, err, "no run record")
+}
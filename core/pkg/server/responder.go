@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -17,12 +18,19 @@ type ResponderEntry struct {
 }
 
 type Dispatcher struct {
+	// mu guards responders, which may be added while results are being
+	// dispatched
+	mu sync.Mutex
+
 	responders map[string]Responder
 	logger     *observability.CoreLogger
 }
 
 // AddResponders adds the given responders to the stream's dispatcher.
 func (d *Dispatcher) AddResponders(entries ...ResponderEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	if d.responders == nil {
 		d.responders = make(map[string]Responder)
 	}
@@ -48,7 +56,10 @@ func (d *Dispatcher) handleRespond(result *service.Result) {
 			ResultCommunicate: result,
 		},
 	}
-	if responder, ok := d.responders[responderId]; ok {
+	d.mu.Lock()
+	responder, ok := d.responders[responderId]
+	d.mu.Unlock()
+	if ok {
 		responder.Respond(response)
 	} else {
 		err := fmt.Errorf("dispatch: no responder found: %s", responderId)
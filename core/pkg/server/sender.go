@@ -2,27 +2,34 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/capabilities"
 	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/internal/debounce"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/preflight"
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runresume"
 	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/summaryenv"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/internal/watcher"
 	"github.com/wandb/wandb/core/pkg/artifacts"
@@ -36,24 +43,37 @@ import (
 const (
 	configDebouncerRateLimit  = 1 / 30.0 // todo: audit rate limit
 	configDebouncerBurstSize  = 1        // todo: audit burst size
-	summaryDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
 	summaryDebouncerBurstSize = 1        // todo: audit burst size
 )
 
+// runStateKilled is the backend state of a run aborted by the user.
+const runStateKilled = "killed"
+
+// preflightTimeout bounds how long preflight checks retry before reporting
+// that the backend can't be reached.
+const preflightTimeout = 30 * time.Second
+
 type SenderParams struct {
 	Logger              *observability.CoreLogger
 	Settings            *service.Settings
 	Backend             *api.Backend
 	FileStream          fs.FileStream
 	FileTransferManager filetransfer.FileTransferManager
+	FileTransferStats   filetransfer.FileTransferStats
+	SyncCheckpoint      *SyncCheckpoint
 	FileWatcher         watcher.Watcher
 	RunfilesUploader    runfiles.Uploader
 	GraphqlClient       graphql.Client
 	Peeker              *observability.Peeker
 	RunSummary          *runsummary.RunSummary
 	Mailbox             *mailbox.Mailbox
+	FlushBarriers       *FlushBarriers
+	AnonymousAuth       *AnonymousAuth
+	TerminalPrinter     *observability.Printer
+	Staging             *artifacts.StagingArea
 	OutChan             chan *service.Result
 	FwdChan             chan *service.Record
+	Capabilities        *capabilities.Capabilities
 }
 
 // Sender is the sender for a stream it handles the incoming messages and sends to the server
@@ -83,6 +103,10 @@ type Sender struct {
 	// fileStream is the file stream
 	fileStream fs.FileStream
 
+	// isFileStreamStarted is whether the run started, after which the
+	// file stream sends data
+	isFileStreamStarted bool
+
 	// fileTransferManager is the file uploader/downloader
 	fileTransferManager filetransfer.FileTransferManager
 
@@ -92,6 +116,10 @@ type Sender struct {
 	// runfilesUploader manages uploading a run's files
 	runfilesUploader runfiles.Uploader
 
+	// dirArtifacts are artifacts to commit from watched directories when
+	// the run finishes
+	dirArtifacts []*artifacts.DirArtifact
+
 	// RunRecord is the run record
 	// TODO: remove this and use properly updated settings
 	//       + a flag indicating whether the run has started
@@ -127,6 +155,13 @@ type Sender struct {
 	// syncService is the sync service syncing offline runs
 	syncService *SyncService
 
+	// fileTransferStats tracks uploads, for reporting sync progress
+	fileTransferStats filetransfer.FileTransferStats
+
+	// syncCheckpoint is the progress of an interrupted sync, or nil if
+	// not syncing
+	syncCheckpoint *SyncCheckpoint
+
 	// store is the store where the transaction log is stored
 	store *Store
 
@@ -142,6 +177,22 @@ type Sender struct {
 
 	// mailbox is used to store cancel functions for each mailbox slot
 	mailbox *mailbox.Mailbox
+
+	// flushBarriers is signaled when FlushRequests are acknowledged
+	flushBarriers *FlushBarriers
+
+	// anonymousAuth is the anonymous identity, or nil if not anonymous
+	anonymousAuth *AnonymousAuth
+
+	// terminalPrinter gathers terminal messages to send back to the user process
+	terminalPrinter *observability.Printer
+
+	// capabilities are the backend's optional features, detected when
+	// the stream starts
+	capabilities *capabilities.Capabilities
+
+	// staging holds files staged by core, released after they're uploaded
+	staging *artifacts.StagingArea
 }
 
 // NewSender creates a new Sender with the given settings
@@ -161,21 +212,28 @@ func NewSender(
 		settings:            params.Settings,
 		fileStream:          params.FileStream,
 		fileTransferManager: params.FileTransferManager,
+		fileTransferStats:   params.FileTransferStats,
+		syncCheckpoint:      params.SyncCheckpoint,
 		fileWatcher:         params.FileWatcher,
 		runfilesUploader:    params.RunfilesUploader,
 		networkPeeker:       params.Peeker,
 		graphqlClient:       params.GraphqlClient,
 		mailbox:             params.Mailbox,
+		flushBarriers:       params.FlushBarriers,
+		anonymousAuth:       params.AnonymousAuth,
+		staging:             params.Staging,
+		terminalPrinter:     params.TerminalPrinter,
 		runSummary:          params.RunSummary,
 		outChan:             params.OutChan,
 		fwdChan:             params.FwdChan,
+		capabilities:        params.Capabilities,
 		configDebouncer: debounce.NewDebouncer(
 			configDebouncerRateLimit,
 			configDebouncerBurstSize,
 			params.Logger,
 		),
 		summaryDebouncer: debounce.NewDebouncer(
-			summaryDebouncerRateLimit,
+			summaryUpdateRate(settings.From(params.Settings)),
 			summaryDebouncerBurstSize,
 			params.Logger,
 		),
@@ -189,6 +247,30 @@ func NewSender(
 	return s
 }
 
+// artifactDigestAlgorithm returns the configured algorithm for artifact
+// file digests, or MD5 if the setting is invalid.
+func artifactDigestAlgorithm(
+	settings *service.Settings,
+	logger *observability.CoreLogger,
+) artifacts.DigestAlgorithm {
+	algorithm, err := artifacts.ParseDigestAlgorithm(
+		settings.GetXArtifactDigestAlgorithm().GetValue())
+	if err != nil {
+		logger.Warn("using MD5 for artifact digests", "error", err)
+		return artifacts.DigestMD5
+	}
+	return algorithm
+}
+
+// summaryUpdateRate is how often summary updates may be streamed.
+func summaryUpdateRate(settings *settings.Settings) rate.Limit {
+	interval := settings.GetSummaryUpdateInterval()
+	if interval <= 0 {
+		return rate.Inf
+	}
+	return rate.Every(interval)
+}
+
 // do sending of messages to the server
 func (s *Sender) Do(inChan <-chan *service.Record) {
 	defer s.logger.Reraise()
@@ -319,6 +401,8 @@ func (s *Sender) sendRecord(record *service.Record) {
 		s.sendTelemetry(record, x.Telemetry)
 	case *service.Record_Preempting:
 		s.sendPreempting(x.Preempting)
+	case *service.Record_Notes:
+		s.sendNotes(x.Notes)
 	case *service.Record_Request:
 		s.sendRequest(record, x.Request)
 	case *service.Record_LinkArtifact:
@@ -347,8 +431,12 @@ func (s *Sender) sendRequest(record *service.Record, request *service.Request) {
 		s.sendRequestDefer(x.Defer)
 	case *service.Request_LogArtifact:
 		s.sendRequestLogArtifact(record, x.LogArtifact)
+	case *service.Request_LogArtifactDir:
+		s.sendRequestLogArtifactDir(record, x.LogArtifactDir)
 	case *service.Request_ServerInfo:
 		s.sendRequestServerInfo(record, x.ServerInfo)
+	case *service.Request_Preflight:
+		s.sendRequestPreflight(record, x.Preflight)
 	case *service.Request_DownloadArtifact:
 		s.sendRequestDownloadArtifact(record, x.DownloadArtifact)
 	case *service.Request_Sync:
@@ -359,6 +447,10 @@ func (s *Sender) sendRequest(record *service.Record, request *service.Request) {
 		s.sendRequestStopStatus(record, x.StopStatus)
 	case *service.Request_JobInput:
 		s.sendRequestJobInput(x.JobInput)
+	case *service.Request_Flush:
+		s.sendRequestFlush(x.Flush)
+	case *service.Request_GetConfig:
+		s.sendRequestGetConfig(record, x.GetConfig)
 	case nil:
 		err := fmt.Errorf("sender: sendRequest: nil RequestType")
 		s.logger.CaptureFatalAndPanic("sender: sendRequest: nil RequestType", err)
@@ -368,6 +460,28 @@ func (s *Sender) sendRequest(record *service.Record, request *service.Request) {
 	}
 }
 
+// sendRequestFlush sends pending updates and signals the FlushRequest once
+// the filestream API acknowledges everything before it.
+func (s *Sender) sendRequestFlush(request *service.FlushRequest) {
+	// When offline, records are never sent.
+	if s.fileStream == nil {
+		return
+	}
+
+	// Before the run starts, there is nowhere to send records to.
+	if !s.isFileStreamStarted {
+		s.flushBarriers.MarkUnsendable(request)
+		return
+	}
+
+	s.configDebouncer.Flush(s.upsertConfig)
+	s.summaryDebouncer.Flush(s.streamSummary)
+
+	s.fileStream.StreamUpdate(&fs.FlushUpdate{
+		OnSent: func() { s.flushBarriers.MarkSent(request) },
+	})
+}
+
 // updateSettings updates the settings from the run record upon a run start
 // with the information from the server
 func (s *Sender) updateSettings() {
@@ -403,6 +517,7 @@ func (s *Sender) sendRequestRunStart(_ *service.RunStartRequest) {
 			s.RunRecord.GetRunId(),
 			s.resumeState.GetFileStreamOffset(),
 		)
+		s.isFileStreamStarted = true
 	}
 
 	if s.fileTransferManager != nil {
@@ -482,6 +597,7 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 	case service.DeferRequest_FLUSH_SUM:
 		s.summaryDebouncer.Flush(s.streamSummary)
 		s.uploadSummaryFile()
+		s.exportSummaryEnv()
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_DEBOUNCER:
@@ -499,6 +615,7 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_DIR:
+		s.saveDirArtifacts()
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_FP:
@@ -567,6 +684,17 @@ func (s *Sender) sendPreempting(record *service.RunPreemptingRecord) {
 	s.fileStream.StreamUpdate(&fs.PreemptingUpdate{Record: record})
 }
 
+// sendNotes sets the run's notes on the backend.
+func (s *Sender) sendNotes(record *service.RunNotesRecord) {
+	if s.RunRecord == nil {
+		s.logger.CaptureWarn("sender: sendNotes: run not started, ignoring notes")
+		return
+	}
+
+	s.RunRecord.Notes = record.GetNotes()
+	s.updateRun(&s.RunRecord.Notes, nil)
+}
+
 func (s *Sender) sendLinkArtifact(record *service.Record) {
 	linker := artifacts.ArtifactLinker{
 		Ctx:           s.ctx,
@@ -665,7 +793,7 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		//
 		// Logically, it would make more sense to instead start with the
 		// resumed config and apply updates on top of it.
-		s.runConfig.ApplyChangeRecord(run.Config,
+		s.runConfig.ApplyChangeRecord(s.offloadFileBackedConfig(run.Config),
 			func(err error) {
 				s.logger.CaptureError("Error updating run config", err)
 			})
@@ -673,6 +801,17 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		proto.Merge(s.telemetry, run.Telemetry)
 		s.updateConfigPrivate()
 
+		if s.RunRecord == nil && s.settings.GetXPreflight().GetValue() {
+			if err := s.runPreflight(run.Entity, run.Project); err != nil {
+				s.logger.Error("sender: sendRun: preflight failed", "error", err)
+				if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
+					s.respond(record,
+						&service.RunUpdateResult{Error: preflightErrorInfo(err)})
+				}
+				return
+			}
+		}
+
 		if s.RunRecord == nil {
 			var ok bool
 			s.RunRecord, ok = proto.Clone(run).(*service.RunRecord)
@@ -772,6 +911,8 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		s.RunRecord.Project = project.GetName()
 		s.RunRecord.Entity = entity.GetName()
 		s.RunRecord.SweepId = utils.ZeroIfNil(bucket.GetSweepName())
+
+		s.printClaimURL()
 	}
 
 	if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
@@ -786,6 +927,29 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 	}
 }
 
+// printClaimURL tells the user how to claim an anonymous run.
+func (s *Sender) printClaimURL() {
+	if s.anonymousAuth == nil || s.terminalPrinter == nil {
+		return
+	}
+
+	claimURL := s.anonymousAuth.ClaimURL(
+		s.settings.GetBaseUrl().GetValue(),
+		s.RunRecord.Entity,
+		s.RunRecord.Project,
+		s.RunRecord.RunId,
+	)
+	if claimURL == "" {
+		return
+	}
+
+	s.terminalPrinter.Write(fmt.Sprintf(
+		"Logging anonymously. Do NOT share this link, it can be used to"+
+			" claim your run: %s",
+		claimURL,
+	))
+}
+
 // sendHistory sends a history record to the file stream,
 // which will then send it to the server
 func (s *Sender) sendHistory(record *service.HistoryRecord) {
@@ -872,13 +1036,63 @@ func (s *Sender) upsertConfig() {
 	}
 }
 
+// markRunKilled sets the run's state to "killed" on the backend.
+//
+// This distinguishes runs aborted by the user from runs that failed
+// or crashed.
+func (s *Sender) markRunKilled() {
+	state := runStateKilled
+	s.updateRun(nil, &state)
+}
+
+// updateRun sets the run's notes and state on the backend.
+//
+// Nil values are left unchanged.
+func (s *Sender) updateRun(notes *string, state *string) {
+	if s.graphqlClient == nil || s.RunRecord == nil {
+		return
+	}
+
+	ctx := context.WithValue(s.ctx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
+	_, err := gql.UpsertBucket(
+		ctx,                                  // ctx
+		s.graphqlClient,                      // client
+		nil,                                  // id
+		&s.RunRecord.RunId,                   // name
+		utils.NilIfZero(s.RunRecord.Project), // project
+		utils.NilIfZero(s.RunRecord.Entity),  // entity
+		nil,                                  // groupName
+		nil,                                  // description
+		nil,                                  // displayName
+		notes,                                // notes
+		nil,                                  // commit
+		nil,                                  // config
+		nil,                                  // host
+		nil,                                  // debug
+		nil,                                  // program
+		nil,                                  // repo
+		nil,                                  // jobType
+		state,                                // state
+		nil,                                  // sweep
+		nil,                                  // tags []string,
+		nil,                                  // summaryMetrics
+	)
+	if err != nil {
+		s.logger.Error("sender: updateRun:", "error", err)
+	}
+}
+
 func (s *Sender) uploadSummaryFile() {
 	if s.settings.GetXSync().GetValue() {
 		// if sync is enabled, we don't need to do all this
 		return
 	}
 
-	summary, err := s.runSummary.Serialize()
+	var opts []runsummary.SerializeOption
+	if s.settings.GetXSummaryAggregationMeta().GetValue() {
+		opts = append(opts, runsummary.WithAggregations())
+	}
+	summary, err := s.runSummary.Serialize(opts...)
 	if err != nil {
 		s.logger.Error("sender: uploadSummaryFile: failed to serialize summary", "error", err)
 		return
@@ -904,6 +1118,26 @@ func (s *Sender) uploadSummaryFile() {
 	s.fwdRecord(record)
 }
 
+// exportSummaryEnv writes the summary to the summary env file, if any.
+func (s *Sender) exportSummaryEnv() {
+	settings := settings.From(s.settings)
+	path := settings.GetSummaryEnvFile()
+	if path == "" || settings.IsSync() {
+		return
+	}
+
+	summary, err := s.runSummary.Flatten()
+	if err != nil {
+		s.logger.CaptureError("sender: failed to flatten summary for env file", err)
+		return
+	}
+
+	err = summaryenv.Write(path, summary, settings.GetSummaryEnvOptions())
+	if err != nil {
+		s.logger.CaptureError("sender: failed to write summary env file", err)
+	}
+}
+
 func (s *Sender) uploadConfigFile() {
 	if s.settings.GetXSync().GetValue() {
 		// if sync is enabled, we don't need to do all this
@@ -940,7 +1174,7 @@ func (s *Sender) uploadConfigFile() {
 // and updates the in memory config
 func (s *Sender) sendConfig(_ *service.Record, configRecord *service.ConfigRecord) {
 	if configRecord != nil {
-		s.runConfig.ApplyChangeRecord(configRecord,
+		s.runConfig.ApplyChangeRecord(s.offloadFileBackedConfig(configRecord),
 			func(err error) {
 				s.logger.CaptureError("Error updating run config", err)
 			})
@@ -948,6 +1182,33 @@ func (s *Sender) sendConfig(_ *service.Record, configRecord *service.ConfigRecor
 	s.configDebouncer.SetNeedsDebounce()
 }
 
+// offloadFileBackedConfig uploads the record's file-backed config values
+// as run files and returns the record with references in their place.
+func (s *Sender) offloadFileBackedConfig(
+	configRecord *service.ConfigRecord,
+) *service.ConfigRecord {
+	configRecord, paths := runconfig.OffloadFileBacked(
+		configRecord,
+		s.settings.GetFilesDir().GetValue(),
+		func(err error) {
+			s.logger.CaptureError("sender: failed to offload config value", err)
+		},
+	)
+
+	if len(paths) > 0 {
+		files := &service.FilesRecord{}
+		for _, path := range paths {
+			files.Files = append(files.Files, &service.FilesItem{
+				Path: path,
+				Type: service.FilesItem_WANDB,
+			})
+		}
+		s.sendFiles(nil, files)
+	}
+
+	return configRecord
+}
+
 // sendSystemMetrics sends a system metrics record via the file stream
 func (s *Sender) sendSystemMetrics(record *service.StatsRecord) {
 	if s.fileStream == nil {
@@ -1029,8 +1290,9 @@ func (s *Sender) sendAlert(_ *service.Record, alert *service.AlertRecord) {
 	// TODO: handle invalid alert levels
 	severity := gql.AlertSeverity(alert.Level)
 
+	// Retrying could send the alert twice.
 	data, err := gql.NotifyScriptableRunAlert(
-		s.ctx,
+		api.WithRetryPredicate(s.ctx, api.NeverRetry),
 		s.graphqlClient,
 		s.RunRecord.Entity,
 		s.RunRecord.Project,
@@ -1054,6 +1316,10 @@ func (s *Sender) sendExit(record *service.Record, exitRecord *service.RunExitRec
 	// response is done by respond() and called when defer state machine is complete
 	s.exitRecord = record
 
+	if exitRecord.GetKilled() {
+		s.markRunKilled()
+	}
+
 	if s.fileStream != nil {
 		s.fileStream.StreamUpdate(&fs.ExitUpdate{Record: exitRecord})
 	}
@@ -1111,6 +1377,8 @@ func (s *Sender) sendArtifact(_ *service.Record, msg *service.ArtifactRecord) {
 	saver := artifacts.NewArtifactSaver(
 		s.ctx, s.graphqlClient, s.fileTransferManager, msg, 0, "",
 	)
+	saver.Staging = s.staging
+	saver.Capabilities = s.capabilities
 	artifactID, err := saver.Save(s.fwdChan)
 	if err != nil {
 		err = fmt.Errorf("sender: sendArtifact: failed to log artifact ID: %s; error: %s", artifactID, err)
@@ -1124,12 +1392,16 @@ func (s *Sender) sendRequestLogArtifact(record *service.Record, msg *service.Log
 	saver := artifacts.NewArtifactSaver(
 		s.ctx, s.graphqlClient, s.fileTransferManager, msg.Artifact, msg.HistoryStep, msg.StagingDir,
 	)
+	saver.Capabilities = s.capabilities
 	artifactID, err := saver.Save(s.fwdChan)
 	if err != nil {
 		response.ErrorMessage = err.Error()
 	} else {
 		response.ArtifactId = artifactID
 	}
+	if saver.UploadResult != nil {
+		response.FileResults = saver.UploadResult.ToProto()
+	}
 
 	s.jobBuilder.HandleLogArtifactResult(&response, msg.Artifact)
 	s.respond(record,
@@ -1140,6 +1412,81 @@ func (s *Sender) sendRequestLogArtifact(record *service.Record, msg *service.Log
 		})
 }
 
+// sendRequestLogArtifactDir starts collecting an artifact's files from a
+// directory. The artifact is saved in saveDirArtifacts.
+func (s *Sender) sendRequestLogArtifactDir(
+	record *service.Record,
+	msg *service.LogArtifactDirRequest,
+) {
+	var response service.LogArtifactResponse
+
+	dirArtifact := artifacts.NewDirArtifact(
+		msg.Artifact,
+		msg.Path,
+		artifactDigestAlgorithm(s.settings, s.logger),
+	)
+	err := s.fileWatcher.WatchDir(msg.Path, func(path string) {
+		if err := dirArtifact.Update(path); err != nil {
+			s.logger.Warn(
+				"sender: failed to add file to directory artifact",
+				"path", path,
+				"error", err,
+			)
+		}
+	})
+	if err != nil {
+		response.ErrorMessage = err.Error()
+	} else {
+		s.dirArtifacts = append(s.dirArtifacts, dirArtifact)
+	}
+
+	s.respond(record,
+		&service.Response{
+			ResponseType: &service.Response_LogArtifactResponse{
+				LogArtifactResponse: &response,
+			},
+		})
+}
+
+// saveDirArtifacts commits the artifacts collected from watched
+// directories.
+func (s *Sender) saveDirArtifacts() {
+	if s.graphqlClient == nil {
+		if len(s.dirArtifacts) > 0 {
+			s.logger.Warn(
+				"sender: can't save directory artifacts while offline",
+				"count", len(s.dirArtifacts),
+			)
+		}
+		s.dirArtifacts = nil
+		return
+	}
+
+	for _, dirArtifact := range s.dirArtifacts {
+		artifact, err := dirArtifact.Build()
+		if err != nil {
+			s.logger.CaptureError(
+				"sender: failed to build directory artifact", err,
+				"path", dirArtifact.Root(),
+			)
+			continue
+		}
+
+		saver := artifacts.NewArtifactSaver(
+			s.ctx, s.graphqlClient, s.fileTransferManager, artifact, 0, "",
+		)
+		saver.Staging = s.staging
+		saver.Capabilities = s.capabilities
+		if _, err := saver.Save(s.fwdChan); err != nil {
+			s.logger.CaptureError(
+				"sender: failed to save directory artifact", err,
+				"path", dirArtifact.Root(),
+			)
+		}
+	}
+	s.dirArtifacts = nil
+}
+
 func (s *Sender) sendRequestDownloadArtifact(record *service.Record, msg *service.DownloadArtifactRequest) {
 	// TODO: this should be handled by a separate service startup mechanism
 	s.fileTransferManager.Start()
@@ -1163,13 +1510,34 @@ func (s *Sender) sendRequestDownloadArtifact(record *service.Record, msg *servic
 }
 
 func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRequest) {
+	progress := s.newSyncProgress()
+	stopProgress := progress.StartReporting(SyncProgressPeriod, func(report string) {
+		s.logger.Info("sender: sync progress", "progress", report)
+		if s.terminalPrinter != nil {
+			s.terminalPrinter.Write(report)
+		}
+	})
 
-	s.syncService = NewSyncService(s.ctx,
+	opts := []SyncServiceOption{
 		WithSyncServiceLogger(s.logger),
 		WithSyncServiceSenderFunc(s.sendRecord),
 		WithSyncServiceOverwrite(request.GetOverwrite()),
 		WithSyncServiceSkip(request.GetSkip()),
+		WithSyncServiceProgress(progress),
 		WithSyncServiceFlushCallback(func(err error) {
+			stopProgress()
+
+			if err == nil && s.syncCheckpoint != nil {
+				if err := s.syncCheckpoint.Remove(); err != nil {
+					s.logger.CaptureError("sender: failed to remove sync checkpoint", err)
+				}
+			}
+
+			var divergences []string
+			if err == nil && request.GetVerify() {
+				divergences, err = s.verifySync(request.GetOverwrite())
+			}
+
 			var errorInfo *service.ErrorInfo
 			if err != nil {
 				errorInfo = &service.ErrorInfo{
@@ -1188,14 +1556,26 @@ func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRe
 				&service.Response{
 					ResponseType: &service.Response_SyncResponse{
 						SyncResponse: &service.SyncResponse{
-							Url:   url,
-							Error: errorInfo,
+							Url:         url,
+							Error:       errorInfo,
+							Divergences: divergences,
 						},
 					},
 				},
 			)
 		}),
-	)
+	}
+
+	if s.syncCheckpoint != nil && s.fileStream != nil {
+		// Append-only data is skipped when resuming, so the filestream must
+		// continue from the backend's offsets.
+		if s.syncCheckpoint.ReplayedRecords() > 0 && s.settings.GetResume().GetValue() == "" {
+			s.settings.Resume = &wrapperspb.StringValue{Value: "allow"}
+		}
+		opts = append(opts, WithSyncServiceCheckpoint(s.syncCheckpoint, s.checkpointSync))
+	}
+
+	s.syncService = NewSyncService(s.ctx, opts...)
 	s.syncService.Start()
 
 	rec := &service.Record{
@@ -1215,6 +1595,57 @@ func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRe
 	s.fwdRecord(rec)
 }
 
+// verifySync compares the synced transaction log to the backend and
+// describes each difference.
+func (s *Sender) verifySync(overwrite *service.SyncOverwrite) ([]string, error) {
+	report, err := VerifyRun(
+		s.ctx,
+		s.settings.GetSyncFile().GetValue(),
+		overwrite,
+		s.graphqlClient,
+		s.logger,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sender: failed to verify sync: %v", err)
+	}
+
+	divergences := make([]string, 0, len(report.Divergences))
+	for _, divergence := range report.Divergences {
+		divergences = append(divergences, divergence.String())
+	}
+	return divergences, nil
+}
+
+// checkpointSync saves the sync checkpoint once the filestream API
+// acknowledges the first n records.
+func (s *Sender) checkpointSync(n int64) {
+	s.fileStream.StreamUpdate(&fs.FlushUpdate{
+		OnSent: func() {
+			if err := s.syncCheckpoint.SetReplayedRecords(n); err != nil {
+				s.logger.CaptureError("sender: failed to save sync checkpoint", err)
+			}
+		},
+	})
+}
+
+// newSyncProgress creates a progress tracker for syncing the run's
+// transaction log.
+//
+// The total number of records is read up front so that the progress can be
+// shown as a percentage.
+func (s *Sender) newSyncProgress() *SyncProgress {
+	totalRecords, err := CountStoreRecords(
+		s.ctx,
+		s.settings.GetSyncFile().GetValue(),
+		s.logger,
+	)
+	if err != nil {
+		s.logger.CaptureError("sender: failed to count records to sync", err)
+	}
+
+	return NewSyncProgress(totalRecords, s.fileTransferStats)
+}
+
 func (s *Sender) sendRequestStopStatus(record *service.Record, _ *service.StopStatusRequest) {
 
 	// TODO: unify everywhere to use settings
@@ -1262,6 +1693,25 @@ func (s *Sender) sendRequestStopStatus(record *service.Record, _ *service.StopSt
 	)
 }
 
+// sendRequestGetConfig responds with the run's config.
+func (s *Sender) sendRequestGetConfig(
+	record *service.Record,
+	_ *service.GetConfigRequest,
+) {
+	response := &service.Response{}
+
+	items, err := s.runConfig.Items()
+	if err != nil {
+		s.logger.CaptureError("sender: error reading config", err)
+	} else {
+		response.ResponseType = &service.Response_GetConfigResponse{
+			GetConfigResponse: &service.GetConfigResponse{Item: items},
+		}
+	}
+
+	s.respond(record, response)
+}
+
 func (s *Sender) sendRequestSenderRead(_ *service.Record, _ *service.SenderReadRequest) {
 	if s.store == nil {
 		store := NewStore(s.ctx, s.settings.GetSyncFile().GetValue(), s.logger)
@@ -1346,6 +1796,75 @@ func (s *Sender) sendRequestServerInfo(record *service.Record, _ *service.Server
 	)
 }
 
+// runPreflight checks that the run can be created in the project before
+// creating it.
+//
+// The entity and project default to the ones in the settings.
+func (s *Sender) runPreflight(entity, project string) *preflight.Error {
+	if entity == "" {
+		entity = s.settings.GetEntity().GetValue()
+	}
+	if project == "" {
+		project = s.settings.GetProject().GetValue()
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, preflightTimeout)
+	defer cancel()
+
+	err := preflight.Run(ctx, s.graphqlClient, entity, project)
+	if err == nil {
+		return nil
+	}
+
+	var preflightErr *preflight.Error
+	if !errors.As(err, &preflightErr) {
+		preflightErr = &preflight.Error{
+			Check:   preflight.CheckConnectivity,
+			Message: "unexpected error",
+			Err:     err,
+		}
+	}
+	return preflightErr
+}
+
+// preflightErrorInfo converts a failed preflight check for the client.
+func preflightErrorInfo(err *preflight.Error) *service.ErrorInfo {
+	code := service.ErrorInfo_USAGE
+	switch err.Check {
+	case preflight.CheckConnectivity:
+		code = service.ErrorInfo_COMMUNICATION
+	case preflight.CheckAuthentication:
+		code = service.ErrorInfo_AUTHENTICATION
+	}
+
+	return &service.ErrorInfo{Message: err.Error(), Code: code}
+}
+
+func (s *Sender) sendRequestPreflight(
+	record *service.Record,
+	request *service.PreflightRequest,
+) {
+	response := &service.PreflightResponse{}
+
+	if s.graphqlClient == nil {
+		response.Error = &service.ErrorInfo{
+			Message: "sender: preflight checks require an online run",
+			Code:    service.ErrorInfo_UNSUPPORTED,
+		}
+	} else if err := s.runPreflight(request.GetEntity(), request.GetProject()); err != nil {
+		response.Error = preflightErrorInfo(err)
+		response.FailedCheck = string(err.Check)
+	}
+
+	s.respond(record,
+		&service.Response{
+			ResponseType: &service.Response_PreflightResponse{
+				PreflightResponse: response,
+			},
+		},
+	)
+}
+
 func (s *Sender) sendRequestJobInput(request *service.JobInputRequest) {
 	if s.jobBuilder == nil {
 		s.logger.Warn("sender: sendJobInput: job builder disabled, skipping")
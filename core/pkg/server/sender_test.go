@@ -2,14 +2,20 @@ package server_test
 
 import (
 	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/capabilities"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gqlmock"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/runsummary"
 	wbsettings "github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/watchertest"
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -45,18 +51,36 @@ const validCreateArtifactResponse = `{
 }`
 
 func makeSender(client graphql.Client, recordChan chan *service.Record, resultChan chan *service.Result) *server.Sender {
+	return makeSenderWithSummary(
+		client,
+		recordChan,
+		resultChan,
+		&service.Settings{RunId: &wrapperspb.StringValue{Value: "run1"}},
+		nil,
+	)
+}
+
+func makeSenderWithSummary(
+	client graphql.Client,
+	recordChan chan *service.Record,
+	resultChan chan *service.Result,
+	settingsProto *service.Settings,
+	runSummary *runsummary.RunSummary,
+) *server.Sender {
 	ctx, cancel := context.WithCancel(context.Background())
 	logger := observability.NewNoOpLogger()
-	settings := wbsettings.From(&service.Settings{
-		RunId: &wrapperspb.StringValue{Value: "run1"},
-	})
-	backend := server.NewBackend(logger, settings)
+	settings := wbsettings.From(settingsProto)
+	backend := server.NewBackend(logger, settings, nil, nil, nil)
 	fileStream := server.NewFileStream(
-		backend, logger, observability.NewPrinter(), settings, nil)
+		backend, logger, observability.NewPrinter(), settings, nil, nil, nil)
 	fileTransferManager := server.NewFileTransferManager(
 		filetransfer.NewFileTransferStats(),
 		logger,
 		settings,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	runfilesUploader := server.NewRunfilesUploader(
 		ctx,
@@ -66,6 +90,8 @@ func makeSender(client graphql.Client, recordChan chan *service.Record, resultCh
 		fileTransferManager,
 		watchertest.NewFakeWatcher(),
 		client,
+		nil,
+		nil,
 	)
 	sender := server.NewSender(
 		ctx,
@@ -81,6 +107,9 @@ func makeSender(client graphql.Client, recordChan chan *service.Record, resultCh
 			OutChan:             resultChan,
 			Mailbox:             mailbox.NewMailbox(),
 			GraphqlClient:       client,
+			FileWatcher:         watchertest.NewFakeWatcher(),
+			RunSummary:          runSummary,
+			Capabilities:        capabilities.New(client, logger, nil),
 		},
 	)
 	return sender
@@ -128,6 +157,92 @@ func TestSendRun(t *testing.T) {
 		requests[0])
 }
 
+func TestSendNotes(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	for range 2 {
+		mockGQL.StubMatchOnce(
+			gqlmock.WithOpName("UpsertBucket"),
+			validUpsertBucketResponse,
+		)
+	}
+	outChan := make(chan *service.Result, 1)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), outChan)
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{Project: "testProject", Entity: "testEntity"},
+		},
+		Control: &service.Control{MailboxSlot: "junk"},
+	})
+	<-outChan
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Notes{
+			Notes: &service.RunNotesRecord{Notes: "tuned the learning rate"},
+		},
+	})
+
+	requests := mockGQL.AllRequests()
+	require.Len(t, requests, 2)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("notes", gomock.Eq("tuned the learning rate")),
+		),
+		requests[1])
+}
+
+func TestSendRequestGetConfig(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		validUpsertBucketResponse,
+	)
+	outChan := make(chan *service.Result, 1)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), outChan)
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				Project: "testProject",
+				Entity:  "testEntity",
+				Config: &service.ConfigRecord{
+					Update: []*service.ConfigItem{
+						{Key: "lr", ValueJson: "0.1"},
+						{Key: "_wandb", ValueJson: `{"cli_version": "1.0"}`},
+					},
+				},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "junk"},
+	})
+	<-outChan
+
+	sender.SendRecord(&service.Record{RecordType: &service.Record_Config{
+		Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{NestedKey: []string{"model", "layers"}, ValueJson: "4"},
+				{Key: "lr", ValueJson: "0.01"},
+			},
+		},
+	}})
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_GetConfig{
+					GetConfig: &service.GetConfigRequest{},
+				},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "junk"},
+	})
+
+	config := make(map[string]string)
+	for _, item := range (<-outChan).GetResponse().GetGetConfigResponse().GetItem() {
+		config[item.Key] = item.ValueJson
+	}
+	assert.Equal(t,
+		map[string]string{"lr": "0.01", "model": `{"layers":4}`},
+		config)
+}
+
 // Verify that arguments are properly passed through to graphql
 func TestSendLinkArtifact(t *testing.T) {
 	mockGQL := gqlmock.NewMockClient()
@@ -282,6 +397,228 @@ func TestSendUseArtifact(t *testing.T) {
 }
 
 // Verify that arguments are properly passed through to graphql
+func TestSendArtifact_ExistingVersionGetsAliasesAndMetadata(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifact"),
+		`{"createArtifact": {"artifact": {
+			"id": "artifact-id",
+			"state": "COMMITTED",
+			"artifactSequence": {"id": "sequence-id"}
+		}}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("UpdateArtifact"),
+		`{"updateArtifact": {"artifact": {"id": "artifact-id"}}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("TypeFields"),
+		`{"__type": {"inputFields": [{"name": "aliases"}]}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("AddAliases"),
+		`{"addAliases": {"success": true}}`,
+	)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Artifact{
+			Artifact: &service.ArtifactRecord{
+				Entity:   "entity",
+				Project:  "project",
+				Name:     "test-artifact",
+				Aliases:  []string{"latest", "prod", "latest"},
+				Metadata: `{"rows": 10}`,
+				Manifest: &service.ArtifactManifest{},
+			}},
+	})
+
+	requests := mockGQL.AllRequests()
+	require.Len(t, requests, 4)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("artifactID", gomock.Eq("artifact-id")),
+			gqlmock.GQLVar("metadata", gomock.Eq(`{"rows": 10}`)),
+		),
+		requests[1])
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("artifactID", gomock.Eq("artifact-id")),
+			gqlmock.GQLVar("aliases", gomock.Len(2)),
+		),
+		requests[3])
+}
+
+func TestSendArtifact_ExistingVersionKeepsExistingAliases(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifact"),
+		`{"createArtifact": {"artifact": {
+			"id": "artifact-id",
+			"state": "COMMITTED",
+			"artifactSequence": {"id": "sequence-id"}
+		}}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("TypeFields"),
+		`{"__type": {"inputFields": [{"name": "aliases"}]}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("AddAliases"),
+		`{"addAliases": {"success": true}}`,
+	)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+
+	// The existing version may have aliases such as "v0" and "best".
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Artifact{
+			Artifact: &service.ArtifactRecord{
+				Entity:   "entity",
+				Project:  "project",
+				Name:     "test-artifact",
+				Aliases:  []string{"prod"},
+				Manifest: &service.ArtifactManifest{},
+			}},
+	})
+
+	// Aliases are only added, and nothing sets the version's alias list.
+	requests := mockGQL.AllRequests()
+	require.Len(t, requests, 3)
+	for _, request := range requests {
+		assert.NotEqual(t, "UpdateArtifact", request.OpName)
+	}
+	assert.Equal(t, "AddAliases", requests[2].OpName)
+	variables, err := json.Marshal(requests[2].Variables)
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{
+			"artifactID": "artifact-id",
+			"aliases": [{
+				"entityName": "entity",
+				"projectName": "project",
+				"artifactCollectionName": "test-artifact",
+				"alias": "prod"
+			}]
+		}`,
+		string(variables))
+}
+
+func TestSendArtifact_ExistingVersionMetadataOnly(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifact"),
+		`{"createArtifact": {"artifact": {
+			"id": "artifact-id",
+			"state": "COMMITTED",
+			"artifactSequence": {"id": "sequence-id"}
+		}}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("UpdateArtifact"),
+		`{"updateArtifact": {"artifact": {"id": "artifact-id"}}}`,
+	)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Artifact{
+			Artifact: &service.ArtifactRecord{
+				Name:     "test-artifact",
+				Metadata: `{"rows": 10}`,
+				Manifest: &service.ArtifactManifest{},
+			}},
+	})
+
+	// Sending null aliases would clear the version's existing aliases.
+	requests := mockGQL.AllRequests()
+	require.Len(t, requests, 2)
+	variables, err := json.Marshal(requests[1].Variables)
+	require.NoError(t, err)
+	assert.NotContains(t, string(variables), "aliases")
+}
+
+func TestSendDirArtifact_UsesCapabilities(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifact"),
+		`{"createArtifact": {"artifact": {
+			"id": "artifact-id",
+			"state": "COMMITTED",
+			"artifactSequence": {"id": "sequence-id"}
+		}}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("TypeFields"),
+		`{"__type": {"inputFields": [{"name": "artifactID"}]}}`,
+	)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_LogArtifactDir{
+					LogArtifactDir: &service.LogArtifactDirRequest{
+						Artifact: &service.ArtifactRecord{
+							Name:    "test-artifact",
+							Aliases: []string{"prod"},
+						},
+						Path: t.TempDir(),
+					},
+				},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "slot"},
+	})
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Defer{
+					Defer: &service.DeferRequest{
+						State: service.DeferRequest_FLUSH_DIR,
+					},
+				},
+			},
+		},
+	})
+
+	// The server doesn't support adding aliases, so none are added.
+	requests := mockGQL.AllRequests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "CreateArtifact", requests[0].OpName)
+	assert.Equal(t, "TypeFields", requests[1].OpName)
+}
+
+func TestSendDirArtifact_Offline(t *testing.T) {
+	sender := makeSender(nil, make(chan *service.Record, 1), make(chan *service.Result, 1))
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_LogArtifactDir{
+					LogArtifactDir: &service.LogArtifactDirRequest{
+						Artifact: &service.ArtifactRecord{Name: "test-artifact"},
+						Path:     t.TempDir(),
+					},
+				},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "slot"},
+	})
+
+	assert.NotPanics(t, func() {
+		sender.SendRecord(&service.Record{
+			RecordType: &service.Record_Request{
+				Request: &service.Request{
+					RequestType: &service.Request_Defer{
+						Defer: &service.DeferRequest{
+							State: service.DeferRequest_FLUSH_DIR,
+						},
+					},
+				},
+			},
+		})
+	})
+}
+
 func TestSendArtifact(t *testing.T) {
 	mockGQL := gqlmock.NewMockClient()
 	mockGQL.StubMatchOnce(
@@ -328,3 +665,84 @@ func TestSendArtifact(t *testing.T) {
 		),
 		requests[0])
 }
+
+func TestSendRequestPreflight(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("Viewer"),
+		`{"viewer": {"id": "user-id", "entity": "my-user"}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("Preflight"),
+		`{"entity": {"id": "e"}, "project": {"id": "p", "readOnly": true}}`,
+	)
+	outChan := make(chan *service.Result, 1)
+	sender := makeSender(mockGQL, make(chan *service.Record, 1), outChan)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Preflight{
+					Preflight: &service.PreflightRequest{
+						Entity:  "team",
+						Project: "project",
+					},
+				},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "slot"},
+	})
+	result := <-outChan
+
+	response := result.GetResponse().GetPreflightResponse()
+	assert.Equal(t, "permissions", response.GetFailedCheck())
+	assert.Equal(t, service.ErrorInfo_USAGE, response.GetError().GetCode())
+	assert.Contains(t, response.GetError().GetMessage(), "team/project")
+}
+
+func TestSendDefer_SummaryFileWithAggregations(t *testing.T) {
+	filesDir := t.TempDir()
+	runSummary := runsummary.New()
+	runSummary.ApplyChangeRecord(
+		&service.SummaryRecord{Update: []*service.SummaryItem{
+			{NestedKey: []string{"loss", "mean"}, ValueJson: "0.25"},
+		}},
+		func(err error) { require.NoError(t, err) },
+	)
+	runSummary.SetAggregation(
+		[]string{"loss", "mean"},
+		runsummary.Aggregation{Kind: runsummary.AggregationMean, Count: 4},
+	)
+	recordChan := make(chan *service.Record, 2)
+	sender := makeSenderWithSummary(
+		gqlmock.NewMockClient(),
+		recordChan,
+		make(chan *service.Result, 1),
+		&service.Settings{
+			RunId:                   &wrapperspb.StringValue{Value: "run1"},
+			FilesDir:                &wrapperspb.StringValue{Value: filesDir},
+			XSummaryAggregationMeta: &wrapperspb.BoolValue{Value: true},
+		},
+		runSummary,
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_Defer{Defer: &service.DeferRequest{
+				State: service.DeferRequest_FLUSH_SUM,
+			}},
+		}},
+	})
+
+	data, err := os.ReadFile(filepath.Join(filesDir, server.SummaryFileName))
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{
+			"loss": {"mean": 0.25},
+			"_summary_meta": {
+				"loss": {"mean": {"aggregation": "mean", "count": 4}}
+			}
+		}`,
+		string(data))
+	assert.NotNil(t, (<-recordChan).GetFiles())
+}
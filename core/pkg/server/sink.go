@@ -0,0 +1,74 @@
+package server
+
+import (
+	"io"
+	"slices"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Sink is a destination for a stream's records besides the W&B backend.
+//
+// The writer passes each record to its sinks after the record is written to
+// the transaction log, in order and from a single goroutine. Sinks receive
+// records even in offline mode. Write should return quickly, since it
+// blocks the stream, and must not modify the record.
+//
+// If a Sink also implements io.Closer, it is closed when the stream closes.
+type Sink interface {
+	// Write handles a record.
+	//
+	// Errors are logged and do not stop the stream.
+	Write(record *service.Record) error
+}
+
+// NoopSink is a Sink that discards all records.
+type NoopSink struct{}
+
+func (NoopSink) Write(*service.Record) error { return nil }
+
+// recordTypeSink passes only some types of records to a Sink.
+type recordTypeSink struct {
+	Sink
+
+	// recordTypes are the names of the record types to accept.
+	recordTypes []string
+}
+
+// WithRecordTypes returns a Sink that only passes the given types of records
+// to the sink.
+//
+// Types are named by the field of the record's "record_type" oneof, like
+// "history" or "summary".
+func WithRecordTypes(sink Sink, recordTypes ...string) Sink {
+	return &recordTypeSink{Sink: sink, recordTypes: recordTypes}
+}
+
+func (s *recordTypeSink) Write(record *service.Record) error {
+	if !slices.Contains(s.recordTypes, recordTypeName(record)) {
+		return nil
+	}
+	return s.Sink.Write(record)
+}
+
+func (s *recordTypeSink) Close() error {
+	return closeSink(s.Sink)
+}
+
+// recordTypeName returns the name of the record's type, like "history".
+func recordTypeName(record *service.Record) string {
+	message := record.ProtoReflect()
+	field := message.WhichOneof(message.Descriptor().Oneofs().ByName("record_type"))
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
+
+// closeSink closes the sink if it implements io.Closer.
+func closeSink(sink Sink) error {
+	if closer, ok := sink.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
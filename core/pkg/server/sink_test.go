@@ -0,0 +1,117 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type fakeSink struct {
+	records []*service.Record
+	closed  bool
+	err     error
+}
+
+func (s *fakeSink) Write(record *service.Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func (s *fakeSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+// writeWithSinks passes records through a Writer with the given sinks.
+//
+// Returns the records forwarded to the sender.
+func writeWithSinks(
+	records []*service.Record,
+	offline bool,
+	sinks ...server.Sink,
+) []*service.Record {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(),
+		&server.WriterParams{
+			Logger: observability.NewNoOpLogger(),
+			Settings: &service.Settings{
+				XDisableTransactionLog: wrapperspb.Bool(true),
+				XOffline:               wrapperspb.Bool(offline),
+			},
+			FwdChan: fwdChan,
+		},
+	)
+	writer.AddSinks(sinks...)
+
+	inChan := make(chan *service.Record, len(records))
+	for _, record := range records {
+		inChan <- record
+	}
+	close(inChan)
+	writer.Do(inChan)
+
+	var forwarded []*service.Record
+	for record := range fwdChan {
+		forwarded = append(forwarded, record)
+	}
+	return forwarded
+}
+
+func historyRecord() *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_History{History: &service.HistoryRecord{}},
+	}
+}
+
+func summaryRecord() *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{}},
+	}
+}
+
+func TestWriter_FansOutToSinks(t *testing.T) {
+	sink1 := &fakeSink{}
+	sink2 := &fakeSink{err: errors.New("sink failed")}
+	records := []*service.Record{historyRecord(), summaryRecord()}
+
+	forwarded := writeWithSinks(records, false, sink1, sink2)
+
+	assert.Equal(t, records, forwarded)
+	assert.Equal(t, records, sink1.records)
+	assert.Equal(t, records, sink2.records)
+	assert.True(t, sink1.closed)
+}
+
+func TestWriter_SinksReceiveRecordsOffline(t *testing.T) {
+	sink := &fakeSink{}
+
+	forwarded := writeWithSinks(
+		[]*service.Record{historyRecord()},
+		true,
+		sink,
+	)
+
+	assert.Empty(t, forwarded)
+	assert.Len(t, sink.records, 1)
+}
+
+func TestWithRecordTypes(t *testing.T) {
+	sink := &fakeSink{}
+	summary := summaryRecord()
+
+	writeWithSinks(
+		[]*service.Record{historyRecord(), summary},
+		false,
+		server.WithRecordTypes(sink, "summary"),
+		server.NoopSink{},
+	)
+
+	assert.Equal(t, []*service.Record{summary}, sink.records)
+	assert.True(t, sink.closed)
+}
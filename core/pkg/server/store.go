@@ -25,6 +25,10 @@ const (
 	headerMagic = 0xBEE1
 	// headerVersion is the version of the header.
 	headerVersion = 0
+	// headerVersionCompressed is the version of the header of transaction
+	// logs whose records start with a RecordCodec ID, possibly with
+	// historyBatchFlag set.
+	headerVersionCompressed = 1
 )
 
 // headerIdent returns the header identifier.
@@ -60,7 +64,8 @@ func (o *HeaderOptions) UnmarshalBinary(r io.Reader) error {
 
 // Valid checks if the header is valid based on a reference header.
 func (o *HeaderOptions) Valid() bool {
-	return o.IDENT == headerIdent() && o.Magic == headerMagic && o.Version == headerVersion
+	return o.IDENT == headerIdent() && o.Magic == headerMagic &&
+		(o.Version == headerVersion || o.Version == headerVersionCompressed)
 }
 
 // Store is the persistent store for a stream
@@ -82,14 +87,62 @@ type Store struct {
 
 	// logger is the logger for the store
 	logger *observability.CoreLogger
+
+	// codec compresses written records, or is nil.
+	codec RecordCodec
+
+	// decoders decompresses read records, or is nil if the file's records
+	// aren't compressed.
+	decoders recordDecoders
+
+	// history collects written history records into Arrow batches, or is
+	// nil if history records are stored like other records.
+	history *historyBatch
+
+	// pending holds records of a read history batch that haven't been
+	// returned yet.
+	pending []*service.Record
+}
+
+type StoreOption func(*Store)
+
+// WithStoreCodec compresses written records with the codec.
+//
+// Older versions of wandb can't read compressed transaction logs. Reading
+// doesn't need this option: compression is detected from the header.
+func WithStoreCodec(codec RecordCodec) StoreOption {
+	return func(sr *Store) {
+		sr.codec = codec
+	}
+}
+
+// WithStoreArrowHistory stores consecutive numeric history records as
+// batches encoded with Apache Arrow.
+//
+// This makes logs of many numeric metrics smaller and faster to read.
+// Up to historyBatchRows records are buffered in memory until the store is
+// flushed, so a crash can lose more of them than without this option.
+// Like compression, older versions of wandb can't read the file.
+func WithStoreArrowHistory() StoreOption {
+	return func(sr *Store) {
+		sr.history = &historyBatch{}
+	}
 }
 
 // NewStore creates a new store
-func NewStore(ctx context.Context, fileName string, logger *observability.CoreLogger) *Store {
+func NewStore(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts ...StoreOption,
+) *Store {
 	sr := &Store{ctx: ctx,
 		name:   fileName,
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(sr)
+	}
 	return sr
 }
 
@@ -114,6 +167,9 @@ func (sr *Store) Open(flag int) error {
 			sr.logger.CaptureError("can't read header", err)
 			return err
 		}
+		if header.Version == headerVersionCompressed {
+			sr.decoders = make(recordDecoders)
+		}
 		return nil
 	case os.O_WRONLY:
 		f, err := os.Create(sr.name)
@@ -124,6 +180,9 @@ func (sr *Store) Open(flag int) error {
 		sr.db = f
 		sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
 		header := NewHeader()
+		if sr.codec != nil || sr.history != nil {
+			header.Version = headerVersionCompressed
+		}
 		if err := header.MarshalBinary(sr.db); err != nil {
 			sr.logger.CaptureError("can't write header", err)
 			return err
@@ -140,6 +199,10 @@ func (sr *Store) Open(flag int) error {
 // Close closes the store
 func (sr *Store) Close() error {
 	if sr.writer != nil {
+		if err := sr.writeHistoryBatch(); err != nil {
+			sr.logger.CaptureError("can't write history batch", err)
+		}
+
 		err := sr.writer.Close()
 		if err != nil {
 			sr.logger.CaptureError("can't close file", err)
@@ -155,24 +218,83 @@ func (sr *Store) Close() error {
 }
 
 func (sr *Store) Write(msg *service.Record) error {
-	writer, err := sr.writer.Next()
+	if sr.history != nil {
+		if sr.history.add(msg) {
+			if sr.history.len() < historyBatchRows {
+				return nil
+			}
+			return sr.writeHistoryBatch()
+		}
+
+		if sr.history.len() > 0 {
+			if err := sr.writeHistoryBatch(); err != nil {
+				return err
+			}
+			if sr.history.add(msg) {
+				return nil
+			}
+		}
+	}
+
+	out, err := proto.Marshal(msg)
 	if err != nil {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
-	out, err := proto.Marshal(msg)
+	return sr.writeData(out, 0)
+}
+
+// writeHistoryBatch writes the records collected into the history batch.
+func (sr *Store) writeHistoryBatch() error {
+	if sr.history == nil || sr.history.len() == 0 {
+		return nil
+	}
+
+	out, err := sr.history.encode(nil)
+	if err != nil {
+		sr.logger.CaptureError("can't encode history batch", err)
+		return err
+	}
+	return sr.writeData(out, historyBatchFlag)
+}
+
+// writeData writes a record's data, prefixed by its codec ID and the flags
+// if the file's records have IDs.
+func (sr *Store) writeData(data []byte, flags byte) error {
+	writer, err := sr.writer.Next()
 	if err != nil {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
+	switch {
+	case sr.codec != nil:
+		if data, err = encodeRecord(sr.codec, data); err != nil {
+			sr.logger.CaptureError("can't compress record", err)
+			return err
+		}
+		data[0] |= flags
+	case sr.history != nil:
+		data = append([]byte{uncompressedRecordID | flags}, data...)
+	}
 
-	if _, err = writer.Write(out); err != nil {
+	if _, err = writer.Write(data); err != nil {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
 	return nil
 }
 
+// Flush writes buffered records to the file and syncs it to disk.
+func (sr *Store) Flush() error {
+	if err := sr.writeHistoryBatch(); err != nil {
+		return err
+	}
+	if err := sr.writer.Flush(); err != nil {
+		return err
+	}
+	return sr.db.Sync()
+}
+
 func (sr *Store) WriteDirectlyToDB(data []byte) (int, error) {
 	// this is for testing purposes only
 	return sr.db.Write(data)
@@ -186,6 +308,12 @@ func (sr *Store) Read() (*service.Record, error) {
 		return nil, err
 	}
 
+	if len(sr.pending) > 0 {
+		msg := sr.pending[0]
+		sr.pending = sr.pending[1:]
+		return msg, nil
+	}
+
 	reader, err := sr.reader.Next()
 	if err == io.EOF {
 		return nil, err
@@ -202,6 +330,20 @@ func (sr *Store) Read() (*service.Record, error) {
 		sr.reader.Recover()
 		return nil, err
 	}
+	if sr.decoders != nil {
+		isHistoryBatch := len(buf) > 0 && buf[0]&historyBatchFlag != 0
+
+		// The record passed its checksum, so a record that can't be
+		// decompressed is corrupt in a way the reader can't recover from.
+		if buf, err = sr.decoders.decode(buf); err != nil {
+			sr.logger.CaptureError("can't decompress record", err)
+			return nil, err
+		}
+
+		if isHistoryBatch {
+			return sr.readHistoryBatch(buf)
+		}
+	}
 	msg := &service.Record{}
 	if err = proto.Unmarshal(buf, msg); err != nil {
 		sr.logger.CaptureError("can't read record", err)
@@ -209,3 +351,19 @@ func (sr *Store) Read() (*service.Record, error) {
 	}
 	return msg, nil
 }
+
+// readHistoryBatch returns the first record of a history batch and keeps
+// the rest for the next reads.
+func (sr *Store) readHistoryBatch(data []byte) (*service.Record, error) {
+	records, err := decodeHistoryBatch(data)
+	if err == nil && len(records) == 0 {
+		err = fmt.Errorf("server: history batch is empty")
+	}
+	if err != nil {
+		sr.logger.CaptureError("can't decode history batch", err)
+		return nil, err
+	}
+
+	sr.pending = records[1:]
+	return records[0], nil
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// RecordCodec compresses records in the transaction log.
+//
+// A codec is used by one store at a time, so it may reuse buffers between
+// calls.
+type RecordCodec interface {
+	// ID identifies the codec in the transaction log.
+	//
+	// It is stored before each compressed record so that readers know how
+	// to decode it. Zero is reserved for uncompressed records, and the
+	// ID must be less than 128 since the high bit is historyBatchFlag.
+	ID() byte
+
+	// Encode returns the compressed data.
+	//
+	// The result may alias a buffer that is reused by the next call.
+	Encode(data []byte) ([]byte, error)
+
+	// Decode returns the decompressed data.
+	Decode(data []byte) ([]byte, error)
+}
+
+const (
+	// uncompressedRecordID marks records stored without compression in a
+	// compressed transaction log.
+	uncompressedRecordID = 0
+
+	deflateRecordID = 1
+	zstdRecordID    = 2
+)
+
+var (
+	recordCodecsMu     sync.Mutex
+	recordCodecsByName = map[string]func() RecordCodec{}
+	recordCodecsByID   = map[byte]func() RecordCodec{}
+)
+
+func init() {
+	RegisterRecordCodec("deflate", func() RecordCodec { return &deflateCodec{} })
+	RegisterRecordCodec("zstd", func() RecordCodec { return &zstdCodec{} })
+}
+
+// RegisterRecordCodec makes a codec available for the transaction log
+// under the given name.
+//
+// Panics if the name or the codec's ID is already registered, or if the ID
+// is zero or at least 128.
+func RegisterRecordCodec(name string, newCodec func() RecordCodec) {
+	recordCodecsMu.Lock()
+	defer recordCodecsMu.Unlock()
+
+	id := newCodec().ID()
+	if id == uncompressedRecordID {
+		panic("server: record codec ID must not be zero")
+	}
+	if id&historyBatchFlag != 0 {
+		panic("server: record codec ID must be less than 128")
+	}
+	if _, ok := recordCodecsByName[name]; ok {
+		panic(fmt.Sprintf("server: record codec %q already registered", name))
+	}
+	if _, ok := recordCodecsByID[id]; ok {
+		panic(fmt.Sprintf("server: record codec ID %d already registered", id))
+	}
+
+	recordCodecsByName[name] = newCodec
+	recordCodecsByID[id] = newCodec
+}
+
+// NewRecordCodec returns the registered codec with the given name.
+//
+// Returns nil for an empty name or "none", meaning records aren't
+// compressed.
+func NewRecordCodec(name string) (RecordCodec, error) {
+	if name == "" || name == "none" {
+		return nil, nil
+	}
+
+	recordCodecsMu.Lock()
+	defer recordCodecsMu.Unlock()
+
+	newCodec, ok := recordCodecsByName[name]
+	if !ok {
+		return nil, fmt.Errorf("server: unknown transaction log compression %q", name)
+	}
+	return newCodec(), nil
+}
+
+// recordDecoders decodes records of a compressed transaction log.
+type recordDecoders map[byte]RecordCodec
+
+// decode returns the record's data without its codec ID.
+//
+// It ignores historyBatchFlag.
+func (d recordDecoders) decode(record []byte) ([]byte, error) {
+	if len(record) == 0 {
+		return nil, fmt.Errorf("server: compressed record is empty")
+	}
+
+	id, data := record[0]&^historyBatchFlag, record[1:]
+	if id == uncompressedRecordID {
+		return data, nil
+	}
+
+	codec, ok := d[id]
+	if !ok {
+		recordCodecsMu.Lock()
+		newCodec, registered := recordCodecsByID[id]
+		recordCodecsMu.Unlock()
+
+		if !registered {
+			return nil, fmt.Errorf("server: unknown record codec ID %d", id)
+		}
+		codec = newCodec()
+		d[id] = codec
+	}
+
+	return codec.Decode(data)
+}
+
+// encodeRecord returns the record's data prefixed by its codec ID.
+//
+// Records that don't get smaller are stored uncompressed.
+func encodeRecord(codec RecordCodec, data []byte) ([]byte, error) {
+	compressed, err := codec.Encode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(compressed) < len(data) {
+		return append([]byte{codec.ID()}, compressed...), nil
+	}
+	return append([]byte{uncompressedRecordID}, data...), nil
+}
+
+// deflateCodec compresses records with DEFLATE.
+//
+// It favors speed over size, since records are compressed as they are
+// logged.
+type deflateCodec struct {
+	buf    bytes.Buffer
+	writer *flate.Writer
+	reader io.ReadCloser
+}
+
+func (c *deflateCodec) ID() byte { return deflateRecordID }
+
+func (c *deflateCodec) Encode(data []byte) ([]byte, error) {
+	c.buf.Reset()
+
+	if c.writer == nil {
+		writer, err := flate.NewWriter(&c.buf, flate.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		c.writer = writer
+	} else {
+		c.writer.Reset(&c.buf)
+	}
+
+	if _, err := c.writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := c.writer.Close(); err != nil {
+		return nil, err
+	}
+	return c.buf.Bytes(), nil
+}
+
+func (c *deflateCodec) Decode(data []byte) ([]byte, error) {
+	if c.reader == nil {
+		c.reader = flate.NewReader(bytes.NewReader(data))
+	} else if err := c.reader.(flate.Resetter).Reset(bytes.NewReader(data), nil); err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(c.reader)
+}
+
+// zstdCodec compresses records with Zstandard.
+//
+// Like deflateCodec, it favors speed over size.
+type zstdCodec struct {
+	buf     []byte
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func (c *zstdCodec) ID() byte { return zstdRecordID }
+
+func (c *zstdCodec) Encode(data []byte) ([]byte, error) {
+	if c.encoder == nil {
+		encoder, err := zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedFastest),
+			zstd.WithEncoderConcurrency(1),
+		)
+		if err != nil {
+			return nil, err
+		}
+		c.encoder = encoder
+	}
+
+	c.buf = c.encoder.EncodeAll(data, c.buf[:0])
+	return c.buf, nil
+}
+
+func (c *zstdCodec) Decode(data []byte) ([]byte, error) {
+	if c.decoder == nil {
+		decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		c.decoder = decoder
+	}
+
+	return c.decoder.DecodeAll(data, nil)
+}
@@ -0,0 +1,375 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/wandb/wandb/core/internal/arrowipc"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// historyBatchFlag is set in the codec ID of records that hold a batch
+	// of history records encoded with Apache Arrow.
+	historyBatchFlag = 0x80
+
+	// historyBatchRows is the most history records stored in one batch.
+	historyBatchRows = 1024
+)
+
+// historyBatch collects consecutive numeric history records to store them
+// as one Arrow record batch.
+//
+// Records in a batch differ only in their number, their step and the
+// values of their history items, whose keys and value types must be the
+// same and in the same order. Everything else is stored once, in a template
+// record.
+//
+// Values are numbers parsed from the items' JSON, so they are read back in
+// a canonical form: for example, "3.500000" is read as "3.5".
+type historyBatch struct {
+	// template is the serialized template record, or nil if the batch is
+	// empty.
+	template []byte
+
+	// hasStep is whether the records have a step.
+	hasStep bool
+
+	// columns holds the record numbers, the steps if hasStep, and then
+	// one column per history item.
+	columns []arrowipc.Column
+
+	// buf and values are reused between calls to add.
+	buf    []byte
+	values []historyValue
+}
+
+// historyValue is the number in a history item.
+type historyValue struct {
+	typ     arrowipc.Type
+	integer int64
+	float   float64
+}
+
+// len returns the number of records in the batch.
+func (b *historyBatch) len() int {
+	if b.template == nil {
+		return 0
+	}
+	return b.columns[0].Len()
+}
+
+// firstItem returns the index of the first history item column.
+func (b *historyBatch) firstItem() int {
+	if b.hasStep {
+		return 2
+	}
+	return 1
+}
+
+// add adds the record to the batch, reporting whether it fits.
+//
+// Any history record whose items are all numbers fits an empty batch.
+func (b *historyBatch) add(record *service.Record) bool {
+	history := record.GetHistory()
+	if history == nil {
+		return false
+	}
+
+	b.values = b.values[:0]
+	for _, item := range history.GetItem() {
+		value, ok := parseHistoryItem(item)
+		if !ok {
+			return false
+		}
+		b.values = append(b.values, value)
+	}
+
+	var err error
+	if b.buf, err = marshalHistoryTemplate(b.buf[:0], record); err != nil {
+		return false
+	}
+
+	if b.template == nil {
+		b.start(history)
+	} else if !b.fits(history) {
+		return false
+	}
+
+	b.columns[0].Ints = append(b.columns[0].Ints, record.GetNum())
+	if b.hasStep {
+		b.columns[1].Ints = append(b.columns[1].Ints, history.GetStep().GetNum())
+	}
+	for i, value := range b.values {
+		column := &b.columns[b.firstItem()+i]
+		if value.typ == arrowipc.Float64 {
+			column.Floats = append(column.Floats, value.float)
+		} else {
+			column.Ints = append(column.Ints, value.integer)
+		}
+	}
+
+	return true
+}
+
+// start makes the batch hold records like the history record, whose
+// values and template are in b.values and b.buf.
+func (b *historyBatch) start(history *service.HistoryRecord) {
+	b.template = append([]byte{}, b.buf...)
+	b.hasStep = history.GetStep() != nil
+
+	b.columns = []arrowipc.Column{{Name: "record.num", Type: arrowipc.Int64}}
+	if b.hasStep {
+		b.columns = append(b.columns,
+			arrowipc.Column{Name: "history.step.num", Type: arrowipc.Int64})
+	}
+	for i, item := range history.GetItem() {
+		b.columns = append(b.columns,
+			arrowipc.Column{Name: item.GetKey(), Type: b.values[i].typ})
+	}
+}
+
+// fits returns whether the history record, whose values and template are
+// in b.values and b.buf, can be added to the batch.
+func (b *historyBatch) fits(history *service.HistoryRecord) bool {
+	if (history.GetStep() != nil) != b.hasStep ||
+		len(b.values) != len(b.columns)-b.firstItem() ||
+		string(b.buf) != string(b.template) {
+		return false
+	}
+
+	for i, item := range history.GetItem() {
+		column := &b.columns[b.firstItem()+i]
+		if item.GetKey() != column.Name || b.values[i].typ != column.Type {
+			return false
+		}
+	}
+
+	return true
+}
+
+// encode appends the encoded batch to dst and empties the batch.
+//
+// The data is the length of the template as a uvarint, the template and
+// then an Arrow IPC stream of the columns.
+func (b *historyBatch) encode(dst []byte) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(b.template)))
+	dst = append(dst, b.template...)
+	dst, err := arrowipc.AppendStream(dst, b.columns)
+
+	b.template = nil
+	b.columns = nil
+	return dst, err
+}
+
+// decodeHistoryBatch returns the records of an encoded batch.
+func decodeHistoryBatch(data []byte) ([]*service.Record, error) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return nil, errors.New("server: malformed history batch")
+	}
+
+	template := &service.Record{}
+	if err := proto.Unmarshal(data[n:n+int(size)], template); err != nil {
+		return nil, fmt.Errorf("server: malformed history batch: %v", err)
+	}
+	if template.GetHistory() == nil {
+		return nil, errors.New("server: history batch template isn't history")
+	}
+
+	columns, err := arrowipc.ReadStream(data[n+int(size):])
+	if err != nil {
+		return nil, err
+	}
+
+	firstItem := 1
+	hasStep := template.GetHistory().GetStep() != nil
+	if hasStep {
+		firstItem = 2
+	}
+	if len(columns) < firstItem ||
+		columns[0].Type != arrowipc.Int64 ||
+		columns[firstItem-1].Type != arrowipc.Int64 {
+		return nil, errors.New("server: history batch is missing columns")
+	}
+
+	records := make([]*service.Record, columns[0].Len())
+	for row := range records {
+		record := proto.Clone(template).(*service.Record)
+		record.Num = columns[0].Ints[row]
+
+		history := record.GetHistory()
+		if hasStep {
+			history.Step.Num = columns[1].Ints[row]
+		}
+
+		items := make([]service.HistoryItem, len(columns)-firstItem)
+		history.Item = make([]*service.HistoryItem, len(items))
+		for i := range items {
+			column := &columns[firstItem+i]
+			items[i].Key = column.Name
+			items[i].ValueJson = formatHistoryValue(column, row)
+			history.Item[i] = &items[i]
+		}
+
+		records[row] = record
+	}
+
+	return records, nil
+}
+
+// marshalHistoryTemplate appends the serialized history record to dst,
+// leaving out the fields that are stored in batch columns.
+func marshalHistoryTemplate(
+	dst []byte,
+	record *service.Record,
+) ([]byte, error) {
+	history := shallowCopy(record.GetHistory())
+	history.Item = nil
+	if history.Step != nil {
+		if len(history.Step.ProtoReflect().GetUnknown()) > 0 {
+			return nil, errors.New("server: history step has unknown fields")
+		}
+		history.Step = &service.HistoryStep{}
+	}
+
+	template := shallowCopy(record)
+	template.Num = 0
+	template.RecordType = &service.Record_History{History: history}
+
+	return proto.MarshalOptions{Deterministic: true}.MarshalAppend(dst, template)
+}
+
+// shallowCopy returns a copy of the message that shares its fields.
+//
+// Unlike proto.Clone, it doesn't copy the history items that batches
+// store separately.
+func shallowCopy[M proto.Message](msg M) M {
+	src := msg.ProtoReflect()
+	dst := src.New()
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+	dst.SetUnknown(src.GetUnknown())
+	return dst.Interface().(M)
+}
+
+// parseHistoryItem returns the number in a history item.
+//
+// Returns false if the item's value isn't a number, or if the item can't be
+// stored in a batch column for another reason.
+func parseHistoryItem(item *service.HistoryItem) (historyValue, bool) {
+	if len(item.GetNestedKey()) > 0 ||
+		len(item.ProtoReflect().GetUnknown()) > 0 {
+		return historyValue{}, false
+	}
+
+	valueJSON := item.GetValueJson()
+	switch valueJSON {
+	case "NaN":
+		return historyValue{typ: arrowipc.Float64, float: math.NaN()}, true
+	case "Infinity":
+		return historyValue{typ: arrowipc.Float64, float: math.Inf(1)}, true
+	case "-Infinity":
+		return historyValue{typ: arrowipc.Float64, float: math.Inf(-1)}, true
+	}
+
+	if !isJSONNumber(valueJSON) {
+		return historyValue{}, false
+	}
+
+	if !strings.ContainsAny(valueJSON, ".eE") {
+		integer, err := strconv.ParseInt(valueJSON, 10, 64)
+		if err != nil {
+			return historyValue{}, false
+		}
+		return historyValue{typ: arrowipc.Int64, integer: integer}, true
+	}
+
+	float, err := strconv.ParseFloat(valueJSON, 64)
+	if err != nil || math.IsInf(float, 0) {
+		return historyValue{}, false
+	}
+	return historyValue{typ: arrowipc.Float64, float: float}, true
+}
+
+// isJSONNumber returns whether the string is made of the characters of a
+// JSON number.
+//
+// Together with strconv's parsers, this rejects forms that aren't JSON
+// such as "0x10", "1_000" or "inf".
+func isJSONNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range []byte(s) {
+		if !('0' <= c && c <= '9') && !strings.ContainsRune("+-.eE", rune(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatHistoryValue returns the JSON of a value in a batch column.
+//
+// Floats are formatted like Python's json.dumps so that records read back
+// by wandb-core and by the Python reader are the same.
+func formatHistoryValue(column *arrowipc.Column, row int) string {
+	if column.Type != arrowipc.Float64 {
+		return strconv.FormatInt(column.Ints[row], 10)
+	}
+
+	value := column.Floats[row]
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+
+	// Python uses scientific notation for exponents below -4 or from 16.
+	var buf [32]byte
+	scientific := strconv.AppendFloat(buf[:0], value, 'e', -1, 64)
+	e := bytes.IndexByte(scientific, 'e')
+	exponent, _ := strconv.Atoi(string(scientific[e+1:]))
+	if exponent < -4 || exponent >= 16 {
+		return string(scientific)
+	}
+
+	// Otherwise, move the decimal point of the shortest digits.
+	sign, digits := scientific[:0], scientific[:e]
+	if digits[0] == '-' {
+		sign, digits = digits[:1], digits[1:]
+	}
+	if len(digits) > 1 {
+		digits = append(digits[:1:1], digits[2:]...) // drop the '.'
+	}
+
+	var fixed []byte
+	fixed = append(fixed, sign...)
+	if exponent < 0 {
+		fixed = append(fixed, "0."...)
+		fixed = append(fixed, strings.Repeat("0", -exponent-1)...)
+		fixed = append(fixed, digits...)
+	} else if len(digits) <= exponent+1 {
+		fixed = append(fixed, digits...)
+		fixed = append(fixed, strings.Repeat("0", exponent+1-len(digits))...)
+		fixed = append(fixed, ".0"...)
+	} else {
+		fixed = append(fixed, digits[:exponent+1]...)
+		fixed = append(fixed, '.')
+		fixed = append(fixed, digits[exponent+1:]...)
+	}
+	return string(fixed)
+}
@@ -2,14 +2,19 @@ package server_test
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestValidHeader(t *testing.T) {
@@ -240,3 +245,299 @@ func TestReadFromClosedStore(t *testing.T) {
 	_, err = store.Read()
 	assert.Error(t, err, "can't read record")
 }
+
+func TestCompressedStore_ReadWrite(t *testing.T) {
+	for _, name := range []string{"deflate", "zstd"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "run.wandb")
+			logger := observability.NewNoOpLogger()
+			codec, err := server.NewRecordCodec(name)
+			require.NoError(t, err)
+			records := []*service.Record{
+				{Num: 1, Uuid: strings.Repeat("compressible", 100)},
+				{Num: 2, Uuid: "x"}, // too small to benefit from compression
+			}
+
+			store := server.NewStore(context.Background(), path, logger,
+				server.WithStoreCodec(codec))
+			require.NoError(t, store.Open(os.O_WRONLY))
+			for _, record := range records {
+				require.NoError(t, store.Write(record))
+			}
+			require.NoError(t, store.Close())
+
+			store = server.NewStore(context.Background(), path, logger)
+			require.NoError(t, store.Open(os.O_RDONLY))
+			defer store.Close()
+			for _, record := range records {
+				read, err := store.Read()
+				require.NoError(t, err)
+				assert.Equal(t, record.Num, read.Num)
+				assert.Equal(t, record.Uuid, read.Uuid)
+			}
+			_, err = store.Read()
+			assert.ErrorIs(t, err, io.EOF)
+
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Less(t, info.Size(), int64(len(records[0].Uuid)))
+		})
+	}
+}
+
+func TestCompressedStore_RecoversRecordsBeforeCorruption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	codec, err := server.NewRecordCodec("deflate")
+	require.NoError(t, err)
+
+	store := server.NewStore(context.Background(), path, logger,
+		server.WithStoreCodec(codec))
+	require.NoError(t, store.Open(os.O_WRONLY))
+	require.NoError(t, store.Write(&service.Record{Num: 1, Uuid: "first"}))
+	require.NoError(t, store.Flush())
+	_, err = store.WriteDirectlyToDB([]byte("bad record"))
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	store = server.NewStore(context.Background(), path, logger)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	read, err := store.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "first", read.Uuid)
+	_, err = store.Read()
+	assert.Error(t, err)
+}
+
+func TestNewRecordCodec(t *testing.T) {
+	codec, err := server.NewRecordCodec("none")
+	assert.NoError(t, err)
+	assert.Nil(t, codec)
+
+	_, err = server.NewRecordCodec("unknown")
+	assert.ErrorContains(t, err, "unknown transaction log compression")
+}
+
+func storeHistoryRecord(num int64, step *service.HistoryStep, items ...string) *service.Record {
+	history := &service.HistoryRecord{Step: step}
+	for i := 0; i < len(items); i += 2 {
+		history.Item = append(history.Item,
+			&service.HistoryItem{Key: items[i], ValueJson: items[i+1]})
+	}
+	return &service.Record{
+		Num:        num,
+		RecordType: &service.Record_History{History: history},
+		Control:    &service.Control{Local: true},
+	}
+}
+
+func writeAndReadStore(
+	t *testing.T,
+	records []*service.Record,
+	opts ...server.StoreOption,
+) []*service.Record {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+
+	store := server.NewStore(context.Background(), path, logger, opts...)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range records {
+		require.NoError(t, store.Write(record))
+	}
+	require.NoError(t, store.Close())
+
+	store = server.NewStore(context.Background(), path, logger)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	var read []*service.Record
+	for {
+		record, err := store.Read()
+		if err == io.EOF {
+			return read
+		}
+		require.NoError(t, err)
+		read = append(read, record)
+	}
+}
+
+func assertSameRecords(t *testing.T, expected, actual []*service.Record) {
+	t.Helper()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.True(t, proto.Equal(expected[i], actual[i]),
+			"record %d: expected %v, got %v", i, expected[i], actual[i])
+	}
+}
+
+func TestArrowHistoryStore_ReadWrite(t *testing.T) {
+	step := func(num int64) *service.HistoryStep {
+		return &service.HistoryStep{Num: num}
+	}
+	written := []*service.Record{
+		storeHistoryRecord(1, step(0), "_step", "0", "loss", "0.5", "_runtime", "3.500000"),
+		storeHistoryRecord(2, step(1), "_step", "1", "loss", "NaN", "_runtime", "4.000000"),
+		storeHistoryRecord(3, step(2), "_step", "2", "text", `"abc"`),
+		storeHistoryRecord(4, step(3), "_step", "3", "loss", "1e16", "_runtime", "5"),
+		storeHistoryRecord(5, step(4), "_step", "4", "loss", "1.5e-05", "_runtime", "5"),
+		{Num: 6, Uuid: "not history"},
+		storeHistoryRecord(7, nil, "x", "-0.0", "y", "-Infinity"),
+		storeHistoryRecord(8, nil, "x", "0.0001", "y", "123456789.25"),
+		storeHistoryRecord(9, nil, "big", "12345678901234567890"),
+	}
+	// Numbers are read back in a canonical form, like Python's json.dumps.
+	expected := []*service.Record{
+		storeHistoryRecord(1, step(0), "_step", "0", "loss", "0.5", "_runtime", "3.5"),
+		storeHistoryRecord(2, step(1), "_step", "1", "loss", "NaN", "_runtime", "4.0"),
+		storeHistoryRecord(3, step(2), "_step", "2", "text", `"abc"`),
+		storeHistoryRecord(4, step(3), "_step", "3", "loss", "1e+16", "_runtime", "5"),
+		storeHistoryRecord(5, step(4), "_step", "4", "loss", "1.5e-05", "_runtime", "5"),
+		{Num: 6, Uuid: "not history"},
+		storeHistoryRecord(7, nil, "x", "-0.0", "y", "-Infinity"),
+		storeHistoryRecord(8, nil, "x", "0.0001", "y", "123456789.25"),
+		storeHistoryRecord(9, nil, "big", "12345678901234567890"),
+	}
+
+	for _, compression := range []string{"none", "zstd"} {
+		t.Run(compression, func(t *testing.T) {
+			codec, err := server.NewRecordCodec(compression)
+			require.NoError(t, err)
+			opts := []server.StoreOption{server.WithStoreArrowHistory()}
+			if codec != nil {
+				opts = append(opts, server.WithStoreCodec(codec))
+			}
+
+			read := writeAndReadStore(t, written, opts...)
+
+			assertSameRecords(t, expected, read)
+		})
+	}
+}
+
+func TestArrowHistoryStore_BatchesConsecutiveRecords(t *testing.T) {
+	var records []*service.Record
+	for i := 0; i < 3000; i++ {
+		records = append(records, storeHistoryRecord(int64(i), &service.HistoryStep{Num: int64(i)},
+			"_step", fmt.Sprint(i), "loss", fmt.Sprint(float64(i)+0.5)))
+	}
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+
+	store := server.NewStore(context.Background(), path, logger,
+		server.WithStoreArrowHistory())
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range records {
+		require.NoError(t, store.Write(record))
+	}
+	require.NoError(t, store.Close())
+	store = server.NewStore(context.Background(), path, logger)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	for _, record := range records {
+		read, err := store.Read()
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(record, read), "expected %v, got %v", record, read)
+	}
+	_, err := store.Read()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// Each record's values take 32 bytes in a batch.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, info.Size(), int64(len(records)*40))
+}
+
+func TestArrowHistoryStore_FlushWritesBatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	record := storeHistoryRecord(1, nil, "x", "1")
+
+	store := server.NewStore(context.Background(), path, logger,
+		server.WithStoreArrowHistory())
+	require.NoError(t, store.Open(os.O_WRONLY))
+	defer store.Close()
+	require.NoError(t, store.Write(record))
+	require.NoError(t, store.Flush())
+
+	reader := server.NewStore(context.Background(), path, logger)
+	require.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+	read, err := reader.Read()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(record, read))
+}
+
+func benchmarkHistoryRecords() []*service.Record {
+	// 10,000 steps of 100 metrics, for a million values.
+	records := make([]*service.Record, 10_000)
+	items := make([]string, 0, 200)
+	for i := range records {
+		items = items[:0]
+		for j := 0; j < 100; j++ {
+			items = append(items,
+				fmt.Sprintf("metric_%d", j),
+				fmt.Sprintf("%f", float64(i*j)/7))
+		}
+		records[i] = storeHistoryRecord(int64(i), &service.HistoryStep{Num: int64(i)}, items...)
+	}
+	return records
+}
+
+func BenchmarkStore_WriteHistory(b *testing.B) {
+	records := benchmarkHistoryRecords()
+	for _, encoding := range []string{"json", "arrow"} {
+		b.Run(encoding, func(b *testing.B) {
+			var opts []server.StoreOption
+			if encoding == "arrow" {
+				opts = append(opts, server.WithStoreArrowHistory())
+			}
+			path := filepath.Join(b.TempDir(), "run.wandb")
+			logger := observability.NewNoOpLogger()
+
+			for i := 0; i < b.N; i++ {
+				store := server.NewStore(context.Background(), path, logger, opts...)
+				require.NoError(b, store.Open(os.O_WRONLY))
+				for _, record := range records {
+					require.NoError(b, store.Write(record))
+				}
+				require.NoError(b, store.Close())
+			}
+
+			info, err := os.Stat(path)
+			require.NoError(b, err)
+			b.ReportMetric(float64(info.Size()), "file-bytes")
+		})
+	}
+}
+
+func BenchmarkStore_ReadHistory(b *testing.B) {
+	records := benchmarkHistoryRecords()
+	for _, encoding := range []string{"json", "arrow"} {
+		b.Run(encoding, func(b *testing.B) {
+			var opts []server.StoreOption
+			if encoding == "arrow" {
+				opts = append(opts, server.WithStoreArrowHistory())
+			}
+			path := filepath.Join(b.TempDir(), "run.wandb")
+			logger := observability.NewNoOpLogger()
+			store := server.NewStore(context.Background(), path, logger, opts...)
+			require.NoError(b, store.Open(os.O_WRONLY))
+			for _, record := range records {
+				require.NoError(b, store.Write(record))
+			}
+			require.NoError(b, store.Close())
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				store := server.NewStore(context.Background(), path, logger)
+				require.NoError(b, store.Open(os.O_RDONLY))
+				for range records {
+					_, err := store.Read()
+					require.NoError(b, err)
+				}
+				require.NoError(b, store.Close())
+			}
+		})
+	}
+}
@@ -9,24 +9,47 @@ import (
 	"path/filepath"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/capabilities"
+	"github.com/wandb/wandb/core/internal/droppeddata"
 	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/healthprobe"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/netpause"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runsummary"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/artifacts"
 	"github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/shutdownhooks"
 	"github.com/wandb/wandb/core/pkg/utils"
 )
 
 const (
 	internalConnectionId = "internal"
+
+	// maxRunDurationExitCode is the exit code used when a run is finished
+	// because it exceeded its maximum duration.
+	maxRunDurationExitCode = 1
+
+	// killedExitCode is the exit code used when a run is killed, matching
+	// an interrupted Python process.
+	killedExitCode = 255
+
+	// killFlushTimeout is how long a killed run may spend sending its
+	// remaining data before it is abandoned.
+	killFlushTimeout = 10 * time.Second
+
+	// networkPauseCheckPeriod is how often to check for the network
+	// pause file.
+	networkPauseCheckPeriod = time.Second
 )
 
 // Stream is a collection of components that work together to handle incoming
@@ -63,6 +86,10 @@ type Stream struct {
 	// inChan is the channel for incoming messages
 	inChan chan *service.Record
 
+	// handlerChan is the channel of records from inChan and loopBackChan
+	// for the handler
+	handlerChan chan *service.Record
+
 	// loopBackChan is the channel for internal loopback messages
 	loopBackChan chan *service.Record
 
@@ -74,6 +101,49 @@ type Stream struct {
 
 	// closed indicates if the inChan and loopBackChan are closed
 	closed *atomic.Bool
+
+	// finishing indicates if FinishAndClose has been called
+	finishing *atomic.Bool
+
+	// maxRunDurationTimer finishes the run once it exceeds the configured
+	// maximum duration; nil if there is no limit
+	maxRunDurationTimer *time.Timer
+
+	// flushBarriers tracks pending Flush calls
+	flushBarriers *FlushBarriers
+
+	// networkGate holds back network requests while the network pause
+	// file exists; nil if there is no such file
+	networkGate *netpause.Gate
+
+	// stopNetworkPauseWatch stops watching the network pause file and
+	// resumes the network; nil if there is no such file
+	stopNetworkPauseWatch func()
+
+	// droppedData counts data that was dropped or failed to upload
+	droppedData *droppeddata.Tracker
+
+	// staging holds copies of files made by core for uploading
+	staging *artifacts.StagingArea
+
+	// capabilities are the backend's optional features; nil if there is
+	// no backend
+	capabilities *capabilities.Capabilities
+
+	// healthStatus tracks successful syncs for the health probe; nil if
+	// the probe is disabled
+	healthStatus *healthprobe.Status
+
+	// stopHealthProbe stops the health probe server; nil if it isn't
+	// running
+	stopHealthProbe func()
+
+	// shutdownHooks are called before the stream finishes
+	shutdownHooks shutdownhooks.Hooks
+
+	// pendingExit tracks the client's exit record while it waits for the
+	// shutdown hooks, so that the stream isn't closed before it's handled
+	pendingExit sync.WaitGroup
 }
 
 func streamLogger(settings *settings.Settings) *observability.CoreLogger {
@@ -92,7 +162,11 @@ func streamLogger(settings *settings.Settings) *observability.CoreLogger {
 
 	var writers []io.Writer
 	name := settings.GetInternalLogFile()
-	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	file, err := observability.OpenRotatingFile(
+		name,
+		settings.GetInternalLogMaxBytes(),
+		settings.GetInternalLogMaxFiles(),
+	)
 	if err != nil {
 		slog.Error(fmt.Sprintf("error opening log file: %s", err))
 	} else {
@@ -130,8 +204,37 @@ func streamLogger(settings *settings.Settings) *observability.CoreLogger {
 	return logger
 }
 
+// QueueDepths is the number of records waiting in each of a stream's
+// queues.
+type QueueDepths struct {
+	// Incoming is the number of records not yet picked up by the stream.
+	Incoming int
+
+	// Handler, Writer and Sender are the number of records waiting to be
+	// processed by each stage.
+	Handler int
+	Writer  int
+	Sender  int
+}
+
 // NewStream creates a new stream with the given settings and responders.
-func NewStream(settings *settings.Settings, _ string) *Stream {
+//
+// Returns an error if the run can't be started with these settings, such
+// as when its directory isn't writable.
+func NewStream(settings *settings.Settings, _ string) (*Stream, error) {
+	// Records queue up between stages when the network is slow. Bounding
+	// the queues makes logging block instead of using unbounded memory.
+	queueSize := settings.GetRecordQueueSize()
+	if queueSize == 0 {
+		queueSize = BufferSize
+	}
+
+	// The logger and the staging area live in the run directory, so it
+	// must be checked before they are created.
+	if err := settings.CheckRunDirWritable(); err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	s := &Stream{
 		ctx:          ctx,
@@ -139,36 +242,144 @@ func NewStream(settings *settings.Settings, _ string) *Stream {
 		logger:       streamLogger(settings),
 		wg:           sync.WaitGroup{},
 		settings:     settings,
-		inChan:       make(chan *service.Record, BufferSize),
+		inChan:       make(chan *service.Record, queueSize),
+		handlerChan:  make(chan *service.Record, queueSize),
 		loopBackChan: make(chan *service.Record, BufferSize),
 		outChan:      make(chan *service.ServerResponse, BufferSize),
 		closed:       &atomic.Bool{},
+		finishing:    &atomic.Bool{},
+
+		flushBarriers: NewFlushBarriers(),
+		droppedData:   droppeddata.New(),
+		staging:       NewStagingArea(settings),
 	}
 
 	// TODO: replace this with a logger that can be read by the user
 	peeker := &observability.Peeker{}
 	terminalPrinter := observability.NewPrinter()
 
-	backendOrNil := NewBackend(s.logger, settings)
+	if err := settings.SanitizeExtraHTTPHeaders(); err != nil {
+		s.logger.Warn("stream: invalid extra HTTP headers", "error", err)
+		terminalPrinter.Write(err.Error())
+	}
+
+	if err := settings.ValidateUserAgentToken(); err != nil {
+		s.logger.Warn("stream: invalid User-Agent token", "error", err)
+		terminalPrinter.Write(err.Error())
+	}
+
+	if err := settings.ValidateTransactionLog(); err != nil {
+		s.logger.Warn("stream: keeping transaction log", "error", err)
+		terminalPrinter.Write(err.Error())
+	}
+
+	if err := settings.ValidateSummaryEnvNonScalar(); err != nil {
+		s.logger.Warn("stream: skipping non-scalar summary values", "error", err)
+		terminalPrinter.Write(err.Error())
+	}
+
+	if err := monitor.ValidateDisabledAssets(settings.Proto); err != nil {
+		s.logger.Warn("stream: unknown disabled assets", "error", err)
+		terminalPrinter.Write(err.Error())
+	}
+
+	if err := settings.ValidateFaultInjection(); err != nil {
+		s.logger.Warn("stream: not injecting faults", "error", err)
+		terminalPrinter.Write(err.Error())
+	} else if spec := settings.GetFaultInjection(); spec != "" {
+		s.logger.Warn("stream: injecting network faults", "spec", spec)
+		terminalPrinter.Write(fmt.Sprintf(
+			"Injecting network faults for testing: %s", spec))
+	}
+
+	var networkGateOrNil *netpause.Gate
+	if path := settings.GetNetworkPauseFile(); path != "" {
+		networkGateOrNil = netpause.New()
+		s.networkGate = networkGateOrNil
+		s.stopNetworkPauseWatch = networkGateOrNil.WatchFile(
+			path,
+			networkPauseCheckPeriod,
+			func(paused bool) {
+				if paused {
+					s.logger.Warn("stream: network paused", "path", path)
+					terminalPrinter.Write(fmt.Sprintf(
+						"Network requests are paused until %s is removed.", path))
+				} else {
+					s.logger.Info("stream: network resumed", "path", path)
+					terminalPrinter.Write("Network requests resumed.")
+				}
+			},
+		)
+	}
+
+	if settings.IsHealthProbeEnabled() {
+		s.healthStatus = healthprobe.New(settings.IsOffline())
+		addr, stop, err := s.healthStatus.Serve(settings.GetHealthProbeAddr())
+		if err != nil {
+			s.logger.CaptureError("stream: failed to start health probe", err)
+			terminalPrinter.Write(fmt.Sprintf("Health probe not started: %v", err))
+		} else {
+			s.logger.Info("stream: serving health probe", "addr", addr)
+			terminalPrinter.Write(fmt.Sprintf("Health probe serving at http://%s", addr))
+			s.stopHealthProbe = stop
+		}
+	}
+
+	anonymousAuthOrNil := NewAnonymousAuthOrNil(s.logger, settings)
+	credentialHelperOrNil := NewCredentialHelperOrNil(settings)
+	backendOrNil := NewBackend(
+		s.logger,
+		settings,
+		anonymousAuthOrNil,
+		networkGateOrNil,
+		credentialHelperOrNil,
+	)
 	fileTransferStats := filetransfer.NewFileTransferStats()
 	fileWatcher := watcher.New(watcher.Params{Logger: s.logger})
+
+	var syncCheckpointOrNil *SyncCheckpoint
+	if settings.IsSync() {
+		checkpoint, err := LoadSyncCheckpoint(settings.Proto.GetSyncFile().GetValue())
+		if err != nil {
+			s.logger.CaptureError("stream: ignoring sync checkpoint", err)
+		}
+		syncCheckpointOrNil = checkpoint
+	}
+
+	// Avoid a non-nil interface holding a nil pointer.
+	var committedFiles runfiles.CommittedFiles
+	if syncCheckpointOrNil != nil {
+		committedFiles = syncCheckpointOrNil
+	}
+
 	var graphqlClientOrNil graphql.Client
 	var fileStreamOrNil filestream.FileStream
 	var fileTransferManagerOrNil filetransfer.FileTransferManager
 	var runfilesUploaderOrNil runfiles.Uploader
 	if backendOrNil != nil {
 		graphqlClientOrNil = NewGraphQLClient(backendOrNil, settings, peeker)
+		s.capabilities = capabilities.New(
+			graphqlClientOrNil,
+			s.logger,
+			terminalPrinter,
+		)
 		fileStreamOrNil = NewFileStream(
 			backendOrNil,
 			s.logger,
 			terminalPrinter,
 			settings,
 			peeker,
+			s.healthStatus,
+			s.droppedData,
 		)
 		fileTransferManagerOrNil = NewFileTransferManager(
 			fileTransferStats,
 			s.logger,
 			settings,
+			networkGateOrNil,
+			credentialHelperOrNil,
+			s.droppedData,
+			s.healthStatus,
 		)
 		runfilesUploaderOrNil = NewRunfilesUploader(
 			s.ctx,
@@ -178,6 +389,8 @@ func NewStream(settings *settings.Settings, _ string) *Stream {
 			fileTransferManagerOrNil,
 			fileWatcher,
 			graphqlClientOrNil,
+			committedFiles,
+			s.staging,
 		)
 	}
 
@@ -187,16 +400,21 @@ func NewStream(settings *settings.Settings, _ string) *Stream {
 		&HandlerParams{
 			Logger:            s.logger,
 			Settings:          s.settings.Proto,
-			FwdChan:           make(chan *service.Record, BufferSize),
+			FwdChan:           make(chan *service.Record, queueSize),
 			OutChan:           make(chan *service.Result, BufferSize),
 			SystemMonitor:     monitor.NewSystemMonitor(s.logger, s.settings.Proto, s.loopBackChan),
 			RunfilesUploader:  runfilesUploaderOrNil,
 			TBHandler:         NewTBHandler(fileWatcher, s.logger, s.settings.Proto, s.loopBackChan),
+			LogFiles:          NewLogFiles(fileWatcher, s.logger, s.loopBackChan),
 			FileTransferStats: fileTransferStats,
 			RunSummary:        runsummary.New(),
 			MetricHandler:     NewMetricHandler(),
 			Mailbox:           mailbox,
 			TerminalPrinter:   terminalPrinter,
+			ClockCorrector:    NewClockCorrectorOrNil(s.logger, terminalPrinter, settings),
+			DroppedData:       s.droppedData,
+			Staging:           s.staging,
+			RunLockDir:        runLockDir(),
 		},
 	)
 
@@ -204,9 +422,15 @@ func NewStream(settings *settings.Settings, _ string) *Stream {
 		&WriterParams{
 			Logger:   s.logger,
 			Settings: s.settings.Proto,
-			FwdChan:  make(chan *service.Record, BufferSize),
+			FwdChan:  make(chan *service.Record, queueSize),
+
+			FlushBarriers: s.flushBarriers,
 		},
 	)
+	if settings.IsHistoryJSONLEnabled() && !settings.IsSync() {
+		s.writer.AddSinks(newHistoryJSONLSink(
+			filepath.Join(settings.GetSyncDir(), HistoryJSONLFileName)))
+	}
 
 	s.sender = NewSender(
 		s.ctx,
@@ -217,6 +441,8 @@ func NewStream(settings *settings.Settings, _ string) *Stream {
 			Backend:             backendOrNil,
 			FileStream:          fileStreamOrNil,
 			FileTransferManager: fileTransferManagerOrNil,
+			FileTransferStats:   fileTransferStats,
+			SyncCheckpoint:      syncCheckpointOrNil,
 			FileWatcher:         fileWatcher,
 			RunfilesUploader:    runfilesUploaderOrNil,
 			Peeker:              peeker,
@@ -225,13 +451,36 @@ func NewStream(settings *settings.Settings, _ string) *Stream {
 			FwdChan:             s.loopBackChan,
 			OutChan:             make(chan *service.Result, BufferSize),
 			Mailbox:             mailbox,
+			FlushBarriers:       s.flushBarriers,
+			AnonymousAuth:       anonymousAuthOrNil,
+			TerminalPrinter:     terminalPrinter,
+			Staging:             s.staging,
+			Capabilities:        s.capabilities,
 		},
 	)
 
 	s.dispatcher = NewDispatcher(s.logger)
 
 	s.logger.Info("created new stream", "id", s.settings.GetRunID())
-	return s
+	return s, nil
+}
+
+// AddSinks adds destinations for the stream's records besides the W&B
+// backend.
+//
+// It must be called before Start.
+func (s *Stream) AddSinks(sinks ...Sink) {
+	s.writer.AddSinks(sinks...)
+}
+
+// AddCollectors registers custom system metric collectors.
+//
+// Collectors are sampled along with the built-in system metrics. They may
+// be added concurrently with the stream, but not once system metrics are
+// being sampled. Returns an error for collectors that can't be registered,
+// see monitor.SystemMonitor.AddCollectors.
+func (s *Stream) AddCollectors(collectors ...monitor.Collector) error {
+	return s.handler.systemMonitor.AddCollectors(collectors...)
 }
 
 // AddResponders adds the given responders to the stream's dispatcher.
@@ -239,12 +488,39 @@ func (s *Stream) AddResponders(entries ...ResponderEntry) {
 	s.dispatcher.AddResponders(entries...)
 }
 
+// AddShutdownHook registers a function to call when the stream finishes.
+//
+// Hooks run when the client's exit record arrives, or in FinishAndClose or
+// Kill if the run is stopped without one. They are called in the reverse
+// order they were added, before the run's exit is recorded and before the
+// stream's components are shut down, so they may still send records. Each
+// hook may run for up to its timeout, or shutdownhooks.DefaultTimeout if
+// the timeout is not positive; after that, the stream stops waiting for
+// it. A panic in a hook is logged and does not stop the stream from
+// finishing.
+//
+// Returns false if the stream is already finishing, in which case the hook
+// is never called.
+func (s *Stream) AddShutdownHook(
+	name string,
+	timeout time.Duration,
+	hook shutdownhooks.Hook,
+) bool {
+	return s.shutdownHooks.Add(name, timeout, hook)
+}
+
 // Start starts the stream's handler, writer, sender, and dispatcher.
 // We use Stream's wait group to ensure that all of these components are cleanly
 // finalized and closed when the stream is closed in Stream.Close().
 func (s *Stream) Start() {
+	// check for optional backend features up front, so that unsupported
+	// ones are reported once at the start of the run
+	if s.capabilities != nil {
+		go s.capabilities.Detect(s.ctx, capabilities.OptionalFeatures...)
+	}
+
 	// forward records from the inChan and loopBackChan to the handler
-	fwdChan := make(chan *service.Record, BufferSize)
+	fwdChan := s.handlerChan
 	s.wg.Add(1)
 	go func() {
 		wg := sync.WaitGroup{}
@@ -300,10 +576,53 @@ func (s *Stream) Start() {
 		close(s.outChan)
 		s.wg.Done()
 	}()
+	// finish the run if it is still going after the maximum duration
+	if d := s.settings.GetMaxRunDuration(); d > 0 && !s.settings.IsSync() {
+		s.maxRunDurationTimer = time.NewTimer(d)
+		go s.watchMaxRunDuration(s.maxRunDurationTimer)
+	}
+
 	s.logger.Debug("starting stream", "id", s.settings.GetRunID())
 }
 
+// watchMaxRunDuration finishes the run if the timer fires before
+// the stream is done.
+func (s *Stream) watchMaxRunDuration(timer *time.Timer) {
+	select {
+	case <-timer.C:
+		s.logger.CaptureWarn(
+			"stream: run exceeded its maximum duration, finishing",
+			"id", s.settings.GetRunID(),
+			"max_run_duration", s.settings.GetMaxRunDuration(),
+		)
+		s.FinishAndClose(maxRunDurationExitCode)
+	case <-s.ctx.Done():
+	}
+}
+
+// stopMaxRunDurationTimer cancels the maximum run duration timeout.
+func (s *Stream) stopMaxRunDurationTimer() {
+	if s.maxRunDurationTimer != nil {
+		s.maxRunDurationTimer.Stop()
+	}
+}
+
+// QueueDepths returns the current number of records in each queue.
+//
+// This is for diagnostics: a full queue means logging is blocked on a
+// slower stage, such as the sender when the network is slow.
+func (s *Stream) QueueDepths() QueueDepths {
+	return QueueDepths{
+		Incoming: len(s.inChan),
+		Handler:  len(s.handlerChan),
+		Writer:   len(s.handler.fwdChan),
+		Sender:   len(s.writer.fwdChan),
+	}
+}
+
 // HandleRecord handles the given record by sending it to the stream's handler.
+//
+// It blocks while the stream's incoming queue is full.
 func (s *Stream) HandleRecord(rec *service.Record) {
 	s.logger.Debug("handling record", "record", rec)
 	if s.closed.Load() {
@@ -311,7 +630,181 @@ func (s *Stream) HandleRecord(rec *service.Record) {
 		s.logger.Error("context done, not handling record", "record", rec)
 		return
 	}
-	s.inChan <- rec
+	if rec.GetExit() != nil && rec.GetControl().GetConnectionId() != internalConnectionId {
+		// the run is finishing normally, so there is no need for the timeout
+		s.stopMaxRunDurationTimer()
+
+		// the run may already be finishing, e.g. due to exceeding its
+		// maximum duration, and a second exit record would restart the
+		// sender's defer state machine
+		if s.finishing.Swap(true) {
+			s.logger.Info("stream: run is already finishing, ignoring exit record")
+			s.respondExit(rec)
+			return
+		}
+
+		// Hooks can take a while, so they run without blocking the
+		// client's connection, and the exit record is handled after them.
+		s.pendingExit.Add(1)
+		go func() {
+			defer s.pendingExit.Done()
+			s.shutdownHooks.Run(s.logger)
+			s.enqueue(rec)
+		}()
+		return
+	}
+
+	// a client's flush has no barrier yet, since only the stream knows
+	// when the flush completes
+	if flush := rec.GetRequest().GetFlush(); flush != nil && flush.GetId() == 0 {
+		s.flushForClient(rec)
+		return
+	}
+
+	s.enqueue(rec)
+}
+
+// enqueue sends the record to the stream's handler.
+//
+// It blocks while the stream's incoming queue is full.
+func (s *Stream) enqueue(rec *service.Record) {
+	select {
+	case s.inChan <- rec:
+	default:
+		s.logger.Debug(
+			"stream: incoming queue is full, waiting",
+			"depths", s.QueueDepths())
+		s.inChan <- rec
+	}
+}
+
+// respondExit answers an exit record that is ignored because the run is
+// already finishing.
+func (s *Stream) respondExit(rec *service.Record) {
+	if !rec.GetControl().GetReqResp() && rec.GetControl().GetMailboxSlot() == "" {
+		return
+	}
+	s.dispatcher.handleRespond(&service.Result{
+		ResultType: &service.Result_ExitResult{ExitResult: &service.RunExitResult{}},
+		Control:    rec.GetControl(),
+		Uuid:       rec.GetUuid(),
+	})
+}
+
+// Flush blocks until all records handled so far are durably persisted.
+//
+// It waits until the records are written to the transaction log and synced
+// to disk and then, unless offline, until the filestream API acknowledges
+// them. It returns the last stage that completed, which is less than
+// FlushStageSent if the context is canceled, the stream closes or a stage
+// fails first. Before the run starts, records cannot be sent, so it
+// returns once they are persisted, like when offline.
+//
+// Partial history that has not been committed to a step is not flushed.
+func (s *Stream) Flush(ctx context.Context) (FlushStage, error) {
+	id, barrier := s.startFlush()
+	defer s.flushBarriers.remove(id)
+
+	return s.waitFlush(ctx, barrier)
+}
+
+// startFlush adds a FlushRequest behind the records handled so far.
+//
+// The caller must remove the returned barrier once done waiting on it.
+func (s *Stream) startFlush() (int64, *flushBarrier) {
+	id, barrier := s.flushBarriers.add()
+
+	s.HandleRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Flush{
+					Flush: &service.FlushRequest{Id: id},
+				},
+			},
+		},
+	})
+
+	return id, barrier
+}
+
+// waitFlush waits for the barrier of a flush started with startFlush.
+func (s *Stream) waitFlush(
+	ctx context.Context,
+	barrier *flushBarrier,
+) (FlushStage, error) {
+	select {
+	case <-barrier.persisted:
+	case <-barrier.failed:
+		return FlushStageNone, barrier.err
+	case <-ctx.Done():
+		return FlushStageNone, ctx.Err()
+	case <-s.ctx.Done():
+		return FlushStageNone, fmt.Errorf("stream: closed before flush completed")
+	}
+
+	if s.settings.IsOffline() {
+		return FlushStagePersisted, nil
+	}
+
+	select {
+	case <-barrier.sent:
+		return FlushStageSent, nil
+	case <-barrier.unsendable:
+		return FlushStagePersisted, nil
+	case <-barrier.failed:
+		return FlushStagePersisted, barrier.err
+	case <-ctx.Done():
+		return FlushStagePersisted, ctx.Err()
+	case <-s.ctx.Done():
+		return FlushStagePersisted, fmt.Errorf("stream: closed before flush completed")
+	}
+}
+
+// flushForClient flushes on behalf of a client's FlushRequest and responds
+// with the stage that completed.
+//
+// The request is placed behind the records handled so far right away, but
+// the response is sent asynchronously so that the client's other records
+// keep being handled.
+func (s *Stream) flushForClient(rec *service.Record) {
+	id, barrier := s.startFlush()
+
+	go func() {
+		defer s.flushBarriers.remove(id)
+
+		// The client may never read the response, so stop waiting once
+		// the stream shuts down.
+		stage, err := s.waitFlush(s.ctx, barrier)
+		response := &service.FlushResponse{Stage: stage.Proto()}
+		if err != nil {
+			response.Error = err.Error()
+		}
+
+		s.dispatcher.handleRespond(&service.Result{
+			ResultType: &service.Result_Response{
+				Response: &service.Response{
+					ResponseType: &service.Response_FlushResponse{
+						FlushResponse: response,
+					},
+				},
+			},
+			Control: rec.GetControl(),
+			Uuid:    rec.GetUuid(),
+		})
+	}()
+}
+
+// releaseNetworkPause stops watching the network pause file and resumes
+// network requests.
+func (s *Stream) releaseNetworkPause() {
+	if s.stopNetworkPauseWatch == nil {
+		return
+	}
+
+	if s.networkGate.IsPaused() {
+		s.logger.Warn("stream: resuming paused network to finish the run")
+	}
+	s.stopNetworkPauseWatch()
 }
 
 // Close Gracefully wait for handler, writer, sender, dispatcher to shut down cleanly
@@ -319,11 +812,35 @@ func (s *Stream) HandleRecord(rec *service.Record) {
 func (s *Stream) Close() {
 	// wait for the context to be canceled in the defer state machine in the sender
 	<-s.ctx.Done()
+	s.stopMaxRunDurationTimer()
+	s.pendingExit.Wait()
 	if !s.closed.Swap(true) {
 		close(s.loopBackChan)
 		close(s.inChan)
 	}
 	s.wg.Wait()
+	if s.stopNetworkPauseWatch != nil {
+		s.stopNetworkPauseWatch()
+	}
+	if s.stopHealthProbe != nil {
+		s.stopHealthProbe()
+	}
+	// Offline runs upload their staged files when synced later.
+	if !s.settings.IsOffline() {
+		s.staging.RemoveAll()
+	}
+	if !s.droppedData.IsEmpty() {
+		s.logger.Warn(
+			"stream: some data was dropped or failed to upload",
+			"summary", droppeddata.Format(s.droppedData.Summary()),
+		)
+	}
+}
+
+// DroppedData returns counts and examples of data that was dropped or
+// failed to upload so far.
+func (s *Stream) DroppedData() *service.DroppedDataSummary {
+	return s.droppedData.Summary()
 }
 
 // Respond Handle internal responses like from the finish and close path
@@ -335,20 +852,91 @@ func (s *Stream) Respond(resp *service.ServerResponse) {
 // This will be called when we recieve a teardown signal from the client.
 // So it is used to close all active streams in the system.
 func (s *Stream) FinishAndClose(exitCode int32) {
+	s.finishAndClose(&service.RunExitRecord{ExitCode: exitCode})
+}
+
+// Kill aborts the run and marks it as killed rather than finished or
+// failed.
+//
+// The run stops like with FinishAndClose, except that data that isn't sent
+// within a short deadline is abandoned.
+func (s *Stream) Kill(reason string) {
+	s.logger.Info("stream: killing run", "id", s.settings.GetRunID(), "reason", reason)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.finishAndClose(&service.RunExitRecord{
+			ExitCode:   killedExitCode,
+			Killed:     true,
+			KillReason: reason,
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(killFlushTimeout):
+		s.logger.CaptureWarn(
+			"stream: killed run did not stop in time, abandoning unsent data",
+			"id", s.settings.GetRunID(),
+			"timeout", killFlushTimeout,
+		)
+		s.abort()
+	}
+}
+
+// abort cancels the stream and stops the components that send data in
+// the background, so that a stream that is stuck closing can finish.
+func (s *Stream) abort() {
+	s.cancel()
+
+	if s.sender != nil {
+		if s.sender.fileStream != nil {
+			s.sender.fileStream.Abort()
+		}
+		if s.sender.fileWatcher != nil {
+			s.sender.fileWatcher.Finish()
+		}
+	}
+
+	if s.handler != nil {
+		s.handler.systemMonitor.Stop()
+	}
+}
+
+// finishAndClose sends the exit record and closes the stream.
+func (s *Stream) finishAndClose(exit *service.RunExitRecord) {
+	s.stopMaxRunDurationTimer()
+
+	// the run may already be finishing, e.g. due to exceeding its
+	// maximum duration, in which case we only wait for it to close
+	if s.finishing.Swap(true) {
+		s.Close()
+		return
+	}
+
+	s.shutdownHooks.Run(s.logger)
+
+	// Teardown can't wait for the network pause file to be removed, since
+	// the process is exiting, so buffered data is sent now.
+	s.releaseNetworkPause()
+
 	s.AddResponders(ResponderEntry{s, internalConnectionId})
 
 	if !s.settings.IsSync() {
 		// send exit record to handler
 		record := &service.Record{
-			RecordType: &service.Record_Exit{
-				Exit: &service.RunExitRecord{
-					ExitCode: exitCode,
-				}},
-			Control: &service.Control{AlwaysSend: true, ConnectionId: internalConnectionId, ReqResp: true},
+			RecordType: &service.Record_Exit{Exit: exit},
+			Control:    &service.Control{AlwaysSend: true, ConnectionId: internalConnectionId, ReqResp: true},
 		}
 
 		s.HandleRecord(record)
-		<-s.outChan
+
+		// the stream is canceled if it is killed and doesn't exit in time
+		select {
+		case <-s.outChan:
+		case <-s.ctx.Done():
+		}
 	}
 
 	s.Close()
@@ -364,3 +952,15 @@ func (s *Stream) FinishAndClose(exitCode int32) {
 
 	s.logger.Info("closed stream", "id", s.settings.GetRunID())
 }
+
+// runLockDir is where runs are locked to detect multiple processes logging
+// to the same run.
+//
+// Locks are per user, so it prefers the user's cache directory.
+func runLockDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "wandb", "run-locks")
+}
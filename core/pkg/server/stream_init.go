@@ -6,27 +6,46 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/wandb/wandb/core/internal/api"
 	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/internal/clocksync"
+	"github.com/wandb/wandb/core/internal/droppeddata"
+	"github.com/wandb/wandb/core/internal/faultinject"
 	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gqlrouter"
+	"github.com/wandb/wandb/core/internal/healthprobe"
+	"github.com/wandb/wandb/core/internal/netpause"
+	"github.com/wandb/wandb/core/internal/persistedquery"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/waiting"
 	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/artifacts"
 	"github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/utils"
 )
 
 // NewBackend returns a Backend or nil if we're offline.
+//
+// Requests are authenticated using anonymousAuth if it's not nil, then
+// using credentialHelper if it's not nil, and using the API key from the
+// settings otherwise. They are held back while networkGate is paused, if
+// it's not nil.
 func NewBackend(
 	logger *observability.CoreLogger,
 	settings *settings.Settings,
+	anonymousAuth *AnonymousAuth,
+	networkGate *netpause.Gate,
+	credentialHelper *api.CredentialHelper,
 ) *api.Backend {
 	if settings.IsOffline() {
 		return nil
@@ -36,11 +55,116 @@ func NewBackend(
 	if err != nil {
 		logger.CaptureFatalAndPanic("sender: failed to parse base URL", err)
 	}
-	return api.New(api.BackendOptions{
-		BaseURL: baseURL,
-		Logger:  logger.Logger,
-		APIKey:  settings.GetAPIKey(),
+
+	opts := api.BackendOptions{
+		BaseURL:     baseURL,
+		Logger:      logger.Logger,
+		APIKey:      settings.GetAPIKey(),
+		NetworkGate: networkGate,
+		Warmup:      settings.GetNetworkWarmup(),
+
+		UserAgentToken: settings.GetUserAgentToken(),
+	}
+	for _, otherURL := range []string{
+		settings.GetGraphQLQueryBaseURL(),
+		settings.GetGraphQLMutationBaseURL(),
+	} {
+		if otherURL == baseURL.String() {
+			continue
+		}
+
+		parsed, err := url.Parse(otherURL)
+		if err != nil {
+			logger.CaptureFatalAndPanic("sender: failed to parse GraphQL base URL", err)
+		}
+		opts.AdditionalURLs = append(opts.AdditionalURLs, parsed)
+	}
+	if credentialHelper != nil {
+		opts.AuthProvider = credentialHelper
+		opts.Proxy = credentialHelper.Proxy
+	} else {
+		// Long runs can outlive their API key. A key from .netrc is read
+		// again if the backend rejects it, so that logging in again fixes
+		// the run; other keys are used as given.
+		if settings.IsAPIKeyFromNetrc() {
+			opts.AuthProvider = api.NewReloadableAPIKey(
+				settings.GetAPIKey(),
+				settings.ReadNetrcAPIKey,
+			)
+		}
+
+		// Credential helpers add their own credentials to the proxy, so
+		// only streams without one share connections.
+		opts.Proxy = ProxyFunc(settings.GetProxies())
+		opts.Transport = sharedTransports.Get(
+			baseURL.String(),
+			settings.GetProxies(),
+		)
+	}
+	if anonymousAuth != nil {
+		opts.AuthProvider = anonymousAuth
+	}
+	if faults := newFaultInjectorOrNil(settings); faults != nil {
+		opts.Middleware = append(opts.Middleware, faults.Wrap)
+	}
+	return api.New(opts)
+}
+
+// newFaultInjectorOrNil returns an Injector if the settings enable fault
+// injection and nil otherwise.
+//
+// The setting is validated when the stream is created.
+func newFaultInjectorOrNil(settings *settings.Settings) *faultinject.Injector {
+	config, err := faultinject.Parse(settings.GetFaultInjection())
+	if err != nil {
+		return nil
+	}
+	return faultinject.New(config)
+}
+
+// NewCredentialHelperOrNil returns a CredentialHelper if the settings
+// configure one and nil otherwise.
+func NewCredentialHelperOrNil(settings *settings.Settings) *api.CredentialHelper {
+	command := settings.GetCredentialHelper()
+	if command == "" {
+		return nil
+	}
+
+	return api.NewCredentialHelper(command, settings.GetAPIKey())
+}
+
+// NewAnonymousAuthOrNil returns an AnonymousAuth if the settings enable
+// anonymous mode and nil otherwise.
+func NewAnonymousAuthOrNil(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) *AnonymousAuth {
+	if !settings.IsAnonymous() {
+		return nil
+	}
+
+	baseURL, err := url.Parse(settings.Proto.GetBaseUrl().GetValue())
+	if err != nil {
+		logger.CaptureFatalAndPanic("sender: failed to parse base URL", err)
+	}
+
+	// The anonymous API key is created by an unauthenticated request.
+	backend := api.New(api.BackendOptions{
+		BaseURL:        baseURL,
+		Logger:         logger.Logger,
+		AuthProvider:   api.Unauthenticated,
+		UserAgentToken: settings.GetUserAgentToken(),
 	})
+	httpClient := backend.NewClient(api.ClientOptions{
+		RetryPolicy:     clients.CheckRetry,
+		RetryMax:        int(settings.Proto.GetXGraphqlRetryMax().GetValue()),
+		RetryWaitMin:    clients.SecondsToDuration(settings.Proto.GetXGraphqlRetryWaitMinSeconds().GetValue()),
+		RetryWaitMax:    clients.SecondsToDuration(settings.Proto.GetXGraphqlRetryWaitMaxSeconds().GetValue()),
+		NonRetryTimeout: settings.GetGraphQLTimeout(),
+	})
+	endpoint := fmt.Sprintf("%s/graphql", settings.Proto.GetBaseUrl().GetValue())
+
+	return NewAnonymousAuth(graphql.NewClient(endpoint, httpClient))
 }
 
 func NewGraphQLClient(
@@ -48,24 +172,37 @@ func NewGraphQLClient(
 	settings *settings.Settings,
 	peeker *observability.Peeker,
 ) graphql.Client {
-	graphqlHeaders := map[string]string{
-		"X-WANDB-USERNAME":   settings.Proto.GetUsername().GetValue(),
-		"X-WANDB-USER-EMAIL": settings.Proto.GetEmail().GetValue(),
+	graphqlHeaders := maps.Clone(settings.GetExtraHTTPHeaders())
+	if graphqlHeaders == nil {
+		graphqlHeaders = make(map[string]string)
 	}
-	maps.Copy(graphqlHeaders, settings.Proto.GetXExtraHttpHeaders().GetValue())
+	graphqlHeaders["X-WANDB-USERNAME"] = settings.Proto.GetUsername().GetValue()
+	graphqlHeaders["X-WANDB-USER-EMAIL"] = settings.Proto.GetEmail().GetValue()
 
 	httpClient := backend.NewClient(api.ClientOptions{
 		RetryPolicy:     clients.CheckRetry,
 		RetryMax:        int(settings.Proto.GetXGraphqlRetryMax().GetValue()),
 		RetryWaitMin:    clients.SecondsToDuration(settings.Proto.GetXGraphqlRetryWaitMinSeconds().GetValue()),
 		RetryWaitMax:    clients.SecondsToDuration(settings.Proto.GetXGraphqlRetryWaitMaxSeconds().GetValue()),
-		NonRetryTimeout: clients.SecondsToDuration(settings.Proto.GetXGraphqlTimeoutSeconds().GetValue()),
+		NonRetryTimeout: settings.GetGraphQLTimeout(),
 		ExtraHeaders:    graphqlHeaders,
 		NetworkPeeker:   peeker,
 	})
-	endpoint := fmt.Sprintf("%s/graphql", settings.Proto.GetBaseUrl().GetValue())
 
-	return graphql.NewClient(endpoint, httpClient)
+	newClient := func(baseURL string) graphql.Client {
+		endpoint := fmt.Sprintf("%s/graphql", baseURL)
+		if settings.IsGraphQLPersistedQueriesEnabled() {
+			return persistedquery.NewClient(endpoint, httpClient)
+		}
+		return graphql.NewClient(endpoint, httpClient)
+	}
+
+	queryURL := settings.GetGraphQLQueryBaseURL()
+	mutationURL := settings.GetGraphQLMutationBaseURL()
+	if queryURL == mutationURL {
+		return newClient(mutationURL)
+	}
+	return gqlrouter.New(newClient(queryURL), newClient(mutationURL))
 }
 
 func NewFileStream(
@@ -74,8 +211,13 @@ func NewFileStream(
 	printer *observability.Printer,
 	settings *settings.Settings,
 	peeker api.Peeker,
+	healthStatus *healthprobe.Status,
+	droppedData *droppeddata.Tracker,
 ) filestream.FileStream {
-	fileStreamHeaders := map[string]string{}
+	fileStreamHeaders := maps.Clone(settings.GetExtraHTTPHeaders())
+	if fileStreamHeaders == nil {
+		fileStreamHeaders = make(map[string]string)
+	}
 	if settings.Proto.GetXShared().GetValue() {
 		fileStreamHeaders["X-WANDB-USE-ASYNC-FILESTREAM"] = "true"
 	}
@@ -84,7 +226,7 @@ func NewFileStream(
 		RetryMax:        int(settings.Proto.GetXFileStreamRetryMax().GetValue()),
 		RetryWaitMin:    clients.SecondsToDuration(settings.Proto.GetXFileStreamRetryWaitMinSeconds().GetValue()),
 		RetryWaitMax:    clients.SecondsToDuration(settings.Proto.GetXFileStreamRetryWaitMaxSeconds().GetValue()),
-		NonRetryTimeout: clients.SecondsToDuration(settings.Proto.GetXFileStreamTimeoutSeconds().GetValue()),
+		NonRetryTimeout: settings.GetFileStreamTimeout(),
 		ExtraHeaders:    fileStreamHeaders,
 		NetworkPeeker:   peeker,
 	})
@@ -95,6 +237,12 @@ func NewFileStream(
 		Printer:   printer,
 		ApiClient: fileStreamRetryClient,
 		ClientId:  utils.ShortID(32),
+
+		HealthStatus: healthStatus,
+		DroppedData:  droppedData,
+		Connections:  int(settings.Proto.GetXFileStreamConnections().GetValue()),
+
+		FlushEveryNPoints: int(settings.Proto.GetXFileStreamFlushEveryNPoints().GetValue()),
 	}
 
 	return filestream.NewFileStream(params)
@@ -104,6 +252,10 @@ func NewFileTransferManager(
 	fileTransferStats filetransfer.FileTransferStats,
 	logger *observability.CoreLogger,
 	settings *settings.Settings,
+	networkGate *netpause.Gate,
+	credentialHelper *api.CredentialHelper,
+	droppedData *droppeddata.Tracker,
+	healthStatus *healthprobe.Status,
 ) filetransfer.FileTransferManager {
 	fileTransferRetryClient := retryablehttp.NewClient()
 	fileTransferRetryClient.Logger = logger
@@ -111,22 +263,126 @@ func NewFileTransferManager(
 	fileTransferRetryClient.RetryMax = int(settings.Proto.GetXFileTransferRetryMax().GetValue())
 	fileTransferRetryClient.RetryWaitMin = clients.SecondsToDuration(settings.Proto.GetXFileTransferRetryWaitMinSeconds().GetValue())
 	fileTransferRetryClient.RetryWaitMax = clients.SecondsToDuration(settings.Proto.GetXFileTransferRetryWaitMaxSeconds().GetValue())
-	fileTransferRetryClient.HTTPClient.Timeout = clients.SecondsToDuration(settings.Proto.GetXFileTransferTimeoutSeconds().GetValue())
-	fileTransferRetryClient.Backoff = clients.ExponentialBackoffWithJitter
+	fileTransferRetryClient.HTTPClient.Timeout = settings.GetFileTransferTimeout()
+	networkGate.Install(fileTransferRetryClient)
+
+	// Uploads of artifacts and run files share one scheduler, so that
+	// they're subject to the same limits and back off together.
+	scheduler := filetransfer.NewScheduler(filetransfer.SchedulerParams{
+		Concurrency: settings.GetFileTransferConcurrency(),
+		RateLimit:   settings.GetFileTransferRateLimit(),
+		BackoffMin:  fileTransferRetryClient.RetryWaitMin,
+		BackoffMax:  fileTransferRetryClient.RetryWaitMax,
+	})
+
+	if credentialHelper != nil {
+		if transport, ok := fileTransferRetryClient.HTTPClient.Transport.(*http.Transport); ok {
+			transport.Proxy = credentialHelper.Proxy
+		}
+	}
+
+	// Faults are injected innermost so that retries and the scheduler's
+	// backoff respond to them like to real failures.
+	fileTransferRetryClient.HTTPClient.Transport =
+		newFaultInjectorOrNil(settings).Wrap(fileTransferRetryClient.HTTPClient.Transport)
+
+	// Large uploads can legitimately take a long time, so this timeout
+	// only applies while a transfer makes no progress.
+	if timeout := settings.GetFileTransferStallTimeout(); timeout > 0 {
+		fileTransferRetryClient.HTTPClient.Transport = filetransfer.NewStallTimeoutTransport(
+			fileTransferRetryClient.HTTPClient.Transport,
+			timeout,
+		)
+	}
+
+	// Transfers identify the core like other backend requests do.
+	headers := maps.Clone(settings.GetExtraHTTPHeaders())
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	headers["User-Agent"] = api.UserAgent(settings.GetUserAgentToken())
+	fileTransferRetryClient.HTTPClient.Transport = filetransfer.NewHeadersTransport(
+		fileTransferRetryClient.HTTPClient.Transport,
+		headers,
+	)
+
+	scheduler.Install(fileTransferRetryClient)
 
 	defaultFileTransfer := filetransfer.NewDefaultFileTransfer(
 		fileTransferRetryClient,
 		logger,
 		fileTransferStats,
+		settings.GetFileTransferURLRefreshMax(),
 	)
 	return filetransfer.NewFileTransferManager(
 		filetransfer.WithLogger(logger),
 		filetransfer.WithSettings(settings.Proto),
 		filetransfer.WithFileTransfer(defaultFileTransfer),
 		filetransfer.WithFileTransferStats(fileTransferStats),
+		filetransfer.WithDroppedData(droppedData),
+		filetransfer.WithHealthStatus(healthStatus),
+		filetransfer.WithScheduler(scheduler),
 	)
 }
 
+// clockSyncTimeout is how long to wait for the NTP server.
+const clockSyncTimeout = 3 * time.Second
+
+// NewClockCorrectorOrNil returns a Corrector for timestamps recorded with
+// the local clock, or nil if they don't need correcting.
+//
+// If an NTP server is configured, the offset is measured against it and a
+// warning is printed if it exceeds the skew threshold. Otherwise, or if the
+// server can't be reached, the fixed offset from the settings is used.
+// Timestamps are never corrected when syncing, since they were corrected
+// when the run was recorded.
+func NewClockCorrectorOrNil(
+	logger *observability.CoreLogger,
+	printer *observability.Printer,
+	settings *settings.Settings,
+) *clocksync.Corrector {
+	if settings.IsSync() {
+		return nil
+	}
+
+	offset := settings.GetClockOffset()
+	server := settings.GetClockNTPServer()
+	if server == "" {
+		return clocksync.NewCorrectorOrNil(offset)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clockSyncTimeout)
+	defer cancel()
+	measured, err := clocksync.QueryOffset(ctx, server)
+	if err != nil {
+		logger.Warn("stream: failed to measure clock offset", "error", err)
+		printer.Write(fmt.Sprintf(
+			"Could not check the clock against %s; using a clock offset of %v.",
+			server, offset))
+		return clocksync.NewCorrectorOrNil(offset)
+	}
+
+	logger.Info("stream: measured clock offset", "offset", measured)
+	if measured.Abs() > settings.GetClockSkewWarningThreshold() {
+		printer.Write(fmt.Sprintf(
+			"This machine's clock is off by %v; correcting timestamps.",
+			measured.Round(time.Millisecond)))
+	}
+	return clocksync.NewCorrectorOrNil(measured)
+}
+
+// NewStagingArea returns the area for files staged by core for upload.
+//
+// It is a directory for the run under the temporary code directory.
+func NewStagingArea(settings *settings.Settings) *artifacts.StagingArea {
+	tmpDir := settings.Proto.GetXTmpCodeDir().GetValue()
+	if tmpDir == "" {
+		tmpDir = os.TempDir()
+	}
+	return artifacts.NewStagingArea(
+		filepath.Join(tmpDir, "staging", settings.GetRunID()))
+}
+
 func NewRunfilesUploader(
 	ctx context.Context,
 	logger *observability.CoreLogger,
@@ -135,8 +391,15 @@ func NewRunfilesUploader(
 	fileTransfer filetransfer.FileTransferManager,
 	fileWatcher watcher.Watcher,
 	graphQL graphql.Client,
+	committedFiles runfiles.CommittedFiles,
+	staging *artifacts.StagingArea,
 ) runfiles.Uploader {
-	return runfiles.NewUploader(runfiles.UploaderParams{
+	filesPolicy, err := runfiles.ParseFilesPolicy(settings.GetFilesPolicy())
+	if err != nil {
+		logger.CaptureError("stream_init: ignoring files policy", err)
+	}
+
+	params := runfiles.UploaderParams{
 		Ctx:          ctx,
 		Logger:       logger,
 		Settings:     settings,
@@ -144,6 +407,13 @@ func NewRunfilesUploader(
 		FileTransfer: fileTransfer,
 		GraphQL:      graphQL,
 		FileWatcher:  fileWatcher,
-		BatchDelay:   waiting.NewDelay(50 * time.Millisecond),
-	})
+		FilesPolicy:  filesPolicy,
+
+		CommittedFiles: committedFiles,
+		BatchDelay:     waiting.NewDelay(50 * time.Millisecond),
+	}
+	if staging != nil {
+		params.Staging = staging
+	}
+	return runfiles.NewUploader(params)
 }
@@ -0,0 +1,435 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// makeStream creates an offline stream that keeps its files in a
+// temporary directory, which is returned along with it.
+//
+// Fields set in overrides replace the defaults.
+func makeStream(
+	t *testing.T,
+	overrides *service.Settings,
+) (*server.Stream, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	s := &service.Settings{
+		RunId:       &wrapperspb.StringValue{Value: "test-run"},
+		XOffline:    &wrapperspb.BoolValue{Value: true},
+		SyncDir:     &wrapperspb.StringValue{Value: dir},
+		LogDir:      &wrapperspb.StringValue{Value: dir},
+		LogInternal: &wrapperspb.StringValue{Value: filepath.Join(dir, "debug-internal.log")},
+		FilesDir:    &wrapperspb.StringValue{Value: dir},
+		SyncFile:    &wrapperspb.StringValue{Value: filepath.Join(dir, "run.wandb")},
+	}
+	overrides.ProtoReflect().Range(
+		func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			s.ProtoReflect().Set(field, value)
+			return true
+		})
+
+	stream, err := server.NewStream(settings.From(s), "test-run")
+	require.NoError(t, err)
+	return stream, dir
+}
+
+func TestStream_MaxRunDuration(t *testing.T) {
+	stream, _ := makeStream(t, &service.Settings{
+		MaxRunDuration: &wrapperspb.DoubleValue{Value: 0.01},
+	})
+	stream.Start()
+
+	closed := make(chan struct{})
+	go func() {
+		stream.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not finish after exceeding its max run duration")
+	}
+
+	// Finishing the stream again must not block.
+	stream.FinishAndClose(0)
+}
+
+// chanResponder collects a stream's responses.
+type chanResponder chan *service.ServerResponse
+
+func (r chanResponder) Respond(response *service.ServerResponse) {
+	r <- response
+}
+
+func TestStream_ExitWhileFinishing(t *testing.T) {
+	stream, _ := makeStream(t, nil)
+	responses := make(chanResponder, 1)
+	stream.AddResponders(server.ResponderEntry{Responder: responses, ID: "client"})
+	stream.Start()
+
+	// The user's exit arrives while the stream is finishing on its own,
+	// like when the maximum run duration is exceeded.
+	stream.AddShutdownHook("user-exit", 0, func(ctx context.Context) {
+		stream.HandleRecord(&service.Record{
+			RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+			Control:    &service.Control{ConnectionId: "client", ReqResp: true},
+		})
+	})
+	finished := make(chan struct{})
+	go func() {
+		stream.FinishAndClose(1)
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not finish")
+	}
+	select {
+	case response := <-responses:
+		assert.NotNil(t, response.GetResultCommunicate().GetExitResult())
+	default:
+		t.Fatal("expected a response to the user's exit record")
+	}
+}
+
+func TestStream_FinishAfterExit(t *testing.T) {
+	stream, _ := makeStream(t, nil)
+	responses := make(chanResponder, 1)
+	stream.AddResponders(server.ResponderEntry{Responder: responses, ID: "client"})
+	stream.Start()
+
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+		Control:    &service.Control{ConnectionId: "client", ReqResp: true},
+	})
+	// Like the max run duration timer firing just after the user's exit.
+	finished := make(chan struct{})
+	go func() {
+		stream.FinishAndClose(1)
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not finish")
+	}
+	response := <-responses
+	assert.NotNil(t, response.GetResultCommunicate().GetExitResult())
+}
+
+func TestStream_Flush(t *testing.T) {
+	stream, dir := makeStream(t, nil)
+	syncFile := filepath.Join(dir, "run.wandb")
+	stream.Start()
+	defer stream.FinishAndClose(0)
+
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{
+				Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+			},
+		},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stage, err := stream.Flush(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, server.FlushStagePersisted, stage)
+
+	store := server.NewStore(context.Background(), syncFile, observability.NewNoOpLogger())
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	record, err := store.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "lr", record.GetConfig().GetUpdate()[0].GetKey())
+}
+
+func TestStream_Flush_ContextCanceled(t *testing.T) {
+	stream, _ := makeStream(t, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The stream is not started, so the flush cannot complete.
+	stage, err := stream.Flush(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, server.FlushStageNone, stage)
+}
+
+func TestStream_Flush_BeforeRunStart(t *testing.T) {
+	stream, _ := makeStream(t, &service.Settings{
+		XOffline: &wrapperspb.BoolValue{Value: false},
+		ApiKey:   &wrapperspb.StringValue{Value: "test-key"},
+		BaseUrl:  &wrapperspb.StringValue{Value: "http://127.0.0.1:0"},
+	})
+	stream.Start()
+	defer stream.FinishAndClose(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stage, err := stream.Flush(ctx)
+
+	// There is nowhere to send records until the run starts.
+	require.NoError(t, err)
+	assert.Equal(t, server.FlushStagePersisted, stage)
+}
+
+func TestStream_Flush_FromClient(t *testing.T) {
+	stream, _ := makeStream(t, nil)
+	responses := make(chanResponder, 1)
+	stream.AddResponders(server.ResponderEntry{Responder: responses, ID: "client"})
+	stream.Start()
+	defer stream.FinishAndClose(0)
+
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Flush{
+					Flush: &service.FlushRequest{},
+				},
+			},
+		},
+		Control: &service.Control{ConnectionId: "client", ReqResp: true},
+	})
+
+	select {
+	case response := <-responses:
+		flush := response.GetResultCommunicate().GetResponse().GetFlushResponse()
+		assert.Equal(t, service.FlushResponse_PERSISTED, flush.GetStage())
+		assert.Empty(t, flush.GetError())
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a response to the client's flush")
+	}
+}
+
+func TestStream_RecordQueueSize(t *testing.T) {
+	stream, _ := makeStream(t, &service.Settings{
+		XRecordQueueSize: &wrapperspb.Int32Value{Value: 1},
+	})
+	configRecord := func() *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Config{
+				Config: &service.ConfigRecord{
+					Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+				},
+			},
+		}
+	}
+
+	stream.HandleRecord(configRecord())
+	assert.Equal(t, 1, stream.QueueDepths().Incoming)
+
+	handled := make(chan struct{})
+	go func() {
+		stream.HandleRecord(configRecord())
+		close(handled)
+	}()
+	select {
+	case <-handled:
+		t.Fatal("expected HandleRecord to block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	stream.Start()
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("HandleRecord did not unblock after the stream started")
+	}
+	stream.FinishAndClose(0)
+}
+
+func TestStream_ShutdownHooks(t *testing.T) {
+	stream, _ := makeStream(t, nil)
+	stream.Start()
+
+	calls := make(chan string, 3)
+	stream.AddShutdownHook("first", 0, func(ctx context.Context) {
+		calls <- "first"
+	})
+	stream.AddShutdownHook("panics", 0, func(ctx context.Context) {
+		calls <- "panics"
+		panic("test panic")
+	})
+	stream.AddShutdownHook("hangs", 10*time.Millisecond, func(ctx context.Context) {
+		calls <- "hangs"
+		select {}
+	})
+
+	finished := make(chan struct{})
+	go func() {
+		stream.FinishAndClose(0)
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not finish")
+	}
+	assert.Equal(t,
+		[]string{"hangs", "panics", "first"},
+		[]string{<-calls, <-calls, <-calls})
+	assert.False(t,
+		stream.AddShutdownHook("late", 0, func(ctx context.Context) {}))
+}
+
+func TestStream_ShutdownHooks_ClientExit(t *testing.T) {
+	stream, _ := makeStream(t, nil)
+	responses := make(chanResponder, 1)
+	stream.AddResponders(server.ResponderEntry{Responder: responses, ID: "client"})
+	stream.Start()
+
+	release := make(chan struct{})
+	stream.AddShutdownHook("hook", 0, func(ctx context.Context) {
+		<-release
+	})
+
+	// The hook must not block the caller, which is the client's
+	// connection.
+	handled := make(chan struct{})
+	go func() {
+		stream.HandleRecord(&service.Record{
+			RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+			Control:    &service.Control{ConnectionId: "client", ReqResp: true},
+		})
+		close(handled)
+	}()
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("HandleRecord blocked on the shutdown hook")
+	}
+
+	select {
+	case <-responses:
+		t.Fatal("expected the exit record to wait for the hook")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	select {
+	case response := <-responses:
+		assert.NotNil(t, response.GetResultCommunicate().GetExitResult())
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a response to the exit record")
+	}
+	stream.Close()
+	assert.False(t,
+		stream.AddShutdownHook("late", 0, func(ctx context.Context) {}))
+}
+
+func TestStream_FinishAndClose_ReleasesNetworkPause(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			switch {
+			case strings.Contains(string(body), "CreateRunFiles"):
+				_, _ = w.Write([]byte(
+					`{"data": {"createRunFiles": {"files": []}}}`))
+			case r.URL.Path == "/graphql":
+				_, _ = w.Write([]byte(
+					`{"data": ` + validUpsertBucketResponse + `}`))
+			default:
+				_, _ = w.Write([]byte(`{}`))
+			}
+		}))
+	defer backend.Close()
+	pauseFile := filepath.Join(t.TempDir(), "pause")
+	require.NoError(t, os.WriteFile(pauseFile, nil, 0o644))
+	stream, _ := makeStream(t, &service.Settings{
+		XOffline:          &wrapperspb.BoolValue{Value: false},
+		ApiKey:            &wrapperspb.StringValue{Value: "test-key"},
+		BaseUrl:           &wrapperspb.StringValue{Value: backend.URL},
+		XNetworkPauseFile: &wrapperspb.StringValue{Value: pauseFile},
+	})
+	stream.Start()
+
+	// Upserting the run is held back by the pause.
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "test-run"},
+		},
+	})
+	finished := make(chan struct{})
+	go func() {
+		stream.FinishAndClose(0)
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(10 * time.Second):
+		t.Fatal("stream did not finish while the network was paused")
+	}
+}
+
+func TestStream_HistoryJSONL(t *testing.T) {
+	stream, dir := makeStream(t, &service.Settings{
+		XHistoryJsonl: &wrapperspb.BoolValue{Value: true},
+	})
+	stream.Start()
+
+	for _, loss := range []string{"0.5", "0.25"} {
+		stream.HandleRecord(&service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Item: []*service.HistoryItem{{Key: "loss", ValueJson: loss}},
+				},
+			},
+		})
+	}
+	stream.FinishAndClose(0)
+
+	content, err := os.ReadFile(filepath.Join(dir, server.HistoryJSONLFileName))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"loss":0.5`)
+	assert.Contains(t, lines[1], `"loss":0.25`)
+}
+
+func TestNewStream_RunDirNotWritable(t *testing.T) {
+	// The run directory can't be created, even as root, because its
+	// parent is a regular file.
+	file := filepath.Join(t.TempDir(), "not-a-dir")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+	runDir := filepath.Join(file, "run-123")
+
+	stream, err := server.NewStream(
+		settings.From(&service.Settings{
+			RunId:    &wrapperspb.StringValue{Value: "test-run"},
+			XOffline: &wrapperspb.BoolValue{Value: true},
+			SyncDir:  &wrapperspb.StringValue{Value: runDir},
+			LogDir:   &wrapperspb.StringValue{Value: runDir},
+			SyncFile: &wrapperspb.StringValue{Value: filepath.Join(runDir, "run.wandb")},
+		}),
+		"test-run",
+	)
+
+	assert.Nil(t, stream)
+	assert.ErrorContains(t, err, "set WANDB_DIR")
+}
@@ -22,6 +22,21 @@ type SyncService struct {
 	syncErr       error
 	overwrite     *service.SyncOverwrite
 	skip          *service.SyncSkip
+	progress      *SyncProgress
+
+	// checkpoint records progress so an interrupted sync can resume, or
+	// nil if not checkpointing
+	checkpoint *SyncCheckpoint
+
+	// checkpointFunc saves the checkpoint once the given number of records
+	// is acknowledged by the backend
+	checkpointFunc func(int64)
+
+	// resumeFrom is the number of records synced by an earlier attempt
+	resumeFrom int64
+
+	// recordNum is the number of records received so far
+	recordNum int64
 }
 
 type SyncServiceOption func(*SyncService)
@@ -50,6 +65,27 @@ func WithSyncServiceSkip(skip *service.SyncSkip) SyncServiceOption {
 	}
 }
 
+func WithSyncServiceProgress(progress *SyncProgress) SyncServiceOption {
+	return func(s *SyncService) {
+		s.progress = progress
+	}
+}
+
+// WithSyncServiceCheckpoint resumes from and updates a checkpoint.
+//
+// checkpointFunc is called periodically with the number of records sent so
+// far and must update the checkpoint once the backend acknowledges them.
+func WithSyncServiceCheckpoint(
+	checkpoint *SyncCheckpoint,
+	checkpointFunc func(int64),
+) SyncServiceOption {
+	return func(s *SyncService) {
+		s.checkpoint = checkpoint
+		s.checkpointFunc = checkpointFunc
+		s.resumeFrom = checkpoint.ReplayedRecords()
+	}
+}
+
 func WithSyncServiceLogger(logger *observability.CoreLogger) SyncServiceOption {
 	return func(s *SyncService) {
 		s.logger = logger
@@ -84,6 +120,9 @@ func (s *SyncService) SyncRecord(record *service.Record, err error) {
 		s.inChan <- record
 	} else if record != nil {
 		s.inChan <- record
+		if s.progress != nil {
+			s.progress.RecordReplayed()
+		}
 	}
 }
 
@@ -104,6 +143,13 @@ func (s *SyncService) sync() {
 		// is used for something else, we should re-evaluate this.
 		// remove the control from the record:
 		record.Control = nil
+		s.recordNum++
+
+		// Data from these records was acknowledged in an earlier attempt.
+		if s.recordNum <= s.resumeFrom && isAppendOnlyRecord(record) {
+			continue
+		}
+
 		switch record.RecordType.(type) {
 		case *service.Record_Run:
 			s.syncRun(record)
@@ -114,6 +160,10 @@ func (s *SyncService) sync() {
 		default:
 			s.senderFunc(record)
 		}
+
+		if s.checkpointFunc != nil && s.recordNum%syncCheckpointInterval == 0 {
+			s.checkpointFunc(s.recordNum)
+		}
 	}
 	s.wg.Done()
 }
@@ -130,6 +180,10 @@ func (s *SyncService) syncRun(record *service.Record) {
 			record.GetRun().RunId = s.overwrite.GetRunId()
 		}
 	}
+	if s.checkpoint != nil && !s.checkpoint.MatchRun(record.GetRun()) {
+		// The checkpoint is for a different run, so start from scratch.
+		s.resumeFrom = 0
+	}
 	s.senderFunc(record)
 	record = &service.Record{
 		RecordType: &service.Record_Request{
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// syncCheckpointInterval is how many records to sync between checkpoints.
+const syncCheckpointInterval = 1000
+
+// SyncCheckpoint records the progress of syncing an offline run so that an
+// interrupted sync can be resumed.
+//
+// It is saved to a sidecar file next to the transaction log and is only
+// advanced once the backend acknowledges the work, so that resuming never
+// skips anything the backend doesn't have:
+//
+//   - ReplayedRecords only counts records that were acknowledged by the
+//     filestream API. On resume, records before it that append data
+//     (history, console output and system metrics) are skipped. All other
+//     records are upserts and are replayed.
+//   - Run files are marked as committed after their upload succeeds, and
+//     are skipped on resume if they haven't changed since.
+type SyncCheckpoint struct {
+	mu sync.Mutex
+
+	// path is the sidecar file.
+	path string
+
+	state syncCheckpointState
+}
+
+type syncCheckpointState struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	RunID   string `json:"run_id"`
+
+	// ReplayedRecords is the number of records at the start of the
+	// transaction log whose data the backend acknowledged.
+	ReplayedRecords int64 `json:"replayed_records"`
+
+	// CommittedFiles are run files that finished uploading, by path
+	// relative to the files directory.
+	CommittedFiles map[string]committedFile `json:"committed_files,omitempty"`
+}
+
+// committedFile identifies the version of a file that was uploaded.
+type committedFile struct {
+	Size      int64 `json:"size"`
+	ModTimeNs int64 `json:"mod_time_ns"`
+}
+
+// SyncCheckpointPath returns the checkpoint file for a transaction log.
+func SyncCheckpointPath(syncFile string) string {
+	return syncFile + ".checkpoint"
+}
+
+// LoadSyncCheckpoint reads the checkpoint for a transaction log.
+//
+// Returns an empty checkpoint if there is none.
+func LoadSyncCheckpoint(syncFile string) (*SyncCheckpoint, error) {
+	checkpoint := &SyncCheckpoint{path: SyncCheckpointPath(syncFile)}
+
+	data, err := os.ReadFile(checkpoint.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return checkpoint, nil
+	case err != nil:
+		return checkpoint, err
+	}
+
+	if err := json.Unmarshal(data, &checkpoint.state); err != nil {
+		checkpoint.state = syncCheckpointState{}
+		return checkpoint, fmt.Errorf("sync: corrupt checkpoint %s: %v", checkpoint.path, err)
+	}
+	return checkpoint, nil
+}
+
+// ReplayedRecords is the number of records known to be synced.
+func (c *SyncCheckpoint) ReplayedRecords() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state.ReplayedRecords
+}
+
+// MatchRun checks that the checkpoint is for the given run.
+//
+// If the checkpoint is for a different run, for example because the sync
+// was restarted with a different run ID, it is reset and false is returned.
+func (c *SyncCheckpoint) MatchRun(run *service.RunRecord) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches := c.state.RunID == run.GetRunId() &&
+		c.state.Project == run.GetProject() &&
+		c.state.Entity == run.GetEntity()
+	if !matches {
+		c.state = syncCheckpointState{
+			Entity:  run.GetEntity(),
+			Project: run.GetProject(),
+			RunID:   run.GetRunId(),
+		}
+	}
+
+	return matches
+}
+
+// SetReplayedRecords records that the first n records were synced and
+// saves the checkpoint.
+func (c *SyncCheckpoint) SetReplayedRecords(n int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state.ReplayedRecords = max(c.state.ReplayedRecords, n)
+	return c.saveLocked()
+}
+
+// IsCommitted reports whether the current version of a run file was
+// uploaded before.
+func (c *SyncCheckpoint) IsCommitted(realPath, runPath string) bool {
+	info, err := os.Stat(realPath)
+	if err != nil {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	committed, ok := c.state.CommittedFiles[filepath.ToSlash(runPath)]
+	return ok &&
+		committed.Size == info.Size() &&
+		committed.ModTimeNs == info.ModTime().UnixNano()
+}
+
+// MarkCommitted records that a run file was uploaded and saves the
+// checkpoint.
+func (c *SyncCheckpoint) MarkCommitted(realPath, runPath string) error {
+	info, err := os.Stat(realPath)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state.CommittedFiles == nil {
+		c.state.CommittedFiles = make(map[string]committedFile)
+	}
+	c.state.CommittedFiles[filepath.ToSlash(runPath)] = committedFile{
+		Size:      info.Size(),
+		ModTimeNs: info.ModTime().UnixNano(),
+	}
+	return c.saveLocked()
+}
+
+// Remove deletes the checkpoint file once the sync is complete.
+func (c *SyncCheckpoint) Remove() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := os.Remove(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// saveLocked atomically writes the checkpoint file.
+func (c *SyncCheckpoint) saveLocked() error {
+	data, err := json.Marshal(c.state)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, c.path)
+}
+
+// isAppendOnlyRecord reports whether the backend appends the record's data
+// rather than replacing it, so that sending it twice duplicates it.
+func isAppendOnlyRecord(record *service.Record) bool {
+	switch record.RecordType.(type) {
+	case *service.Record_History,
+		*service.Record_Output,
+		*service.Record_OutputRaw,
+		*service.Record_Stats:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,81 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestSyncCheckpoint_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	syncFile := filepath.Join(dir, "run.wandb")
+	filePath := filepath.Join(dir, "output.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("data"), 0o644))
+	run := &service.RunRecord{Entity: "e", Project: "p", RunId: "r"}
+
+	checkpoint, err := server.LoadSyncCheckpoint(syncFile)
+	require.NoError(t, err)
+	assert.False(t, checkpoint.MatchRun(run))
+	require.NoError(t, checkpoint.SetReplayedRecords(1000))
+	require.NoError(t, checkpoint.MarkCommitted(filePath, "output.txt"))
+
+	loaded, err := server.LoadSyncCheckpoint(syncFile)
+	require.NoError(t, err)
+	assert.True(t, loaded.MatchRun(run))
+	assert.EqualValues(t, 1000, loaded.ReplayedRecords())
+	assert.True(t, loaded.IsCommitted(filePath, "output.txt"))
+}
+
+func TestSyncCheckpoint_DifferentRunResets(t *testing.T) {
+	syncFile := filepath.Join(t.TempDir(), "run.wandb")
+	checkpoint, _ := server.LoadSyncCheckpoint(syncFile)
+	checkpoint.MatchRun(&service.RunRecord{RunId: "old"})
+	require.NoError(t, checkpoint.SetReplayedRecords(5))
+
+	loaded, err := server.LoadSyncCheckpoint(syncFile)
+	require.NoError(t, err)
+
+	assert.False(t, loaded.MatchRun(&service.RunRecord{RunId: "new"}))
+	assert.Zero(t, loaded.ReplayedRecords())
+}
+
+func TestSyncCheckpoint_ModifiedFileNotCommitted(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "output.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("data"), 0o644))
+	checkpoint, _ := server.LoadSyncCheckpoint(filepath.Join(dir, "run.wandb"))
+	require.NoError(t, checkpoint.MarkCommitted(filePath, "output.txt"))
+
+	require.NoError(t, os.WriteFile(filePath, []byte("more data"), 0o644))
+	require.NoError(t, os.Chtimes(filePath, time.Now(), time.Now().Add(time.Hour)))
+
+	assert.False(t, checkpoint.IsCommitted(filePath, "output.txt"))
+}
+
+func TestSyncCheckpoint_CorruptFile(t *testing.T) {
+	syncFile := filepath.Join(t.TempDir(), "run.wandb")
+	require.NoError(t,
+		os.WriteFile(server.SyncCheckpointPath(syncFile), []byte("{"), 0o644))
+
+	checkpoint, err := server.LoadSyncCheckpoint(syncFile)
+
+	assert.Error(t, err)
+	assert.Zero(t, checkpoint.ReplayedRecords())
+}
+
+func TestSyncCheckpoint_Remove(t *testing.T) {
+	syncFile := filepath.Join(t.TempDir(), "run.wandb")
+	checkpoint, _ := server.LoadSyncCheckpoint(syncFile)
+	require.NoError(t, checkpoint.SetReplayedRecords(1))
+
+	require.NoError(t, checkpoint.Remove())
+
+	assert.NoFileExists(t, server.SyncCheckpointPath(syncFile))
+	assert.NoError(t, checkpoint.Remove())
+}
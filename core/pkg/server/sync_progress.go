@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// syncProgressWindow is how much recent history is used to estimate the
+// time left in a sync.
+const syncProgressWindow = 30 * time.Second
+
+// SyncProgressPeriod is how often progress is reported during a sync.
+const SyncProgressPeriod = 5 * time.Second
+
+// SyncProgress tracks how far along the sync of an offline run is.
+//
+// Counting a record is cheap. The throughput used for the ETA is sampled
+// separately, once per report.
+type SyncProgress struct {
+	mu sync.Mutex
+
+	// totalRecords is the number of records in the transaction log.
+	totalRecords int64
+
+	// replayedRecords is the number of records sent so far.
+	replayedRecords atomic.Int64
+
+	// samples are recent (time, replayedRecords) pairs for estimating
+	// throughput, oldest first.
+	samples []syncProgressSample
+
+	// fileTransferStats tracks uploads, if files are uploaded.
+	fileTransferStats filetransfer.FileTransferStats
+}
+
+type syncProgressSample struct {
+	time    time.Time
+	records int64
+}
+
+// NewSyncProgress creates a tracker for syncing totalRecords records.
+//
+// fileTransferStats may be nil.
+func NewSyncProgress(
+	totalRecords int64,
+	fileTransferStats filetransfer.FileTransferStats,
+) *SyncProgress {
+	return &SyncProgress{
+		totalRecords:      totalRecords,
+		fileTransferStats: fileTransferStats,
+	}
+}
+
+// CountStoreRecords returns the number of records in a transaction log.
+func CountStoreRecords(
+	ctx context.Context,
+	path string,
+	logger *observability.CoreLogger,
+) (int64, error) {
+	store := NewStore(ctx, path, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return 0, err
+	}
+	defer store.Close()
+
+	var count int64
+	for {
+		_, err := store.Read()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// RecordReplayed counts a record that was sent.
+func (p *SyncProgress) RecordReplayed() {
+	p.replayedRecords.Add(1)
+}
+
+// Sample records the number of records replayed by the given time.
+//
+// Samples older than the throughput window are discarded, except for the
+// most recent of them.
+func (p *SyncProgress) Sample(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cutoff := now.Add(-syncProgressWindow)
+	for len(p.samples) > 1 && p.samples[1].time.Before(cutoff) {
+		p.samples = p.samples[1:]
+	}
+	p.samples = append(p.samples,
+		syncProgressSample{now, p.replayedRecords.Load()})
+}
+
+// ETA estimates how long it will take to replay the remaining records.
+//
+// The estimate uses the throughput between the oldest and newest samples.
+// Returns false if there isn't enough data for an estimate.
+func (p *SyncProgress) ETA() (time.Duration, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	remaining := p.totalRecords - p.replayedRecords.Load()
+	if remaining <= 0 {
+		return 0, true
+	}
+	if len(p.samples) < 2 {
+		return 0, false
+	}
+
+	oldest := p.samples[0]
+	newest := p.samples[len(p.samples)-1]
+	elapsed := newest.time.Sub(oldest.time)
+	replayed := newest.records - oldest.records
+	if elapsed <= 0 || replayed <= 0 {
+		return 0, false
+	}
+
+	rate := float64(replayed) / elapsed.Seconds()
+	return time.Duration(float64(remaining) / rate * float64(time.Second)), true
+}
+
+// Report describes the progress for the user.
+func (p *SyncProgress) Report() string {
+	eta, hasETA := p.ETA()
+	replayed, total := p.replayedRecords.Load(), p.totalRecords
+
+	var parts []string
+	if total > 0 {
+		parts = append(parts, fmt.Sprintf(
+			"%d/%d records (%d%%)",
+			replayed, total, min(100, replayed*100/total)))
+	} else {
+		parts = append(parts, fmt.Sprintf("%d records", replayed))
+	}
+
+	if p.fileTransferStats != nil {
+		counts := p.fileTransferStats.GetFileCounts()
+		stats := p.fileTransferStats.GetFilesStats()
+		files := counts.GetWandbCount() + counts.GetMediaCount() +
+			counts.GetArtifactCount() + counts.GetOtherCount()
+		parts = append(parts,
+			fmt.Sprintf("%d files", files),
+			fmt.Sprintf(
+				"%s/%s uploaded",
+				formatBytes(stats.GetUploadedBytes()),
+				formatBytes(stats.GetTotalBytes())))
+	}
+
+	if hasETA {
+		parts = append(parts, fmt.Sprintf("ETA %v", eta.Round(time.Second)))
+	}
+
+	return "Syncing: " + strings.Join(parts, ", ")
+}
+
+// StartReporting samples and writes the progress every period until stop
+// is called.
+//
+// The stop function writes the final progress.
+func (p *SyncProgress) StartReporting(
+	period time.Duration,
+	write func(string),
+) (stop func()) {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	p.Sample(time.Now())
+
+	go func() {
+		defer close(finished)
+
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				p.Sample(now)
+				write(p.Report())
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-finished
+			p.Sample(time.Now())
+			write(p.Report())
+		})
+	}
+}
+
+// formatBytes formats a byte count using binary units.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value := float64(n)
+	for _, suffix := range []string{"KiB", "MiB", "GiB", "TiB"} {
+		value /= unit
+		if value < unit || suffix == "TiB" {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+	}
+	return "" // unreachable
+}
@@ -0,0 +1,73 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestSyncProgress_ETAFromRecentThroughput(t *testing.T) {
+	progress := server.NewSyncProgress(100, nil)
+	start := time.Unix(1000, 0)
+
+	// 10 records per second for the first 5 seconds.
+	progress.Sample(start)
+	for i := 0; i < 50; i++ {
+		progress.RecordReplayed()
+	}
+	progress.Sample(start.Add(5 * time.Second))
+	eta, ok := progress.ETA()
+
+	assert.True(t, ok)
+	assert.InDelta(t, 5*time.Second, eta, float64(200*time.Millisecond))
+}
+
+func TestSyncProgress_ETAUnknownWithoutData(t *testing.T) {
+	progress := server.NewSyncProgress(100, nil)
+
+	progress.RecordReplayed()
+	progress.Sample(time.Now())
+	_, ok := progress.ETA()
+
+	assert.False(t, ok)
+}
+
+func TestSyncProgress_Report(t *testing.T) {
+	stats := filetransfer.NewFileTransferStats()
+	stats.UpdateUploadStats(filetransfer.FileUploadInfo{
+		FileKind:      filetransfer.RunFileKindMedia,
+		Path:          "media/image.png",
+		UploadedBytes: 1024,
+		TotalBytes:    2048,
+	})
+	progress := server.NewSyncProgress(4, stats)
+	now := time.Unix(1000, 0)
+	progress.Sample(now)
+	progress.RecordReplayed()
+	progress.RecordReplayed()
+	progress.Sample(now.Add(time.Second))
+
+	report := progress.Report()
+
+	assert.Equal(t,
+		"Syncing: 2/4 records (50%), 1 files, 1.0 KiB/2.0 KiB uploaded, ETA 1s",
+		report)
+}
+
+func TestCountStoreRecords(t *testing.T) {
+	path := writeTransactionLog(t,
+		&service.Record{RecordType: &service.Record_Run{Run: &service.RunRecord{}}},
+		&service.Record{RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}}},
+	)
+
+	count, err := server.CountStoreRecords(context.Background(), path, observability.NewNoOpLogger())
+
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
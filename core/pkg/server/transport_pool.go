@@ -0,0 +1,128 @@
+package server
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// TransportPool holds HTTP transports shared by streams in the process.
+//
+// A process may log to several runs one after another, such as the steps
+// of a pipeline that write to different projects. Streams for the same
+// backend and proxies reuse a transport so that new runs keep its open
+// connections instead of dialing and negotiating TLS again.
+//
+// Credentials are added to each request rather than to the transport, so
+// streams with different credentials can share one.
+//
+// The pool keeps a bounded number of transports. When it is full, the
+// least recently used transport is dropped and its idle connections are
+// closed; streams still using it are unaffected.
+type TransportPool struct {
+	mu      sync.Mutex
+	maxSize int
+
+	// entries are the pooled transports, least recently used first.
+	entries []pooledTransport
+}
+
+// pooledTransport is a transport in a TransportPool.
+type pooledTransport struct {
+	key       transportKey
+	transport *http.Transport
+}
+
+// transportKey identifies streams that may share a transport.
+type transportKey struct {
+	baseURL string
+
+	// proxies is the proxies by scheme, in a canonical form.
+	proxies string
+}
+
+// maxPooledTransports is the size of the process's TransportPool.
+//
+// Processes rarely use more than a couple of backends.
+const maxPooledTransports = 8
+
+// NewTransportPool returns a pool that keeps at most maxSize transports.
+func NewTransportPool(maxSize int) *TransportPool {
+	return &TransportPool{maxSize: max(1, maxSize)}
+}
+
+// Get returns the transport for the backend and proxies, creating it on
+// first use.
+//
+// The transport sends requests through the proxy for their URL's scheme,
+// or the proxy from the environment if there's none.
+func (p *TransportPool) Get(
+	baseURL string,
+	proxies map[string]string,
+) *http.Transport {
+	key := transportKey{baseURL: baseURL, proxies: proxiesKey(proxies)}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, entry := range p.entries {
+		if entry.key == key {
+			p.entries = append(slices.Delete(p.entries, i, i+1), entry)
+			return entry.transport
+		}
+	}
+
+	// Match the pooled transport that retryablehttp creates by default.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = runtime.GOMAXPROCS(0) + 1
+	transport.Proxy = ProxyFunc(proxies)
+	p.entries = append(p.entries, pooledTransport{key, transport})
+
+	if len(p.entries) > p.maxSize {
+		p.entries[0].transport.CloseIdleConnections()
+		p.entries = slices.Delete(p.entries, 0, 1)
+	}
+
+	return transport
+}
+
+// ProxyFunc returns a function for http.Transport's Proxy that uses the
+// proxy for the request's URL scheme, falling back to the environment.
+func ProxyFunc(proxies map[string]string) func(*http.Request) (*url.URL, error) {
+	if len(proxies) == 0 {
+		return http.ProxyFromEnvironment
+	}
+
+	proxies = maps.Clone(proxies)
+	return func(req *http.Request) (*url.URL, error) {
+		if proxy, ok := proxies[req.URL.Scheme]; ok && proxy != "" {
+			return url.Parse(proxy)
+		}
+		return http.ProxyFromEnvironment(req)
+	}
+}
+
+// proxiesKey returns a string that is equal for equal proxy maps.
+func proxiesKey(proxies map[string]string) string {
+	schemes := make([]string, 0, len(proxies))
+	for scheme := range proxies {
+		schemes = append(schemes, scheme)
+	}
+	slices.Sort(schemes)
+
+	var key strings.Builder
+	for _, scheme := range schemes {
+		key.WriteString(url.QueryEscape(scheme))
+		key.WriteByte('=')
+		key.WriteString(url.QueryEscape(proxies[scheme]))
+		key.WriteByte('&')
+	}
+	return key.String()
+}
+
+// sharedTransports is the process's TransportPool.
+var sharedTransports = NewTransportPool(maxPooledTransports)
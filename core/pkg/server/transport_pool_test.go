@@ -0,0 +1,50 @@
+package server_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+func TestTransportPool_SharesByBackendAndProxies(t *testing.T) {
+	pool := server.NewTransportPool(3)
+	proxies := map[string]string{"https": "http://proxy:3128"}
+
+	first := pool.Get("https://api.wandb.ai", nil)
+
+	assert.Same(t, first, pool.Get("https://api.wandb.ai", nil))
+	assert.Same(t, first, pool.Get("https://api.wandb.ai", map[string]string{}))
+	assert.NotSame(t, first, pool.Get("https://api.wandb.ai", proxies))
+	assert.NotSame(t, first, pool.Get("https://wandb.example.com", nil))
+}
+
+func TestTransportPool_UsesProxies(t *testing.T) {
+	pool := server.NewTransportPool(1)
+	transport := pool.Get(
+		"https://api.wandb.ai",
+		map[string]string{"https": "http://proxy:3128"},
+	)
+
+	proxy, err := transport.Proxy(&http.Request{
+		URL: &url.URL{Scheme: "https", Host: "api.wandb.ai"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy:3128", proxy.String())
+}
+
+func TestTransportPool_EvictsLeastRecentlyUsed(t *testing.T) {
+	pool := server.NewTransportPool(2)
+
+	first := pool.Get("https://one.example.com", nil)
+	second := pool.Get("https://two.example.com", nil)
+	assert.Same(t, first, pool.Get("https://one.example.com", nil))
+	pool.Get("https://three.example.com", nil)
+
+	assert.Same(t, first, pool.Get("https://one.example.com", nil))
+	assert.NotSame(t, second, pool.Get("https://two.example.com", nil))
+}
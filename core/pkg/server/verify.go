@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// DivergenceKind is the way in which the backend differs from the
+// transaction log.
+type DivergenceKind int
+
+const (
+	// The key is in the transaction log but not in the backend.
+	DivergenceMissingKey DivergenceKind = iota
+
+	// The key has a different value in the backend.
+	DivergenceMismatchedValue
+
+	// The backend has a different number of history rows or last step.
+	DivergenceHistory
+)
+
+// Divergence is a difference between the transaction log and the backend.
+type Divergence struct {
+	Kind DivergenceKind
+
+	// Section is "config", "summary" or "history".
+	Section string
+
+	// Key is the top-level config or summary key, or the name of the
+	// history property that differs.
+	Key string
+
+	// Local and Backend are JSON representations of the values.
+	//
+	// Backend is empty for missing keys.
+	Local   string
+	Backend string
+}
+
+func (d Divergence) String() string {
+	switch d.Kind {
+	case DivergenceMissingKey:
+		return fmt.Sprintf("%s: %q is missing (local value %s)",
+			d.Section, d.Key, d.Local)
+	default:
+		return fmt.Sprintf("%s: %q differs (local %s, backend %s)",
+			d.Section, d.Key, d.Local, d.Backend)
+	}
+}
+
+// VerifyReport is the result of comparing a run's transaction log to the
+// backend.
+type VerifyReport struct {
+	Entity  string
+	Project string
+	RunID   string
+
+	Divergences []Divergence
+}
+
+// OK returns whether the backend matches the transaction log.
+func (r *VerifyReport) OK() bool {
+	return len(r.Divergences) == 0
+}
+
+// localRunState is the final state of a run according to its transaction
+// log.
+type localRunState struct {
+	run          *service.RunRecord
+	config       *runconfig.RunConfig
+	summary      *runsummary.RunSummary
+	historyCount int
+	lastStep     int64
+}
+
+// VerifyRun checks that the backend reflects the run in a transaction log.
+//
+// It replays the `.wandb` file at `path` to compute the run's final config,
+// summary and history step, queries the backend for the same run and
+// reports any differences. Only keys present in the transaction log are
+// compared, and internal "_wandb" keys are ignored since the backend adds
+// its own.
+//
+// If the run was synced with an overwrite, pass it so that the same run is
+// looked up in the backend; it may be nil.
+//
+// Verification only reads the file and the backend, so it is safe to run
+// repeatedly, including while a sync is in progress.
+func VerifyRun(
+	ctx context.Context,
+	path string,
+	overwrite *service.SyncOverwrite,
+	graphqlClient graphql.Client,
+	logger *observability.CoreLogger,
+) (*VerifyReport, error) {
+	local, err := readLocalRunState(ctx, path, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VerifyReport{
+		Entity:  local.run.GetEntity(),
+		Project: local.run.GetProject(),
+		RunID:   local.run.GetRunId(),
+	}
+	if overwrite.GetEntity() != "" {
+		report.Entity = overwrite.GetEntity()
+	}
+	if overwrite.GetProject() != "" {
+		report.Project = overwrite.GetProject()
+	}
+	if overwrite.GetRunId() != "" {
+		report.RunID = overwrite.GetRunId()
+	}
+
+	data, err := gql.RunResumeStatus(
+		ctx,
+		graphqlClient,
+		&report.Project,
+		utils.NilIfZero(report.Entity),
+		report.RunID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("verify: failed to query run: %v", err)
+	}
+
+	bucket := data.GetModel().GetBucket()
+	if bucket == nil {
+		return nil, fmt.Errorf(
+			"verify: run %s/%s/%s not found",
+			report.Entity, report.Project, report.RunID)
+	}
+
+	backendConfig, err := decodeBackendConfig(bucket.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	backendSummary, err := decodeBackendObject(bucket.GetSummaryMetrics())
+	if err != nil {
+		return nil, fmt.Errorf("verify: bad summary: %v", err)
+	}
+
+	localSummary, err := local.summary.CloneTree()
+	if err != nil {
+		return nil, fmt.Errorf("verify: bad local summary: %v", err)
+	}
+
+	report.Divergences = append(report.Divergences,
+		compareTrees("config", local.config.Tree(), backendConfig)...)
+	report.Divergences = append(report.Divergences,
+		compareTrees("summary", localSummary, backendSummary)...)
+	report.Divergences = append(report.Divergences,
+		compareHistory(local, bucket)...)
+
+	return report, nil
+}
+
+// readLocalRunState replays a transaction log.
+func readLocalRunState(
+	ctx context.Context,
+	path string,
+	logger *observability.CoreLogger,
+) (*localRunState, error) {
+	store := NewStore(ctx, path, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, fmt.Errorf("verify: failed to open %s: %v", path, err)
+	}
+	defer store.Close()
+
+	state := &localRunState{
+		config:  runconfig.New(),
+		summary: runsummary.New(),
+	}
+	onError := func(err error) {
+		logger.CaptureError("verify: failed to apply record", err)
+	}
+
+	for {
+		record, err := store.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("verify: failed to read %s: %v", path, err)
+		}
+
+		switch x := record.RecordType.(type) {
+		case *service.Record_Run:
+			// Later run records are updates to the run; the first one
+			// identifies it.
+			if state.run == nil {
+				state.run = x.Run
+			}
+			if x.Run.GetConfig() != nil {
+				state.config.ApplyChangeRecord(x.Run.GetConfig(), onError)
+			}
+		case *service.Record_Config:
+			state.config.ApplyChangeRecord(x.Config, onError)
+		case *service.Record_Summary:
+			state.summary.ApplyChangeRecord(x.Summary, onError)
+		case *service.Record_History:
+			state.historyCount++
+			state.lastStep = x.History.GetStep().GetNum()
+		}
+	}
+
+	if state.run == nil {
+		return nil, fmt.Errorf("verify: no run record in %s", path)
+	}
+
+	return state, nil
+}
+
+// decodeBackendObject parses a JSON object returned by the backend.
+func decodeBackendObject(data *string) (map[string]any, error) {
+	if data == nil {
+		return map[string]any{}, nil
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(*data), &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// decodeBackendConfig parses the backend's config, in which each top-level
+// value is wrapped in a {"value": ...} object.
+func decodeBackendConfig(data *string) (map[string]any, error) {
+	wrapped, err := decodeBackendObject(data)
+	if err != nil {
+		return nil, fmt.Errorf("verify: bad config: %v", err)
+	}
+
+	config := make(map[string]any, len(wrapped))
+	for key, value := range wrapped {
+		if valueDict, ok := value.(map[string]any); ok {
+			config[key] = valueDict["value"]
+		} else {
+			config[key] = value
+		}
+	}
+	return config, nil
+}
+
+// compareTrees reports keys of the local tree that are missing or have a
+// different value in the backend's tree.
+func compareTrees(
+	section string,
+	local pathtree.TreeData,
+	backend map[string]any,
+) []Divergence {
+	keys := make([]string, 0, len(local))
+	for key := range local {
+		if key != "_wandb" {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var divergences []Divergence
+	for _, key := range keys {
+		localJSON, err := simplejsonext.Marshal(local[key])
+		if err != nil {
+			localJSON = []byte(fmt.Sprintf("%v", local[key]))
+		}
+
+		backendValue, ok := backend[key]
+		if !ok {
+			divergences = append(divergences, Divergence{
+				Kind:    DivergenceMissingKey,
+				Section: section,
+				Key:     key,
+				Local:   string(localJSON),
+			})
+			continue
+		}
+
+		backendJSON, _ := simplejsonext.Marshal(backendValue)
+		if !jsonEqual(localJSON, backendJSON) {
+			divergences = append(divergences, Divergence{
+				Kind:    DivergenceMismatchedValue,
+				Section: section,
+				Key:     key,
+				Local:   string(localJSON),
+				Backend: string(backendJSON),
+			})
+		}
+	}
+
+	return divergences
+}
+
+// jsonEqual returns whether two JSON documents represent the same value.
+//
+// Numbers are compared as floats, so 1 and 1.0 are equal.
+func jsonEqual(a, b []byte) bool {
+	var aValue, bValue any
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		// Values like NaN are not standard JSON.
+		return strings.TrimSpace(string(a)) == strings.TrimSpace(string(b))
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// compareHistory checks the number of history rows and the last step.
+func compareHistory(local *localRunState, bucket *gql.RunResumeStatusModelProjectBucketRun) []Divergence {
+	var divergences []Divergence
+
+	backendCount := 0
+	if bucket.GetHistoryLineCount() != nil {
+		backendCount = *bucket.GetHistoryLineCount()
+	}
+	if backendCount != local.historyCount {
+		divergences = append(divergences, Divergence{
+			Kind:    DivergenceHistory,
+			Section: "history",
+			Key:     "rows",
+			Local:   fmt.Sprint(local.historyCount),
+			Backend: fmt.Sprint(backendCount),
+		})
+	}
+
+	if local.historyCount == 0 {
+		return divergences
+	}
+
+	backendStep, ok := lastBackendStep(bucket.GetHistoryTail())
+	if !ok || backendStep != local.lastStep {
+		backend := ""
+		if ok {
+			backend = fmt.Sprint(backendStep)
+		}
+		divergences = append(divergences, Divergence{
+			Kind:    DivergenceHistory,
+			Section: "history",
+			Key:     "_step",
+			Local:   fmt.Sprint(local.lastStep),
+			Backend: backend,
+		})
+	}
+
+	return divergences
+}
+
+// lastBackendStep returns the step of the last row in the history tail.
+func lastBackendStep(historyTail *string) (int64, bool) {
+	if historyTail == nil {
+		return 0, false
+	}
+
+	var rows []string
+	if err := json.Unmarshal([]byte(*historyTail), &rows); err != nil {
+		return 0, false
+	}
+	if len(rows) == 0 {
+		return 0, false
+	}
+
+	var row map[string]any
+	if err := json.Unmarshal([]byte(rows[len(rows)-1]), &row); err != nil {
+		return 0, false
+	}
+
+	step, ok := row["_step"].(float64)
+	return int64(step), ok
+}
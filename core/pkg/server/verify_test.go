@@ -0,0 +1,228 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writeTransactionLog writes records to a new .wandb file.
+func writeTransactionLog(t *testing.T, records ...*service.Record) string {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	store := server.NewStore(context.Background(), path, observability.NewNoOpLogger())
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range records {
+		require.NoError(t, store.Write(record))
+	}
+	require.NoError(t, store.Close())
+	return path
+}
+
+// runResumeStatusResponse returns a RunResumeStatus response JSON.
+func runResumeStatusResponse(
+	t *testing.T,
+	config, summary, historyTail string,
+	historyLineCount int,
+) string {
+	response, err := json.Marshal(map[string]any{
+		"model": map[string]any{
+			"bucket": map[string]any{
+				"name":             "run-id",
+				"config":           config,
+				"summaryMetrics":   summary,
+				"historyTail":      historyTail,
+				"historyLineCount": historyLineCount,
+			},
+		},
+	})
+	require.NoError(t, err)
+	return string(response)
+}
+
+func testTransactionLog(t *testing.T) string {
+	return writeTransactionLog(t,
+		&service.Record{RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				Entity:  "entity",
+				Project: "project",
+				RunId:   "run-id",
+			},
+		}},
+		&service.Record{RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{
+				Update: []*service.ConfigItem{
+					{Key: "lr", ValueJson: "0.1"},
+					{Key: "epochs", ValueJson: "10"},
+				},
+			},
+		}},
+		&service.Record{RecordType: &service.Record_History{
+			History: &service.HistoryRecord{Step: &service.HistoryStep{Num: 0}},
+		}},
+		&service.Record{RecordType: &service.Record_History{
+			History: &service.HistoryRecord{Step: &service.HistoryStep{Num: 1}},
+		}},
+		&service.Record{RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{
+				Update: []*service.SummaryItem{
+					{Key: "loss", ValueJson: "0.5"},
+					{Key: "acc", ValueJson: "1"},
+				},
+			},
+		}},
+	)
+}
+
+func TestVerifyRun_Matches(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunResumeStatus"),
+		runResumeStatusResponse(t,
+			`{"lr": {"value": 0.1}, "epochs": {"value": 10},
+				"_wandb": {"value": {"cli_version": "0.17.0"}}}`,
+			`{"loss": 0.5, "acc": 1.0, "_wandb": {"runtime": 3}}`,
+			`["{\"_step\": 1}"]`,
+			2,
+		),
+	)
+
+	report, err := server.VerifyRun(
+		context.Background(),
+		testTransactionLog(t),
+		nil,
+		mockGQL,
+		observability.NewNoOpLogger(),
+	)
+
+	require.NoError(t, err)
+	assert.True(t, report.OK(), report.Divergences)
+	assert.Equal(t, "entity", report.Entity)
+	assert.Equal(t, "project", report.Project)
+	assert.Equal(t, "run-id", report.RunID)
+}
+
+func TestVerifyRun_ReportsDivergences(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunResumeStatus"),
+		runResumeStatusResponse(t,
+			`{"lr": {"value": 0.2}}`,
+			`{"loss": 0.5}`,
+			`["{\"_step\": 0}"]`,
+			1,
+		),
+	)
+
+	report, err := server.VerifyRun(
+		context.Background(),
+		testTransactionLog(t),
+		nil,
+		mockGQL,
+		observability.NewNoOpLogger(),
+	)
+
+	require.NoError(t, err)
+	assert.False(t, report.OK())
+	assert.Equal(t,
+		[]server.Divergence{
+			{
+				Kind:    server.DivergenceMissingKey,
+				Section: "config",
+				Key:     "epochs",
+				Local:   "10",
+			},
+			{
+				Kind:    server.DivergenceMismatchedValue,
+				Section: "config",
+				Key:     "lr",
+				Local:   "0.1",
+				Backend: "0.2",
+			},
+			{
+				Kind:    server.DivergenceMissingKey,
+				Section: "summary",
+				Key:     "acc",
+				Local:   "1",
+			},
+			{
+				Kind:    server.DivergenceHistory,
+				Section: "history",
+				Key:     "rows",
+				Local:   "2",
+				Backend: "1",
+			},
+			{
+				Kind:    server.DivergenceHistory,
+				Section: "history",
+				Key:     "_step",
+				Local:   "1",
+				Backend: "0",
+			},
+		},
+		report.Divergences,
+	)
+}
+
+func TestVerifyRun_RunNotFound(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunResumeStatus"),
+		`{"model": {"bucket": null}}`,
+	)
+
+	_, err := server.VerifyRun(
+		context.Background(),
+		testTransactionLog(t),
+		nil,
+		mockGQL,
+		observability.NewNoOpLogger(),
+	)
+
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestVerifyRun_Overwrite(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunResumeStatus"),
+		runResumeStatusResponse(t,
+			`{"lr": {"value": 0.1}, "epochs": {"value": 10}}`,
+			`{"loss": 0.5, "acc": 1}`,
+			`["{\"_step\": 1}"]`,
+			2,
+		),
+	)
+
+	report, err := server.VerifyRun(
+		context.Background(),
+		testTransactionLog(t),
+		&service.SyncOverwrite{Project: "other-project", RunId: "other-id"},
+		mockGQL,
+		observability.NewNoOpLogger(),
+	)
+
+	require.NoError(t, err)
+	assert.True(t, report.OK(), report.Divergences)
+	assert.Equal(t, "entity", report.Entity)
+	assert.Equal(t, "other-project", report.Project)
+	assert.Equal(t, "other-id", report.RunID)
+	requests := mockGQL.AllRequests()
+	require.Len(t, requests, 1)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("project", gomock.Eq("other-project")),
+			gqlmock.GQLVar("entity", gomock.Eq("entity")),
+			gqlmock.GQLVar("name", gomock.Eq("other-id")),
+		),
+		requests[0])
+}
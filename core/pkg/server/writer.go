@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"sync"
 
@@ -24,9 +25,10 @@ func WithWriterSettings(settings *service.Settings) WriterOption {
 }
 
 type WriterParams struct {
-	Logger   *observability.CoreLogger
-	Settings *service.Settings
-	FwdChan  chan *service.Record
+	Logger        *observability.CoreLogger
+	Settings      *service.Settings
+	FwdChan       chan *service.Record
+	FlushBarriers *FlushBarriers
 }
 
 // Writer is responsible for writing messages to the append-only log.
@@ -52,9 +54,24 @@ type Writer struct {
 	// store is the store for the writer
 	store *Store
 
+	// flushBarriers is signaled when FlushRequests are persisted
+	flushBarriers *FlushBarriers
+
 	// recordNum is the running count of stored records
 	recordNum int64
 
+	// fsyncRecords is how many records to store between syncs to disk,
+	// or zero to let the OS decide.
+	fsyncRecords int
+
+	// unsynced is the number of records stored since the last sync.
+	//
+	// It is only used in the store goroutine.
+	unsynced int
+
+	// sinks are additional destinations for records
+	sinks []Sink
+
 	// wg is the wait group for the writer
 	wg sync.WaitGroup
 }
@@ -67,7 +84,11 @@ func NewWriter(ctx context.Context, params *WriterParams) *Writer {
 		logger:   params.Logger,
 		settings: params.Settings,
 		fwdChan:  params.FwdChan,
+
+		flushBarriers: params.FlushBarriers,
 	}
+	w.fsyncRecords = max(0,
+		int(w.settings.GetXTransactionLogFsyncRecords().GetValue()))
 	return w
 }
 
@@ -77,10 +98,31 @@ func (w *Writer) startStore() {
 		return
 	}
 
+	if w.settings.GetXDisableTransactionLog().GetValue() {
+		w.logger.Info("writer: transaction log is disabled")
+		return
+	}
+
 	w.storeChan = make(chan *service.Record, BufferSize*8)
 
-	var err error
-	w.store = NewStore(w.ctx, w.settings.GetSyncFile().GetValue(), w.logger)
+	var opts []StoreOption
+	codec, err := NewRecordCodec(w.settings.GetXTransactionLogCompression().GetValue())
+	if err != nil {
+		w.logger.CaptureError("writer: not compressing transaction log", err)
+	} else if codec != nil {
+		opts = append(opts, WithStoreCodec(codec))
+	}
+	switch encoding := w.settings.GetXTransactionLogHistoryEncoding().GetValue(); encoding {
+	case "", "json":
+	case "arrow":
+		opts = append(opts, WithStoreArrowHistory())
+	default:
+		w.logger.CaptureError(
+			"writer: not encoding history with Arrow",
+			fmt.Errorf("unknown transaction log history encoding %q", encoding))
+	}
+
+	w.store = NewStore(w.ctx, w.settings.GetSyncFile().GetValue(), w.logger, opts...)
 	err = w.store.Open(os.O_WRONLY)
 	if err != nil {
 		w.logger.CaptureFatalAndPanic("writer: startStore: error creating store", err)
@@ -89,9 +131,16 @@ func (w *Writer) startStore() {
 	w.wg.Add(1)
 	go func() {
 		for record := range w.storeChan {
+			if flush := record.GetRequest().GetFlush(); flush != nil {
+				w.flushStore(flush)
+				continue
+			}
+
 			if err = w.store.Write(record); err != nil {
 				w.logger.Error("writer: startStore: error storing record", "error", err)
+				continue
 			}
+			w.syncStoreIfDue()
 		}
 
 		if err = w.store.Close(); err != nil {
@@ -116,6 +165,13 @@ func (w *Writer) Do(inChan <-chan *service.Record) {
 	w.wg.Wait()
 }
 
+// AddSinks adds destinations for records besides the sender.
+//
+// It must be called before Do.
+func (w *Writer) AddSinks(sinks ...Sink) {
+	w.sinks = append(w.sinks, sinks...)
+}
+
 // Close closes the writer and all its resources
 // which includes the store
 func (w *Writer) Close() {
@@ -123,6 +179,11 @@ func (w *Writer) Close() {
 	if w.storeChan != nil {
 		close(w.storeChan)
 	}
+	for _, sink := range w.sinks {
+		if err := closeSink(sink); err != nil {
+			w.logger.CaptureError("writer: error closing sink", err)
+		}
+	}
 	w.logger.Info("writer: Close: closed", "stream_id", w.settings.RunId)
 }
 
@@ -131,20 +192,84 @@ func (w *Writer) Close() {
 // We ensure that the messages are written to the log
 // before they are sent to the server.
 func (w *Writer) writeRecord(record *service.Record) {
-	switch record.RecordType.(type) {
+	switch x := record.RecordType.(type) {
 	case *service.Record_Request:
+		if flush := x.Request.GetFlush(); flush != nil {
+			w.writeFlush(flush, record)
+		}
 		w.fwdRecord(record)
 	case nil:
 		w.logger.Error("writer: writeRecord: nil record type")
 	default:
-		w.fwdRecord(record)
+		if !record.GetControl().GetPersistOnly() {
+			w.fwdRecord(record)
+		}
 		w.storeRecord(record)
+		w.sinkRecord(record)
+	}
+}
+
+// sinkRecord passes the record to all sinks.
+func (w *Writer) sinkRecord(record *service.Record) {
+	for _, sink := range w.sinks {
+		if err := sink.Write(record); err != nil {
+			w.logger.Error("writer: error writing record to sink", "error", err)
+		}
+	}
+}
+
+// writeFlush passes a FlushRequest through the store so that it is
+// signaled after all earlier records are persisted.
+func (w *Writer) writeFlush(flush *service.FlushRequest, record *service.Record) {
+	if w.storeChan == nil {
+		// There is no transaction log when syncing an offline run or
+		// when it's disabled.
+		w.flushBarriers.MarkPersisted(flush)
+		return
+	}
+
+	w.storeChan <- record
+}
+
+// flushStore syncs the transaction log to disk and signals the request.
+//
+// It runs in the store goroutine.
+func (w *Writer) flushStore(flush *service.FlushRequest) {
+	if err := w.store.Flush(); err != nil {
+		w.logger.CaptureError("writer: failed to flush store", err)
+		w.flushBarriers.MarkFailed(flush,
+			fmt.Errorf("writer: failed to flush transaction log: %v", err))
+		return
+	}
+	w.unsynced = 0
+
+	w.flushBarriers.MarkPersisted(flush)
+}
+
+// syncStoreIfDue syncs the transaction log to disk if enough records were
+// stored since the last sync.
+//
+// It runs in the store goroutine.
+func (w *Writer) syncStoreIfDue() {
+	if w.fsyncRecords == 0 {
+		return
+	}
+
+	w.unsynced++
+	if w.unsynced < w.fsyncRecords {
+		return
+	}
+
+	if err := w.store.Flush(); err != nil {
+		w.logger.CaptureError("writer: failed to sync store", err)
+		return
 	}
+	w.unsynced = 0
 }
 
 // storeRecord stores the record in the append-only log
 func (w *Writer) storeRecord(record *service.Record) {
-	if record.GetControl().GetLocal() {
+	if w.storeChan == nil || record.GetControl().GetLocal() {
 		return
 	}
 	w.recordNum += 1
@@ -0,0 +1,104 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// readStoredRecords returns the records in a transaction log.
+func readStoredRecords(path string) []*service.Record {
+	store := server.NewStore(context.Background(), path, observability.NewNoOpLogger())
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil
+	}
+	defer store.Close()
+
+	var records []*service.Record
+	for {
+		record, err := store.Read()
+		if err != nil {
+			return records
+		}
+		records = append(records, record)
+	}
+}
+
+func TestWriter_FsyncEveryRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(),
+		&server.WriterParams{
+			Logger: observability.NewNoOpLogger(),
+			Settings: &service.Settings{
+				SyncFile:                    wrapperspb.String(path),
+				XTransactionLogFsyncRecords: wrapperspb.Int32(1),
+			},
+			FwdChan: fwdChan,
+		},
+	)
+	inChan := make(chan *service.Record, server.BufferSize)
+	done := make(chan struct{})
+	go func() {
+		writer.Do(inChan)
+		close(done)
+	}()
+
+	inChan <- historyRecord()
+
+	// The record is on disk before the writer is closed.
+	require.Eventually(t,
+		func() bool { return len(readStoredRecords(path)) == 1 },
+		5*time.Second, time.Millisecond)
+	assert.NotNil(t, readStoredRecords(path)[0].GetHistory())
+
+	close(inChan)
+	for range fwdChan {
+	}
+	<-done
+}
+
+func TestWriter_PersistOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(),
+		&server.WriterParams{
+			Logger: observability.NewNoOpLogger(),
+			Settings: &service.Settings{
+				SyncFile: wrapperspb.String(path),
+			},
+			FwdChan: fwdChan,
+		},
+	)
+	inChan := make(chan *service.Record, server.BufferSize)
+	done := make(chan struct{})
+	go func() {
+		writer.Do(inChan)
+		close(done)
+	}()
+
+	record := historyRecord()
+	record.Control = &service.Control{PersistOnly: true}
+	inChan <- record
+	close(inChan)
+
+	var forwarded []*service.Record
+	for record := range fwdChan {
+		forwarded = append(forwarded, record)
+	}
+	<-done
+
+	assert.Empty(t, forwarded)
+	stored := readStoredRecords(path)
+	require.Len(t, stored, 1)
+	assert.NotNil(t, stored[0].GetHistory())
+}
@@ -0,0 +1,124 @@
+// Package shutdownhooks runs functions when a run finishes.
+package shutdownhooks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// DefaultTimeout is how long a hook may run if it was added without a
+// timeout.
+const DefaultTimeout = 10 * time.Second
+
+// Hook is a function called when a run finishes.
+//
+// The context is canceled when the hook's timeout expires, after which the
+// run stops waiting for the hook.
+type Hook func(ctx context.Context)
+
+// Hooks are the functions to call before a run finishes.
+//
+// The zero value is ready to use.
+type Hooks struct {
+	mu sync.Mutex
+
+	hooks []namedHook
+
+	// ran is whether Run was called.
+	ran bool
+
+	// done is closed once the hooks finish running.
+	done chan struct{}
+}
+
+type namedHook struct {
+	name    string
+	timeout time.Duration
+	hook    Hook
+}
+
+// Add registers a hook.
+//
+// Returns false if the hooks already ran.
+func (h *Hooks) Add(
+	name string,
+	timeout time.Duration,
+	hook Hook,
+) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.ran {
+		return false
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	h.hooks = append(h.hooks, namedHook{name, timeout, hook})
+	return true
+}
+
+// Run calls the hooks in the reverse order they were added.
+//
+// It only runs the hooks the first time it's called; later calls wait
+// until they finish. Hooks that time out or panic are logged and don't
+// stop the remaining hooks.
+func (h *Hooks) Run(logger *observability.CoreLogger) {
+	h.mu.Lock()
+	if h.done == nil {
+		h.done = make(chan struct{})
+	}
+	done := h.done
+	if h.ran {
+		h.mu.Unlock()
+		<-done
+		return
+	}
+	h.ran = true
+	hooks := h.hooks
+	h.hooks = nil
+	h.mu.Unlock()
+
+	defer close(done)
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hooks[i].run(logger)
+	}
+}
+
+// run calls the hook and waits until it returns or times out.
+func (h namedHook) run(logger *observability.CoreLogger) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if err := recover(); err != nil {
+				logger.CaptureError(
+					"shutdownhooks: hook panicked",
+					fmt.Errorf("%v", err),
+					"hook", h.name,
+				)
+			}
+		}()
+
+		h.hook(ctx)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logger.CaptureWarn(
+			"shutdownhooks: hook timed out",
+			"hook", h.name,
+			"timeout", h.timeout,
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package shutdownhooks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/shutdownhooks"
+)
+
+func TestRun_ReverseOrderDespitePanicsAndTimeouts(t *testing.T) {
+	var hooks shutdownhooks.Hooks
+	calls := make(chan string, 3)
+	hooks.Add("first", 0, func(ctx context.Context) {
+		calls <- "first"
+	})
+	hooks.Add("panics", 0, func(ctx context.Context) {
+		calls <- "panics"
+		panic("test panic")
+	})
+	hooks.Add("hangs", 10*time.Millisecond, func(ctx context.Context) {
+		calls <- "hangs"
+		select {}
+	})
+
+	hooks.Run(observability.NewNoOpLogger())
+
+	assert.Equal(t,
+		[]string{"hangs", "panics", "first"},
+		[]string{<-calls, <-calls, <-calls})
+	assert.False(t, hooks.Add("late", 0, func(ctx context.Context) {}))
+}
+
+func TestRun_SecondCallWaits(t *testing.T) {
+	var hooks shutdownhooks.Hooks
+	started := make(chan struct{})
+	release := make(chan struct{})
+	finished := false
+	hooks.Add("hook", 0, func(ctx context.Context) {
+		close(started)
+		<-release
+		finished = true
+	})
+	go hooks.Run(observability.NewNoOpLogger())
+	<-started
+
+	secondDone := make(chan struct{})
+	go func() {
+		hooks.Run(observability.NewNoOpLogger())
+		close(secondDone)
+	}()
+
+	select {
+	case <-secondDone:
+		t.Fatal("expected the second Run to wait for the hooks")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	select {
+	case <-secondDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("second Run did not return")
+	}
+	assert.True(t, finished)
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"log/slog"
 )
@@ -28,3 +29,23 @@ func ShortID(length int) string {
 	}
 	return string(b)
 }
+
+// SeededShortID is like ShortID but derives the ID from a seed.
+//
+// The same seed always produces the same ID.
+func SeededShortID(seed string, length int) string {
+	charsLen := len(chars)
+	b := make([]byte, 0, length)
+
+	digest := sha256.Sum256([]byte(seed))
+	for len(b) < length {
+		for _, x := range digest {
+			if len(b) == length {
+				break
+			}
+			b = append(b, chars[int(x)%charsLen])
+		}
+		digest = sha256.Sum256(digest[:])
+	}
+	return string(b)
+}
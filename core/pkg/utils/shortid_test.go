@@ -13,3 +13,13 @@ func TestShortID(t *testing.T) {
 		assert.Equal(t, len(item), 8)
 	})
 }
+
+func TestSeededShortID(t *testing.T) {
+	id := utils.SeededShortID("job-1234", 8)
+
+	assert.Len(t, id, 8)
+	assert.Regexp(t, "^[a-z0-9]+$", id)
+	assert.Equal(t, id, utils.SeededShortID("job-1234", 8))
+	assert.NotEqual(t, id, utils.SeededShortID("job-1235", 8))
+	assert.Len(t, utils.SeededShortID("job-1234", 100), 100)
+}
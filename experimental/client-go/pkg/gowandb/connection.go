@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -22,6 +23,9 @@ type Connection struct {
 	// Conn is the connection to the server
 	net.Conn
 	Mbox *Mailbox
+
+	// sendMu keeps messages sent concurrently from interleaving.
+	sendMu sync.Mutex
 }
 
 // NewConnection creates a new connection to the server.
@@ -46,6 +50,10 @@ func (c *Connection) Send(msg proto.Message) error {
 	if err != nil {
 		return fmt.Errorf("error marshaling message: %w", err)
 	}
+
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+
 	writer := bufio.NewWriterSize(c, 16384)
 
 	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
@@ -1,7 +1,10 @@
 package gowandb
 
 import (
+	"context"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/wandb/wandb/core/pkg/service"
 	"github.com/wandb/wandb/core/pkg/utils"
@@ -12,6 +15,7 @@ type MailboxHandle struct {
 }
 
 type Mailbox struct {
+	mu      sync.Mutex
 	handles map[string]*MailboxHandle
 }
 
@@ -22,7 +26,8 @@ func NewMailbox() *Mailbox {
 }
 
 func NewMailboxHandle() *MailboxHandle {
-	mbh := &MailboxHandle{responseChan: make(chan *service.Result)}
+	// Buffered so that responding never blocks if the waiter gave up.
+	mbh := &MailboxHandle{responseChan: make(chan *service.Result, 1)}
 	return mbh
 }
 
@@ -31,11 +36,35 @@ func (mbh *MailboxHandle) wait() *service.Result {
 	return got
 }
 
+// waitTimeout is like wait, but returns nil if there is no response
+// within the timeout.
+func (mbh *MailboxHandle) waitTimeout(timeout time.Duration) *service.Result {
+	select {
+	case got := <-mbh.responseChan:
+		return got
+	case <-time.After(timeout):
+		return nil
+	}
+}
+
+// waitContext is like wait, but returns nil if the context is done
+// before there is a response.
+func (mbh *MailboxHandle) waitContext(ctx context.Context) *service.Result {
+	select {
+	case got := <-mbh.responseChan:
+		return got
+	case <-ctx.Done():
+		return nil
+	}
+}
+
 func (mb *Mailbox) Deliver(rec *service.Record) *MailboxHandle {
 	uuid := "core:" + utils.ShortID(12)
 	rec.Control = &service.Control{MailboxSlot: uuid}
 	handle := NewMailboxHandle()
+	mb.mu.Lock()
 	mb.handles[uuid] = handle
+	mb.mu.Unlock()
 	return handle
 }
 
@@ -44,11 +73,12 @@ func (mb *Mailbox) Respond(result *service.Result) bool {
 	if !strings.HasPrefix(slot, "core:") {
 		return false
 	}
+	mb.mu.Lock()
 	handle, ok := mb.handles[slot]
+	delete(mb.handles, slot)
+	mb.mu.Unlock()
 	if ok {
 		handle.responseChan <- result
-		// clean up after thyself?
-		delete(mb.handles, slot)
 	}
 	return ok
 }
@@ -35,7 +35,9 @@ func (m *Manager) NewRun(runParams *runopts.RunParams) *Run {
 	conn := m.Connect(m.ctx)
 	// make a copy of the base manager settings
 	runSettings := m.settings.Copy()
-	if runParams.RunID != nil {
+	if seed := runSettings.RunIdSeed.GetValue(); seed != "" {
+		runSettings.SetRunID(utils.SeededShortID(seed, 8))
+	} else if runParams.RunID != nil {
 		runSettings.SetRunID(*runParams.RunID)
 	} else if runSettings.RunId == nil {
 		runSettings.SetRunID(utils.ShortID(8))
@@ -0,0 +1,119 @@
+package gowandb
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// PreflightError says why runs can't be logged to a project.
+type PreflightError struct {
+	// Check is the check that failed: "connectivity", "authentication"
+	// or "permissions".
+	Check string
+
+	// Message explains the failure.
+	Message string
+}
+
+func (e *PreflightError) Error() string {
+	return e.Message
+}
+
+// Preflight checks that runs can be logged to the project, without
+// starting one.
+//
+// It verifies that the backend is reachable, that it accepts the
+// credentials, and that the entity and project are writable. Empty
+// values mean the entity and project in the session's settings.
+//
+// Returns nil if runs can be logged, a *PreflightError if a check failed,
+// and another error if the checks couldn't run.
+func (s *Session) Preflight(ctx context.Context, entity, project string) error {
+	return s.manager.Preflight(ctx, entity, project)
+}
+
+// Preflight runs preflight checks in a stream that never creates a run.
+func (m *Manager) Preflight(ctx context.Context, entity, project string) error {
+	tmpDir, err := os.MkdirTemp("", "wandb-preflight-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Nothing is logged or saved, so everything goes to a scratch directory.
+	streamSettings := m.settings.Copy()
+	streamSettings.WandbDir = wrapperspb.String(tmpDir)
+	streamSettings.SetRunID(utils.ShortID(8))
+	streamSettings.XDisableTransactionLog = wrapperspb.Bool(true)
+	streamID := streamSettings.GetRunId().GetValue()
+	if err := os.MkdirAll(streamSettings.GetLogDir().GetValue(), os.ModePerm); err != nil {
+		return err
+	}
+
+	conn := m.Connect(ctx)
+	defer conn.Close()
+	go conn.Recv()
+
+	err = conn.Send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_InformInit{
+			InformInit: &service.ServerInformInitRequest{
+				Settings: streamSettings.Settings,
+				XInfo:    &service.XRecordInfo{StreamId: streamID},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Send(&service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_InformFinish{
+				InformFinish: &service.ServerInformFinishRequest{
+					XInfo: &service.XRecordInfo{StreamId: streamID},
+				},
+			},
+		})
+	}()
+
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_Preflight{
+				Preflight: &service.PreflightRequest{
+					Entity:  entity,
+					Project: project,
+				},
+			},
+		}},
+		Control: &service.Control{Local: true},
+		XInfo:   &service.XRecordInfo{StreamId: streamID},
+	}
+	handle := conn.Mbox.Deliver(&record)
+	err = conn.Send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{
+			RecordCommunicate: &record,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	result := handle.waitContext(ctx)
+	if result == nil {
+		return fmt.Errorf("gowandb: no preflight response: %w", ctx.Err())
+	}
+
+	response := result.GetResponse().GetPreflightResponse()
+	if response.GetError() == nil {
+		return nil
+	}
+	return &PreflightError{
+		Check:   response.GetFailedCheck(),
+		Message: response.GetError().GetMessage(),
+	}
+}
@@ -2,30 +2,49 @@ package gowandb
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/segmentio/encoding/json"
 
+	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/shutdownhooks"
 	"github.com/wandb/wandb/core/pkg/utils"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/metricopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/runconfig"
 )
 
+// killFlushTimeout is how long Kill waits for the run's data to be sent.
+const killFlushTimeout = 10 * time.Second
+
+// requestTimeout is how long to wait for the core to answer a request
+// before giving up on it.
+const requestTimeout = 30 * time.Second
+
+// killedExitCode is the exit code of a killed run, matching an interrupted
+// Python process.
+const killedExitCode = 255
+
 type Settings map[string]interface{}
 
 type Run struct {
 	// ctx is the context for the run
-	ctx            context.Context
-	settings       *service.Settings
-	config         *runconfig.Config
-	conn           *Connection
-	wg             sync.WaitGroup
-	run            *service.RunRecord
-	params         *runopts.RunParams
-	partialHistory History
+	ctx      context.Context
+	settings *service.Settings
+	config   *runconfig.Config
+	conn     *Connection
+	wg       sync.WaitGroup
+	run      *service.RunRecord
+	params   *runopts.RunParams
+
+	// shutdownHooks are called before the run's exit is sent
+	shutdownHooks shutdownhooks.Hooks
 }
 
 // NewRun creates a new run with the given settings and responders.
@@ -38,7 +57,6 @@ func NewRun(ctx context.Context, settings *service.Settings, conn *Connection, r
 		config:   runParams.Config,
 		params:   runParams,
 	}
-	run.resetPartialHistory()
 	return run
 }
 
@@ -150,8 +168,14 @@ func (r *Run) start() {
 	handle.wait()
 }
 
-func (r *Run) logCommit(data map[string]interface{}) {
-	history := service.PartialHistoryRequest{}
+// logPartial sends history items for the current step.
+//
+// Items accumulate in the core until a request with commit set, which logs
+// them as one row and advances the step.
+func (r *Run) logPartial(data map[string]interface{}, commit bool) {
+	history := service.PartialHistoryRequest{
+		Action: &service.HistoryAction{Flush: commit},
+	}
 	for key, value := range data {
 		// strValue := strconv.FormatFloat(value, 'f', -1, 64)
 		data, err := json.Marshal(value)
@@ -182,34 +206,221 @@ func (r *Run) logCommit(data map[string]interface{}) {
 	}
 }
 
-func (r *Run) resetPartialHistory() {
-	r.partialHistory = make(map[string]interface{})
+// communicate sends a request to the core and waits for its response.
+//
+// Requests are handled after all records sent before them. Returns nil if
+// the request could not be sent or there was no response within
+// requestTimeout.
+func (r *Run) communicate(request *service.Request) *service.Response {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: request},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+
+	handle := r.conn.Mbox.Deliver(&record)
+	err := r.conn.Send(&serverRecord)
+	if err != nil {
+		slog.Error("gowandb: error sending request", "err", err)
+		return nil
+	}
+
+	result := handle.waitTimeout(requestTimeout)
+	if result == nil {
+		slog.Error("gowandb: no response to request", "timeout", requestTimeout)
+	}
+	return result.GetResponse()
+}
+
+// runStatus asks the core for the run's step and runtime.
+//
+// The request is handled after all history logged before it, so the
+// status reflects everything logged so far. Returns nil if the request
+// could not be sent.
+func (r *Run) runStatus() *service.RunStatusResponse {
+	return r.communicate(&service.Request{RequestType: &service.Request_RunStatus{
+		RunStatus: &service.RunStatusRequest{},
+	}}).GetRunStatusResponse()
 }
 
-func (r *Run) LogPartial(data map[string]interface{}, commit bool) {
-	for k, v := range data {
-		r.partialHistory[k] = v
+// Summary returns the run's current summary.
+//
+// It reflects all values logged before the call, including summaries
+// computed by the core such as the best value of a metric. The result is
+// a copy that the caller may modify.
+func (r *Run) Summary() map[string]any {
+	response := r.communicate(&service.Request{RequestType: &service.Request_GetSummary{
+		GetSummary: &service.GetSummaryRequest{},
+	}})
+
+	summary := make(map[string]any)
+	for _, item := range response.GetGetSummaryResponse().GetItem() {
+		setPath(summary, itemPath(item.GetKey(), item.GetNestedKey()), item.GetValueJson())
 	}
-	if commit {
-		r.LogPartialCommit()
+	return summary
+}
+
+// Config returns the run's current config.
+//
+// It reflects all config updates made before the call. Internal values
+// are left out. The result is a copy that the caller may modify.
+func (r *Run) Config() map[string]any {
+	response := r.communicate(&service.Request{RequestType: &service.Request_GetConfig{
+		GetConfig: &service.GetConfigRequest{},
+	}})
+
+	config := make(map[string]any)
+	for _, item := range response.GetGetConfigResponse().GetItem() {
+		setPath(config, itemPath(item.GetKey(), item.GetNestedKey()), item.GetValueJson())
 	}
+	return config
 }
 
+// Step returns the step of the next history row.
+//
+// This is the core's step counter, so it counts every row logged before
+// the call. It is safe to call concurrently with logging.
+func (r *Run) Step() int64 {
+	return r.runStatus().GetHistoryStep()
+}
+
+// StartTime returns when the run started.
+//
+// It is safe to call concurrently with logging.
+func (r *Run) StartTime() time.Time {
+	startTime := r.runStatus().GetStartTime()
+	if startTime == nil {
+		return time.Time{}
+	}
+	return startTime.AsTime()
+}
+
+// Runtime returns how long the run has been running.
+//
+// It is safe to call concurrently with logging.
+func (r *Run) Runtime() time.Duration {
+	seconds := r.runStatus().GetRuntime()
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// Flush blocks until all data logged so far is durably persisted.
+//
+// It waits until the data is written to the run's transaction log and,
+// when online, until the backend acknowledges it. Before the run starts,
+// or when offline, the data cannot be sent, and it returns once the data
+// is persisted.
+//
+// It returns the last stage that the data completed, and an error if it
+// stopped short because of a failure or because the context is done.
+func (r *Run) Flush(ctx context.Context) (service.FlushResponse_Stage, error) {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_Flush{Flush: &service.FlushRequest{}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+
+	handle := r.conn.Mbox.Deliver(&record)
+	if err := r.conn.Send(&serverRecord); err != nil {
+		return service.FlushResponse_NONE, err
+	}
+
+	result := handle.waitContext(ctx)
+	if result == nil {
+		return service.FlushResponse_NONE, ctx.Err()
+	}
+
+	response := result.GetResponse().GetFlushResponse()
+	if response.GetError() != "" {
+		return response.GetStage(), errors.New(response.GetError())
+	}
+	return response.GetStage(), nil
+}
+
+// LogPartial logs values for the current step.
+//
+// If commit is false, the values accumulate into the current step without
+// advancing it. Once committed, all values accumulated for the step are
+// logged as one row, with later values of a key replacing earlier ones.
+// Uncommitted values are committed when the run finishes.
+func (r *Run) LogPartial(data map[string]interface{}, commit bool) {
+	r.logPartial(data, commit)
+}
+
+// LogPartialCommit commits the values accumulated for the current step.
+//
+// If there are none, no row is logged but the step still advances.
 func (r *Run) LogPartialCommit() {
-	r.logCommit(r.partialHistory)
-	r.resetPartialHistory()
+	r.logPartial(nil, true)
 }
 
 func (r *Run) Log(data map[string]interface{}) {
 	r.LogPartial(data, true)
 }
 
+// DefineMetric sets how a metric is plotted and summarized.
+//
+// A name ending in "*" defines every metric with that prefix. It applies
+// to values logged after the call.
+func (r *Run) DefineMetric(name string, opts ...metricopts.MetricOption) {
+	params := &metricopts.MetricParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	metric := &service.MetricRecord{
+		Options: &service.MetricOptions{Defined: true},
+		Summary: &service.MetricSummary{},
+	}
+	if strings.HasSuffix(name, "*") {
+		metric.GlobName = name
+	} else {
+		metric.Name = name
+	}
+	if params.StepMetric != nil {
+		metric.StepMetric = *params.StepMetric
+		metric.Options.StepSync = true
+	}
+	if params.ArraySummary != nil {
+		metric.Summary.Array = *params.ArraySummary
+	}
+	if params.HistogramBins != nil {
+		metric.Summary.HistogramBins = *params.HistogramBins
+	}
+
+	record := service.Record{
+		RecordType: &service.Record_Metric{Metric: metric},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	}
+
+	if err := r.conn.Send(&serverRecord); err != nil {
+		slog.Error("gowandb: error defining metric", "err", err)
+	}
+}
+
 func (r *Run) sendExit() {
+	handle := r.deliverExit(&service.RunExitRecord{ExitCode: 0})
+	if handle != nil {
+		handle.wait()
+	}
+}
+
+// deliverExit sends an exit record and returns the handle for its response.
+//
+// Returns nil if the record could not be sent.
+func (r *Run) deliverExit(exit *service.RunExitRecord) *MailboxHandle {
+	exit.XInfo = &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}
 	record := service.Record{
-		RecordType: &service.Record_Exit{
-			Exit: &service.RunExitRecord{
-				ExitCode: 0, XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}}},
-		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+		RecordType: &service.Record_Exit{Exit: exit},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
@@ -217,9 +428,9 @@ func (r *Run) sendExit() {
 	handle := r.conn.Mbox.Deliver(&record)
 	err := r.conn.Send(&serverRecord)
 	if err != nil {
-		return
+		return nil
 	}
-	handle.wait()
+	return handle
 }
 
 func (r *Run) sendShutdown() {
@@ -255,7 +466,30 @@ func (r *Run) sendInformFinish() {
 	}
 }
 
+// AddShutdownHook registers a function to call when the run finishes.
+//
+// Hooks are called by Finish and Kill in the reverse order they were
+// added, before the run's exit is sent, so they may still log data. Each
+// hook may run for up to its timeout, or shutdownhooks.DefaultTimeout if
+// the timeout is not positive; a panic in a hook is logged and does not
+// stop the run from finishing.
+//
+// Returns false if the run is already finishing, in which case the hook
+// is never called.
+func (r *Run) AddShutdownHook(
+	name string,
+	timeout time.Duration,
+	hook shutdownhooks.Hook,
+) bool {
+	return r.shutdownHooks.Add(name, timeout, hook)
+}
+
+func (r *Run) runShutdownHooks() {
+	r.shutdownHooks.Run(observability.NewCoreLogger(slog.Default()))
+}
+
 func (r *Run) Finish() {
+	r.runShutdownHooks()
 	r.sendExit()
 	r.sendShutdown()
 	r.sendInformFinish()
@@ -264,3 +498,24 @@ func (r *Run) Finish() {
 	r.wg.Wait()
 	utils.PrintHeadFoot(r.run, r.settings, true)
 }
+
+// Kill stops the run early and marks it as killed rather than finished.
+//
+// The reason is recorded in the run's summary. Data that isn't sent within
+// a short deadline is abandoned.
+func (r *Run) Kill(reason string) {
+	r.runShutdownHooks()
+	handle := r.deliverExit(&service.RunExitRecord{
+		ExitCode:   killedExitCode,
+		Killed:     true,
+		KillReason: reason,
+	})
+	if handle != nil && handle.waitTimeout(killFlushTimeout) == nil {
+		slog.Warn("gowandb: killed run did not stop in time", "reason", reason)
+	}
+	r.sendInformFinish()
+
+	r.conn.Close()
+	r.wg.Wait()
+	utils.PrintHeadFoot(r.run, r.settings, true)
+}
@@ -0,0 +1,56 @@
+package gowandb
+
+import (
+	"log/slog"
+	"math"
+
+	"github.com/segmentio/encoding/json"
+)
+
+// itemPath returns the path of a summary or config item.
+func itemPath(key string, nestedKey []string) []string {
+	if len(nestedKey) > 0 {
+		return nestedKey
+	}
+	return []string{key}
+}
+
+// setPath sets the decoded JSON value at the path in a nested map.
+//
+// Values that can't be decoded are skipped.
+func setPath(tree map[string]any, path []string, valueJSON string) {
+	value, ok := decodeValue(valueJSON)
+	if !ok {
+		slog.Error("gowandb: cannot decode value", "path", path)
+		return
+	}
+
+	for _, key := range path[:len(path)-1] {
+		subtree, ok := tree[key].(map[string]any)
+		if !ok {
+			subtree = make(map[string]any)
+			tree[key] = subtree
+		}
+		tree = subtree
+	}
+	tree[path[len(path)-1]] = value
+}
+
+// decodeValue decodes a JSON value, accepting the NaN and infinity
+// values that the core writes for such floats.
+func decodeValue(valueJSON string) (any, bool) {
+	switch valueJSON {
+	case "NaN":
+		return math.NaN(), true
+	case "Infinity":
+		return math.Inf(1), true
+	case "-Infinity":
+		return math.Inf(-1), true
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(valueJSON), &value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
@@ -0,0 +1,38 @@
+// sub-package for gowandb metric options
+package metricopts
+
+import (
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+type MetricParams struct {
+	StepMetric    *string
+	ArraySummary  *service.MetricSummary_ArrayMode
+	HistogramBins *int32
+}
+
+type MetricOption func(*MetricParams)
+
+// WithStepMetric plots the metric against another metric instead of the
+// run's step.
+func WithStepMetric(stepMetric string) MetricOption {
+	return func(p *MetricParams) {
+		p.StepMetric = &stepMetric
+	}
+}
+
+// WithArraySummary sets how numeric array values of the metric are
+// summarized.
+func WithArraySummary(mode service.MetricSummary_ArrayMode) MetricOption {
+	return func(p *MetricParams) {
+		p.ArraySummary = &mode
+	}
+}
+
+// WithHistogramBins adds a histogram of all the metric's logged values to
+// the summary when the run finishes.
+func WithHistogramBins(bins int32) MetricOption {
+	return func(p *MetricParams) {
+		p.HistogramBins = &bins
+	}
+}
@@ -3,6 +3,8 @@ import os
 from logging import getLogger
 from pathlib import Path, PurePath
 
+import pytest
+
 from wandb.sdk.artifacts.artifact import Artifact
 from wandb.sdk.artifacts.artifact_manifest_entry import ArtifactManifestEntry
 
@@ -72,3 +74,33 @@ def test_manifest_download(monkeypatch):
     short_entry.path = default_cache
     fpath = PurePath(short_entry.download(root=abspath_to_cur_dir, skip_cache=True))
     assert fpath.parts[-3:] == ("unit_tests", "test_artifacts", "default_cache")
+
+
+def test_add_file_metadata(tmp_path):
+    path = tmp_path / "cat.png"
+    path.write_text("meow")
+    artifact = Artifact("pets", type="dataset")
+
+    entry = artifact.add_file(str(path), policy="immutable", metadata={"label": "cat"})
+
+    assert entry.metadata == {"label": "cat"}
+    assert artifact.manifest.entries["cat.png"].extra == {"metadata": {"label": "cat"}}
+
+
+@pytest.mark.parametrize(
+    "metadata, error",
+    [
+        ({"": 1}, "non-empty strings"),
+        ({"label": object()}, "JSON-serializable"),
+        ({"label": "x" * 5000}, "limit of 4096"),
+    ],
+)
+def test_add_file_invalid_metadata(tmp_path, metadata, error):
+    path = tmp_path / "cat.png"
+    path.write_text("meow")
+    artifact = Artifact("pets", type="dataset")
+
+    with pytest.raises(ValueError, match=error):
+        artifact.add_file(str(path), metadata=metadata)
+
+    assert not artifact.manifest.entries
@@ -2,6 +2,7 @@
 
 import json
 import os
+import zlib
 
 import pytest
 import wandb
@@ -141,3 +142,73 @@ def test_data_write_fill(with_datastore):
         expected_records=records,
         expected_record_sizes=lengths,
     )
+
+
+def test_scan_compressed(with_datastore):
+    """Read records of a transaction log compressed by wandb-core."""
+    compressor = zlib.compressobj(wbits=-zlib.MAX_WBITS)
+    deflated = compressor.compress(b"compressed" * 100) + compressor.flush()
+    with_datastore._write_data(b"\x00" + b"uncompressed")
+    with_datastore._write_data(b"\x01" + deflated)
+    with_datastore.close()
+    with open(FNAME, "r+b") as f:
+        f.seek(6)
+        f.write(bytes([datastore.LEVELDBLOG_HEADER_VERSION_COMPRESSED]))
+
+    s = datastore.DataStore()
+    s.open_for_scan(FNAME)
+
+    assert s.scan_data() == b"uncompressed"
+    assert s.scan_data() == b"compressed" * 100
+    assert s.scan_data() is None
+    s.close()
+
+
+def test_scan_history_batch(with_datastore):
+    """Read history records batched by wandb-core's "arrow" encoding."""
+    pa = pytest.importorskip("pyarrow")
+
+    template = wandb_internal_pb2.Record()
+    template.history.step.SetInParent()
+    template.control.local = True
+    template_data = template.SerializeToString()
+    table = pa.table(
+        {
+            "record.num": pa.array([7, 8], pa.int64()),
+            "history.step.num": pa.array([0, 1], pa.int64()),
+            "_step": pa.array([0, 1], pa.int64()),
+            "loss": pa.array([0.5, float("nan")], pa.float64()),
+        }
+    )
+    stream = pa.BufferOutputStream()
+    with pa.ipc.new_stream(stream, table.schema) as writer:
+        writer.write_table(table)
+
+    with_datastore._write_data(
+        bytes([datastore.LEVELDBLOG_HISTORY_BATCH, len(template_data)])
+        + template_data
+        + stream.getvalue().to_pybytes()
+    )
+    with_datastore._write_data(b"\x00" + b"after")
+    with_datastore.close()
+    with open(FNAME, "r+b") as f:
+        f.seek(6)
+        f.write(bytes([datastore.LEVELDBLOG_HEADER_VERSION_COMPRESSED]))
+
+    s = datastore.DataStore()
+    s.open_for_scan(FNAME)
+
+    records = [wandb_internal_pb2.Record(), wandb_internal_pb2.Record()]
+    records[0].ParseFromString(s.scan_data())
+    records[1].ParseFromString(s.scan_data())
+    assert s.scan_data() == b"after"
+    assert s.scan_data() is None
+    s.close()
+
+    assert [r.num for r in records] == [7, 8]
+    assert [r.history.step.num for r in records] == [0, 1]
+    assert all(r.control.local for r in records)
+    assert [[(i.key, i.value_json) for i in r.history.item] for r in records] == [
+        [("_step", "0"), ("loss", "0.5")],
+        [("_step", "1"), ("loss", "NaN")],
+    ]
@@ -11,3 +11,17 @@ def test_generate_id_is_base36():
 
 def test_generate_id_default_8_chars():
     assert len(runid.generate_id()) == 8
+
+
+def test_generate_seeded_id_is_stable():
+    new_id = runid.generate_seeded_id("job-1234")
+    assert len(new_id) == 8
+    assert set(new_id) <= set("0123456789abcdefghijklmnopqrstuvwxyz")
+    assert new_id == runid.generate_seeded_id("job-1234")
+    assert new_id != runid.generate_seeded_id("job-1235")
+    assert len(runid.generate_seeded_id("job-1234", 100)) == 100
+
+
+def test_generate_seeded_id_matches_go_client():
+    # utils.SeededShortID("job-1234", 8) in the Go code
+    assert runid.generate_seeded_id("job-1234") == "9ae4n8lt"
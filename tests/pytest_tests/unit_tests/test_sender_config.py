@@ -27,6 +27,35 @@ def test_config_record_update():
     }
 
 
+def test_config_record_update_replaces_subtree():
+    config = sender_config.ConfigState({"b": {"x": 9, "c": "old"}})
+
+    config.update_from_proto(
+        wandb_internal_pb2.ConfigRecord(
+            update=[
+                wandb_internal_pb2.ConfigItem(key="b", value_json='{"c": "new"}'),
+            ]
+        )
+    )
+
+    assert config.non_internal_config() == {"b": {"c": "new"}}
+
+
+def test_config_record_merge():
+    config = sender_config.ConfigState({"b": {"x": 9, "c": "old"}})
+
+    config.update_from_proto(
+        wandb_internal_pb2.ConfigRecord(
+            update=[
+                wandb_internal_pb2.ConfigItem(key="b", value_json='{"c": "new"}'),
+            ],
+            merge=True,
+        )
+    )
+
+    assert config.non_internal_config() == {"b": {"x": 9, "c": "new"}}
+
+
 def test_config_record_remove():
     config = sender_config.ConfigState(
         {
@@ -6,7 +6,7 @@ from wandb import wandb_sdk
 
 
 def get_callback(d):
-    def callback_func(key=None, val=None, data=None):
+    def callback_func(key=None, val=None, data=None, merge=False):
         print("CONFIG", key, val, data)
         if data:
             d.update(data)
@@ -65,6 +65,27 @@ def test_update(consolidated, config):
     assert consolidated == dict(config)
 
 
+def test_merge(config):
+    config.update(dict(optimizer=dict(name="adam", lr=0.1)))
+    config.merge(dict(optimizer=dict(lr=0.01)), allow_val_change=True)
+    assert dict(config) == dict(optimizer=dict(name="adam", lr=0.01))
+
+
+def test_update_file_backed():
+    calls = []
+    config = wandb_sdk.Config()
+    config._set_callback(lambda **kwargs: calls.append(kwargs))
+
+    config.update(dict(schema=dict(columns=["a", "b"])), file_backed=True)
+    config.update(dict(lr=0.1))
+
+    assert dict(config) == dict(schema=dict(columns=["a", "b"]), lr=0.1)
+    assert calls == [
+        dict(data=dict(schema=dict(columns=["a", "b"])), file_backed=True),
+        dict(data=dict(lr=0.1)),
+    ]
+
+
 def test_setdefaults(consolidated, config):
     config.update(dict(this=8))
     assert dict(config) == dict(this=8)
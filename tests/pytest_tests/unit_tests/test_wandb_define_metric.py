@@ -239,3 +239,31 @@ def test_metric_twice_or_overwrite(kwargs, mock_run, parse_records, record_q):
     assert len(parsed.records) == 2
     assert len(parsed.metric) == 2
     # merging or overwriting happens in handler (internal)
+
+
+def test_metric_array_summary(mock_run, parse_records, record_q):
+    run = mock_run()
+    metric = run.define_metric("scores", array_summary="ends")
+    assert metric.array_summary == "ends"
+
+    with pytest.raises(wandb.Error):
+        run.define_metric("scores", array_summary="median")
+
+    parsed = parse_records(record_q)
+    assert len(parsed.metric) == 1
+    assert parsed.metric[0].summary.array == pb.MetricSummary.ARRAY_ENDS
+
+
+def test_metric_histogram_bins(mock_run, parse_records, record_q):
+    run = mock_run()
+    metric = run.define_metric("loss", histogram_bins=10)
+    assert metric.histogram_bins == 10
+
+    with pytest.raises(wandb.Error):
+        run.define_metric("loss", histogram_bins=0)
+    with pytest.raises(wandb.Error):
+        run.define_metric("loss", summary="best", histogram_bins=10)
+
+    parsed = parse_records(record_q)
+    assert len(parsed.metric) == 1
+    assert parsed.metric[0].summary.histogram_bins == 10
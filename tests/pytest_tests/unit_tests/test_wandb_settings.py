@@ -816,6 +816,25 @@ def test_non_writable_root_dir(capsys):
         assert "wasn't writable, using system temp directory" in err
 
 
+def test_run_dir_fallback(tmp_path, capsys):
+    # The stage directory exists but can't be written to, like a read-only
+    # mount in a container.
+    root_dir = tmp_path / "root"
+    (root_dir / "wandb").mkdir(parents=True)
+    fallback = tmp_path / "fallback"
+
+    with mock.patch.object(
+        os, "access", side_effect=lambda p, _: not str(p).startswith(str(root_dir))
+    ):
+        s = Settings()
+        s.update(root_dir=str(root_dir), _run_dir_fallback=str(fallback))
+        wandb_dir = s.wandb_dir
+
+    assert wandb_dir == os.path.join(str(fallback), "wandb", "")
+    _, err = capsys.readouterr()
+    assert "wasn't writable, using" in err
+
+
 def test_log_user(test_settings):
     test_settings = test_settings({"run_id": "test"})
     _, run_dir, log_dir, fname = os.path.abspath(
@@ -1097,3 +1116,21 @@ def test_is_instance_recursive_custom_types():
     assert is_instance_recursive(
         {"a": Custom(), "b": CustomSubclass()}, Mapping[str, Custom]
     )
+
+
+def test_metadata_env(monkeypatch):
+    monkeypatch.setenv("METADATA_TEST_VISIBLE", "1")
+    monkeypatch.setenv("METADATA_TEST_SECRET", "2")
+
+    s = Settings(_metadata_env_vars=["METADATA_TEST_V*"])
+
+    assert s._metadata_env == {"METADATA_TEST_VISIBLE": "1"}
+    assert Settings()._metadata_env is None
+
+
+def test_summary_env_file_relative_to_cwd(tmp_path, monkeypatch):
+    monkeypatch.chdir(tmp_path)
+
+    s = Settings(_summary_env_file="out/summary.env")
+
+    assert s._summary_env_file == str(tmp_path / "out" / "summary.env")
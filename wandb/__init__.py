@@ -34,6 +34,7 @@ init = wandb_sdk.init
 setup = wandb_sdk.setup
 _attach = wandb_sdk._attach
 _sync = wandb_sdk._sync
+_preflight = wandb_sdk._preflight
 _teardown = wandb_sdk.teardown
 watch = wandb_sdk.watch
 unwatch = wandb_sdk.unwatch
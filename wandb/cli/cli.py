@@ -486,6 +486,12 @@ def beta():
 @click.option(
     "--dry-run", is_flag=True, help="Perform a dry run without uploading anything."
 )
+@click.option(
+    "--verify",
+    is_flag=True,
+    default=False,
+    help="Check that the backend matches each run after syncing it.",
+)
 @display_error
 def sync_beta(
     ctx,
@@ -500,6 +506,7 @@ def sync_beta(
     skip_synced: bool = True,
     mark_synced: bool = True,
     dry_run: bool = False,
+    verify: bool = False,
 ):
     import concurrent.futures
     from multiprocessing import cpu_count
@@ -578,6 +585,7 @@ def sync_beta(
                 skip_console=skip_console,
                 append=append,
                 mark_synced=mark_synced,
+                verify=verify,
             )
             futures.append(future)
 
@@ -586,6 +594,29 @@ def sync_beta(
             pass
 
 
+@beta.command(
+    name="preflight",
+    context_settings=CONTEXT,
+    help="Check that runs can be logged to a project, without starting one",
+)
+@click.option("--project", "-p", help="The project to check.")
+@click.option("--entity", "-e", help="The entity to check.")
+@display_error
+def preflight_beta(project: Optional[str] = None, entity: Optional[str] = None):
+    response = wandb._preflight(entity=entity, project=project)
+
+    if response.error.message:
+        click.secho(
+            f"Failed {response.failed_check or 'preflight'} check:"
+            f" {response.error.message}",
+            fg="red",
+            err=True,
+        )
+        sys.exit(1)
+
+    click.echo("Preflight checks passed; runs can be logged.")
+
+
 @cli.command(
     context_settings=CONTEXT, help="Upload an offline training directory to W&B"
 )
@@ -17,7 +17,7 @@ from wandb.proto import wandb_base_pb2 as wandb_dot_proto_dot_wandb__base__pb2
 from wandb.proto import wandb_telemetry_pb2 as wandb_dot_proto_dot_wandb__telemetry__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\x9c\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xde\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\x0e\n\x06\x66orked\x18\x16 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xec\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03J\x04\x08\x10\x10\x11\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xcf\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12\x12\n\nskip_cache\x18\x08 \x01(\x08\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xbe\x0f\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\tjob_input\x18M \x01(\x0b\x32\x1f.wandb_internal.JobInputRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_typeJ\x04\x08K\x10L\"\xe2\x0b\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\xbe\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12\x12\n\nskip_cache\x18\x05 \x01(\x08\x12\x13\n\x0bpath_prefix\x18\x06 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"q\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\x87\x01\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\x96\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x1c\n\x0cJobInputPath\x12\x0c\n\x04path\x18\x01 \x03(\t\"\xd6\x01\n\x0eJobInputSource\x12\x44\n\nrun_config\x18\x01 \x01(\x0b\x32..wandb_internal.JobInputSource.RunConfigSourceH\x00\x12?\n\x04\x66ile\x18\x02 \x01(\x0b\x32/.wandb_internal.JobInputSource.ConfigFileSourceH\x00\x1a\x11\n\x0fRunConfigSource\x1a \n\x10\x43onfigFileSource\x12\x0c\n\x04path\x18\x01 \x01(\tB\x08\n\x06source\"\xb1\x01\n\x0fJobInputRequest\x12\x34\n\x0cinput_source\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.JobInputSource\x12\x33\n\rinclude_paths\x18\x02 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x33\n\rexclude_paths\x18\x03 \x03(\x0b\x32\x1c.wandb_internal.JobInputPathb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\xcd\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12/\n\x05notes\x18\x1a \x01(\x0b\x32\x1e.wandb_internal.RunNotesRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xbe\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\x12\x14\n\x0cpersist_only\x18\t \x01(\x08\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xde\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\x0e\n\x06\x66orked\x18\x16 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"\x85\x01\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12\x0e\n\x06killed\x18\x03 \x01(\x08\x12\x13\n\x0bkill_reason\x18\x04 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"L\n\x0eRunNotesRecord\x12\r\n\x05notes\x18\x01 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\xc1\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"9\n\rHistoryResult\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"\xfd\x01\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\x12\x36\n\x05\x61rray\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricSummary.ArrayMode\x12\x16\n\x0ehistogram_bins\x18\t \x01(\x05\"<\n\tArrayMode\x12\x0f\n\x0b\x41RRAY_STATS\x10\x00\x12\x0e\n\nARRAY_ENDS\x10\x01\x12\x0e\n\nARRAY_FULL\x10\x02\"\xa8\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12\r\n\x05merge\x18\x04 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoJ\x04\x08\x03\x10\x04\"V\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\x12\x13\n\x0b\x66ile_backed\x18\x03 \x01(\x08\"8\n\x0c\x43onfigResult\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xec\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12(\n\x03pin\x18\x03 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12*\n\x05unpin\x18\x04 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"9\n\rSummaryResult\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xec\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03J\x04\x08\x10\x10\x11\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xcf\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12\x12\n\nskip_cache\x18\x08 \x01(\x08\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xdd\x11\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\tjob_input\x18M \x01(\x0b\x32\x1f.wandb_internal.JobInputRequestH\x00\x12-\n\x05\x66lush\x18N \x01(\x0b\x32\x1c.wandb_internal.FlushRequestH\x00\x12\x41\n\x10log_artifact_dir\x18O \x01(\x0b\x32%.wandb_internal.LogArtifactDirRequestH\x00\x12<\n\rbatch_history\x18P \x01(\x0b\x32#.wandb_internal.BatchHistoryRequestH\x00\x12\x36\n\nget_config\x18Q \x01(\x0b\x32 .wandb_internal.GetConfigRequestH\x00\x12\x35\n\tpreflight\x18R \x01(\x0b\x32 .wandb_internal.PreflightRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_typeJ\x04\x08K\x10L\"\xe6\r\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x46\n\x16\x62\x61tch_history_response\x18G \x01(\x0b\x32$.wandb_internal.BatchHistoryResponseH\x00\x12@\n\x13get_config_response\x18H \x01(\x0b\x32!.wandb_internal.GetConfigResponseH\x00\x12?\n\x12preflight_response\x18I \x01(\x0b\x32!.wandb_internal.PreflightResponseH\x00\x12\x37\n\x0e\x66lush_response\x18J \x01(\x0b\x32\x1d.wandb_internal.FlushResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"@\n\x10GetConfigRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"=\n\x11GetConfigResponse\x12(\n\x04item\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\"a\n\x10PreflightRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"S\n\x11PreflightResponse\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12\x14\n\x0c\x66\x61iled_check\x18\x02 \x01(\t\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xf6\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\x12\x38\n\x0c\x64ropped_data\x18\x05 \x01(\x0b\x32\".wandb_internal.DroppedDataSummary\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x1a\n\x0c\x46lushRequest\x12\n\n\x02id\x18\x01 \x01(\x03\"~\n\rFlushResponse\x12\x32\n\x05stage\x18\x01 \x01(\x0e\x32#.wandb_internal.FlushResponse.Stage\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"*\n\x05Stage\x12\x08\n\x04NONE\x10\x00\x12\r\n\tPERSISTED\x10\x01\x12\x08\n\x04SENT\x10\x02\"\xa3\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\x12\x0e\n\x06verify\x18\x05 \x01(\x08\"Z\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12\x13\n\x0b\x64ivergences\x18\x03 \x03(\t\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"M\n\x12\x44roppedDataSummary\x12\x37\n\ncategories\x18\x01 \x03(\x0b\x32#.wandb_internal.DroppedDataCategory\"D\n\x13\x44roppedDataCategory\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05\x63ount\x18\x02 \x01(\x03\x12\x10\n\x08\x65xamples\x18\x03 \x03(\t\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xf9\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"r\n\x13\x42\x61tchHistoryRequest\x12-\n\x04rows\x18\x01 \x03(\x0b\x32\x1f.wandb_internal.BatchHistoryRow\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"y\n\x0f\x42\x61tchHistoryRow\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12\x0c\n\x04step\x18\x02 \x01(\x03\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\";\n\x14\x42\x61tchHistoryResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\x12\x0c\n\x04step\x18\x02 \x01(\x03\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xd5\x01\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x14\n\x0chistory_step\x18\x07 \x01(\x03\x12.\n\nstart_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07runtime\x18\x06 \x01(\x01J\x04\x08\x04\x10\x05\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x85\x01\n\x15LogArtifactDirRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x0c\n\x04path\x18\x02 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x81\x01\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\x12>\n\x0c\x66ile_results\x18\x03 \x03(\x0b\x32(.wandb_internal.ArtifactFileUploadResult\"\xc3\x01\n\x18\x41rtifactFileUploadResult\x12\x0c\n\x04name\x18\x01 \x01(\t\x12?\n\x06status\x18\x02 \x01(\x0e\x32/.wandb_internal.ArtifactFileUploadResult.Status\x12\x0f\n\x07retries\x18\x03 \x01(\x05\x12\x15\n\rerror_message\x18\x04 \x01(\t\"0\n\x06Status\x12\r\n\tSUCCEEDED\x10\x00\x12\x0b\n\x07SKIPPED\x10\x01\x12\n\n\x06\x46\x41ILED\x10\x02\"\xbe\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12\x12\n\nskip_cache\x18\x05 \x01(\x08\x12\x13\n\x0bpath_prefix\x18\x06 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"q\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\x87\x01\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"\xc5\x01\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\x12\x0c\n\x04uuid\x18\x03 \x01(\t\x12\x14\n\x0c\x61rchitecture\x18\x04 \x01(\t\x12\x1f\n\x17\x63uda_compute_capability\x18\x05 \x01(\t\x12\x1c\n\x14pcie_link_generation\x18\x06 \x01(\r\x12\x17\n\x0fpcie_link_width\x18\x07 \x01(\r\x12\x14\n\x0cnvlink_count\x18\x08 \x01(\r\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\xff\t\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x12.\n\x12gpu_driver_version\x18\x1e \x01(\tR\x12gpu_driver_version\x12\x30\n\x13\x63uda_driver_version\x18\x1f \x01(\tR\x13\x63uda_driver_version\x12\x35\n\x03\x65nv\x18  \x03(\x0b\x32(.wandb_internal.MetadataRequest.EnvEntry\x12\"\n\x0c\x63ontainer_id\x18! \x01(\tR\x0c\x63ontainer_id\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a*\n\x08\x45nvEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x1c\n\x0cJobInputPath\x12\x0c\n\x04path\x18\x01 \x03(\t\"\xd6\x01\n\x0eJobInputSource\x12\x44\n\nrun_config\x18\x01 \x01(\x0b\x32..wandb_internal.JobInputSource.RunConfigSourceH\x00\x12?\n\x04\x66ile\x18\x02 \x01(\x0b\x32/.wandb_internal.JobInputSource.ConfigFileSourceH\x00\x1a\x11\n\x0fRunConfigSource\x1a \n\x10\x43onfigFileSource\x12\x0c\n\x04path\x18\x01 \x01(\tB\x08\n\x06source\"\xb1\x01\n\x0fJobInputRequest\x12\x34\n\x0cinput_source\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.JobInputSource\x12\x33\n\rinclude_paths\x18\x02 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x33\n\rexclude_paths\x18\x03 \x03(\x0b\x32\x1c.wandb_internal.JobInputPathb\x06proto3')
 
 
 
@@ -36,6 +36,7 @@ _RUNEXITRECORD = DESCRIPTOR.message_types_by_name['RunExitRecord']
 _RUNEXITRESULT = DESCRIPTOR.message_types_by_name['RunExitResult']
 _RUNPREEMPTINGRECORD = DESCRIPTOR.message_types_by_name['RunPreemptingRecord']
 _RUNPREEMPTINGRESULT = DESCRIPTOR.message_types_by_name['RunPreemptingResult']
+_RUNNOTESRECORD = DESCRIPTOR.message_types_by_name['RunNotesRecord']
 _SETTINGSRECORD = DESCRIPTOR.message_types_by_name['SettingsRecord']
 _SETTINGSITEM = DESCRIPTOR.message_types_by_name['SettingsItem']
 _HISTORYSTEP = DESCRIPTOR.message_types_by_name['HistoryStep']
@@ -85,6 +86,10 @@ _LOGINREQUEST = DESCRIPTOR.message_types_by_name['LoginRequest']
 _LOGINRESPONSE = DESCRIPTOR.message_types_by_name['LoginResponse']
 _GETSUMMARYREQUEST = DESCRIPTOR.message_types_by_name['GetSummaryRequest']
 _GETSUMMARYRESPONSE = DESCRIPTOR.message_types_by_name['GetSummaryResponse']
+_GETCONFIGREQUEST = DESCRIPTOR.message_types_by_name['GetConfigRequest']
+_GETCONFIGRESPONSE = DESCRIPTOR.message_types_by_name['GetConfigResponse']
+_PREFLIGHTREQUEST = DESCRIPTOR.message_types_by_name['PreflightRequest']
+_PREFLIGHTRESPONSE = DESCRIPTOR.message_types_by_name['PreflightResponse']
 _GETSYSTEMMETRICSREQUEST = DESCRIPTOR.message_types_by_name['GetSystemMetricsRequest']
 _SYSTEMMETRICSAMPLE = DESCRIPTOR.message_types_by_name['SystemMetricSample']
 _SYSTEMMETRICSBUFFER = DESCRIPTOR.message_types_by_name['SystemMetricsBuffer']
@@ -105,6 +110,8 @@ _POLLEXITRESPONSE = DESCRIPTOR.message_types_by_name['PollExitResponse']
 _SYNCOVERWRITE = DESCRIPTOR.message_types_by_name['SyncOverwrite']
 _SYNCSKIP = DESCRIPTOR.message_types_by_name['SyncSkip']
 _SENDERMARKREQUEST = DESCRIPTOR.message_types_by_name['SenderMarkRequest']
+_FLUSHREQUEST = DESCRIPTOR.message_types_by_name['FlushRequest']
+_FLUSHRESPONSE = DESCRIPTOR.message_types_by_name['FlushResponse']
 _SYNCREQUEST = DESCRIPTOR.message_types_by_name['SyncRequest']
 _SYNCRESPONSE = DESCRIPTOR.message_types_by_name['SyncResponse']
 _SENDERREADREQUEST = DESCRIPTOR.message_types_by_name['SenderReadRequest']
@@ -116,6 +123,8 @@ _SERVERINFORESPONSE = DESCRIPTOR.message_types_by_name['ServerInfoResponse']
 _SERVERMESSAGES = DESCRIPTOR.message_types_by_name['ServerMessages']
 _SERVERMESSAGE = DESCRIPTOR.message_types_by_name['ServerMessage']
 _FILECOUNTS = DESCRIPTOR.message_types_by_name['FileCounts']
+_DROPPEDDATASUMMARY = DESCRIPTOR.message_types_by_name['DroppedDataSummary']
+_DROPPEDDATACATEGORY = DESCRIPTOR.message_types_by_name['DroppedDataCategory']
 _FILEPUSHERSTATS = DESCRIPTOR.message_types_by_name['FilePusherStats']
 _FILESUPLOADED = DESCRIPTOR.message_types_by_name['FilesUploaded']
 _FILETRANSFERINFOREQUEST = DESCRIPTOR.message_types_by_name['FileTransferInfoRequest']
@@ -128,6 +137,9 @@ _TESTINJECTREQUEST = DESCRIPTOR.message_types_by_name['TestInjectRequest']
 _TESTINJECTRESPONSE = DESCRIPTOR.message_types_by_name['TestInjectResponse']
 _HISTORYACTION = DESCRIPTOR.message_types_by_name['HistoryAction']
 _PARTIALHISTORYREQUEST = DESCRIPTOR.message_types_by_name['PartialHistoryRequest']
+_BATCHHISTORYREQUEST = DESCRIPTOR.message_types_by_name['BatchHistoryRequest']
+_BATCHHISTORYROW = DESCRIPTOR.message_types_by_name['BatchHistoryRow']
+_BATCHHISTORYRESPONSE = DESCRIPTOR.message_types_by_name['BatchHistoryResponse']
 _PARTIALHISTORYRESPONSE = DESCRIPTOR.message_types_by_name['PartialHistoryResponse']
 _SAMPLEDHISTORYREQUEST = DESCRIPTOR.message_types_by_name['SampledHistoryRequest']
 _SAMPLEDHISTORYITEM = DESCRIPTOR.message_types_by_name['SampledHistoryItem']
@@ -141,7 +153,9 @@ _CHECKVERSIONRESPONSE = DESCRIPTOR.message_types_by_name['CheckVersionResponse']
 _JOBINFOREQUEST = DESCRIPTOR.message_types_by_name['JobInfoRequest']
 _JOBINFORESPONSE = DESCRIPTOR.message_types_by_name['JobInfoResponse']
 _LOGARTIFACTREQUEST = DESCRIPTOR.message_types_by_name['LogArtifactRequest']
+_LOGARTIFACTDIRREQUEST = DESCRIPTOR.message_types_by_name['LogArtifactDirRequest']
 _LOGARTIFACTRESPONSE = DESCRIPTOR.message_types_by_name['LogArtifactResponse']
+_ARTIFACTFILEUPLOADRESULT = DESCRIPTOR.message_types_by_name['ArtifactFileUploadResult']
 _DOWNLOADARTIFACTREQUEST = DESCRIPTOR.message_types_by_name['DownloadArtifactRequest']
 _DOWNLOADARTIFACTRESPONSE = DESCRIPTOR.message_types_by_name['DownloadArtifactResponse']
 _KEEPALIVEREQUEST = DESCRIPTOR.message_types_by_name['KeepaliveRequest']
@@ -166,6 +180,7 @@ _GPUAMDINFO = DESCRIPTOR.message_types_by_name['GpuAmdInfo']
 _METADATAREQUEST = DESCRIPTOR.message_types_by_name['MetadataRequest']
 _METADATAREQUEST_DISKENTRY = _METADATAREQUEST.nested_types_by_name['DiskEntry']
 _METADATAREQUEST_SLURMENTRY = _METADATAREQUEST.nested_types_by_name['SlurmEntry']
+_METADATAREQUEST_ENVENTRY = _METADATAREQUEST.nested_types_by_name['EnvEntry']
 _PYTHONPACKAGESREQUEST = DESCRIPTOR.message_types_by_name['PythonPackagesRequest']
 _PYTHONPACKAGESREQUEST_PYTHONPACKAGE = _PYTHONPACKAGESREQUEST.nested_types_by_name['PythonPackage']
 _JOBINPUTPATH = DESCRIPTOR.message_types_by_name['JobInputPath']
@@ -177,11 +192,14 @@ _ERRORINFO_ERRORCODE = _ERRORINFO.enum_types_by_name['ErrorCode']
 _OUTPUTRECORD_OUTPUTTYPE = _OUTPUTRECORD.enum_types_by_name['OutputType']
 _OUTPUTRAWRECORD_OUTPUTTYPE = _OUTPUTRAWRECORD.enum_types_by_name['OutputType']
 _METRICRECORD_METRICGOAL = _METRICRECORD.enum_types_by_name['MetricGoal']
+_METRICSUMMARY_ARRAYMODE = _METRICSUMMARY.enum_types_by_name['ArrayMode']
 _FILESITEM_POLICYTYPE = _FILESITEM.enum_types_by_name['PolicyType']
 _FILESITEM_FILETYPE = _FILESITEM.enum_types_by_name['FileType']
 _STATSRECORD_STATSTYPE = _STATSRECORD.enum_types_by_name['StatsType']
 _DEFERREQUEST_DEFERSTATE = _DEFERREQUEST.enum_types_by_name['DeferState']
+_FLUSHRESPONSE_STAGE = _FLUSHRESPONSE.enum_types_by_name['Stage']
 _FILETRANSFERINFOREQUEST_TRANSFERTYPE = _FILETRANSFERINFOREQUEST.enum_types_by_name['TransferType']
+_ARTIFACTFILEUPLOADRESULT_STATUS = _ARTIFACTFILEUPLOADRESULT.enum_types_by_name['Status']
 Record = _reflection.GeneratedProtocolMessageType('Record', (_message.Message,), {
   'DESCRIPTOR' : _RECORD,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -287,6 +305,13 @@ RunPreemptingResult = _reflection.GeneratedProtocolMessageType('RunPreemptingRes
   })
 _sym_db.RegisterMessage(RunPreemptingResult)
 
+RunNotesRecord = _reflection.GeneratedProtocolMessageType('RunNotesRecord', (_message.Message,), {
+  'DESCRIPTOR' : _RUNNOTESRECORD,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunNotesRecord)
+  })
+_sym_db.RegisterMessage(RunNotesRecord)
+
 SettingsRecord = _reflection.GeneratedProtocolMessageType('SettingsRecord', (_message.Message,), {
   'DESCRIPTOR' : _SETTINGSRECORD,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -630,6 +655,34 @@ GetSummaryResponse = _reflection.GeneratedProtocolMessageType('GetSummaryRespons
   })
 _sym_db.RegisterMessage(GetSummaryResponse)
 
+GetConfigRequest = _reflection.GeneratedProtocolMessageType('GetConfigRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETCONFIGREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.GetConfigRequest)
+  })
+_sym_db.RegisterMessage(GetConfigRequest)
+
+GetConfigResponse = _reflection.GeneratedProtocolMessageType('GetConfigResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETCONFIGRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.GetConfigResponse)
+  })
+_sym_db.RegisterMessage(GetConfigResponse)
+
+PreflightRequest = _reflection.GeneratedProtocolMessageType('PreflightRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PREFLIGHTREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.PreflightRequest)
+  })
+_sym_db.RegisterMessage(PreflightRequest)
+
+PreflightResponse = _reflection.GeneratedProtocolMessageType('PreflightResponse', (_message.Message,), {
+  'DESCRIPTOR' : _PREFLIGHTRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.PreflightResponse)
+  })
+_sym_db.RegisterMessage(PreflightResponse)
+
 GetSystemMetricsRequest = _reflection.GeneratedProtocolMessageType('GetSystemMetricsRequest', (_message.Message,), {
   'DESCRIPTOR' : _GETSYSTEMMETRICSREQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -771,6 +824,20 @@ SenderMarkRequest = _reflection.GeneratedProtocolMessageType('SenderMarkRequest'
   })
 _sym_db.RegisterMessage(SenderMarkRequest)
 
+FlushRequest = _reflection.GeneratedProtocolMessageType('FlushRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FLUSHREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FlushRequest)
+  })
+_sym_db.RegisterMessage(FlushRequest)
+
+FlushResponse = _reflection.GeneratedProtocolMessageType('FlushResponse', (_message.Message,), {
+  'DESCRIPTOR' : _FLUSHRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FlushResponse)
+  })
+_sym_db.RegisterMessage(FlushResponse)
+
 SyncRequest = _reflection.GeneratedProtocolMessageType('SyncRequest', (_message.Message,), {
   'DESCRIPTOR' : _SYNCREQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -848,6 +915,20 @@ FileCounts = _reflection.GeneratedProtocolMessageType('FileCounts', (_message.Me
   })
 _sym_db.RegisterMessage(FileCounts)
 
+DroppedDataSummary = _reflection.GeneratedProtocolMessageType('DroppedDataSummary', (_message.Message,), {
+  'DESCRIPTOR' : _DROPPEDDATASUMMARY,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.DroppedDataSummary)
+  })
+_sym_db.RegisterMessage(DroppedDataSummary)
+
+DroppedDataCategory = _reflection.GeneratedProtocolMessageType('DroppedDataCategory', (_message.Message,), {
+  'DESCRIPTOR' : _DROPPEDDATACATEGORY,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.DroppedDataCategory)
+  })
+_sym_db.RegisterMessage(DroppedDataCategory)
+
 FilePusherStats = _reflection.GeneratedProtocolMessageType('FilePusherStats', (_message.Message,), {
   'DESCRIPTOR' : _FILEPUSHERSTATS,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -932,6 +1013,27 @@ PartialHistoryRequest = _reflection.GeneratedProtocolMessageType('PartialHistory
   })
 _sym_db.RegisterMessage(PartialHistoryRequest)
 
+BatchHistoryRequest = _reflection.GeneratedProtocolMessageType('BatchHistoryRequest', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHHISTORYREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.BatchHistoryRequest)
+  })
+_sym_db.RegisterMessage(BatchHistoryRequest)
+
+BatchHistoryRow = _reflection.GeneratedProtocolMessageType('BatchHistoryRow', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHHISTORYROW,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.BatchHistoryRow)
+  })
+_sym_db.RegisterMessage(BatchHistoryRow)
+
+BatchHistoryResponse = _reflection.GeneratedProtocolMessageType('BatchHistoryResponse', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHHISTORYRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.BatchHistoryResponse)
+  })
+_sym_db.RegisterMessage(BatchHistoryResponse)
+
 PartialHistoryResponse = _reflection.GeneratedProtocolMessageType('PartialHistoryResponse', (_message.Message,), {
   'DESCRIPTOR' : _PARTIALHISTORYRESPONSE,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -1023,6 +1125,13 @@ LogArtifactRequest = _reflection.GeneratedProtocolMessageType('LogArtifactReques
   })
 _sym_db.RegisterMessage(LogArtifactRequest)
 
+LogArtifactDirRequest = _reflection.GeneratedProtocolMessageType('LogArtifactDirRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LOGARTIFACTDIRREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.LogArtifactDirRequest)
+  })
+_sym_db.RegisterMessage(LogArtifactDirRequest)
+
 LogArtifactResponse = _reflection.GeneratedProtocolMessageType('LogArtifactResponse', (_message.Message,), {
   'DESCRIPTOR' : _LOGARTIFACTRESPONSE,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -1030,6 +1139,13 @@ LogArtifactResponse = _reflection.GeneratedProtocolMessageType('LogArtifactRespo
   })
 _sym_db.RegisterMessage(LogArtifactResponse)
 
+ArtifactFileUploadResult = _reflection.GeneratedProtocolMessageType('ArtifactFileUploadResult', (_message.Message,), {
+  'DESCRIPTOR' : _ARTIFACTFILEUPLOADRESULT,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ArtifactFileUploadResult)
+  })
+_sym_db.RegisterMessage(ArtifactFileUploadResult)
+
 DownloadArtifactRequest = _reflection.GeneratedProtocolMessageType('DownloadArtifactRequest', (_message.Message,), {
   'DESCRIPTOR' : _DOWNLOADARTIFACTREQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -1192,6 +1308,13 @@ MetadataRequest = _reflection.GeneratedProtocolMessageType('MetadataRequest', (_
     # @@protoc_insertion_point(class_scope:wandb_internal.MetadataRequest.SlurmEntry)
     })
   ,
+
+  'EnvEntry' : _reflection.GeneratedProtocolMessageType('EnvEntry', (_message.Message,), {
+    'DESCRIPTOR' : _METADATAREQUEST_ENVENTRY,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.MetadataRequest.EnvEntry)
+    })
+  ,
   'DESCRIPTOR' : _METADATAREQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
   # @@protoc_insertion_point(class_scope:wandb_internal.MetadataRequest)
@@ -1199,6 +1322,7 @@ MetadataRequest = _reflection.GeneratedProtocolMessageType('MetadataRequest', (_
 _sym_db.RegisterMessage(MetadataRequest)
 _sym_db.RegisterMessage(MetadataRequest.DiskEntry)
 _sym_db.RegisterMessage(MetadataRequest.SlurmEntry)
+_sym_db.RegisterMessage(MetadataRequest.EnvEntry)
 
 PythonPackagesRequest = _reflection.GeneratedProtocolMessageType('PythonPackagesRequest', (_message.Message,), {
 
@@ -1261,326 +1385,364 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _METADATAREQUEST_DISKENTRY._serialized_options = b'8\001'
   _METADATAREQUEST_SLURMENTRY._options = None
   _METADATAREQUEST_SLURMENTRY._serialized_options = b'8\001'
+  _METADATAREQUEST_ENVENTRY._options = None
+  _METADATAREQUEST_ENVENTRY._serialized_options = b'8\001'
   _RECORD._serialized_start=151
-  _RECORD._serialized_end=1331
-  _CONTROL._serialized_start=1334
-  _CONTROL._serialized_end=1502
-  _RESULT._serialized_start=1505
-  _RESULT._serialized_end=2004
-  _FINALRECORD._serialized_start=2006
-  _FINALRECORD._serialized_end=2064
-  _VERSIONINFO._serialized_start=2066
-  _VERSIONINFO._serialized_end=2164
-  _HEADERRECORD._serialized_start=2166
-  _HEADERRECORD._serialized_end=2276
-  _FOOTERRECORD._serialized_start=2278
-  _FOOTERRECORD._serialized_end=2337
-  _RUNRECORD._serialized_start=2340
-  _RUNRECORD._serialized_end=2946
-  _GITREPORECORD._serialized_start=2948
-  _GITREPORECORD._serialized_end=3007
-  _RUNUPDATERESULT._serialized_start=3009
-  _RUNUPDATERESULT._serialized_end=3108
-  _ERRORINFO._serialized_start=3111
-  _ERRORINFO._serialized_end=3283
-  _ERRORINFO_ERRORCODE._serialized_start=3192
-  _ERRORINFO_ERRORCODE._serialized_end=3283
-  _RUNEXITRECORD._serialized_start=3285
-  _RUNEXITRECORD._serialized_end=3381
-  _RUNEXITRESULT._serialized_start=3383
-  _RUNEXITRESULT._serialized_end=3398
-  _RUNPREEMPTINGRECORD._serialized_start=3400
-  _RUNPREEMPTINGRECORD._serialized_end=3466
-  _RUNPREEMPTINGRESULT._serialized_start=3468
-  _RUNPREEMPTINGRESULT._serialized_end=3489
-  _SETTINGSRECORD._serialized_start=3491
-  _SETTINGSRECORD._serialized_end=3596
-  _SETTINGSITEM._serialized_start=3598
-  _SETTINGSITEM._serialized_end=3645
-  _HISTORYSTEP._serialized_start=3647
-  _HISTORYSTEP._serialized_end=3673
-  _HISTORYRECORD._serialized_start=3676
-  _HISTORYRECORD._serialized_end=3822
-  _HISTORYITEM._serialized_start=3824
-  _HISTORYITEM._serialized_end=3890
-  _HISTORYRESULT._serialized_start=3892
-  _HISTORYRESULT._serialized_end=3907
-  _OUTPUTRECORD._serialized_start=3910
-  _OUTPUTRECORD._serialized_end=4130
-  _OUTPUTRECORD_OUTPUTTYPE._serialized_start=4094
-  _OUTPUTRECORD_OUTPUTTYPE._serialized_end=4130
-  _OUTPUTRESULT._serialized_start=4132
-  _OUTPUTRESULT._serialized_end=4146
-  _OUTPUTRAWRECORD._serialized_start=4149
-  _OUTPUTRAWRECORD._serialized_end=4375
-  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_start=4094
-  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_end=4130
-  _OUTPUTRAWRESULT._serialized_start=4377
-  _OUTPUTRAWRESULT._serialized_end=4394
-  _METRICRECORD._serialized_start=4397
-  _METRICRECORD._serialized_end=4805
-  _METRICRECORD_METRICGOAL._serialized_start=4739
-  _METRICRECORD_METRICGOAL._serialized_end=4805
-  _METRICRESULT._serialized_start=4807
-  _METRICRESULT._serialized_end=4821
-  _METRICOPTIONS._serialized_start=4823
-  _METRICOPTIONS._serialized_end=4890
-  _METRICCONTROL._serialized_start=4892
-  _METRICCONTROL._serialized_end=4926
-  _METRICSUMMARY._serialized_start=4928
-  _METRICSUMMARY._serialized_end=5039
-  _CONFIGRECORD._serialized_start=5042
-  _CONFIGRECORD._serialized_end=5189
-  _CONFIGITEM._serialized_start=5191
-  _CONFIGITEM._serialized_end=5256
-  _CONFIGRESULT._serialized_start=5258
-  _CONFIGRESULT._serialized_end=5272
-  _SUMMARYRECORD._serialized_start=5275
-  _SUMMARYRECORD._serialized_end=5425
-  _SUMMARYITEM._serialized_start=5427
-  _SUMMARYITEM._serialized_end=5493
-  _SUMMARYRESULT._serialized_start=5495
-  _SUMMARYRESULT._serialized_end=5510
-  _FILESRECORD._serialized_start=5512
-  _FILESRECORD._serialized_end=5612
-  _FILESITEM._serialized_start=5615
-  _FILESITEM._serialized_end=5851
-  _FILESITEM_POLICYTYPE._serialized_start=5746
-  _FILESITEM_POLICYTYPE._serialized_end=5786
-  _FILESITEM_FILETYPE._serialized_start=5788
-  _FILESITEM_FILETYPE._serialized_end=5845
-  _FILESRESULT._serialized_start=5853
-  _FILESRESULT._serialized_end=5866
-  _STATSRECORD._serialized_start=5869
-  _STATSRECORD._serialized_end=6099
-  _STATSRECORD_STATSTYPE._serialized_start=6076
-  _STATSRECORD_STATSTYPE._serialized_end=6099
-  _STATSITEM._serialized_start=6101
-  _STATSITEM._serialized_end=6145
-  _ARTIFACTRECORD._serialized_start=6148
-  _ARTIFACTRECORD._serialized_end=6621
-  _ARTIFACTMANIFEST._serialized_start=6624
-  _ARTIFACTMANIFEST._serialized_end=6812
-  _ARTIFACTMANIFESTENTRY._serialized_start=6815
-  _ARTIFACTMANIFESTENTRY._serialized_end=7022
-  _EXTRAITEM._serialized_start=7024
-  _EXTRAITEM._serialized_end=7068
-  _STORAGEPOLICYCONFIGITEM._serialized_start=7070
-  _STORAGEPOLICYCONFIGITEM._serialized_end=7128
-  _ARTIFACTRESULT._serialized_start=7130
-  _ARTIFACTRESULT._serialized_end=7146
-  _LINKARTIFACTRESULT._serialized_start=7148
-  _LINKARTIFACTRESULT._serialized_end=7168
-  _LINKARTIFACTRECORD._serialized_start=7171
-  _LINKARTIFACTRECORD._serialized_end=7378
-  _TBRECORD._serialized_start=7380
-  _TBRECORD._serialized_end=7484
-  _TBRESULT._serialized_start=7486
-  _TBRESULT._serialized_end=7496
-  _ALERTRECORD._serialized_start=7498
-  _ALERTRECORD._serialized_end=7623
-  _ALERTRESULT._serialized_start=7625
-  _ALERTRESULT._serialized_end=7638
-  _REQUEST._serialized_start=7641
-  _REQUEST._serialized_end=9623
-  _RESPONSE._serialized_start=9626
-  _RESPONSE._serialized_end=11132
-  _DEFERREQUEST._serialized_start=11135
-  _DEFERREQUEST._serialized_end=11455
-  _DEFERREQUEST_DEFERSTATE._serialized_start=11208
-  _DEFERREQUEST_DEFERSTATE._serialized_end=11455
-  _PAUSEREQUEST._serialized_start=11457
-  _PAUSEREQUEST._serialized_end=11517
-  _PAUSERESPONSE._serialized_start=11519
-  _PAUSERESPONSE._serialized_end=11534
-  _RESUMEREQUEST._serialized_start=11536
-  _RESUMEREQUEST._serialized_end=11597
-  _RESUMERESPONSE._serialized_start=11599
-  _RESUMERESPONSE._serialized_end=11615
-  _LOGINREQUEST._serialized_start=11617
-  _LOGINREQUEST._serialized_end=11694
-  _LOGINRESPONSE._serialized_start=11696
-  _LOGINRESPONSE._serialized_end=11734
-  _GETSUMMARYREQUEST._serialized_start=11736
-  _GETSUMMARYREQUEST._serialized_end=11801
-  _GETSUMMARYRESPONSE._serialized_start=11803
-  _GETSUMMARYRESPONSE._serialized_end=11866
-  _GETSYSTEMMETRICSREQUEST._serialized_start=11868
-  _GETSYSTEMMETRICSREQUEST._serialized_end=11939
-  _SYSTEMMETRICSAMPLE._serialized_start=11941
-  _SYSTEMMETRICSAMPLE._serialized_end=12023
-  _SYSTEMMETRICSBUFFER._serialized_start=12025
-  _SYSTEMMETRICSBUFFER._serialized_end=12098
-  _GETSYSTEMMETRICSRESPONSE._serialized_start=12101
-  _GETSYSTEMMETRICSRESPONSE._serialized_end=12303
-  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_start=12214
-  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_end=12303
-  _STATUSREQUEST._serialized_start=12305
-  _STATUSREQUEST._serialized_end=12366
-  _STATUSRESPONSE._serialized_start=12368
-  _STATUSRESPONSE._serialized_end=12409
-  _STOPSTATUSREQUEST._serialized_start=12411
-  _STOPSTATUSREQUEST._serialized_end=12476
-  _STOPSTATUSRESPONSE._serialized_start=12478
-  _STOPSTATUSRESPONSE._serialized_end=12523
-  _NETWORKSTATUSREQUEST._serialized_start=12525
-  _NETWORKSTATUSREQUEST._serialized_end=12593
-  _NETWORKSTATUSRESPONSE._serialized_start=12595
-  _NETWORKSTATUSRESPONSE._serialized_end=12675
-  _HTTPRESPONSE._serialized_start=12677
-  _HTTPRESPONSE._serialized_end=12745
-  _INTERNALMESSAGESREQUEST._serialized_start=12747
-  _INTERNALMESSAGESREQUEST._serialized_end=12818
-  _INTERNALMESSAGESRESPONSE._serialized_start=12820
-  _INTERNALMESSAGESRESPONSE._serialized_end=12898
-  _INTERNALMESSAGES._serialized_start=12900
-  _INTERNALMESSAGES._serialized_end=12935
-  _POLLEXITREQUEST._serialized_start=12937
-  _POLLEXITREQUEST._serialized_end=13000
-  _POLLEXITRESPONSE._serialized_start=13003
-  _POLLEXITRESPONSE._serialized_end=13191
-  _SYNCOVERWRITE._serialized_start=13193
-  _SYNCOVERWRITE._serialized_end=13257
-  _SYNCSKIP._serialized_start=13259
-  _SYNCSKIP._serialized_end=13289
-  _SENDERMARKREQUEST._serialized_start=13291
-  _SENDERMARKREQUEST._serialized_end=13310
-  _SYNCREQUEST._serialized_start=13313
-  _SYNCREQUEST._serialized_end=13460
-  _SYNCRESPONSE._serialized_start=13462
-  _SYNCRESPONSE._serialized_end=13531
-  _SENDERREADREQUEST._serialized_start=13533
-  _SENDERREADREQUEST._serialized_end=13596
-  _STATUSREPORTREQUEST._serialized_start=13598
-  _STATUSREPORTREQUEST._serialized_end=13707
-  _SUMMARYRECORDREQUEST._serialized_start=13709
-  _SUMMARYRECORDREQUEST._serialized_end=13779
-  _TELEMETRYRECORDREQUEST._serialized_start=13781
-  _TELEMETRYRECORDREQUEST._serialized_end=13857
-  _SERVERINFOREQUEST._serialized_start=13859
-  _SERVERINFOREQUEST._serialized_end=13924
-  _SERVERINFORESPONSE._serialized_start=13926
-  _SERVERINFORESPONSE._serialized_end=14050
-  _SERVERMESSAGES._serialized_start=14052
-  _SERVERMESSAGES._serialized_end=14113
-  _SERVERMESSAGE._serialized_start=14115
-  _SERVERMESSAGE._serialized_end=14216
-  _FILECOUNTS._serialized_start=14218
-  _FILECOUNTS._serialized_end=14317
-  _FILEPUSHERSTATS._serialized_start=14319
-  _FILEPUSHERSTATS._serialized_end=14404
-  _FILESUPLOADED._serialized_start=14406
-  _FILESUPLOADED._serialized_end=14436
-  _FILETRANSFERINFOREQUEST._serialized_start=14439
-  _FILETRANSFERINFOREQUEST._serialized_end=14683
-  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_start=14643
-  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_end=14683
-  _LOCALINFO._serialized_start=14685
-  _LOCALINFO._serialized_end=14734
-  _SHUTDOWNREQUEST._serialized_start=14736
-  _SHUTDOWNREQUEST._serialized_end=14799
-  _SHUTDOWNRESPONSE._serialized_start=14801
-  _SHUTDOWNRESPONSE._serialized_end=14819
-  _ATTACHREQUEST._serialized_start=14821
-  _ATTACHREQUEST._serialized_end=14901
-  _ATTACHRESPONSE._serialized_start=14903
-  _ATTACHRESPONSE._serialized_end=15001
-  _TESTINJECTREQUEST._serialized_start=15004
-  _TESTINJECTREQUEST._serialized_end=15345
-  _TESTINJECTRESPONSE._serialized_start=15347
-  _TESTINJECTRESPONSE._serialized_end=15367
-  _HISTORYACTION._serialized_start=15369
-  _HISTORYACTION._serialized_end=15399
-  _PARTIALHISTORYREQUEST._serialized_start=15402
-  _PARTIALHISTORYREQUEST._serialized_end=15604
-  _PARTIALHISTORYRESPONSE._serialized_start=15606
-  _PARTIALHISTORYRESPONSE._serialized_end=15630
-  _SAMPLEDHISTORYREQUEST._serialized_start=15632
-  _SAMPLEDHISTORYREQUEST._serialized_end=15701
-  _SAMPLEDHISTORYITEM._serialized_start=15703
-  _SAMPLEDHISTORYITEM._serialized_end=15798
-  _SAMPLEDHISTORYRESPONSE._serialized_start=15800
-  _SAMPLEDHISTORYRESPONSE._serialized_end=15874
-  _RUNSTATUSREQUEST._serialized_start=15876
-  _RUNSTATUSREQUEST._serialized_end=15940
-  _RUNSTATUSRESPONSE._serialized_start=15942
-  _RUNSTATUSRESPONSE._serialized_end=16062
-  _RUNSTARTREQUEST._serialized_start=16064
-  _RUNSTARTREQUEST._serialized_end=16167
-  _RUNSTARTRESPONSE._serialized_start=16169
-  _RUNSTARTRESPONSE._serialized_end=16187
-  _CHECKVERSIONREQUEST._serialized_start=16189
-  _CHECKVERSIONREQUEST._serialized_end=16281
-  _CHECKVERSIONRESPONSE._serialized_start=16283
-  _CHECKVERSIONRESPONSE._serialized_end=16376
-  _JOBINFOREQUEST._serialized_start=16378
-  _JOBINFOREQUEST._serialized_end=16440
-  _JOBINFORESPONSE._serialized_start=16442
-  _JOBINFORESPONSE._serialized_end=16496
-  _LOGARTIFACTREQUEST._serialized_start=16499
-  _LOGARTIFACTREQUEST._serialized_end=16658
-  _LOGARTIFACTRESPONSE._serialized_start=16660
-  _LOGARTIFACTRESPONSE._serialized_end=16725
-  _DOWNLOADARTIFACTREQUEST._serialized_start=16728
-  _DOWNLOADARTIFACTREQUEST._serialized_end=16918
-  _DOWNLOADARTIFACTRESPONSE._serialized_start=16920
-  _DOWNLOADARTIFACTRESPONSE._serialized_end=16969
-  _KEEPALIVEREQUEST._serialized_start=16971
-  _KEEPALIVEREQUEST._serialized_end=17035
-  _KEEPALIVERESPONSE._serialized_start=17037
-  _KEEPALIVERESPONSE._serialized_end=17056
-  _ARTIFACTINFO._serialized_start=17058
-  _ARTIFACTINFO._serialized_end=17171
-  _GITINFO._serialized_start=17173
-  _GITINFO._serialized_end=17214
-  _GITSOURCE._serialized_start=17217
-  _GITSOURCE._serialized_end=17352
-  _IMAGESOURCE._serialized_start=17354
-  _IMAGESOURCE._serialized_end=17382
-  _SOURCE._serialized_start=17385
-  _SOURCE._serialized_end=17525
-  _JOBSOURCE._serialized_start=17527
-  _JOBSOURCE._serialized_end=17634
-  _PARTIALJOBARTIFACT._serialized_start=17636
-  _PARTIALJOBARTIFACT._serialized_end=17722
-  _USEARTIFACTRECORD._serialized_start=17725
-  _USEARTIFACTRECORD._serialized_end=17882
-  _USEARTIFACTRESULT._serialized_start=17884
-  _USEARTIFACTRESULT._serialized_end=17903
-  _CANCELREQUEST._serialized_start=17905
-  _CANCELREQUEST._serialized_end=17987
-  _CANCELRESPONSE._serialized_start=17989
-  _CANCELRESPONSE._serialized_end=18005
-  _DISKINFO._serialized_start=18007
-  _DISKINFO._serialized_end=18046
-  _MEMORYINFO._serialized_start=18048
-  _MEMORYINFO._serialized_end=18075
-  _CPUINFO._serialized_start=18077
-  _CPUINFO._serialized_end=18124
-  _GPUAPPLEINFO._serialized_start=18126
-  _GPUAPPLEINFO._serialized_end=18188
-  _GPUNVIDIAINFO._serialized_start=18190
-  _GPUNVIDIAINFO._serialized_end=18241
-  _GPUAMDINFO._serialized_start=18244
-  _GPUAMDINFO._serialized_end=18509
-  _METADATAREQUEST._serialized_start=18512
-  _METADATAREQUEST._serialized_end=19558
-  _METADATAREQUEST_DISKENTRY._serialized_start=19443
-  _METADATAREQUEST_DISKENTRY._serialized_end=19512
-  _METADATAREQUEST_SLURMENTRY._serialized_start=19514
-  _METADATAREQUEST_SLURMENTRY._serialized_end=19558
-  _PYTHONPACKAGESREQUEST._serialized_start=19561
-  _PYTHONPACKAGESREQUEST._serialized_end=19702
-  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_start=19656
-  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_end=19702
-  _JOBINPUTPATH._serialized_start=19704
-  _JOBINPUTPATH._serialized_end=19732
-  _JOBINPUTSOURCE._serialized_start=19735
-  _JOBINPUTSOURCE._serialized_end=19949
-  _JOBINPUTSOURCE_RUNCONFIGSOURCE._serialized_start=19888
-  _JOBINPUTSOURCE_RUNCONFIGSOURCE._serialized_end=19905
-  _JOBINPUTSOURCE_CONFIGFILESOURCE._serialized_start=19907
-  _JOBINPUTSOURCE_CONFIGFILESOURCE._serialized_end=19939
-  _JOBINPUTREQUEST._serialized_start=19952
-  _JOBINPUTREQUEST._serialized_end=20129
+  _RECORD._serialized_end=1380
+  _CONTROL._serialized_start=1383
+  _CONTROL._serialized_end=1573
+  _RESULT._serialized_start=1576
+  _RESULT._serialized_end=2075
+  _FINALRECORD._serialized_start=2077
+  _FINALRECORD._serialized_end=2135
+  _VERSIONINFO._serialized_start=2137
+  _VERSIONINFO._serialized_end=2235
+  _HEADERRECORD._serialized_start=2237
+  _HEADERRECORD._serialized_end=2347
+  _FOOTERRECORD._serialized_start=2349
+  _FOOTERRECORD._serialized_end=2408
+  _RUNRECORD._serialized_start=2411
+  _RUNRECORD._serialized_end=3017
+  _GITREPORECORD._serialized_start=3019
+  _GITREPORECORD._serialized_end=3078
+  _RUNUPDATERESULT._serialized_start=3080
+  _RUNUPDATERESULT._serialized_end=3179
+  _ERRORINFO._serialized_start=3182
+  _ERRORINFO._serialized_end=3354
+  _ERRORINFO_ERRORCODE._serialized_start=3263
+  _ERRORINFO_ERRORCODE._serialized_end=3354
+  _RUNEXITRECORD._serialized_start=3357
+  _RUNEXITRECORD._serialized_end=3490
+  _RUNEXITRESULT._serialized_start=3492
+  _RUNEXITRESULT._serialized_end=3507
+  _RUNPREEMPTINGRECORD._serialized_start=3509
+  _RUNPREEMPTINGRECORD._serialized_end=3575
+  _RUNPREEMPTINGRESULT._serialized_start=3577
+  _RUNPREEMPTINGRESULT._serialized_end=3598
+  _RUNNOTESRECORD._serialized_start=3600
+  _RUNNOTESRECORD._serialized_end=3676
+  _SETTINGSRECORD._serialized_start=3678
+  _SETTINGSRECORD._serialized_end=3783
+  _SETTINGSITEM._serialized_start=3785
+  _SETTINGSITEM._serialized_end=3832
+  _HISTORYSTEP._serialized_start=3834
+  _HISTORYSTEP._serialized_end=3860
+  _HISTORYRECORD._serialized_start=3863
+  _HISTORYRECORD._serialized_end=4056
+  _HISTORYITEM._serialized_start=4058
+  _HISTORYITEM._serialized_end=4124
+  _HISTORYRESULT._serialized_start=4126
+  _HISTORYRESULT._serialized_end=4183
+  _OUTPUTRECORD._serialized_start=4186
+  _OUTPUTRECORD._serialized_end=4406
+  _OUTPUTRECORD_OUTPUTTYPE._serialized_start=4370
+  _OUTPUTRECORD_OUTPUTTYPE._serialized_end=4406
+  _OUTPUTRESULT._serialized_start=4408
+  _OUTPUTRESULT._serialized_end=4422
+  _OUTPUTRAWRECORD._serialized_start=4425
+  _OUTPUTRAWRECORD._serialized_end=4651
+  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_start=4370
+  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_end=4406
+  _OUTPUTRAWRESULT._serialized_start=4653
+  _OUTPUTRAWRESULT._serialized_end=4670
+  _METRICRECORD._serialized_start=4673
+  _METRICRECORD._serialized_end=5081
+  _METRICRECORD_METRICGOAL._serialized_start=5015
+  _METRICRECORD_METRICGOAL._serialized_end=5081
+  _METRICRESULT._serialized_start=5083
+  _METRICRESULT._serialized_end=5097
+  _METRICOPTIONS._serialized_start=5099
+  _METRICOPTIONS._serialized_end=5166
+  _METRICCONTROL._serialized_start=5168
+  _METRICCONTROL._serialized_end=5202
+  _METRICSUMMARY._serialized_start=5205
+  _METRICSUMMARY._serialized_end=5458
+  _METRICSUMMARY_ARRAYMODE._serialized_start=5398
+  _METRICSUMMARY_ARRAYMODE._serialized_end=5458
+  _CONFIGRECORD._serialized_start=5461
+  _CONFIGRECORD._serialized_end=5629
+  _CONFIGITEM._serialized_start=5631
+  _CONFIGITEM._serialized_end=5717
+  _CONFIGRESULT._serialized_start=5719
+  _CONFIGRESULT._serialized_end=5775
+  _SUMMARYRECORD._serialized_start=5778
+  _SUMMARYRECORD._serialized_end=6014
+  _SUMMARYITEM._serialized_start=6016
+  _SUMMARYITEM._serialized_end=6082
+  _SUMMARYRESULT._serialized_start=6084
+  _SUMMARYRESULT._serialized_end=6141
+  _FILESRECORD._serialized_start=6143
+  _FILESRECORD._serialized_end=6243
+  _FILESITEM._serialized_start=6246
+  _FILESITEM._serialized_end=6482
+  _FILESITEM_POLICYTYPE._serialized_start=6377
+  _FILESITEM_POLICYTYPE._serialized_end=6417
+  _FILESITEM_FILETYPE._serialized_start=6419
+  _FILESITEM_FILETYPE._serialized_end=6476
+  _FILESRESULT._serialized_start=6484
+  _FILESRESULT._serialized_end=6497
+  _STATSRECORD._serialized_start=6500
+  _STATSRECORD._serialized_end=6730
+  _STATSRECORD_STATSTYPE._serialized_start=6707
+  _STATSRECORD_STATSTYPE._serialized_end=6730
+  _STATSITEM._serialized_start=6732
+  _STATSITEM._serialized_end=6776
+  _ARTIFACTRECORD._serialized_start=6779
+  _ARTIFACTRECORD._serialized_end=7252
+  _ARTIFACTMANIFEST._serialized_start=7255
+  _ARTIFACTMANIFEST._serialized_end=7443
+  _ARTIFACTMANIFESTENTRY._serialized_start=7446
+  _ARTIFACTMANIFESTENTRY._serialized_end=7653
+  _EXTRAITEM._serialized_start=7655
+  _EXTRAITEM._serialized_end=7699
+  _STORAGEPOLICYCONFIGITEM._serialized_start=7701
+  _STORAGEPOLICYCONFIGITEM._serialized_end=7759
+  _ARTIFACTRESULT._serialized_start=7761
+  _ARTIFACTRESULT._serialized_end=7777
+  _LINKARTIFACTRESULT._serialized_start=7779
+  _LINKARTIFACTRESULT._serialized_end=7799
+  _LINKARTIFACTRECORD._serialized_start=7802
+  _LINKARTIFACTRECORD._serialized_end=8009
+  _TBRECORD._serialized_start=8011
+  _TBRECORD._serialized_end=8115
+  _TBRESULT._serialized_start=8117
+  _TBRESULT._serialized_end=8127
+  _ALERTRECORD._serialized_start=8129
+  _ALERTRECORD._serialized_end=8254
+  _ALERTRESULT._serialized_start=8256
+  _ALERTRESULT._serialized_end=8269
+  _REQUEST._serialized_start=8272
+  _REQUEST._serialized_end=10541
+  _RESPONSE._serialized_start=10544
+  _RESPONSE._serialized_end=12310
+  _DEFERREQUEST._serialized_start=12313
+  _DEFERREQUEST._serialized_end=12633
+  _DEFERREQUEST_DEFERSTATE._serialized_start=12386
+  _DEFERREQUEST_DEFERSTATE._serialized_end=12633
+  _PAUSEREQUEST._serialized_start=12635
+  _PAUSEREQUEST._serialized_end=12695
+  _PAUSERESPONSE._serialized_start=12697
+  _PAUSERESPONSE._serialized_end=12712
+  _RESUMEREQUEST._serialized_start=12714
+  _RESUMEREQUEST._serialized_end=12775
+  _RESUMERESPONSE._serialized_start=12777
+  _RESUMERESPONSE._serialized_end=12793
+  _LOGINREQUEST._serialized_start=12795
+  _LOGINREQUEST._serialized_end=12872
+  _LOGINRESPONSE._serialized_start=12874
+  _LOGINRESPONSE._serialized_end=12912
+  _GETSUMMARYREQUEST._serialized_start=12914
+  _GETSUMMARYREQUEST._serialized_end=12979
+  _GETSUMMARYRESPONSE._serialized_start=12981
+  _GETSUMMARYRESPONSE._serialized_end=13044
+  _GETCONFIGREQUEST._serialized_start=13046
+  _GETCONFIGREQUEST._serialized_end=13110
+  _GETCONFIGRESPONSE._serialized_start=13112
+  _GETCONFIGRESPONSE._serialized_end=13173
+  _PREFLIGHTREQUEST._serialized_start=13175
+  _PREFLIGHTREQUEST._serialized_end=13272
+  _PREFLIGHTRESPONSE._serialized_start=13274
+  _PREFLIGHTRESPONSE._serialized_end=13357
+  _GETSYSTEMMETRICSREQUEST._serialized_start=13359
+  _GETSYSTEMMETRICSREQUEST._serialized_end=13430
+  _SYSTEMMETRICSAMPLE._serialized_start=13432
+  _SYSTEMMETRICSAMPLE._serialized_end=13514
+  _SYSTEMMETRICSBUFFER._serialized_start=13516
+  _SYSTEMMETRICSBUFFER._serialized_end=13589
+  _GETSYSTEMMETRICSRESPONSE._serialized_start=13592
+  _GETSYSTEMMETRICSRESPONSE._serialized_end=13794
+  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_start=13705
+  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_end=13794
+  _STATUSREQUEST._serialized_start=13796
+  _STATUSREQUEST._serialized_end=13857
+  _STATUSRESPONSE._serialized_start=13859
+  _STATUSRESPONSE._serialized_end=13900
+  _STOPSTATUSREQUEST._serialized_start=13902
+  _STOPSTATUSREQUEST._serialized_end=13967
+  _STOPSTATUSRESPONSE._serialized_start=13969
+  _STOPSTATUSRESPONSE._serialized_end=14014
+  _NETWORKSTATUSREQUEST._serialized_start=14016
+  _NETWORKSTATUSREQUEST._serialized_end=14084
+  _NETWORKSTATUSRESPONSE._serialized_start=14086
+  _NETWORKSTATUSRESPONSE._serialized_end=14166
+  _HTTPRESPONSE._serialized_start=14168
+  _HTTPRESPONSE._serialized_end=14236
+  _INTERNALMESSAGESREQUEST._serialized_start=14238
+  _INTERNALMESSAGESREQUEST._serialized_end=14309
+  _INTERNALMESSAGESRESPONSE._serialized_start=14311
+  _INTERNALMESSAGESRESPONSE._serialized_end=14389
+  _INTERNALMESSAGES._serialized_start=14391
+  _INTERNALMESSAGES._serialized_end=14426
+  _POLLEXITREQUEST._serialized_start=14428
+  _POLLEXITREQUEST._serialized_end=14491
+  _POLLEXITRESPONSE._serialized_start=14494
+  _POLLEXITRESPONSE._serialized_end=14740
+  _SYNCOVERWRITE._serialized_start=14742
+  _SYNCOVERWRITE._serialized_end=14806
+  _SYNCSKIP._serialized_start=14808
+  _SYNCSKIP._serialized_end=14838
+  _SENDERMARKREQUEST._serialized_start=14840
+  _SENDERMARKREQUEST._serialized_end=14859
+  _FLUSHREQUEST._serialized_start=14861
+  _FLUSHREQUEST._serialized_end=14887
+  _FLUSHRESPONSE._serialized_start=14889
+  _FLUSHRESPONSE._serialized_end=15015
+  _FLUSHRESPONSE_STAGE._serialized_start=14973
+  _FLUSHRESPONSE_STAGE._serialized_end=15015
+  _SYNCREQUEST._serialized_start=15018
+  _SYNCREQUEST._serialized_end=15181
+  _SYNCRESPONSE._serialized_start=15183
+  _SYNCRESPONSE._serialized_end=15273
+  _SENDERREADREQUEST._serialized_start=15275
+  _SENDERREADREQUEST._serialized_end=15338
+  _STATUSREPORTREQUEST._serialized_start=15340
+  _STATUSREPORTREQUEST._serialized_end=15449
+  _SUMMARYRECORDREQUEST._serialized_start=15451
+  _SUMMARYRECORDREQUEST._serialized_end=15521
+  _TELEMETRYRECORDREQUEST._serialized_start=15523
+  _TELEMETRYRECORDREQUEST._serialized_end=15599
+  _SERVERINFOREQUEST._serialized_start=15601
+  _SERVERINFOREQUEST._serialized_end=15666
+  _SERVERINFORESPONSE._serialized_start=15668
+  _SERVERINFORESPONSE._serialized_end=15792
+  _SERVERMESSAGES._serialized_start=15794
+  _SERVERMESSAGES._serialized_end=15855
+  _SERVERMESSAGE._serialized_start=15857
+  _SERVERMESSAGE._serialized_end=15958
+  _FILECOUNTS._serialized_start=15960
+  _FILECOUNTS._serialized_end=16059
+  _DROPPEDDATASUMMARY._serialized_start=16061
+  _DROPPEDDATASUMMARY._serialized_end=16138
+  _DROPPEDDATACATEGORY._serialized_start=16140
+  _DROPPEDDATACATEGORY._serialized_end=16208
+  _FILEPUSHERSTATS._serialized_start=16210
+  _FILEPUSHERSTATS._serialized_end=16295
+  _FILESUPLOADED._serialized_start=16297
+  _FILESUPLOADED._serialized_end=16327
+  _FILETRANSFERINFOREQUEST._serialized_start=16330
+  _FILETRANSFERINFOREQUEST._serialized_end=16574
+  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_start=16534
+  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_end=16574
+  _LOCALINFO._serialized_start=16576
+  _LOCALINFO._serialized_end=16625
+  _SHUTDOWNREQUEST._serialized_start=16627
+  _SHUTDOWNREQUEST._serialized_end=16690
+  _SHUTDOWNRESPONSE._serialized_start=16692
+  _SHUTDOWNRESPONSE._serialized_end=16710
+  _ATTACHREQUEST._serialized_start=16712
+  _ATTACHREQUEST._serialized_end=16792
+  _ATTACHRESPONSE._serialized_start=16794
+  _ATTACHRESPONSE._serialized_end=16892
+  _TESTINJECTREQUEST._serialized_start=16895
+  _TESTINJECTREQUEST._serialized_end=17236
+  _TESTINJECTRESPONSE._serialized_start=17238
+  _TESTINJECTRESPONSE._serialized_end=17258
+  _HISTORYACTION._serialized_start=17260
+  _HISTORYACTION._serialized_end=17290
+  _PARTIALHISTORYREQUEST._serialized_start=17293
+  _PARTIALHISTORYREQUEST._serialized_end=17542
+  _BATCHHISTORYREQUEST._serialized_start=17544
+  _BATCHHISTORYREQUEST._serialized_end=17658
+  _BATCHHISTORYROW._serialized_start=17660
+  _BATCHHISTORYROW._serialized_end=17781
+  _BATCHHISTORYRESPONSE._serialized_start=17783
+  _BATCHHISTORYRESPONSE._serialized_end=17842
+  _PARTIALHISTORYRESPONSE._serialized_start=17844
+  _PARTIALHISTORYRESPONSE._serialized_end=17868
+  _SAMPLEDHISTORYREQUEST._serialized_start=17870
+  _SAMPLEDHISTORYREQUEST._serialized_end=17939
+  _SAMPLEDHISTORYITEM._serialized_start=17941
+  _SAMPLEDHISTORYITEM._serialized_end=18036
+  _SAMPLEDHISTORYRESPONSE._serialized_start=18038
+  _SAMPLEDHISTORYRESPONSE._serialized_end=18112
+  _RUNSTATUSREQUEST._serialized_start=18114
+  _RUNSTATUSREQUEST._serialized_end=18178
+  _RUNSTATUSRESPONSE._serialized_start=18181
+  _RUNSTATUSRESPONSE._serialized_end=18394
+  _RUNSTARTREQUEST._serialized_start=18396
+  _RUNSTARTREQUEST._serialized_end=18499
+  _RUNSTARTRESPONSE._serialized_start=18501
+  _RUNSTARTRESPONSE._serialized_end=18519
+  _CHECKVERSIONREQUEST._serialized_start=18521
+  _CHECKVERSIONREQUEST._serialized_end=18613
+  _CHECKVERSIONRESPONSE._serialized_start=18615
+  _CHECKVERSIONRESPONSE._serialized_end=18708
+  _JOBINFOREQUEST._serialized_start=18710
+  _JOBINFOREQUEST._serialized_end=18772
+  _JOBINFORESPONSE._serialized_start=18774
+  _JOBINFORESPONSE._serialized_end=18828
+  _LOGARTIFACTREQUEST._serialized_start=18831
+  _LOGARTIFACTREQUEST._serialized_end=18990
+  _LOGARTIFACTDIRREQUEST._serialized_start=18993
+  _LOGARTIFACTDIRREQUEST._serialized_end=19126
+  _LOGARTIFACTRESPONSE._serialized_start=19129
+  _LOGARTIFACTRESPONSE._serialized_end=19258
+  _ARTIFACTFILEUPLOADRESULT._serialized_start=19261
+  _ARTIFACTFILEUPLOADRESULT._serialized_end=19456
+  _ARTIFACTFILEUPLOADRESULT_STATUS._serialized_start=19408
+  _ARTIFACTFILEUPLOADRESULT_STATUS._serialized_end=19456
+  _DOWNLOADARTIFACTREQUEST._serialized_start=19459
+  _DOWNLOADARTIFACTREQUEST._serialized_end=19649
+  _DOWNLOADARTIFACTRESPONSE._serialized_start=19651
+  _DOWNLOADARTIFACTRESPONSE._serialized_end=19700
+  _KEEPALIVEREQUEST._serialized_start=19702
+  _KEEPALIVEREQUEST._serialized_end=19766
+  _KEEPALIVERESPONSE._serialized_start=19768
+  _KEEPALIVERESPONSE._serialized_end=19787
+  _ARTIFACTINFO._serialized_start=19789
+  _ARTIFACTINFO._serialized_end=19902
+  _GITINFO._serialized_start=19904
+  _GITINFO._serialized_end=19945
+  _GITSOURCE._serialized_start=19948
+  _GITSOURCE._serialized_end=20083
+  _IMAGESOURCE._serialized_start=20085
+  _IMAGESOURCE._serialized_end=20113
+  _SOURCE._serialized_start=20116
+  _SOURCE._serialized_end=20256
+  _JOBSOURCE._serialized_start=20258
+  _JOBSOURCE._serialized_end=20365
+  _PARTIALJOBARTIFACT._serialized_start=20367
+  _PARTIALJOBARTIFACT._serialized_end=20453
+  _USEARTIFACTRECORD._serialized_start=20456
+  _USEARTIFACTRECORD._serialized_end=20613
+  _USEARTIFACTRESULT._serialized_start=20615
+  _USEARTIFACTRESULT._serialized_end=20634
+  _CANCELREQUEST._serialized_start=20636
+  _CANCELREQUEST._serialized_end=20718
+  _CANCELRESPONSE._serialized_start=20720
+  _CANCELRESPONSE._serialized_end=20736
+  _DISKINFO._serialized_start=20738
+  _DISKINFO._serialized_end=20777
+  _MEMORYINFO._serialized_start=20779
+  _MEMORYINFO._serialized_end=20806
+  _CPUINFO._serialized_start=20808
+  _CPUINFO._serialized_end=20855
+  _GPUAPPLEINFO._serialized_start=20857
+  _GPUAPPLEINFO._serialized_end=20919
+  _GPUNVIDIAINFO._serialized_start=20922
+  _GPUNVIDIAINFO._serialized_end=21119
+  _GPUAMDINFO._serialized_start=21122
+  _GPUAMDINFO._serialized_end=21387
+  _METADATAREQUEST._serialized_start=21390
+  _METADATAREQUEST._serialized_end=22669
+  _METADATAREQUEST_DISKENTRY._serialized_start=22510
+  _METADATAREQUEST_DISKENTRY._serialized_end=22579
+  _METADATAREQUEST_SLURMENTRY._serialized_start=22581
+  _METADATAREQUEST_SLURMENTRY._serialized_end=22625
+  _METADATAREQUEST_ENVENTRY._serialized_start=22627
+  _METADATAREQUEST_ENVENTRY._serialized_end=22669
+  _PYTHONPACKAGESREQUEST._serialized_start=22672
+  _PYTHONPACKAGESREQUEST._serialized_end=22813
+  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_start=22767
+  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_end=22813
+  _JOBINPUTPATH._serialized_start=22815
+  _JOBINPUTPATH._serialized_end=22843
+  _JOBINPUTSOURCE._serialized_start=22846
+  _JOBINPUTSOURCE._serialized_end=23060
+  _JOBINPUTSOURCE_RUNCONFIGSOURCE._serialized_start=22999
+  _JOBINPUTSOURCE_RUNCONFIGSOURCE._serialized_end=23016
+  _JOBINPUTSOURCE_CONFIGFILESOURCE._serialized_start=23018
+  _JOBINPUTSOURCE_CONFIGFILESOURCE._serialized_end=23050
+  _JOBINPUTREQUEST._serialized_start=23063
+  _JOBINPUTREQUEST._serialized_end=23240
 # @@protoc_insertion_point(module_scope)
@@ -53,6 +53,7 @@ class Record(google.protobuf.message.Message):
     PREEMPTING_FIELD_NUMBER: builtins.int
     LINK_ARTIFACT_FIELD_NUMBER: builtins.int
     USE_ARTIFACT_FIELD_NUMBER: builtins.int
+    NOTES_FIELD_NUMBER: builtins.int
     REQUEST_FIELD_NUMBER: builtins.int
     CONTROL_FIELD_NUMBER: builtins.int
     UUID_FIELD_NUMBER: builtins.int
@@ -101,6 +102,8 @@ class Record(google.protobuf.message.Message):
     @property
     def use_artifact(self) -> global___UseArtifactRecord: ...
     @property
+    def notes(self) -> global___RunNotesRecord: ...
+    @property
     def request(self) -> global___Request:
         """request field does not belong here longterm"""
     @property
@@ -132,14 +135,15 @@ class Record(google.protobuf.message.Message):
         preempting: global___RunPreemptingRecord | None = ...,
         link_artifact: global___LinkArtifactRecord | None = ...,
         use_artifact: global___UseArtifactRecord | None = ...,
+        notes: global___RunNotesRecord | None = ...,
         request: global___Request | None = ...,
         control: global___Control | None = ...,
         uuid: builtins.str = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "link_artifact", "use_artifact", "request"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "notes", b"notes", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "notes", b"notes", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "link_artifact", "use_artifact", "notes", "request"] | None: ...
 
 global___Record = Record
 
@@ -154,6 +158,7 @@ class Control(google.protobuf.message.Message):
     FLOW_CONTROL_FIELD_NUMBER: builtins.int
     END_OFFSET_FIELD_NUMBER: builtins.int
     CONNECTION_ID_FIELD_NUMBER: builtins.int
+    PERSIST_ONLY_FIELD_NUMBER: builtins.int
     req_resp: builtins.bool
     """record is expecting a result"""
     local: builtins.bool
@@ -170,6 +175,8 @@ class Control(google.protobuf.message.Message):
     """end of message offset of this written message"""
     connection_id: builtins.str
     """connection id"""
+    persist_only: builtins.bool
+    """should be persisted but not sent"""
     def __init__(
         self,
         *,
@@ -181,8 +188,9 @@ class Control(google.protobuf.message.Message):
         flow_control: builtins.bool = ...,
         end_offset: builtins.int = ...,
         connection_id: builtins.str = ...,
+        persist_only: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["always_send", b"always_send", "connection_id", b"connection_id", "end_offset", b"end_offset", "flow_control", b"flow_control", "local", b"local", "mailbox_slot", b"mailbox_slot", "relay_id", b"relay_id", "req_resp", b"req_resp"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["always_send", b"always_send", "connection_id", b"connection_id", "end_offset", b"end_offset", "flow_control", b"flow_control", "local", b"local", "mailbox_slot", b"mailbox_slot", "persist_only", b"persist_only", "relay_id", b"relay_id", "req_resp", b"req_resp"]) -> None: ...
 
 global___Control = Control
 
@@ -507,9 +515,17 @@ class RunExitRecord(google.protobuf.message.Message):
 
     EXIT_CODE_FIELD_NUMBER: builtins.int
     RUNTIME_FIELD_NUMBER: builtins.int
+    KILLED_FIELD_NUMBER: builtins.int
+    KILL_REASON_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     exit_code: builtins.int
     runtime: builtins.int
+    killed: builtins.bool
+    """Whether the run was aborted by the user, in which case it is marked
+    "killed" instead of finished or failed.
+    """
+    kill_reason: builtins.str
+    """Why the run was killed, if it was."""
     @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
@@ -517,10 +533,12 @@ class RunExitRecord(google.protobuf.message.Message):
         *,
         exit_code: builtins.int = ...,
         runtime: builtins.int = ...,
+        killed: builtins.bool = ...,
+        kill_reason: builtins.str = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "exit_code", b"exit_code", "runtime", b"runtime"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "exit_code", b"exit_code", "kill_reason", b"kill_reason", "killed", b"killed", "runtime", b"runtime"]) -> None: ...
 
 global___RunExitRecord = RunExitRecord
 
@@ -562,6 +580,29 @@ class RunPreemptingResult(google.protobuf.message.Message):
 
 global___RunPreemptingResult = RunPreemptingResult
 
+class RunNotesRecord(google.protobuf.message.Message):
+    """
+    RunNotesRecord: sets the run's notes (description)
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NOTES_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    notes: builtins.str
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        notes: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "notes", b"notes"]) -> None: ...
+
+global___RunNotesRecord = RunNotesRecord
+
 class SettingsRecord(google.protobuf.message.Message):
     """
     SettingsRecord: wandb/sdk/wandb_settings/Settings
@@ -626,22 +667,27 @@ class HistoryRecord(google.protobuf.message.Message):
 
     ITEM_FIELD_NUMBER: builtins.int
     STEP_FIELD_NUMBER: builtins.int
+    TIMESTAMP_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     @property
     def item(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___HistoryItem]: ...
     @property
     def step(self) -> global___HistoryStep: ...
     @property
+    def timestamp(self) -> google.protobuf.timestamp_pb2.Timestamp:
+        """When the values were logged, if not now; sets "_timestamp"."""
+    @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
         self,
         *,
         item: collections.abc.Iterable[global___HistoryItem] | None = ...,
         step: global___HistoryStep | None = ...,
+        timestamp: google.protobuf.timestamp_pb2.Timestamp | None = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "step", b"step"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "item", b"item", "step", b"step"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "step", b"step", "timestamp", b"timestamp"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "item", b"item", "step", b"step", "timestamp", b"timestamp"]) -> None: ...
 
 global___HistoryRecord = HistoryRecord
 
@@ -677,9 +723,16 @@ global___HistoryItem = HistoryItem
 class HistoryResult(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    ERROR_FIELD_NUMBER: builtins.int
+    @property
+    def error(self) -> global___ErrorInfo: ...
     def __init__(
         self,
+        *,
+        error: global___ErrorInfo | None = ...,
     ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error"]) -> None: ...
 
 global___HistoryResult = HistoryResult
 
@@ -901,6 +954,27 @@ global___MetricControl = MetricControl
 class MetricSummary(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    class _ArrayMode:
+        ValueType = typing.NewType("ValueType", builtins.int)
+        V: typing_extensions.TypeAlias = ValueType
+
+    class _ArrayModeEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[MetricSummary._ArrayMode.ValueType], builtins.type):  # noqa: F821
+        DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+        ARRAY_STATS: MetricSummary._ArrayMode.ValueType  # 0
+        """The array's length and the min, max and mean of its numbers."""
+        ARRAY_ENDS: MetricSummary._ArrayMode.ValueType  # 1
+        """The array's length and its first and last elements."""
+        ARRAY_FULL: MetricSummary._ArrayMode.ValueType  # 2
+        """The whole array."""
+
+    class ArrayMode(_ArrayMode, metaclass=_ArrayModeEnumTypeWrapper): ...
+    ARRAY_STATS: MetricSummary.ArrayMode.ValueType  # 0
+    """The array's length and the min, max and mean of its numbers."""
+    ARRAY_ENDS: MetricSummary.ArrayMode.ValueType  # 1
+    """The array's length and its first and last elements."""
+    ARRAY_FULL: MetricSummary.ArrayMode.ValueType  # 2
+    """The whole array."""
+
     MIN_FIELD_NUMBER: builtins.int
     MAX_FIELD_NUMBER: builtins.int
     MEAN_FIELD_NUMBER: builtins.int
@@ -908,6 +982,8 @@ class MetricSummary(google.protobuf.message.Message):
     LAST_FIELD_NUMBER: builtins.int
     NONE_FIELD_NUMBER: builtins.int
     COPY_FIELD_NUMBER: builtins.int
+    ARRAY_FIELD_NUMBER: builtins.int
+    HISTOGRAM_BINS_FIELD_NUMBER: builtins.int
     min: builtins.bool
     max: builtins.bool
     mean: builtins.bool
@@ -915,6 +991,17 @@ class MetricSummary(google.protobuf.message.Message):
     last: builtins.bool
     none: builtins.bool
     copy: builtins.bool
+    array: global___MetricSummary.ArrayMode.ValueType
+    """How numeric array values, like per-class scores or embeddings, are
+    summarized. Arrays with other elements are kept whole.
+    """
+    histogram_bins: builtins.int
+    """If positive, the number of bins of a histogram of all logged values,
+    added to the summary under the metric's key as {"histogram": ...} when
+    the run finishes. The metric's other summary values are then also
+    nested under its key, with the last value as {"last": ...} by default.
+    Metrics with a "best" summary have no histogram.
+    """
     def __init__(
         self,
         *,
@@ -925,8 +1012,10 @@ class MetricSummary(google.protobuf.message.Message):
         last: builtins.bool = ...,
         none: builtins.bool = ...,
         copy: builtins.bool = ...,
+        array: global___MetricSummary.ArrayMode.ValueType = ...,
+        histogram_bins: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["best", b"best", "copy", b"copy", "last", b"last", "max", b"max", "mean", b"mean", "min", b"min", "none", b"none"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["array", b"array", "best", b"best", "copy", b"copy", "histogram_bins", b"histogram_bins", "last", b"last", "max", b"max", "mean", b"mean", "min", b"min", "none", b"none"]) -> None: ...
 
 global___MetricSummary = MetricSummary
 
@@ -939,11 +1028,16 @@ class ConfigRecord(google.protobuf.message.Message):
 
     UPDATE_FIELD_NUMBER: builtins.int
     REMOVE_FIELD_NUMBER: builtins.int
+    MERGE_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     @property
     def update(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ConfigItem]: ...
     @property
     def remove(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ConfigItem]: ...
+    merge: builtins.bool
+    """Whether map values in `update` are merged into existing subtrees,
+    keeping keys that they don't set. By default, they replace them.
+    """
     @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
@@ -951,10 +1045,11 @@ class ConfigRecord(google.protobuf.message.Message):
         *,
         update: collections.abc.Iterable[global___ConfigItem] | None = ...,
         remove: collections.abc.Iterable[global___ConfigItem] | None = ...,
+        merge: builtins.bool = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "remove", b"remove", "update", b"update"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "merge", b"merge", "remove", b"remove", "update", b"update"]) -> None: ...
 
 global___ConfigRecord = ConfigRecord
 
@@ -972,27 +1067,40 @@ class ConfigItem(google.protobuf.message.Message):
     KEY_FIELD_NUMBER: builtins.int
     NESTED_KEY_FIELD_NUMBER: builtins.int
     VALUE_JSON_FIELD_NUMBER: builtins.int
+    FILE_BACKED_FIELD_NUMBER: builtins.int
     key: builtins.str
     @property
     def nested_key(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
     value_json: builtins.str
+    file_backed: builtins.bool
+    """Whether to upload the value as a run file and store a reference to the
+    file in the config instead of the value, for large values.
+    """
     def __init__(
         self,
         *,
         key: builtins.str = ...,
         nested_key: collections.abc.Iterable[builtins.str] | None = ...,
         value_json: builtins.str = ...,
+        file_backed: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "nested_key", b"nested_key", "value_json", b"value_json"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["file_backed", b"file_backed", "key", b"key", "nested_key", b"nested_key", "value_json", b"value_json"]) -> None: ...
 
 global___ConfigItem = ConfigItem
 
 class ConfigResult(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    ERROR_FIELD_NUMBER: builtins.int
+    @property
+    def error(self) -> global___ErrorInfo: ...
     def __init__(
         self,
+        *,
+        error: global___ErrorInfo | None = ...,
     ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error"]) -> None: ...
 
 global___ConfigResult = ConfigResult
 
@@ -1005,22 +1113,35 @@ class SummaryRecord(google.protobuf.message.Message):
 
     UPDATE_FIELD_NUMBER: builtins.int
     REMOVE_FIELD_NUMBER: builtins.int
+    PIN_FIELD_NUMBER: builtins.int
+    UNPIN_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     @property
     def update(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___SummaryItem]: ...
     @property
     def remove(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___SummaryItem]: ...
     @property
+    def pin(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___SummaryItem]:
+        """Keys to include in every later summary update, even if unchanged.
+
+        Each key must already be in the summary. Only the key is used.
+        """
+    @property
+    def unpin(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___SummaryItem]:
+        """Keys to stop including in every summary update."""
+    @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
         self,
         *,
         update: collections.abc.Iterable[global___SummaryItem] | None = ...,
         remove: collections.abc.Iterable[global___SummaryItem] | None = ...,
+        pin: collections.abc.Iterable[global___SummaryItem] | None = ...,
+        unpin: collections.abc.Iterable[global___SummaryItem] | None = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "remove", b"remove", "update", b"update"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "pin", b"pin", "remove", b"remove", "unpin", b"unpin", "update", b"update"]) -> None: ...
 
 global___SummaryRecord = SummaryRecord
 
@@ -1056,9 +1177,16 @@ global___SummaryItem = SummaryItem
 class SummaryResult(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    ERROR_FIELD_NUMBER: builtins.int
+    @property
+    def error(self) -> global___ErrorInfo: ...
     def __init__(
         self,
+        *,
+        error: global___ErrorInfo | None = ...,
     ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error"]) -> None: ...
 
 global___SummaryResult = SummaryResult
 
@@ -1573,6 +1701,11 @@ class Request(google.protobuf.message.Message):
     GET_SYSTEM_METRICS_FIELD_NUMBER: builtins.int
     SYNC_FIELD_NUMBER: builtins.int
     JOB_INPUT_FIELD_NUMBER: builtins.int
+    FLUSH_FIELD_NUMBER: builtins.int
+    LOG_ARTIFACT_DIR_FIELD_NUMBER: builtins.int
+    BATCH_HISTORY_FIELD_NUMBER: builtins.int
+    GET_CONFIG_FIELD_NUMBER: builtins.int
+    PREFLIGHT_FIELD_NUMBER: builtins.int
     TEST_INJECT_FIELD_NUMBER: builtins.int
     @property
     def stop_status(self) -> global___StopStatusRequest: ...
@@ -1641,6 +1774,16 @@ class Request(google.protobuf.message.Message):
     @property
     def job_input(self) -> global___JobInputRequest: ...
     @property
+    def flush(self) -> global___FlushRequest: ...
+    @property
+    def log_artifact_dir(self) -> global___LogArtifactDirRequest: ...
+    @property
+    def batch_history(self) -> global___BatchHistoryRequest: ...
+    @property
+    def get_config(self) -> global___GetConfigRequest: ...
+    @property
+    def preflight(self) -> global___PreflightRequest: ...
+    @property
     def test_inject(self) -> global___TestInjectRequest: ...
     def __init__(
         self,
@@ -1678,11 +1821,16 @@ class Request(google.protobuf.message.Message):
         get_system_metrics: global___GetSystemMetricsRequest | None = ...,
         sync: global___SyncRequest | None = ...,
         job_input: global___JobInputRequest | None = ...,
+        flush: global___FlushRequest | None = ...,
+        log_artifact_dir: global___LogArtifactDirRequest | None = ...,
+        batch_history: global___BatchHistoryRequest | None = ...,
+        get_config: global___GetConfigRequest | None = ...,
+        preflight: global___PreflightRequest | None = ...,
         test_inject: global___TestInjectRequest | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "sync", "job_input", "test_inject"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "batch_history", b"batch_history", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "flush", b"flush", "get_config", b"get_config", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "log_artifact_dir", b"log_artifact_dir", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "preflight", b"preflight", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "batch_history", b"batch_history", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "flush", b"flush", "get_config", b"get_config", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "log_artifact_dir", b"log_artifact_dir", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "preflight", b"preflight", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "sync", "job_input", "flush", "log_artifact_dir", "batch_history", "get_config", "preflight", "test_inject"] | None: ...
 
 global___Request = Request
 
@@ -1714,6 +1862,10 @@ class Response(google.protobuf.message.Message):
     JOB_INFO_RESPONSE_FIELD_NUMBER: builtins.int
     GET_SYSTEM_METRICS_RESPONSE_FIELD_NUMBER: builtins.int
     SYNC_RESPONSE_FIELD_NUMBER: builtins.int
+    BATCH_HISTORY_RESPONSE_FIELD_NUMBER: builtins.int
+    GET_CONFIG_RESPONSE_FIELD_NUMBER: builtins.int
+    PREFLIGHT_RESPONSE_FIELD_NUMBER: builtins.int
+    FLUSH_RESPONSE_FIELD_NUMBER: builtins.int
     TEST_INJECT_RESPONSE_FIELD_NUMBER: builtins.int
     @property
     def keepalive_response(self) -> global___KeepaliveResponse: ...
@@ -1758,6 +1910,14 @@ class Response(google.protobuf.message.Message):
     @property
     def sync_response(self) -> global___SyncResponse: ...
     @property
+    def batch_history_response(self) -> global___BatchHistoryResponse: ...
+    @property
+    def get_config_response(self) -> global___GetConfigResponse: ...
+    @property
+    def preflight_response(self) -> global___PreflightResponse: ...
+    @property
+    def flush_response(self) -> global___FlushResponse: ...
+    @property
     def test_inject_response(self) -> global___TestInjectResponse: ...
     def __init__(
         self,
@@ -1783,11 +1943,15 @@ class Response(google.protobuf.message.Message):
         job_info_response: global___JobInfoResponse | None = ...,
         get_system_metrics_response: global___GetSystemMetricsResponse | None = ...,
         sync_response: global___SyncResponse | None = ...,
+        batch_history_response: global___BatchHistoryResponse | None = ...,
+        get_config_response: global___GetConfigResponse | None = ...,
+        preflight_response: global___PreflightResponse | None = ...,
+        flush_response: global___FlushResponse | None = ...,
         test_inject_response: global___TestInjectResponse | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["response_type", b"response_type"]) -> typing_extensions.Literal["keepalive_response", "stop_status_response", "network_status_response", "login_response", "get_summary_response", "poll_exit_response", "sampled_history_response", "run_start_response", "check_version_response", "log_artifact_response", "download_artifact_response", "run_status_response", "cancel_response", "internal_messages_response", "shutdown_response", "attach_response", "status_response", "server_info_response", "job_info_response", "get_system_metrics_response", "sync_response", "test_inject_response"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "batch_history_response", b"batch_history_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "flush_response", b"flush_response", "get_config_response", b"get_config_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "preflight_response", b"preflight_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "batch_history_response", b"batch_history_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "flush_response", b"flush_response", "get_config_response", b"get_config_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "preflight_response", b"preflight_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["response_type", b"response_type"]) -> typing_extensions.Literal["keepalive_response", "stop_status_response", "network_status_response", "login_response", "get_summary_response", "poll_exit_response", "sampled_history_response", "run_start_response", "check_version_response", "log_artifact_response", "download_artifact_response", "run_status_response", "cancel_response", "internal_messages_response", "shutdown_response", "attach_response", "status_response", "server_info_response", "job_info_response", "get_system_metrics_response", "sync_response", "batch_history_response", "get_config_response", "preflight_response", "flush_response", "test_inject_response"] | None: ...
 
 global___Response = Response
 
@@ -1979,6 +2143,91 @@ class GetSummaryResponse(google.protobuf.message.Message):
 
 global___GetSummaryResponse = GetSummaryResponse
 
+class GetConfigRequest(google.protobuf.message.Message):
+    """
+    GetConfigRequest: request the run's current config
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> None: ...
+
+global___GetConfigRequest = GetConfigRequest
+
+class GetConfigResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ITEM_FIELD_NUMBER: builtins.int
+    @property
+    def item(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ConfigItem]: ...
+    def __init__(
+        self,
+        *,
+        item: collections.abc.Iterable[global___ConfigItem] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["item", b"item"]) -> None: ...
+
+global___GetConfigResponse = GetConfigResponse
+
+class PreflightRequest(google.protobuf.message.Message):
+    """
+    PreflightRequest: check that a run can be logged before it starts
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ENTITY_FIELD_NUMBER: builtins.int
+    PROJECT_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    entity: builtins.str
+    """The entity and project to check; the run's settings by default."""
+    project: builtins.str
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        entity: builtins.str = ...,
+        project: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "entity", b"entity", "project", b"project"]) -> None: ...
+
+global___PreflightRequest = PreflightRequest
+
+class PreflightResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ERROR_FIELD_NUMBER: builtins.int
+    FAILED_CHECK_FIELD_NUMBER: builtins.int
+    @property
+    def error(self) -> global___ErrorInfo:
+        """Why the run can't start, if it can't."""
+    failed_check: builtins.str
+    """The check that failed: "connectivity", "authentication"
+    or "permissions".
+    """
+    def __init__(
+        self,
+        *,
+        error: global___ErrorInfo | None = ...,
+        failed_check: builtins.str = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "failed_check", b"failed_check"]) -> None: ...
+
+global___PreflightResponse = PreflightResponse
+
 class GetSystemMetricsRequest(google.protobuf.message.Message):
     """
     GetSystemMetrics: request system metrics
@@ -2255,6 +2504,7 @@ class PollExitResponse(google.protobuf.message.Message):
     EXIT_RESULT_FIELD_NUMBER: builtins.int
     PUSHER_STATS_FIELD_NUMBER: builtins.int
     FILE_COUNTS_FIELD_NUMBER: builtins.int
+    DROPPED_DATA_FIELD_NUMBER: builtins.int
     done: builtins.bool
     @property
     def exit_result(self) -> global___RunExitResult: ...
@@ -2262,6 +2512,8 @@ class PollExitResponse(google.protobuf.message.Message):
     def pusher_stats(self) -> global___FilePusherStats: ...
     @property
     def file_counts(self) -> global___FileCounts: ...
+    @property
+    def dropped_data(self) -> global___DroppedDataSummary: ...
     def __init__(
         self,
         *,
@@ -2269,9 +2521,10 @@ class PollExitResponse(google.protobuf.message.Message):
         exit_result: global___RunExitResult | None = ...,
         pusher_stats: global___FilePusherStats | None = ...,
         file_counts: global___FileCounts | None = ...,
+        dropped_data: global___DroppedDataSummary | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["exit_result", b"exit_result", "file_counts", b"file_counts", "pusher_stats", b"pusher_stats"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["done", b"done", "exit_result", b"exit_result", "file_counts", b"file_counts", "pusher_stats", b"pusher_stats"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["dropped_data", b"dropped_data", "exit_result", b"exit_result", "file_counts", b"file_counts", "pusher_stats", b"pusher_stats"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["done", b"done", "dropped_data", b"dropped_data", "exit_result", b"exit_result", "file_counts", b"file_counts", "pusher_stats", b"pusher_stats"]) -> None: ...
 
 global___PollExitResponse = PollExitResponse
 
@@ -2322,6 +2575,61 @@ class SenderMarkRequest(google.protobuf.message.Message):
 
 global___SenderMarkRequest = SenderMarkRequest
 
+class FlushRequest(google.protobuf.message.Message):
+    """FlushRequest is a barrier for records that precede it.
+
+    Each stage signals when all earlier records are written to the transaction
+    log and, when online, acknowledged by the filestream API.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ID_FIELD_NUMBER: builtins.int
+    id: builtins.int
+    """Identifies the waiting Flush call."""
+    def __init__(
+        self,
+        *,
+        id: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id"]) -> None: ...
+
+global___FlushRequest = FlushRequest
+
+class FlushResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    class _Stage:
+        ValueType = typing.NewType("ValueType", builtins.int)
+        V: typing_extensions.TypeAlias = ValueType
+
+    class _StageEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[FlushResponse._Stage.ValueType], builtins.type):  # noqa: F821
+        DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+        NONE: FlushResponse._Stage.ValueType  # 0
+        PERSISTED: FlushResponse._Stage.ValueType  # 1
+        SENT: FlushResponse._Stage.ValueType  # 2
+
+    class Stage(_Stage, metaclass=_StageEnumTypeWrapper): ...
+    NONE: FlushResponse.Stage.ValueType  # 0
+    PERSISTED: FlushResponse.Stage.ValueType  # 1
+    SENT: FlushResponse.Stage.ValueType  # 2
+
+    STAGE_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    stage: global___FlushResponse.Stage.ValueType
+    """The last stage that all records before the request completed."""
+    error: builtins.str
+    """Why the flush stopped before the records were sent, if it failed."""
+    def __init__(
+        self,
+        *,
+        stage: global___FlushResponse.Stage.ValueType = ...,
+        error: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "stage", b"stage"]) -> None: ...
+
+global___FlushResponse = FlushResponse
+
 class SyncRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
@@ -2329,12 +2637,17 @@ class SyncRequest(google.protobuf.message.Message):
     FINAL_OFFSET_FIELD_NUMBER: builtins.int
     OVERWRITE_FIELD_NUMBER: builtins.int
     SKIP_FIELD_NUMBER: builtins.int
+    VERIFY_FIELD_NUMBER: builtins.int
     start_offset: builtins.int
     final_offset: builtins.int
     @property
     def overwrite(self) -> global___SyncOverwrite: ...
     @property
     def skip(self) -> global___SyncSkip: ...
+    verify: builtins.bool
+    """Whether to check that the backend reflects the transaction log once
+    the run is synced.
+    """
     def __init__(
         self,
         *,
@@ -2342,9 +2655,10 @@ class SyncRequest(google.protobuf.message.Message):
         final_offset: builtins.int = ...,
         overwrite: global___SyncOverwrite | None = ...,
         skip: global___SyncSkip | None = ...,
+        verify: builtins.bool = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["overwrite", b"overwrite", "skip", b"skip"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "overwrite", b"overwrite", "skip", b"skip", "start_offset", b"start_offset"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "overwrite", b"overwrite", "skip", b"skip", "start_offset", b"start_offset", "verify", b"verify"]) -> None: ...
 
 global___SyncRequest = SyncRequest
 
@@ -2353,17 +2667,24 @@ class SyncResponse(google.protobuf.message.Message):
 
     URL_FIELD_NUMBER: builtins.int
     ERROR_FIELD_NUMBER: builtins.int
+    DIVERGENCES_FIELD_NUMBER: builtins.int
     url: builtins.str
     @property
     def error(self) -> global___ErrorInfo: ...
+    @property
+    def divergences(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """How the backend differs from the transaction log, if the sync was
+        verified.
+        """
     def __init__(
         self,
         *,
         url: builtins.str = ...,
         error: global___ErrorInfo | None = ...,
+        divergences: collections.abc.Iterable[builtins.str] | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "url", b"url"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["divergences", b"divergences", "error", b"error", "url", b"url"]) -> None: ...
 
 global___SyncResponse = SyncResponse
 
@@ -2549,6 +2870,46 @@ class FileCounts(google.protobuf.message.Message):
 
 global___FileCounts = FileCounts
 
+class DroppedDataSummary(google.protobuf.message.Message):
+    """Data that was dropped or failed to upload during a run."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    CATEGORIES_FIELD_NUMBER: builtins.int
+    @property
+    def categories(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___DroppedDataCategory]: ...
+    def __init__(
+        self,
+        *,
+        categories: collections.abc.Iterable[global___DroppedDataCategory] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["categories", b"categories"]) -> None: ...
+
+global___DroppedDataSummary = DroppedDataSummary
+
+class DroppedDataCategory(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NAME_FIELD_NUMBER: builtins.int
+    COUNT_FIELD_NUMBER: builtins.int
+    EXAMPLES_FIELD_NUMBER: builtins.int
+    name: builtins.str
+    """What kind of data was dropped, like "file_upload_failed"."""
+    count: builtins.int
+    @property
+    def examples(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """The first few keys or paths that were dropped."""
+    def __init__(
+        self,
+        *,
+        name: builtins.str = ...,
+        count: builtins.int = ...,
+        examples: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "examples", b"examples", "name", b"name"]) -> None: ...
+
+global___DroppedDataCategory = DroppedDataCategory
+
 class FilePusherStats(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
@@ -2797,6 +3158,18 @@ class HistoryAction(google.protobuf.message.Message):
 
     FLUSH_FIELD_NUMBER: builtins.int
     flush: builtins.bool
+    """Whether to log the current step's accumulated items as a history row.
+
+    This is the opposite of `commit=False` in `run.log()`. Requests without
+    it accumulate into the current step; later values of the same key
+    overwrite earlier ones. The next request with it merges its own items
+    and logs everything as one row, then advances the step. A flush with
+    nothing accumulated logs no row but still advances the step, like
+    `log({}, commit=True)`. Items still accumulated when the run
+    finishes are logged as a final row.
+
+    If the request has neither a step nor an action, it is flushed.
+    """
     def __init__(
         self,
         *,
@@ -2812,6 +3185,7 @@ class PartialHistoryRequest(google.protobuf.message.Message):
     ITEM_FIELD_NUMBER: builtins.int
     STEP_FIELD_NUMBER: builtins.int
     ACTION_FIELD_NUMBER: builtins.int
+    TIMESTAMP_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     @property
     def item(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___HistoryItem]: ...
@@ -2820,6 +3194,9 @@ class PartialHistoryRequest(google.protobuf.message.Message):
     @property
     def action(self) -> global___HistoryAction: ...
     @property
+    def timestamp(self) -> google.protobuf.timestamp_pb2.Timestamp:
+        """When the values were logged, if not now; sets "_timestamp"."""
+    @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
     def __init__(
         self,
@@ -2827,13 +3204,84 @@ class PartialHistoryRequest(google.protobuf.message.Message):
         item: collections.abc.Iterable[global___HistoryItem] | None = ...,
         step: global___HistoryStep | None = ...,
         action: global___HistoryAction | None = ...,
+        timestamp: google.protobuf.timestamp_pb2.Timestamp | None = ...,
         _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "action", b"action", "step", b"step"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "action", b"action", "item", b"item", "step", b"step"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "action", b"action", "step", b"step", "timestamp", b"timestamp"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "action", b"action", "item", b"item", "step", b"step", "timestamp", b"timestamp"]) -> None: ...
 
 global___PartialHistoryRequest = PartialHistoryRequest
 
+class BatchHistoryRequest(google.protobuf.message.Message):
+    """Logs several history rows at once, each at its own step.
+
+    Rows are logged in order, as if each was a PartialHistoryRequest with
+    its step and a flush. Steps must be strictly increasing and no smaller
+    than the current step; otherwise, nothing is logged.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ROWS_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def rows(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___BatchHistoryRow]: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        rows: collections.abc.Iterable[global___BatchHistoryRow] | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "rows", b"rows"]) -> None: ...
+
+global___BatchHistoryRequest = BatchHistoryRequest
+
+class BatchHistoryRow(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ITEM_FIELD_NUMBER: builtins.int
+    STEP_FIELD_NUMBER: builtins.int
+    TIMESTAMP_FIELD_NUMBER: builtins.int
+    @property
+    def item(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___HistoryItem]: ...
+    step: builtins.int
+    @property
+    def timestamp(self) -> google.protobuf.timestamp_pb2.Timestamp:
+        """When the values were logged, if not now; sets "_timestamp"."""
+    def __init__(
+        self,
+        *,
+        item: collections.abc.Iterable[global___HistoryItem] | None = ...,
+        step: builtins.int = ...,
+        timestamp: google.protobuf.timestamp_pb2.Timestamp | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["timestamp", b"timestamp"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["item", b"item", "step", b"step", "timestamp", b"timestamp"]) -> None: ...
+
+global___BatchHistoryRow = BatchHistoryRow
+
+class BatchHistoryResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ERROR_MESSAGE_FIELD_NUMBER: builtins.int
+    STEP_FIELD_NUMBER: builtins.int
+    error_message: builtins.str
+    """Why the batch was rejected, if it was."""
+    step: builtins.int
+    """The next step to log to."""
+    def __init__(
+        self,
+        *,
+        error_message: builtins.str = ...,
+        step: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error_message", b"error_message", "step", b"step"]) -> None: ...
+
+global___BatchHistoryResponse = BatchHistoryResponse
+
 class PartialHistoryResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
@@ -2938,6 +3386,9 @@ class RunStatusResponse(google.protobuf.message.Message):
     SYNC_ITEMS_TOTAL_FIELD_NUMBER: builtins.int
     SYNC_ITEMS_PENDING_FIELD_NUMBER: builtins.int
     SYNC_TIME_FIELD_NUMBER: builtins.int
+    HISTORY_STEP_FIELD_NUMBER: builtins.int
+    START_TIME_FIELD_NUMBER: builtins.int
+    RUNTIME_FIELD_NUMBER: builtins.int
     sync_items_total: builtins.int
     sync_items_pending: builtins.int
     @property
@@ -2946,15 +3397,25 @@ class RunStatusResponse(google.protobuf.message.Message):
         int64 sync_history_step = 3;
         google.protobuf.Timestamp sync_history_time = 4;
         """
+    history_step: builtins.int
+    """The step of the next history row, as counted by the core."""
+    @property
+    def start_time(self) -> google.protobuf.timestamp_pb2.Timestamp:
+        """When the run started."""
+    runtime: builtins.float
+    """The run's elapsed runtime in seconds."""
     def __init__(
         self,
         *,
         sync_items_total: builtins.int = ...,
         sync_items_pending: builtins.int = ...,
         sync_time: google.protobuf.timestamp_pb2.Timestamp | None = ...,
+        history_step: builtins.int = ...,
+        start_time: google.protobuf.timestamp_pb2.Timestamp | None = ...,
+        runtime: builtins.float = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["sync_time", b"sync_time"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["sync_items_pending", b"sync_items_pending", "sync_items_total", b"sync_items_total", "sync_time", b"sync_time"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["start_time", b"start_time", "sync_time", b"sync_time"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["history_step", b"history_step", "runtime", b"runtime", "start_time", b"start_time", "sync_items_pending", b"sync_items_pending", "sync_items_total", b"sync_items_total", "sync_time", b"sync_time"]) -> None: ...
 
 global___RunStatusResponse = RunStatusResponse
 
@@ -3101,23 +3562,105 @@ class LogArtifactRequest(google.protobuf.message.Message):
 
 global___LogArtifactRequest = LogArtifactRequest
 
+class LogArtifactDirRequest(google.protobuf.message.Message):
+    """Logs an artifact containing the files written to a directory.
+
+    The directory is watched for the rest of the run. Files are added to the
+    artifact as they are created or modified, and the artifact is committed
+    when the run finishes. Files deleted before then are excluded.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ARTIFACT_FIELD_NUMBER: builtins.int
+    PATH_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def artifact(self) -> global___ArtifactRecord: ...
+    path: builtins.str
+    """The directory to watch. Only its direct children are included."""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        artifact: global___ArtifactRecord | None = ...,
+        path: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "artifact", b"artifact"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "artifact", b"artifact", "path", b"path"]) -> None: ...
+
+global___LogArtifactDirRequest = LogArtifactDirRequest
+
 class LogArtifactResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     ARTIFACT_ID_FIELD_NUMBER: builtins.int
     ERROR_MESSAGE_FIELD_NUMBER: builtins.int
+    FILE_RESULTS_FIELD_NUMBER: builtins.int
     artifact_id: builtins.str
     error_message: builtins.str
+    @property
+    def file_results(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ArtifactFileUploadResult]:
+        """The outcome of uploading each of the artifact's files.
+
+        Set even if some files failed, in which case error_message says which.
+        """
     def __init__(
         self,
         *,
         artifact_id: builtins.str = ...,
         error_message: builtins.str = ...,
+        file_results: collections.abc.Iterable[global___ArtifactFileUploadResult] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["artifact_id", b"artifact_id", "error_message", b"error_message"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["artifact_id", b"artifact_id", "error_message", b"error_message", "file_results", b"file_results"]) -> None: ...
 
 global___LogArtifactResponse = LogArtifactResponse
 
+class ArtifactFileUploadResult(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    class _Status:
+        ValueType = typing.NewType("ValueType", builtins.int)
+        V: typing_extensions.TypeAlias = ValueType
+
+    class _StatusEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[ArtifactFileUploadResult._Status.ValueType], builtins.type):  # noqa: F821
+        DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+        SUCCEEDED: ArtifactFileUploadResult._Status.ValueType  # 0
+        SKIPPED: ArtifactFileUploadResult._Status.ValueType  # 1
+        """The backend already had the file's contents."""
+        FAILED: ArtifactFileUploadResult._Status.ValueType  # 2
+
+    class Status(_Status, metaclass=_StatusEnumTypeWrapper): ...
+    SUCCEEDED: ArtifactFileUploadResult.Status.ValueType  # 0
+    SKIPPED: ArtifactFileUploadResult.Status.ValueType  # 1
+    """The backend already had the file's contents."""
+    FAILED: ArtifactFileUploadResult.Status.ValueType  # 2
+
+    NAME_FIELD_NUMBER: builtins.int
+    STATUS_FIELD_NUMBER: builtins.int
+    RETRIES_FIELD_NUMBER: builtins.int
+    ERROR_MESSAGE_FIELD_NUMBER: builtins.int
+    name: builtins.str
+    """The file's path in the artifact."""
+    status: global___ArtifactFileUploadResult.Status.ValueType
+    retries: builtins.int
+    """How many times the upload was retried."""
+    error_message: builtins.str
+    """Why the upload failed, if it did."""
+    def __init__(
+        self,
+        *,
+        name: builtins.str = ...,
+        status: global___ArtifactFileUploadResult.Status.ValueType = ...,
+        retries: builtins.int = ...,
+        error_message: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error_message", b"error_message", "name", b"name", "retries", b"retries", "status", b"status"]) -> None: ...
+
+global___ArtifactFileUploadResult = ArtifactFileUploadResult
+
 class DownloadArtifactRequest(google.protobuf.message.Message):
     """
     DownloadArtifact:
@@ -3511,15 +4054,37 @@ class GpuNvidiaInfo(google.protobuf.message.Message):
 
     NAME_FIELD_NUMBER: builtins.int
     MEMORY_TOTAL_FIELD_NUMBER: builtins.int
+    UUID_FIELD_NUMBER: builtins.int
+    ARCHITECTURE_FIELD_NUMBER: builtins.int
+    CUDA_COMPUTE_CAPABILITY_FIELD_NUMBER: builtins.int
+    PCIE_LINK_GENERATION_FIELD_NUMBER: builtins.int
+    PCIE_LINK_WIDTH_FIELD_NUMBER: builtins.int
+    NVLINK_COUNT_FIELD_NUMBER: builtins.int
     name: builtins.str
     memory_total: builtins.int
+    uuid: builtins.str
+    architecture: builtins.str
+    """The GPU architecture, like "Ampere"."""
+    cuda_compute_capability: builtins.str
+    """The CUDA compute capability, like "8.0"."""
+    pcie_link_generation: builtins.int
+    """The current PCIe link generation and width (number of lanes)."""
+    pcie_link_width: builtins.int
+    nvlink_count: builtins.int
+    """The number of active NVLink links."""
     def __init__(
         self,
         *,
         name: builtins.str = ...,
         memory_total: builtins.int = ...,
+        uuid: builtins.str = ...,
+        architecture: builtins.str = ...,
+        cuda_compute_capability: builtins.str = ...,
+        pcie_link_generation: builtins.int = ...,
+        pcie_link_width: builtins.int = ...,
+        nvlink_count: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["memory_total", b"memory_total", "name", b"name"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["architecture", b"architecture", "cuda_compute_capability", b"cuda_compute_capability", "memory_total", b"memory_total", "name", b"name", "nvlink_count", b"nvlink_count", "pcie_link_generation", b"pcie_link_generation", "pcie_link_width", b"pcie_link_width", "uuid", b"uuid"]) -> None: ...
 
 global___GpuNvidiaInfo = GpuNvidiaInfo
 
@@ -3608,6 +4173,21 @@ class MetadataRequest(google.protobuf.message.Message):
         ) -> None: ...
         def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
 
+    class EnvEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     OS_FIELD_NUMBER: builtins.int
     PYTHON_FIELD_NUMBER: builtins.int
     HEARTBEATAT_FIELD_NUMBER: builtins.int
@@ -3637,6 +4217,10 @@ class MetadataRequest(google.protobuf.message.Message):
     GPU_NVIDIA_FIELD_NUMBER: builtins.int
     GPU_AMD_FIELD_NUMBER: builtins.int
     SLURM_FIELD_NUMBER: builtins.int
+    GPU_DRIVER_VERSION_FIELD_NUMBER: builtins.int
+    CUDA_DRIVER_VERSION_FIELD_NUMBER: builtins.int
+    ENV_FIELD_NUMBER: builtins.int
+    CONTAINER_ID_FIELD_NUMBER: builtins.int
     os: builtins.str
     python: builtins.str
     @property
@@ -3677,6 +4261,14 @@ class MetadataRequest(google.protobuf.message.Message):
     def gpu_amd(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___GpuAmdInfo]: ...
     @property
     def slurm(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    gpu_driver_version: builtins.str
+    """The NVIDIA driver version and the newest CUDA version it supports."""
+    cuda_driver_version: builtins.str
+    @property
+    def env(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """Environment variables allowed by the `_metadata_env_vars` setting."""
+    container_id: builtins.str
+    """The ID of the container the run executes in, if any."""
     def __init__(
         self,
         *,
@@ -3709,9 +4301,13 @@ class MetadataRequest(google.protobuf.message.Message):
         gpu_nvidia: collections.abc.Iterable[global___GpuNvidiaInfo] | None = ...,
         gpu_amd: collections.abc.Iterable[global___GpuAmdInfo] | None = ...,
         slurm: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        gpu_driver_version: builtins.str = ...,
+        cuda_driver_version: builtins.str = ...,
+        env: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        container_id: builtins.str = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["cpu", b"cpu", "git", b"git", "gpu_apple", b"gpu_apple", "heartbeatAt", b"heartbeatAt", "memory", b"memory", "startedAt", b"startedAt"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "code_path", b"code_path", "code_path_local", b"code_path_local", "colab", b"colab", "cpu", b"cpu", "cpu_count", b"cpu_count", "cpu_count_logical", b"cpu_count_logical", "cuda", b"cuda", "disk", b"disk", "docker", b"docker", "email", b"email", "executable", b"executable", "git", b"git", "gpu_amd", b"gpu_amd", "gpu_apple", b"gpu_apple", "gpu_count", b"gpu_count", "gpu_nvidia", b"gpu_nvidia", "gpu_type", b"gpu_type", "heartbeatAt", b"heartbeatAt", "host", b"host", "memory", b"memory", "os", b"os", "program", b"program", "python", b"python", "root", b"root", "slurm", b"slurm", "startedAt", b"startedAt", "state", b"state", "username", b"username"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "code_path", b"code_path", "code_path_local", b"code_path_local", "colab", b"colab", "container_id", b"container_id", "cpu", b"cpu", "cpu_count", b"cpu_count", "cpu_count_logical", b"cpu_count_logical", "cuda", b"cuda", "cuda_driver_version", b"cuda_driver_version", "disk", b"disk", "docker", b"docker", "email", b"email", "env", b"env", "executable", b"executable", "git", b"git", "gpu_amd", b"gpu_amd", "gpu_apple", b"gpu_apple", "gpu_count", b"gpu_count", "gpu_driver_version", b"gpu_driver_version", "gpu_nvidia", b"gpu_nvidia", "gpu_type", b"gpu_type", "heartbeatAt", b"heartbeatAt", "host", b"host", "memory", b"memory", "os", b"os", "program", b"program", "python", b"python", "root", b"root", "slurm", b"slurm", "startedAt", b"startedAt", "state", b"state", "username", b"username"]) -> None: ...
 
 global___MetadataRequest = MetadataRequest
 
@@ -153,7 +153,7 @@ class Settings(google.protobuf.message.Message):
 
     Some fields such as `run_id` only make sense at the run level.
 
-    Next ID: 166
+    Next ID: 228
     """
 
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
@@ -161,6 +161,7 @@ class Settings(google.protobuf.message.Message):
     API_KEY_FIELD_NUMBER: builtins.int
     _OFFLINE_FIELD_NUMBER: builtins.int
     RUN_ID_FIELD_NUMBER: builtins.int
+    RUN_ID_SEED_FIELD_NUMBER: builtins.int
     RUN_URL_FIELD_NUMBER: builtins.int
     PROJECT_FIELD_NUMBER: builtins.int
     ENTITY_FIELD_NUMBER: builtins.int
@@ -319,6 +320,67 @@ class Settings(google.protobuf.message.Message):
     _STATS_BUFFER_SIZE_FIELD_NUMBER: builtins.int
     _SHARED_FIELD_NUMBER: builtins.int
     _CODE_PATH_LOCAL_FIELD_NUMBER: builtins.int
+    _STATS_DISABLED_ASSETS_FIELD_NUMBER: builtins.int
+    MAX_RUN_DURATION_FIELD_NUMBER: builtins.int
+    _HISTORY_RATE_LIMIT_FIELD_NUMBER: builtins.int
+    _MAX_METRICS_FIELD_NUMBER: builtins.int
+    _TAIL_LOG_FILES_FIELD_NUMBER: builtins.int
+    _METADATA_ENV_VARS_FIELD_NUMBER: builtins.int
+    _METADATA_ENV_FIELD_NUMBER: builtins.int
+    _POST_FINISH_POLICY_FIELD_NUMBER: builtins.int
+    _RUN_NAME_TEMPLATE_FIELD_NUMBER: builtins.int
+    _FAULT_INJECTION_FIELD_NUMBER: builtins.int
+    _RUN_DIR_FALLBACK_FIELD_NUMBER: builtins.int
+    _USER_AGENT_TOKEN_FIELD_NUMBER: builtins.int
+    _PREFLIGHT_FIELD_NUMBER: builtins.int
+    _STATS_COMPACTION_TOLERANCE_FIELD_NUMBER: builtins.int
+    _STATS_COMPACTION_MAX_GAP_SECONDS_FIELD_NUMBER: builtins.int
+    _HISTORY_JSONL_FIELD_NUMBER: builtins.int
+    _SUMMARY_ENV_FILE_FIELD_NUMBER: builtins.int
+    _SUMMARY_ENV_PREFIX_FIELD_NUMBER: builtins.int
+    _SUMMARY_ENV_PRESERVE_CASE_FIELD_NUMBER: builtins.int
+    _SUMMARY_ENV_NON_SCALAR_FIELD_NUMBER: builtins.int
+    _CLOCK_OFFSET_SECONDS_FIELD_NUMBER: builtins.int
+    _CLOCK_NTP_SERVER_FIELD_NUMBER: builtins.int
+    _CLOCK_SKEW_WARNING_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_STALL_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _RECORD_QUEUE_SIZE_FIELD_NUMBER: builtins.int
+    _DISABLE_RUNTIME_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_CONNECTIONS_FIELD_NUMBER: builtins.int
+    _FILES_POLICY_FIELD_NUMBER: builtins.int
+    _FILES_MIN_STABLE_SECONDS_FIELD_NUMBER: builtins.int
+    _INTERNAL_LOG_MAX_MEGABYTES_FIELD_NUMBER: builtins.int
+    _INTERNAL_LOG_MAX_FILES_FIELD_NUMBER: builtins.int
+    _CODE_SNAPSHOT_FIELD_NUMBER: builtins.int
+    _CODE_SNAPSHOT_GIT_FIELD_NUMBER: builtins.int
+    _CODE_SNAPSHOT_INCLUDE_FIELD_NUMBER: builtins.int
+    _CODE_SNAPSHOT_EXCLUDE_FIELD_NUMBER: builtins.int
+    _CODE_SNAPSHOT_MAX_MEGABYTES_FIELD_NUMBER: builtins.int
+    _MAX_VALUE_BYTES_FIELD_NUMBER: builtins.int
+    _OVERSIZED_VALUE_ACTION_FIELD_NUMBER: builtins.int
+    _DISABLE_TRANSACTION_LOG_FIELD_NUMBER: builtins.int
+    _NETWORK_PAUSE_FILE_FIELD_NUMBER: builtins.int
+    _CREDENTIAL_HELPER_FIELD_NUMBER: builtins.int
+    _GRAPHQL_PERSISTED_QUERIES_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_URL_REFRESH_MAX_FIELD_NUMBER: builtins.int
+    _HEALTH_PROBE_FIELD_NUMBER: builtins.int
+    _HEALTH_PROBE_ADDR_FIELD_NUMBER: builtins.int
+    _SUMMARY_UPDATE_INTERVAL_MS_FIELD_NUMBER: builtins.int
+    _ARTIFACT_DIGEST_ALGORITHM_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_CONCURRENCY_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_RATE_LIMIT_FIELD_NUMBER: builtins.int
+    _SHARED_STEP_FILE_FIELD_NUMBER: builtins.int
+    _STATS_PENDING_SAMPLES_MAX_FIELD_NUMBER: builtins.int
+    _GRAPHQL_QUERY_BASE_URL_FIELD_NUMBER: builtins.int
+    _GRAPHQL_MUTATION_BASE_URL_FIELD_NUMBER: builtins.int
+    _NETWORK_WARMUP_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_FLUSH_EVERY_N_POINTS_FIELD_NUMBER: builtins.int
+    _DISABLE_AUTO_SUMMARY_FIELD_NUMBER: builtins.int
+    _SUMMARY_AGGREGATION_META_FIELD_NUMBER: builtins.int
+    _TRANSACTION_LOG_FSYNC_RECORDS_FIELD_NUMBER: builtins.int
+    _TRANSACTION_LOG_COMPRESSION_FIELD_NUMBER: builtins.int
+    _TRANSACTION_LOG_HISTORY_ENCODING_FIELD_NUMBER: builtins.int
+    _SETTINGS_FILE_FIELD_NUMBER: builtins.int
     _PROXIES_FIELD_NUMBER: builtins.int
     @property
     def api_key(self) -> google.protobuf.wrappers_pb2.StringValue:
@@ -333,6 +395,14 @@ class Settings(google.protobuf.message.Message):
     def run_id(self) -> google.protobuf.wrappers_pb2.StringValue:
         """The ID of the run."""
     @property
+    def run_id_seed(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """A string from which to derive the run ID.
+
+        The same seed always produces the same run ID, so that retrying a
+        pipeline job resumes or overwrites the same run. Takes precedence over
+        run_id. Avoiding collisions between seeds is up to the user.
+        """
+    @property
     def run_url(self) -> google.protobuf.wrappers_pb2.StringValue:
         """The W&B URL where the run can be viewed."""
     @property
@@ -386,7 +456,8 @@ class Settings(google.protobuf.message.Message):
     @property
     def _extra_http_headers(self) -> global___MapStringKeyStringValue: ...
     @property
-    def _file_stream_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    def _file_stream_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Timeout for each filestream request attempt. Defaults to 180."""
     @property
     def _flow_control_custom(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
@@ -646,7 +717,8 @@ class Settings(google.protobuf.message.Message):
     @property
     def _graphql_retry_wait_max_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
     @property
-    def _graphql_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    def _graphql_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Timeout for each GraphQL request attempt. Defaults to 30."""
     @property
     def _disable_machine_info(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
@@ -660,6 +732,439 @@ class Settings(google.protobuf.message.Message):
     @property
     def _code_path_local(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
+    def _stats_disabled_assets(self) -> global___ListStringValue:
+        """Names of system metric assets not to monitor, such as "cpu" or "gpu"."""
+    @property
+    def max_run_duration(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Maximum wall-clock time for a run in seconds.
+
+        If the run is still going after this long, it is finished as if it
+        had exited with a non-zero exit code. Zero or unset means no limit.
+        """
+    @property
+    def _history_rate_limit(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Maximum number of points per second to log to history for each metric.
+
+        Excess points are dropped from history but still update the summary.
+        Zero or unset means no limit.
+        """
+    @property
+    def _max_metrics(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum number of distinct metrics in a run's history and summary.
+
+        Values of new metrics beyond the limit are dropped, while metrics that
+        already exist keep logging. This protects against metric names that are
+        generated by mistake, such as names that include a timestamp.
+        Zero or unset means no limit.
+        """
+    @property
+    def _tail_log_files(self) -> global___ListStringValue:
+        """Paths of log files to follow and stream to the run's console logs."""
+    @property
+    def _metadata_env_vars(self) -> global___ListStringValue:
+        """Environment variables to record in the run's metadata.
+
+        Entries may be glob patterns like "CUDA_*". Unset records none, since
+        environment variables often contain secrets.
+        """
+    @property
+    def _metadata_env(self) -> global___MapStringKeyStringValue:
+        """Environment variables allowed by `_metadata_env_vars`, captured by the
+        client since its environment may differ from the core's.
+
+        If unset, they are read from the core's own environment.
+        """
+    @property
+    def _post_finish_policy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """What to do with history, summary and config updates that arrive after
+        the run finished: "error" (the default) rejects them, and "warn" keeps
+        them in the transaction log without uploading them.
+
+        In both cases the user is warned once.
+        """
+    @property
+    def _run_name_template(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Template for the names of runs that aren't given one, like
+        "{project}-{date}-{short_id}".
+
+        Placeholders are {project}, {run_id}, {short_id}, {group}, {job_type},
+        {date} and {time}; use "{{" and "}}" for literal braces. {entity} is
+        not supported, since the default entity is only known once the run is
+        created. An invalid template fails the run's initialization.
+        """
+    @property
+    def _fault_injection(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Synthetic network faults to inject, for testing how runs cope with a
+        flaky backend. For debugging only.
+
+        A comma-separated list like "delay=0.1,delay_time=2s,throttle=0.05,
+        reset=0.01,seed=1", where "delay", "throttle" (429 responses) and
+        "reset" (connection resets) are the fraction of requests affected.
+        """
+    @property
+    def _run_dir_fallback(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Directory in which to put the wandb directory if the usual one
+        isn't writable, such as a writable mount in a read-only container.
+
+        Applied by the client when resolving wandb_dir, so the run's files,
+        logs and transaction log all move there.
+        """
+    @property
+    def _user_agent_token(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """A product token like "my-tool/1.2" appended to the User-Agent of
+        requests to the backend, to identify the integration making them.
+        """
+    @property
+    def _preflight(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to check connectivity, credentials and project permissions
+        before creating the run, failing early with the specific problem.
+        """
+    @property
+    def _stats_compaction_tolerance(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Relative change below which a system metric is considered unchanged.
+
+        If set, a system metric is only sent when it changes by more than this
+        fraction of its last sent value, or when _stats_compaction_max_gap_seconds
+        have passed. Zero only drops exact repeats. Unset disables compaction.
+        """
+    @property
+    def _stats_compaction_max_gap_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """The longest time to go without sending a compacted system metric.
+
+        Defaults to 5 minutes.
+        """
+    @property
+    def _history_jsonl(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to mirror the run's history to a JSONL file in the run
+        directory, for inspecting it with tools like grep or jq during the run.
+
+        Each history row is written as one line of history.jsonl. Writing is
+        best-effort: errors are logged and don't affect the run. Rows too large
+        to upload are skipped.
+        """
+    @property
+    def _summary_env_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Path of a file to write the run's summary to when the run finishes,
+        as KEY='value' lines for loading into environment variables.
+
+        A relative path is relative to the directory the run was started in.
+        Values are single-quoted like in a POSIX shell, so that the file can be
+        loaded with `source` or `set -a`. Nested keys are joined by underscores, and characters not allowed in
+        variable names are replaced by underscores. See `_summary_env_prefix`,
+        `_summary_env_preserve_case` and `_summary_env_non_scalar`.
+        """
+    @property
+    def _summary_env_prefix(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Prefix of the variable names in `_summary_env_file`.
+
+        Defaults to "WANDB_SUMMARY_".
+        """
+    @property
+    def _summary_env_preserve_case(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether variable names in `_summary_env_file` keep the case of summary
+        keys instead of being uppercase.
+        """
+    @property
+    def _summary_env_non_scalar(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """What to do with summary values in `_summary_env_file` that aren't
+        numbers, booleans or single-line strings.
+
+        One of "skip" (the default) to leave them out, or "json" to write
+        them as JSON.
+        """
+    @property
+    def _clock_offset_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Seconds to add to timestamps recorded with the local clock.
+
+        This corrects hosts whose clocks are known to be wrong. It applies to
+        history, system metrics, console output and the run's start time. If
+        `_clock_ntp_server` is set and reachable, the measured offset is used
+        instead.
+        """
+    @property
+    def _clock_ntp_server(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """NTP server to measure the local clock's offset against at the start
+        of the run, as a host name with an optional port.
+
+        Timestamps are corrected by the measured offset.
+        """
+    @property
+    def _clock_skew_warning_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Clock offset in seconds measured from `_clock_ntp_server` above which
+        a warning is shown. Defaults to 1.
+        """
+    @property
+    def _file_transfer_stall_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """How long a file upload or download attempt may make no progress before
+        it's retried. Unlike _file_transfer_timeout_seconds, this does not limit
+        the total transfer time. Defaults to 0, meaning no timeout.
+        """
+    @property
+    def _record_queue_size(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Capacity of each queue of records between the stages of a stream.
+
+        When a queue is full, logging blocks until there is room. Smaller queues
+        bound memory use when the network is slow, at the cost of slowing down
+        the user's program sooner. Zero or unset uses a default.
+        """
+    @property
+    def _disable_runtime(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to skip adding "_runtime" to history records.
+
+        For users who log their own relative time.
+        """
+    @property
+    def _file_stream_connections(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of concurrent connections to the filestream API.
+
+        Each of the run's history, console logs, system metrics and summary is
+        sent through one connection, so at most 4 connections are useful.
+        Defaults to 1.
+        """
+    @property
+    def _files_policy(self) -> global___ListStringValue:
+        """Upload policies for files that appear in `files_dir`.
+
+        Each entry is "<policy>:<glob>", where the policy is one of "now",
+        "end", "live" or "ignore" and the glob is relative to `files_dir`.
+        Globs match like Python's fnmatch, as in `wandb.save()`, so "*" also
+        matches "/". Files in subdirectories are included. If several globs
+        match a file, the last one wins. An entry with no glob sets the policy
+        for files that match nothing.
+        """
+    @property
+    def _files_min_stable_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """How long in seconds a file must go unmodified before `_files_policy`
+        uploads it, so that files still being written aren't uploaded
+        half-written. Unset or zero uploads files as soon as they change.
+        """
+    @property
+    def _internal_log_max_megabytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Size in megabytes at which the internal debug log is rotated.
+
+        Rotated logs are gzip-compressed. Zero or unset means the log is
+        never rotated.
+        """
+    @property
+    def _internal_log_max_files(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of rotated internal debug logs to keep. Defaults to 5."""
+    @property
+    def _code_snapshot(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to save the run's source code as a "code" artifact at the
+        start of the run.
+
+        By default, the program and the local Python modules it imports are
+        saved. See `_code_snapshot_git`.
+        """
+    @property
+    def _code_snapshot_git(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether the code snapshot includes all files in the git repository
+        that aren't ignored by .gitignore, instead of just the program.
+        """
+    @property
+    def _code_snapshot_include(self) -> global___ListStringValue:
+        """Globs relative to `root_dir` of files to include in the code snapshot.
+
+        If unset, all collected files are included.
+        """
+    @property
+    def _code_snapshot_exclude(self) -> global___ListStringValue:
+        """Globs relative to `root_dir` of files to exclude from the code
+        snapshot. These take precedence over `_code_snapshot_include`.
+        """
+    @property
+    def _code_snapshot_max_megabytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum total size of the code snapshot in megabytes.
+
+        Files that would exceed it are skipped. Defaults to 10. Zero means no
+        limit.
+        """
+    @property
+    def _max_value_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum size in bytes of a single history or summary value, as JSON.
+
+        Zero or unset means no limit. See `_oversized_value_action`.
+        """
+    @property
+    def _oversized_value_action(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """What to do with values larger than `_max_value_bytes`.
+
+        One of "reject" (the default) to drop the value with an error,
+        "truncate" to replace it by a string with its beginning, or "offload"
+        to save it to a file in `files_dir` and log a reference to the file.
+        """
+    @property
+    def _disable_transaction_log(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to skip writing the transaction log (the .wandb file).
+
+        Records are only sent to the backend. This saves disk I/O for
+        short-lived runs, but if the network fails mid-run, the data that
+        wasn't sent is lost and the run can't be synced later. Not allowed in
+        offline mode, which relies on the transaction log.
+        """
+    @property
+    def _network_pause_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Path of a file that pauses all network requests while it exists.
+
+        This is a kill switch for backend incidents: creating the file stops
+        new requests and retries without stopping the run, and deleting it
+        resumes them. Data is buffered in the meantime and records are still
+        written to the transaction log.
+
+        Finishing a run waits until requests are resumed, except on teardown,
+        such as when the process exits, which resumes them.
+        """
+    @property
+    def _credential_helper(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Command that prints backend and proxy credentials.
+
+        It is run with the argument "get" and must print a JSON object with
+        the optional fields "api_key", "proxy_username", "proxy_password" and
+        "expires_at" (RFC 3339). Credentials are cached and the command is run
+        again shortly before they expire or when the backend rejects them.
+        """
+    @property
+    def _graphql_persisted_queries(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to send GraphQL queries as automatic persisted queries (APQ).
+
+        Queries are identified by their SHA-256 hash, and the full text is only
+        sent if the backend doesn't know the hash yet.
+        """
+    @property
+    def _file_transfer_url_refresh_max(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """How many times an upload may get a new upload URL after the backend
+        rejects its URL as expired. Defaults to 3.
+        """
+    @property
+    def _health_probe(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to serve an HTTP health probe reporting when the run last
+        synced with the backend.
+        """
+    @property
+    def _health_probe_addr(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The address for the health probe. Defaults to "127.0.0.1:0", which
+        picks a free port so that runs in one process don't conflict; the
+        address that is used is printed when the run starts.
+        """
+    @property
+    def _summary_update_interval_ms(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The minimum time in milliseconds between summary updates sent to the
+        backend. Defaults to 30 seconds; a non-positive value sends every update.
+
+        The latest summary is always sent when the run finishes.
+        """
+    @property
+    def _artifact_digest_algorithm(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """An additional hash function for artifact files hashed by wandb-core:
+        "md5" (the default) or "sha256".
+
+        Manifest digests are always MD5s. Other digests are recorded in a
+        manifest entry extra named after the algorithm and are checked when
+        files are downloaded.
+        """
+    @property
+    def _file_transfer_concurrency(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The maximum number of file uploads and downloads in progress at once.
+        Defaults to 128.
+        """
+    @property
+    def _file_transfer_rate_limit(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """The maximum number of file transfer requests started per second,
+        including retries. Defaults to 0, meaning no limit.
+        """
+    @property
+    def _shared_step_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """A file through which processes on this machine that log to the same
+        run coordinate history steps, so that each row gets a distinct step.
+
+        If two processes log the same step, the first keeps it and the other's
+        row gets the next unused step.
+        """
+    @property
+    def _stats_pending_samples_max(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The maximum number of system metrics samples kept in memory while they
+        wait to be sent, such as during a network outage. Past this, the oldest
+        samples are dropped. Defaults to 10000.
+        """
+    @property
+    def _graphql_query_base_url(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The base URLs for GraphQL queries and for mutations, for deployments
+        that serve reads from a replica. Each defaults to base_url.
+
+        Requests to these URLs are authenticated like requests to base_url.
+        """
+    @property
+    def _graphql_mutation_base_url(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _network_warmup_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """How long after startup to wait for the backend to be reachable before
+        sending the first request, such as when DNS isn't ready yet in a new
+        container.
+
+        Failed connection attempts during this period are silent and don't
+        count toward retry limits or timeouts. Zero disables the warmup period.
+        """
+    @property
+    def _file_stream_flush_every_n_points(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Send filestream data after this many history points even if the batch
+        delay hasn't passed. Zero disables it.
+        """
+    @property
+    def _disable_auto_summary(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Don't update the summary from logged history.
+
+        The summary then only changes through explicit summary updates, and for
+        metrics defined with a summary mode, which still summarize their
+        history. Internal keys such as "_step" are always summarized.
+        """
+    @property
+    def _summary_aggregation_meta(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Include how each summary value was computed from history in the
+        uploaded summary file, under the "_summary_meta" key.
+
+        Means also record how many values they average, so that summaries of
+        several runs can be combined correctly.
+        """
+    @property
+    def _transaction_log_fsync_records(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Sync the transaction log to disk after this many records.
+
+        1 syncs after every record, so that a crash loses at most the record
+        being written. Each sync waits for the disk, which can make logging
+        much slower, especially on network file systems; larger values trade
+        durability for throughput. Zero leaves syncing to the OS, except when
+        the run is flushed or finished.
+        """
+    @property
+    def _transaction_log_compression(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Compress records in the transaction log: "none" (the default),
+        "deflate" or "zstd".
+
+        Older versions of wandb can't read compressed transaction logs.
+        Reading zstd-compressed logs without wandb-core requires the
+        `zstandard` package.
+        """
+    @property
+    def _transaction_log_history_encoding(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Encode history in the transaction log: "json" (the default) or
+        "arrow".
+
+        "arrow" stores consecutive history records whose values are all
+        numbers as Apache Arrow record batches, which are smaller and faster
+        to read. Up to 1024 records are buffered in memory between flushes.
+        Older versions of wandb can't read these logs, and reading them
+        without wandb-core requires the `pyarrow` package.
+        """
+    @property
+    def _settings_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """A YAML or JSON file of settings for wandb-core, as an object mapping
+        setting names to values.
+
+        wandb-core reads it when the run starts. Its values, and then
+        WANDB_* environment variables of the wandb-core process, only apply
+        to settings the client leaves unset.
+        """
+    @property
     def _proxies(self) -> global___MapStringKeyStringValue: ...
     def __init__(
         self,
@@ -667,6 +1172,7 @@ class Settings(google.protobuf.message.Message):
         api_key: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _offline: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         run_id: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        run_id_seed: google.protobuf.wrappers_pb2.StringValue | None = ...,
         run_url: google.protobuf.wrappers_pb2.StringValue | None = ...,
         project: google.protobuf.wrappers_pb2.StringValue | None = ...,
         entity: google.protobuf.wrappers_pb2.StringValue | None = ...,
@@ -825,9 +1331,70 @@ class Settings(google.protobuf.message.Message):
         _stats_buffer_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _shared: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _code_path_local: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _stats_disabled_assets: global___ListStringValue | None = ...,
+        max_run_duration: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _history_rate_limit: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _max_metrics: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _tail_log_files: global___ListStringValue | None = ...,
+        _metadata_env_vars: global___ListStringValue | None = ...,
+        _metadata_env: global___MapStringKeyStringValue | None = ...,
+        _post_finish_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _run_name_template: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _fault_injection: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _run_dir_fallback: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _user_agent_token: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _preflight: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _stats_compaction_tolerance: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stats_compaction_max_gap_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _history_jsonl: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _summary_env_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _summary_env_prefix: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _summary_env_preserve_case: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _summary_env_non_scalar: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _clock_offset_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _clock_ntp_server: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _clock_skew_warning_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_transfer_stall_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _record_queue_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _disable_runtime: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _file_stream_connections: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _files_policy: global___ListStringValue | None = ...,
+        _files_min_stable_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _internal_log_max_megabytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _internal_log_max_files: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _code_snapshot: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _code_snapshot_git: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _code_snapshot_include: global___ListStringValue | None = ...,
+        _code_snapshot_exclude: global___ListStringValue | None = ...,
+        _code_snapshot_max_megabytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _max_value_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _oversized_value_action: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _disable_transaction_log: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _network_pause_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _credential_helper: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _graphql_persisted_queries: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _file_transfer_url_refresh_max: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _health_probe: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _health_probe_addr: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _summary_update_interval_ms: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _artifact_digest_algorithm: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _file_transfer_concurrency: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_rate_limit: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _shared_step_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _stats_pending_samples_max: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _graphql_query_base_url: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _graphql_mutation_base_url: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _network_warmup_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_flush_every_n_points: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _disable_auto_summary: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _summary_aggregation_meta: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _transaction_log_fsync_records: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _transaction_log_compression: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _transaction_log_history_encoding: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _settings_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _proxies: global___MapStringKeyStringValue | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_artifact_digest_algorithm", b"_artifact_digest_algorithm", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_clock_ntp_server", b"_clock_ntp_server", "_clock_offset_seconds", b"_clock_offset_seconds", "_clock_skew_warning_seconds", b"_clock_skew_warning_seconds", "_code_path_local", b"_code_path_local", "_code_snapshot", b"_code_snapshot", "_code_snapshot_exclude", b"_code_snapshot_exclude", "_code_snapshot_git", b"_code_snapshot_git", "_code_snapshot_include", b"_code_snapshot_include", "_code_snapshot_max_megabytes", b"_code_snapshot_max_megabytes", "_colab", b"_colab", "_credential_helper", b"_credential_helper", "_cuda", b"_cuda", "_disable_auto_summary", b"_disable_auto_summary", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_runtime", b"_disable_runtime", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_transaction_log", b"_disable_transaction_log", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_fault_injection", b"_fault_injection", "_file_stream_connections", b"_file_stream_connections", "_file_stream_flush_every_n_points", b"_file_stream_flush_every_n_points", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_concurrency", b"_file_transfer_concurrency", "_file_transfer_rate_limit", b"_file_transfer_rate_limit", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_stall_timeout_seconds", b"_file_transfer_stall_timeout_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_file_transfer_url_refresh_max", b"_file_transfer_url_refresh_max", "_files_min_stable_seconds", b"_files_min_stable_seconds", "_files_policy", b"_files_policy", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_mutation_base_url", b"_graphql_mutation_base_url", "_graphql_persisted_queries", b"_graphql_persisted_queries", "_graphql_query_base_url", b"_graphql_query_base_url", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_health_probe", b"_health_probe", "_health_probe_addr", b"_health_probe_addr", "_history_jsonl", b"_history_jsonl", "_history_rate_limit", b"_history_rate_limit", "_internal_check_process", b"_internal_check_process", "_internal_log_max_files", b"_internal_log_max_files", "_internal_log_max_megabytes", b"_internal_log_max_megabytes", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_max_metrics", b"_max_metrics", "_max_value_bytes", b"_max_value_bytes", "_metadata_env", b"_metadata_env", "_metadata_env_vars", b"_metadata_env_vars", "_network_buffer", b"_network_buffer", "_network_pause_file", b"_network_pause_file", "_network_warmup_seconds", b"_network_warmup_seconds", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_oversized_value_action", b"_oversized_value_action", "_platform", b"_platform", "_post_finish_policy", b"_post_finish_policy", "_preflight", b"_preflight", "_proxies", b"_proxies", "_python", b"_python", "_record_queue_size", b"_record_queue_size", "_require_core", b"_require_core", "_run_dir_fallback", b"_run_dir_fallback", "_run_name_template", b"_run_name_template", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_settings_file", b"_settings_file", "_shared", b"_shared", "_shared_step_file", b"_shared_step_file", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_compaction_max_gap_seconds", b"_stats_compaction_max_gap_seconds", "_stats_compaction_tolerance", b"_stats_compaction_tolerance", "_stats_disabled_assets", b"_stats_disabled_assets", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pending_samples_max", b"_stats_pending_samples_max", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_summary_aggregation_meta", b"_summary_aggregation_meta", "_summary_env_file", b"_summary_env_file", "_summary_env_non_scalar", b"_summary_env_non_scalar", "_summary_env_prefix", b"_summary_env_prefix", "_summary_env_preserve_case", b"_summary_env_preserve_case", "_summary_update_interval_ms", b"_summary_update_interval_ms", "_sync", b"_sync", "_tail_log_files", b"_tail_log_files", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_transaction_log_compression", b"_transaction_log_compression", "_transaction_log_fsync_records", b"_transaction_log_fsync_records", "_transaction_log_history_encoding", b"_transaction_log_history_encoding", "_unsaved_keys", b"_unsaved_keys", "_user_agent_token", b"_user_agent_token", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "max_run_duration", b"max_run_duration", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_id_seed", b"run_id_seed", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_artifact_digest_algorithm", b"_artifact_digest_algorithm", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_clock_ntp_server", b"_clock_ntp_server", "_clock_offset_seconds", b"_clock_offset_seconds", "_clock_skew_warning_seconds", b"_clock_skew_warning_seconds", "_code_path_local", b"_code_path_local", "_code_snapshot", b"_code_snapshot", "_code_snapshot_exclude", b"_code_snapshot_exclude", "_code_snapshot_git", b"_code_snapshot_git", "_code_snapshot_include", b"_code_snapshot_include", "_code_snapshot_max_megabytes", b"_code_snapshot_max_megabytes", "_colab", b"_colab", "_credential_helper", b"_credential_helper", "_cuda", b"_cuda", "_disable_auto_summary", b"_disable_auto_summary", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_runtime", b"_disable_runtime", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_transaction_log", b"_disable_transaction_log", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_fault_injection", b"_fault_injection", "_file_stream_connections", b"_file_stream_connections", "_file_stream_flush_every_n_points", b"_file_stream_flush_every_n_points", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_concurrency", b"_file_transfer_concurrency", "_file_transfer_rate_limit", b"_file_transfer_rate_limit", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_stall_timeout_seconds", b"_file_transfer_stall_timeout_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_file_transfer_url_refresh_max", b"_file_transfer_url_refresh_max", "_files_min_stable_seconds", b"_files_min_stable_seconds", "_files_policy", b"_files_policy", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_mutation_base_url", b"_graphql_mutation_base_url", "_graphql_persisted_queries", b"_graphql_persisted_queries", "_graphql_query_base_url", b"_graphql_query_base_url", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_health_probe", b"_health_probe", "_health_probe_addr", b"_health_probe_addr", "_history_jsonl", b"_history_jsonl", "_history_rate_limit", b"_history_rate_limit", "_internal_check_process", b"_internal_check_process", "_internal_log_max_files", b"_internal_log_max_files", "_internal_log_max_megabytes", b"_internal_log_max_megabytes", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_max_metrics", b"_max_metrics", "_max_value_bytes", b"_max_value_bytes", "_metadata_env", b"_metadata_env", "_metadata_env_vars", b"_metadata_env_vars", "_network_buffer", b"_network_buffer", "_network_pause_file", b"_network_pause_file", "_network_warmup_seconds", b"_network_warmup_seconds", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_oversized_value_action", b"_oversized_value_action", "_platform", b"_platform", "_post_finish_policy", b"_post_finish_policy", "_preflight", b"_preflight", "_proxies", b"_proxies", "_python", b"_python", "_record_queue_size", b"_record_queue_size", "_require_core", b"_require_core", "_run_dir_fallback", b"_run_dir_fallback", "_run_name_template", b"_run_name_template", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_settings_file", b"_settings_file", "_shared", b"_shared", "_shared_step_file", b"_shared_step_file", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_compaction_max_gap_seconds", b"_stats_compaction_max_gap_seconds", "_stats_compaction_tolerance", b"_stats_compaction_tolerance", "_stats_disabled_assets", b"_stats_disabled_assets", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pending_samples_max", b"_stats_pending_samples_max", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_summary_aggregation_meta", b"_summary_aggregation_meta", "_summary_env_file", b"_summary_env_file", "_summary_env_non_scalar", b"_summary_env_non_scalar", "_summary_env_prefix", b"_summary_env_prefix", "_summary_env_preserve_case", b"_summary_env_preserve_case", "_summary_update_interval_ms", b"_summary_update_interval_ms", "_sync", b"_sync", "_tail_log_files", b"_tail_log_files", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_transaction_log_compression", b"_transaction_log_compression", "_transaction_log_fsync_records", b"_transaction_log_fsync_records", "_transaction_log_history_encoding", b"_transaction_log_history_encoding", "_unsaved_keys", b"_unsaved_keys", "_user_agent_token", b"_user_agent_token", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "max_run_duration", b"max_run_duration", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_id_seed", b"run_id_seed", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
 
 global___Settings = Settings
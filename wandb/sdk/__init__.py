@@ -10,6 +10,7 @@ __all__ = (
     "setup",
     "_attach",
     "_sync",
+    "_preflight",
     "login",
     "require",
     "finish",
@@ -27,6 +28,7 @@ from .wandb_alerts import AlertLevel
 from .wandb_config import Config
 from .wandb_init import _attach, init
 from .wandb_login import login
+from .wandb_preflight import _preflight
 from .wandb_require import require
 from .wandb_run import finish
 from .wandb_settings import Settings
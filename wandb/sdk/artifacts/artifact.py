@@ -1158,6 +1158,7 @@ class Artifact:
         is_tmp: Optional[bool] = False,
         skip_cache: Optional[bool] = False,
         policy: Optional[Literal["mutable", "immutable"]] = "mutable",
+        metadata: Optional[Dict[str, Any]] = None,
     ) -> ArtifactManifestEntry:
         """Add a local file to the artifact.
 
@@ -1171,6 +1172,10 @@ class Artifact:
             policy: "mutable" | "immutable". By default, "mutable"
                 "mutable": Create a temporary copy of the file to prevent corruption during upload.
                 "immutable": Disable protection, rely on the user not to delete or change the file.
+            metadata: Metadata to attach to the file, like its label or dataset split.
+                It is saved in the artifact's manifest and is available as the
+                entry's `metadata` after downloading. Keys must be non-empty
+                strings, and the metadata must be at most 4KB as JSON.
 
         Returns:
             The added manifest entry
@@ -1178,11 +1183,14 @@ class Artifact:
         Raises:
             ArtifactFinalizedError: You cannot make changes to the current artifact
             version because it is finalized. Log a new artifact version instead.
-            ValueError: Policy must be "mutable" or "immutable"
+            ValueError: Policy must be "mutable" or "immutable", or the metadata
+                is invalid
         """
         self._ensure_can_add()
         if not os.path.isfile(local_path):
             raise ValueError("Path is not a file: {}".format(local_path))
+        if metadata:
+            _validate_entry_metadata(metadata)
 
         name = LogicalPath(name or os.path.basename(local_path))
         digest = md5_file_b64(local_path)
@@ -1193,9 +1201,12 @@ class Artifact:
             file_name_parts[0] = b64_to_hex_id(digest)[:20]
             name = os.path.join(file_path, ".".join(file_name_parts))
 
-        return self._add_local_file(
+        entry = self._add_local_file(
             name, local_path, digest=digest, skip_cache=skip_cache, policy=policy
         )
+        if metadata:
+            entry.extra["metadata"] = dict(metadata)
+        return entry
 
     def add_dir(
         self,
@@ -2307,6 +2318,32 @@ class Artifact:
         return None
 
 
+# The largest allowed JSON encoding of a single manifest entry's metadata.
+#
+# This must match MaxEntryMetadataBytes in wandb-core.
+_MAX_ENTRY_METADATA_BYTES = 4096
+
+
+def _validate_entry_metadata(metadata: Dict[str, Any]) -> None:
+    """Raise a ValueError if the metadata can't be attached to a file."""
+    if not isinstance(metadata, dict):
+        raise ValueError("File metadata must be a dict.")
+    if any(not isinstance(key, str) or not key for key in metadata):
+        raise ValueError("File metadata keys must be non-empty strings.")
+
+    try:
+        encoded = json.dumps(metadata, separators=(",", ":"))
+    except (TypeError, ValueError) as e:
+        raise ValueError(f"File metadata must be JSON-serializable: {e}") from e
+
+    size = len(encoded.encode("utf-8"))
+    if size > _MAX_ENTRY_METADATA_BYTES:
+        raise ValueError(
+            f"File metadata is {size} bytes as JSON,"
+            f" more than the limit of {_MAX_ENTRY_METADATA_BYTES}."
+        )
+
+
 def is_require_core() -> bool:
     if env.is_require_core():
         return bool(get_core_path())
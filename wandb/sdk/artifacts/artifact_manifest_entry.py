@@ -101,6 +101,12 @@ class ArtifactManifestEntry:
         )
         return self.path
 
+    @property
+    def metadata(self) -> Optional[Dict]:
+        """Metadata attached to the file when it was added, if any."""
+        metadata = self.extra.get("metadata")
+        return metadata if isinstance(metadata, dict) else None
+
     def parent_artifact(self) -> "Artifact":
         """Get the artifact to which this artifact entry belongs.
 
@@ -124,6 +124,7 @@ class InterfaceBase:
         key: Optional[Union[Tuple[str, ...], str]] = None,
         val: Optional[Any] = None,
         obj: Optional[pb.ConfigRecord] = None,
+        file_backed: bool = False,
     ) -> pb.ConfigRecord:
         config = obj or pb.ConfigRecord()
         if data:
@@ -131,6 +132,7 @@ class InterfaceBase:
                 update = config.update.add()
                 update.key = k
                 update.value_json = json_dumps_safer(json_friendly(v)[0])
+                update.file_backed = file_backed
         if key:
             update = config.update.add()
             if isinstance(key, tuple):
@@ -139,6 +141,7 @@ class InterfaceBase:
             else:
                 update.key = key
             update.value_json = json_dumps_safer(json_friendly(val)[0])
+            update.file_backed = file_backed
         return config
 
     def _make_run(self, run: "Run") -> pb.RunRecord:
@@ -174,8 +177,11 @@ class InterfaceBase:
         data: Optional[dict] = None,
         key: Optional[Union[Tuple[str, ...], str]] = None,
         val: Optional[Any] = None,
+        merge: bool = False,
+        file_backed: bool = False,
     ) -> None:
-        cfg = self._make_config(data=data, key=key, val=val)
+        cfg = self._make_config(data=data, key=key, val=val, file_backed=file_backed)
+        cfg.merge = merge
 
         self._publish_config(cfg)
 
@@ -653,6 +659,14 @@ class InterfaceBase:
     def _publish_preempting(self, preempt_rec: pb.RunPreemptingRecord) -> None:
         raise NotImplementedError
 
+    def publish_notes(self, notes: str) -> None:
+        notes_rec = pb.RunNotesRecord(notes=notes)
+        self._publish_notes(notes_rec)
+
+    @abstractmethod
+    def _publish_notes(self, notes: pb.RunNotesRecord) -> None:
+        raise NotImplementedError
+
     def publish_output(self, name: str, data: str) -> None:
         # from vendor.protobuf import google3.protobuf.timestamp
         # ts = timestamp.Timestamp()
@@ -817,6 +831,7 @@ class InterfaceBase:
         project: Optional[str] = None,
         run_id: Optional[str] = None,
         skip_output_raw: Optional[bool] = None,
+        verify: Optional[bool] = None,
     ) -> MailboxHandle:
         sync = pb.SyncRequest(
             start_offset=start_offset,
@@ -830,6 +845,8 @@ class InterfaceBase:
             sync.overwrite.run_id = run_id
         if skip_output_raw:
             sync.skip.output_raw = skip_output_raw
+        if verify:
+            sync.verify = verify
         return self._deliver_sync(sync)
 
     @abstractmethod
@@ -905,6 +922,18 @@ class InterfaceBase:
     def _deliver_get_summary(self, get_summary: pb.GetSummaryRequest) -> MailboxHandle:
         raise NotImplementedError
 
+    def deliver_preflight(
+        self,
+        entity: Optional[str] = None,
+        project: Optional[str] = None,
+    ) -> MailboxHandle:
+        preflight = pb.PreflightRequest(entity=entity or "", project=project or "")
+        return self._deliver_preflight(preflight)
+
+    @abstractmethod
+    def _deliver_preflight(self, preflight: pb.PreflightRequest) -> MailboxHandle:
+        raise NotImplementedError
+
     def deliver_get_system_metrics(self) -> MailboxHandle:
         get_summary = pb.GetSystemMetricsRequest()
         return self._deliver_get_system_metrics(get_summary)
@@ -96,6 +96,10 @@ class InterfaceShared(InterfaceBase):
         rec = self._make_record(preempting=preempt_rec)
         self._publish(rec)
 
+    def _publish_notes(self, notes: pb.RunNotesRecord) -> None:
+        rec = self._make_record(notes=notes)
+        self._publish(rec)
+
     def _publish_telemetry(self, telem: tpb.TelemetryRecord) -> None:
         rec = self._make_record(telemetry=telem)
         self._publish(rec)
@@ -152,6 +156,7 @@ class InterfaceShared(InterfaceBase):
         get_system_metrics: Optional[pb.GetSystemMetricsRequest] = None,
         python_packages: Optional[pb.PythonPackagesRequest] = None,
         job_input: Optional[pb.JobInputRequest] = None,
+        preflight: Optional[pb.PreflightRequest] = None,
     ) -> pb.Record:
         request = pb.Request()
         if login:
@@ -214,6 +219,8 @@ class InterfaceShared(InterfaceBase):
             request.python_packages.CopyFrom(python_packages)
         elif job_input:
             request.job_input.CopyFrom(job_input)
+        elif preflight:
+            request.preflight.CopyFrom(preflight)
         else:
             raise Exception("Invalid request")
         record = self._make_record(request=request)
@@ -242,6 +249,7 @@ class InterfaceShared(InterfaceBase):
         request: Optional[pb.Request] = None,
         telemetry: Optional[tpb.TelemetryRecord] = None,
         preempting: Optional[pb.RunPreemptingRecord] = None,
+        notes: Optional[pb.RunNotesRecord] = None,
         link_artifact: Optional[pb.LinkArtifactRecord] = None,
         use_artifact: Optional[pb.UseArtifactRecord] = None,
         output: Optional[pb.OutputRecord] = None,
@@ -282,6 +290,8 @@ class InterfaceShared(InterfaceBase):
             record.metric.CopyFrom(metric)
         elif preempting:
             record.preempting.CopyFrom(preempting)
+        elif notes:
+            record.notes.CopyFrom(notes)
         elif link_artifact:
             record.link_artifact.CopyFrom(link_artifact)
         elif use_artifact:
@@ -469,6 +479,10 @@ class InterfaceShared(InterfaceBase):
         record = self._make_request(get_summary=get_summary)
         return self._deliver_record(record)
 
+    def _deliver_preflight(self, preflight: pb.PreflightRequest) -> MailboxHandle:
+        record = self._make_request(preflight=preflight)
+        return self._deliver_record(record)
+
     def _deliver_get_system_metrics(
         self, get_system_metrics: pb.GetSystemMetricsRequest
     ) -> MailboxHandle:
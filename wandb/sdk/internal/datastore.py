@@ -14,15 +14,29 @@ header :=
   ident: char[4]
   magic: uint16
   version: uint8
+
+In version 1, written by wandb-core when transaction log compression is
+enabled, the data of each record starts with a codec ID byte:
+0 (uncompressed), 1 (raw DEFLATE) or 2 (Zstandard).
+
+If the high bit of the codec ID is set, the record holds a batch of history
+records written with the "arrow" history encoding:
+
+batch :=
+  template_length: uvarint
+  template: Record        // without num, step number and history items
+  columns: Arrow IPC stream  // record num, step num if any, then items
 """
 
 # TODO: possibly restructure code by porting the C++ or go implementation
 
+import collections
+import json
 import logging
 import os
 import struct
 import zlib
-from typing import TYPE_CHECKING, Optional, Tuple
+from typing import TYPE_CHECKING, Deque, List, Optional, Tuple
 
 import wandb
 
@@ -47,6 +61,13 @@ LEVELDBLOG_HEADER_MAGIC = (
     0xBEE1  # zlib.crc32(bytes("Weights & Biases", 'iso8859-1')) & 0xffff
 )
 LEVELDBLOG_HEADER_VERSION = 0
+LEVELDBLOG_HEADER_VERSION_COMPRESSED = 1
+
+LEVELDBLOG_CODEC_NONE = 0
+LEVELDBLOG_CODEC_DEFLATE = 1
+LEVELDBLOG_CODEC_ZSTD = 2
+
+LEVELDBLOG_HISTORY_BATCH = 0x80
 
 try:
     bytes("", "ascii")
@@ -73,6 +94,8 @@ class DataStore:
         self._index = 0
         self._flush_offset = 0
         self._size_bytes = 0
+        self._compressed = False
+        self._pending: Deque[bytes] = collections.deque()
 
         self._crc = [0] * (LEVELDBLOG_LAST + 1)
         for x in range(1, LEVELDBLOG_LAST + 1):
@@ -142,6 +165,23 @@ class DataStore:
         return dtype, data
 
     def scan_data(self):
+        if self._pending:
+            return self._pending.popleft()
+
+        data = self._scan_raw_data()
+        if data is None or not self._compressed:
+            return data
+
+        is_history_batch = data and data[0] & LEVELDBLOG_HISTORY_BATCH
+        data = _decode_record(data)
+        if not is_history_batch:
+            return data
+
+        self._pending.extend(_decode_history_batch(data))
+        assert self._pending, "history batch is empty"
+        return self._pending.popleft()
+
+    def _scan_raw_data(self):
         # TODO(jhr): handle some assertions as file corruption issues
         # how much left in the block.  if less than header len, read as pad,
         offset = self._index % LEVELDBLOG_BLOCK_LEN
@@ -203,8 +243,12 @@ class DataStore:
             raise Exception("Invalid header")
         if magic != LEVELDBLOG_HEADER_MAGIC:
             raise Exception("Invalid header")
-        if version != LEVELDBLOG_HEADER_VERSION:
+        if version not in (
+            LEVELDBLOG_HEADER_VERSION,
+            LEVELDBLOG_HEADER_VERSION_COMPRESSED,
+        ):
             raise Exception("Invalid header")
+        self._compressed = version == LEVELDBLOG_HEADER_VERSION_COMPRESSED
         self._index += len(header)
 
     def _write_record(self, s, dtype=None):
@@ -295,3 +339,68 @@ class DataStore:
         if self._fp is not None:
             logger.info("close: %s", self._fname)
             self._fp.close()
+
+
+def _decode_record(data: bytes) -> bytes:
+    """Decompress the data of a record in a compressed transaction log."""
+    assert data, "compressed record is empty"
+
+    codec, payload = data[0] & ~LEVELDBLOG_HISTORY_BATCH, data[1:]
+    if codec == LEVELDBLOG_CODEC_NONE:
+        return payload
+    if codec == LEVELDBLOG_CODEC_DEFLATE:
+        return zlib.decompress(payload, wbits=-zlib.MAX_WBITS)
+    if codec == LEVELDBLOG_CODEC_ZSTD:
+        try:
+            import zstandard
+        except ImportError:
+            raise Exception(
+                "Reading a zstd-compressed transaction log requires the"
+                " `zstandard` package; install it with `pip install zstandard`."
+            ) from None
+        return zstandard.ZstdDecompressor().decompressobj().decompress(payload)
+    raise Exception(f"Unknown record codec ID {codec}")
+
+
+def _decode_history_batch(data: bytes) -> List[bytes]:
+    """Return the serialized records of an Arrow-encoded history batch."""
+    try:
+        import pyarrow.ipc
+    except ImportError:
+        raise Exception(
+            "Reading a transaction log with Arrow-encoded history requires"
+            " the `pyarrow` package; install it with `pip install pyarrow`."
+        ) from None
+    from wandb.proto import wandb_internal_pb2
+
+    template_length, pos = 0, 0
+    for shift in range(0, 64, 7):
+        byte = data[pos]
+        pos += 1
+        template_length |= (byte & 0x7F) << shift
+        if not byte & 0x80:
+            break
+
+    template = wandb_internal_pb2.Record()
+    template.ParseFromString(data[pos : pos + template_length])
+    table = pyarrow.ipc.open_stream(data[pos + template_length :]).read_all()
+
+    columns = [column.to_pylist() for column in table.columns]
+    has_step = template.history.HasField("step")
+    first_item = 2 if has_step else 1
+    keys = table.schema.names[first_item:]
+
+    records = []
+    for row in range(table.num_rows):
+        record = wandb_internal_pb2.Record()
+        record.CopyFrom(template)
+        record.num = columns[0][row]
+        if has_step:
+            record.history.step.num = columns[1][row]
+        for key, column in zip(keys, columns[first_item:]):
+            item = record.history.item.add()
+            item.key = key
+            # json.dumps formats floats like wandb-core does.
+            item.value_json = json.dumps(column[row])
+        records.append(record.SerializeToString())
+    return records
@@ -2,7 +2,7 @@ import json
 from typing import Any, Dict, NewType, Optional, Sequence
 
 from wandb.proto import wandb_internal_pb2
-from wandb.sdk.lib import proto_util, telemetry
+from wandb.sdk.lib import config_util, proto_util, telemetry
 
 BackendConfigDict = NewType("BackendConfigDict", Dict[str, Any])
 """Run config dictionary in the format used by the backend."""
@@ -37,6 +37,7 @@ class ConfigState:
             self._update_at_path(
                 _key_path(config_item),
                 json.loads(config_item.value_json),
+                merge=config_record.merge,
             )
 
         for config_item in config_record.remove:
@@ -146,12 +147,21 @@ class ConfigState:
         self,
         key_path: Sequence[str],
         value: Any,
+        merge: bool = False,
     ) -> None:
-        """Sets the value at the path in the config tree."""
+        """Sets the value at the path in the config tree.
+
+        If `merge` is set, a dict value is merged into an existing dict
+        at the path instead of replacing it.
+        """
         subtree = _subtree(self._tree, key_path[:-1], create=True)
         assert subtree is not None
 
-        subtree[key_path[-1]] = value
+        existing = subtree.get(key_path[-1])
+        if merge and isinstance(existing, dict) and isinstance(value, dict):
+            config_util.merge_dicts(existing, value)
+        else:
+            subtree[key_path[-1]] = value
 
     def _delete_at_path(
         self,
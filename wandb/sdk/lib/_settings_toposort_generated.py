@@ -12,35 +12,66 @@ else:
 
 _Setting = Literal[
     "_args",
+    "_artifact_digest_algorithm",
     "_aws_lambda",
     "_cli_only_mode",
+    "_clock_ntp_server",
+    "_clock_offset_seconds",
+    "_clock_skew_warning_seconds",
     "_code_path_local",
+    "_code_snapshot",
+    "_code_snapshot_exclude",
+    "_code_snapshot_git",
+    "_code_snapshot_include",
+    "_code_snapshot_max_megabytes",
     "_colab",
+    "_credential_helper",
     "_cuda",
+    "_disable_auto_summary",
     "_disable_meta",
+    "_disable_runtime",
     "_disable_service",
     "_disable_setproctitle",
     "_disable_stats",
+    "_disable_transaction_log",
     "_disable_update_check",
     "_disable_viewer",
     "_disable_machine_info",
     "_executable",
     "_extra_http_headers",
+    "_fault_injection",
+    "_file_stream_connections",
+    "_file_stream_flush_every_n_points",
     "_file_stream_retry_max",
     "_file_stream_retry_wait_min_seconds",
     "_file_stream_retry_wait_max_seconds",
     "_file_stream_timeout_seconds",
+    "_file_transfer_concurrency",
+    "_file_transfer_rate_limit",
     "_file_transfer_retry_max",
     "_file_transfer_retry_wait_min_seconds",
     "_file_transfer_retry_wait_max_seconds",
     "_file_transfer_timeout_seconds",
+    "_file_transfer_stall_timeout_seconds",
+    "_file_transfer_url_refresh_max",
+    "_files_min_stable_seconds",
+    "_files_policy",
     "_flow_control_custom",
     "_flow_control_disabled",
+    "_graphql_mutation_base_url",
+    "_graphql_persisted_queries",
+    "_graphql_query_base_url",
     "_graphql_retry_max",
     "_graphql_retry_wait_min_seconds",
     "_graphql_retry_wait_max_seconds",
     "_graphql_timeout_seconds",
+    "_health_probe",
+    "_health_probe_addr",
+    "_history_jsonl",
+    "_history_rate_limit",
     "_internal_check_process",
+    "_internal_log_max_files",
+    "_internal_log_max_megabytes",
     "_internal_queue_timeout",
     "_ipython",
     "_jupyter",
@@ -51,21 +82,35 @@ _Setting = Literal[
     "_live_policy_rate_limit",
     "_live_policy_wait_time",
     "_log_level",
+    "_max_metrics",
+    "_max_value_bytes",
+    "_metadata_env",
+    "_metadata_env_vars",
     "_network_buffer",
+    "_network_pause_file",
+    "_network_warmup_seconds",
     "_noop",
     "_notebook",
     "_offline",
     "_sync",
     "_os",
+    "_oversized_value_action",
     "_platform",
+    "_post_finish_policy",
+    "_preflight",
     "_proxies",
     "_python",
+    "_record_queue_size",
     "_runqueue_item_id",
     "_require_core",
+    "_run_dir_fallback",
+    "_run_name_template",
     "_save_requirements",
     "_service_transport",
     "_service_wait",
+    "_settings_file",
     "_shared",
+    "_shared_step_file",
     "_start_datetime",
     "_start_time",
     "_stats_pid",
@@ -77,9 +122,24 @@ _Setting = Literal[
     "_stats_open_metrics_filters",
     "_stats_disk_paths",
     "_stats_buffer_size",
+    "_stats_compaction_max_gap_seconds",
+    "_stats_compaction_tolerance",
+    "_stats_disabled_assets",
+    "_stats_pending_samples_max",
+    "_summary_aggregation_meta",
+    "_summary_env_file",
+    "_summary_env_non_scalar",
+    "_summary_env_prefix",
+    "_summary_env_preserve_case",
+    "_summary_update_interval_ms",
+    "_tail_log_files",
     "_tmp_code_dir",
     "_tracelog",
+    "_transaction_log_compression",
+    "_transaction_log_fsync_records",
+    "_transaction_log_history_encoding",
     "_unsaved_keys",
+    "_user_agent_token",
     "_windows",
     "allow_val_change",
     "anonymous",
@@ -122,6 +182,7 @@ _Setting = Literal[
     "log_symlink_user",
     "log_user",
     "login_timeout",
+    "max_run_duration",
     "mode",
     "notebook_name",
     "program",
@@ -138,6 +199,7 @@ _Setting = Literal[
     "root_dir",
     "run_group",
     "run_id",
+    "run_id_seed",
     "run_job_type",
     "run_mode",
     "run_name",
@@ -201,6 +263,8 @@ SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
     "_ipython",
     "_jupyter",
     "_kaggle",
+    "_metadata_env_vars",
+    "_metadata_env",
     "_noop",
     "_notebook",
     "disabled",
@@ -210,6 +274,7 @@ SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
     "run_mode",
     "_start_datetime",
     "timespec",
+    "_run_dir_fallback",
     "root_dir",
     "wandb_dir",
     "tmp_dir",
@@ -1,5 +1,6 @@
 """runid util."""
 
+import hashlib
 import secrets
 import string
 
@@ -10,3 +11,18 @@ def generate_id(length: int = 8) -> str:
     # we'll have a ~1% chance of collision.
     alphabet = string.ascii_lowercase + string.digits
     return "".join(secrets.choice(alphabet) for _ in range(length))
+
+
+def generate_seeded_id(seed: str, length: int = 8) -> str:
+    """Derive a base-36 string of `length` digits from a seed.
+
+    The same seed always produces the same ID. The Go client derives IDs the
+    same way, so both map a seed to the same run.
+    """
+    alphabet = string.ascii_lowercase + "1234567890"
+    chars = []
+    digest = hashlib.sha256(seed.encode()).digest()
+    while len(chars) < length:
+        chars.extend(alphabet[x % len(alphabet)] for x in digest)
+        digest = hashlib.sha256(digest).digest()
+    return "".join(chars[:length])
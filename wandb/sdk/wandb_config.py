@@ -1,5 +1,6 @@
 """config."""
 
+import copy
 import logging
 from typing import Optional
 
@@ -180,10 +181,44 @@ class Config:
         self._items.update(sanitized)
         return sanitized
 
-    def update(self, d, allow_val_change=None):
+    def update(self, d, allow_val_change=None, file_backed=False):
+        """Update the config with the values in `d`.
+
+        If `file_backed` is set, each value is uploaded once as a run file
+        and the run's config stores a reference to the file instead of the
+        value. This keeps large values, like dataset schemas, out of config
+        updates. It requires wandb-core; otherwise values are stored inline.
+        """
         sanitized = self._update(d, allow_val_change)
         if self._callback:
-            self._callback(data=sanitized)
+            if file_backed:
+                self._callback(data=sanitized, file_backed=True)
+            else:
+                self._callback(data=sanitized)
+
+    def merge(self, d, allow_val_change=None):
+        """Recursively merge `d` into the config.
+
+        Unlike `update`, nested dicts in `d` keep the keys of existing
+        nested dicts that they don't set.
+        """
+        parsed_dict = wandb_helper.parse_config(d)
+        self._raise_value_error_on_nested_artifact(parsed_dict)
+        sanitized = {}
+        for key, val in parsed_dict.items():
+            if self._check_locked(key):
+                continue
+            key = key.strip("-")
+            existing = self._items.get(key)
+            if isinstance(existing, dict) and isinstance(val, dict):
+                merged = config_util.merge_dicts(copy.deepcopy(existing), val)
+            else:
+                merged = val
+            key, merged = self._sanitize(key, merged, allow_val_change)
+            self._items[key] = merged
+            sanitized[key] = val
+        if self._callback:
+            self._callback(data=sanitized, merge=True)
 
     def get(self, *args):
         return self._items.get(*args)
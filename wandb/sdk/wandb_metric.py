@@ -19,6 +19,8 @@ class Metric:
     _summary: Optional[Sequence[str]]
     _goal: Optional[str]
     _overwrite: Optional[bool]
+    _array_summary: Optional[str]
+    _histogram_bins: Optional[int]
 
     def __init__(
         self,
@@ -29,6 +31,8 @@ class Metric:
         summary: Optional[Sequence[str]] = None,
         goal: Optional[str] = None,
         overwrite: Optional[bool] = None,
+        array_summary: Optional[str] = None,
+        histogram_bins: Optional[int] = None,
     ) -> None:
         self._callback = None
         self._name = name
@@ -40,6 +44,8 @@ class Metric:
         self._summary = summary
         self._goal = goal
         self._overwrite = overwrite
+        self._array_summary = array_summary
+        self._histogram_bins = histogram_bins
 
     def _set_callback(self, cb: Callable[[pb.MetricRecord], None]) -> None:
         self._callback = cb
@@ -71,6 +77,14 @@ class Metric:
         goal_dict = dict(min="minimize", max="maximize")
         return goal_dict[self._goal] if self._goal else None
 
+    @property
+    def array_summary(self) -> Optional[str]:
+        return self._array_summary
+
+    @property
+    def histogram_bins(self) -> Optional[int]:
+        return self._histogram_bins
+
     def _commit(self) -> None:
         m = pb.MetricRecord()
         m.options.defined = True
@@ -100,6 +114,12 @@ class Metric:
                 m.summary.none = True
             if "best" in summary_set:
                 m.summary.best = True
+        if self._array_summary == "ends":
+            m.summary.array = pb.MetricSummary.ARRAY_ENDS
+        if self._array_summary == "full":
+            m.summary.array = pb.MetricSummary.ARRAY_FULL
+        if self._histogram_bins:
+            m.summary.histogram_bins = self._histogram_bins
         if self._goal == "min":
             m.goal = m.GOAL_MINIMIZE
         if self._goal == "max":
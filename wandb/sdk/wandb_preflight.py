@@ -0,0 +1,78 @@
+import os
+import tempfile
+from typing import TYPE_CHECKING, Optional
+
+from wandb.errors import UsageError
+
+from . import wandb_setup
+from .backend.backend import Backend
+from .lib.mailbox import Mailbox
+from .lib.runid import generate_id
+
+if TYPE_CHECKING:
+    from wandb.proto import wandb_internal_pb2
+
+# How long to wait for the checks, in seconds. The service gives up
+# retrying well before this.
+_PREFLIGHT_TIMEOUT = 60
+
+
+def _preflight(
+    entity: Optional[str] = None,
+    project: Optional[str] = None,
+) -> "wandb_internal_pb2.PreflightResponse":
+    """Check that runs can be logged to the project, without starting one.
+
+    Verifies that the backend is reachable, that it accepts the credentials,
+    and that the entity and project are writable. The entity and project
+    default to the ones in the settings.
+
+    Requires wandb-core.
+
+    Returns:
+        The response, whose `error` says why runs can't be logged, if
+        they can't, and whose `failed_check` names the check that failed.
+    """
+    wl = wandb_setup.setup()
+    assert wl is not None
+
+    if not wl.settings._require_core:
+        raise UsageError(
+            "Preflight checks require wandb-core;"
+            ' call `wandb.require("core")` first.'
+        )
+
+    stream_id = generate_id()
+    manager = wl._get_manager()
+    assert manager
+
+    with tempfile.TemporaryDirectory() as tmp_dir:
+        # The checks don't create a run, so nothing is logged or saved.
+        settings = wl.settings.to_proto()
+        settings.run_id.value = stream_id
+        settings.sync_dir.value = tmp_dir
+        settings.files_dir.value = tmp_dir
+        settings.log_dir.value = tmp_dir
+        settings.log_internal.value = os.path.join(tmp_dir, "debug-internal.log")
+        settings._disable_transaction_log.value = True
+        manager._inform_init(settings=settings, run_id=stream_id)
+
+        try:
+            mailbox = Mailbox()
+            backend = Backend(settings=wl.settings, manager=manager, mailbox=mailbox)
+            backend.ensure_launched()
+
+            assert backend.interface
+            backend.interface._stream_id = stream_id  # type: ignore
+
+            handle = backend.interface.deliver_preflight(
+                entity=entity,
+                project=project,
+            )
+            result = handle.wait(timeout=_PREFLIGHT_TIMEOUT)
+        finally:
+            manager._inform_finish(run_id=stream_id)
+
+    if not result:
+        raise TimeoutError("Timed out waiting for preflight checks.")
+    return result.response.preflight_response
@@ -983,7 +983,12 @@ class Run:
     def notes(self, notes: str) -> None:
         self._notes = notes
         if self._backend and self._backend.interface:
-            self._backend.interface.publish_run(self)
+            # wandb-core updates only the notes, and enforces the backend's
+            # length limit.
+            if self._settings._require_core:
+                self._backend.interface.publish_notes(notes)
+            else:
+                self._backend.interface.publish_run(self)
 
     @property
     @_run_decorator._attach
@@ -1372,10 +1377,14 @@ class Run:
         key: Optional[Union[Tuple[str, ...], str]] = None,
         val: Optional[Any] = None,
         data: Optional[Dict[str, object]] = None,
+        merge: bool = False,
+        file_backed: bool = False,
     ) -> None:
         logger.info(f"config_cb {key} {val} {data}")
         if self._backend and self._backend.interface:
-            self._backend.interface.publish_config(key=key, val=val, data=data)
+            self._backend.interface.publish_config(
+                key=key, val=val, data=data, merge=merge, file_backed=file_backed
+            )
 
     def _config_artifact_callback(
         self, key: str, val: Union[str, Artifact, dict]
@@ -2679,6 +2688,8 @@ class Run:
         summary: Optional[str] = None,
         goal: Optional[str] = None,
         overwrite: Optional[bool] = None,
+        array_summary: Optional[str] = None,
+        histogram_bins: Optional[int] = None,
         **kwargs: Any,
     ) -> wandb_metric.Metric:
         """Define metric properties which will later be logged with `wandb.log()`.
@@ -2695,13 +2706,30 @@ class Run:
                 Aggregation `best` defaults to `goal`==`minimize`
             goal: Specify direction for optimizing the metric.
                 Supported directions: "minimize,maximize"
+            array_summary: Specify how numeric array values are summarized.
+                "stats" (the default) keeps the length, min, max and mean,
+                "ends" keeps the length and first and last elements, and
+                "full" keeps the whole array. Other arrays are kept whole.
+            histogram_bins: Add a histogram of all logged values with this
+                many bins to the summary when the run finishes, under the
+                metric's key as "histogram". The last value is then under
+                the metric's key as "last". Can't be used with "best".
 
         Returns:
             A metric object is returned that can be further specified.
 
         """
         return self._define_metric(
-            name, step_metric, step_sync, hidden, summary, goal, overwrite, **kwargs
+            name,
+            step_metric,
+            step_sync,
+            hidden,
+            summary,
+            goal,
+            overwrite,
+            array_summary,
+            histogram_bins,
+            **kwargs,
         )
 
     def _define_metric(
@@ -2713,6 +2741,8 @@ class Run:
         summary: Optional[str] = None,
         goal: Optional[str] = None,
         overwrite: Optional[bool] = None,
+        array_summary: Optional[str] = None,
+        histogram_bins: Optional[int] = None,
         **kwargs: Any,
     ) -> wandb_metric.Metric:
         if not name:
@@ -2729,6 +2759,8 @@ class Run:
             ("summary", summary, str),
             ("goal", goal, str),
             ("overwrite", overwrite, bool),
+            ("array_summary", array_summary, str),
+            ("histogram_bins", histogram_bins, int),
         ):
             # NOTE: type checking is broken for isinstance and str
             if arg_val is not None and not isinstance(arg_val, exp_type):
@@ -2760,6 +2792,24 @@ class Run:
                 raise wandb.Error(f"Unhandled define_metric() arg: goal: {goal}")
             with telemetry.context(run=self) as tel:
                 tel.feature.metric_goal = True
+        if array_summary is not None and array_summary not in {
+            "stats",
+            "ends",
+            "full",
+        }:
+            raise wandb.Error(
+                f"Unhandled define_metric() arg: array_summary: {array_summary}"
+            )
+        if histogram_bins is not None:
+            if histogram_bins <= 0:
+                raise wandb.Error(
+                    f"Unhandled define_metric() arg: histogram_bins: {histogram_bins}"
+                )
+            if summary_ops and "best" in summary_ops:
+                raise wandb.Error(
+                    "define_metric() arg histogram_bins can't be used with"
+                    " summary op: best"
+                )
         if hidden:
             with telemetry.context(run=self) as tel:
                 tel.feature.metric_hidden = True
@@ -2775,6 +2825,8 @@ class Run:
             hidden=hidden,
             goal=goal_cleaned,
             overwrite=overwrite,
+            array_summary=array_summary,
+            histogram_bins=histogram_bins,
         )
         m._set_callback(self._metric_callback)
         m._commit()
@@ -1,6 +1,7 @@
 import collections.abc
 import configparser
 import enum
+import fnmatch
 import getpass
 import json
 import logging
@@ -51,7 +52,7 @@ from wandb.sdk.wandb_setup import _EarlyLogger
 from .lib import apikey
 from .lib.gitlib import GitRepo
 from .lib.ipython import _get_python_type
-from .lib.runid import generate_id
+from .lib.runid import generate_id, generate_seeded_id
 
 if sys.version_info >= (3, 8):
     from typing import get_args, get_origin, get_type_hints
@@ -71,11 +72,14 @@ class SettingsUnexpectedArgsError(UsageError):
     """Raised when unexpected arguments are passed to wandb.Settings()."""
 
 
-def _get_wandb_dir(root_dir: str) -> str:
+def _get_wandb_dir(root_dir: str, fallback: Optional[str] = None) -> str:
     """Get the full path to the wandb directory.
 
     The setting exposed to users as `dir=` or `WANDB_DIR` is the `root_dir`.
     We add the `__stage_dir__` to it to get the full `wandb_dir`
+
+    If the `wandb_dir` isn't writable and a `fallback` is given, the
+    `wandb_dir` is put there instead, along with the run directories in it.
     """
     # We use the hidden version if it already exists, otherwise non-hidden.
     if os.path.exists(os.path.join(root_dir, ".wandb")):
@@ -84,6 +88,16 @@ def _get_wandb_dir(root_dir: str) -> str:
         __stage_dir__ = "wandb" + os.sep
 
     path = os.path.join(root_dir, __stage_dir__)
+    writable = os.access(root_dir or ".", os.W_OK) and (
+        not os.path.exists(path) or os.access(path, os.W_OK)
+    )
+    if not writable and fallback:
+        fallback_path = os.path.join(fallback, __stage_dir__)
+        wandb.termwarn(
+            f"Path {path} wasn't writable, using {fallback_path} instead.",
+            repeat=False,
+        )
+        return os.path.expanduser(fallback_path)
     if not os.access(root_dir or ".", os.W_OK):
         wandb.termwarn(
             f"Path {path} wasn't writable, using system temp directory.",
@@ -146,6 +160,22 @@ def _redact_dict(
     return safe_dict
 
 
+def _get_metadata_env(
+    patterns: Optional[Sequence[str]],
+) -> Optional[Dict[str, str]]:
+    """Return the environment variables matching any of the glob patterns.
+
+    Returns None if there are no patterns, so that nothing is recorded.
+    """
+    if not patterns:
+        return None
+    return {
+        name: value
+        for name, value in os.environ.items()
+        if any(fnmatch.fnmatchcase(name, pattern) for pattern in patterns)
+    }
+
+
 def _get_program() -> Optional[str]:
     program = os.getenv(wandb.env.PROGRAM)
     if program is not None:
@@ -297,41 +327,72 @@ class SettingsData:
     """Settings for the W&B SDK."""
 
     _args: Sequence[str]
+    _artifact_digest_algorithm: str  # extra hash for artifact manifest entries
     _aws_lambda: bool
     _cli_only_mode: bool  # Avoid running any code specific for runs
+    _clock_ntp_server: str  # NTP server for measuring the clock offset
+    _clock_offset_seconds: float  # correction added to local timestamps
+    _clock_skew_warning_seconds: float
     _code_path_local: str
+    _code_snapshot: bool  # save the run's code as an artifact
+    _code_snapshot_exclude: Sequence[str]
+    _code_snapshot_git: bool
+    _code_snapshot_include: Sequence[str]
+    _code_snapshot_max_megabytes: int
     _colab: bool
+    _credential_helper: str  # command that prints proxy credentials
     # _config_dict: Config
     _cuda: str
+    _disable_auto_summary: bool  # do not update the summary from history
     _disable_meta: bool  # Do not collect system metadata
+    _disable_runtime: bool  # do not add _runtime to history
     _disable_service: (
         bool  # Disable wandb-service, spin up internal process the old way
     )
     _disable_setproctitle: bool  # Do not use setproctitle on internal process
     _disable_stats: bool  # Do not collect system metrics
+    _disable_transaction_log: bool  # do not write the .wandb file
     _disable_update_check: bool  # Disable version check
     _disable_viewer: bool  # Prevent early viewer query
     _disable_machine_info: bool  # Disable automatic machine info collection
     _executable: str
     _extra_http_headers: Mapping[str, str]
+    _fault_injection: str  # synthetic network faults, for debugging
+    _file_stream_connections: int
+    _file_stream_flush_every_n_points: int
     # file stream retry client configuration
     _file_stream_retry_max: int  # max number of retries
     _file_stream_retry_wait_min_seconds: float  # min wait time between retries
     _file_stream_retry_wait_max_seconds: float  # max wait time between retries
     _file_stream_timeout_seconds: float  # timeout for individual HTTP requests
+    _file_transfer_concurrency: int
+    _file_transfer_rate_limit: float
     # file transfer retry client configuration
     _file_transfer_retry_max: int
     _file_transfer_retry_wait_min_seconds: float
     _file_transfer_retry_wait_max_seconds: float
     _file_transfer_timeout_seconds: float
+    _file_transfer_stall_timeout_seconds: float
+    _file_transfer_url_refresh_max: int
+    _files_min_stable_seconds: float  # wait for files to stop changing
+    _files_policy: Sequence[str]  # upload policies as <policy>:<glob>
     _flow_control_custom: bool
     _flow_control_disabled: bool
+    _graphql_mutation_base_url: str
+    _graphql_persisted_queries: bool
+    _graphql_query_base_url: str
     # graphql retry client configuration
     _graphql_retry_max: int
     _graphql_retry_wait_min_seconds: float
     _graphql_retry_wait_max_seconds: float
     _graphql_timeout_seconds: float
+    _health_probe: bool
+    _health_probe_addr: str
+    _history_jsonl: bool  # mirror history to history.jsonl
+    _history_rate_limit: float  # max points per second per metric
     _internal_check_process: float
+    _internal_log_max_files: int
+    _internal_log_max_megabytes: int
     _internal_queue_timeout: float
     _ipython: bool
     _jupyter: bool
@@ -342,21 +403,35 @@ class SettingsData:
     _live_policy_rate_limit: int
     _live_policy_wait_time: int
     _log_level: int
+    _max_metrics: int  # max number of distinct metrics
+    _max_value_bytes: int
+    _metadata_env: Mapping[str, str]  # env vars allowed by _metadata_env_vars
+    _metadata_env_vars: Sequence[str]  # env var globs to record in metadata
     _network_buffer: int
+    _network_pause_file: str  # pause network activity while this file exists
+    _network_warmup_seconds: float
     _noop: bool
     _notebook: bool
     _offline: bool
     _sync: bool
     _os: str
+    _oversized_value_action: str
     _platform: str
+    _post_finish_policy: str
+    _preflight: bool  # check access before creating the run
     _proxies: Mapping[str, str]  # dedicated global proxy servers [scheme -> url]
     _python: str
+    _record_queue_size: int
     _runqueue_item_id: str
     _require_core: bool
+    _run_dir_fallback: str  # where to put the run dir if it's not writable
+    _run_name_template: str
     _save_requirements: bool
     _service_transport: str
     _service_wait: float
+    _settings_file: str  # YAML or JSON settings read by wandb-core
     _shared: bool
+    _shared_step_file: str
     _start_datetime: str
     _start_time: float
     _stats_pid: int  # (internal) base pid for system stats
@@ -375,9 +450,24 @@ class SettingsData:
     _stats_open_metrics_filters: Union[Sequence[str], Mapping[str, Mapping[str, str]]]
     _stats_disk_paths: Sequence[str]  # paths to monitor disk usage
     _stats_buffer_size: int  # number of consolidated samples to buffer before flushing, available in run obj
+    _stats_compaction_max_gap_seconds: float
+    _stats_compaction_tolerance: float
+    _stats_disabled_assets: Sequence[str]  # system metric assets not to monitor
+    _stats_pending_samples_max: int
+    _summary_aggregation_meta: bool  # upload how summary values were computed
+    _summary_env_file: str  # file to export the summary to as KEY=value
+    _summary_env_non_scalar: str
+    _summary_env_prefix: str
+    _summary_env_preserve_case: bool
+    _summary_update_interval_ms: int
+    _tail_log_files: Sequence[str]  # log files to stream to the console logs
     _tmp_code_dir: str
     _tracelog: str
+    _transaction_log_compression: str
+    _transaction_log_fsync_records: int
+    _transaction_log_history_encoding: str
     _unsaved_keys: Sequence[str]
+    _user_agent_token: str
     _windows: bool
     allow_val_change: bool
     anonymous: str
@@ -420,6 +510,7 @@ class SettingsData:
     log_symlink_user: str
     log_user: str
     login_timeout: float
+    max_run_duration: float  # seconds after which the run is stopped
     # magic: Union[str, bool, dict]  # never used in code, deprecated
     mode: str
     notebook_name: str
@@ -438,6 +529,7 @@ class SettingsData:
     root_dir: str
     run_group: str
     run_id: str
+    run_id_seed: str  # derive the run ID from this string
     run_job_type: str
     run_mode: str
     run_name: str
@@ -622,14 +714,22 @@ class Settings(SettingsData):
                 "hook": lambda _: is_aws_lambda(),
                 "auto_hook": True,
             },
+            _clock_offset_seconds={"preprocessor": float},
+            _clock_skew_warning_seconds={"preprocessor": float},
             _code_path_local={
                 "hook": lambda _: _get_program_relpath(self.program),
                 "auto_hook": True,
             },
+            _code_snapshot={"preprocessor": _str_as_bool},
+            _code_snapshot_exclude={"preprocessor": _str_as_tuple},
+            _code_snapshot_git={"preprocessor": _str_as_bool},
+            _code_snapshot_include={"preprocessor": _str_as_tuple},
+            _code_snapshot_max_megabytes={"preprocessor": int},
             _colab={
                 "hook": lambda _: "google.colab" in sys.modules,
                 "auto_hook": True,
             },
+            _disable_auto_summary={"preprocessor": _str_as_bool},
             _disable_machine_info={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -639,6 +739,7 @@ class Settings(SettingsData):
                 "preprocessor": _str_as_bool,
                 "hook": lambda x: self._disable_machine_info or x,
             },
+            _disable_runtime={"preprocessor": _str_as_bool},
             _disable_service={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -650,9 +751,12 @@ class Settings(SettingsData):
                 "preprocessor": _str_as_bool,
                 "hook": lambda x: self._disable_machine_info or x,
             },
+            _disable_transaction_log={"preprocessor": _str_as_bool},
             _disable_update_check={"preprocessor": _str_as_bool},
             _disable_viewer={"preprocessor": _str_as_bool},
             _extra_http_headers={"preprocessor": _str_as_json},
+            _file_stream_connections={"preprocessor": int},
+            _file_stream_flush_every_n_points={"preprocessor": int},
             # Retry filestream requests for 2 hours before dropping chunk (how do we recover?)
             # retry_count = seconds_in_2_hours / max_retry_time + num_retries_until_max_60_sec
             #             = 7200 / 60 + ceil(log2(60/2))
@@ -662,10 +766,16 @@ class Settings(SettingsData):
             _file_stream_retry_wait_max_seconds={"value": 60, "preprocessor": float},
             # A 3 minute timeout for all filestream post requests
             _file_stream_timeout_seconds={"value": 180, "preprocessor": float},
+            _file_transfer_concurrency={"preprocessor": int},
+            _file_transfer_rate_limit={"preprocessor": float},
             _file_transfer_retry_max={"value": 20, "preprocessor": int},
             _file_transfer_retry_wait_min_seconds={"value": 2, "preprocessor": float},
             _file_transfer_retry_wait_max_seconds={"value": 60, "preprocessor": float},
             _file_transfer_timeout_seconds={"value": 0, "preprocessor": float},
+            _file_transfer_stall_timeout_seconds={"value": 0, "preprocessor": float},
+            _file_transfer_url_refresh_max={"preprocessor": int},
+            _files_min_stable_seconds={"preprocessor": float},
+            _files_policy={"preprocessor": _str_as_tuple},
             _flow_control_disabled={
                 "hook": lambda _: self._network_buffer == 0,
                 "auto_hook": True,
@@ -674,11 +784,17 @@ class Settings(SettingsData):
                 "hook": lambda _: bool(self._network_buffer),
                 "auto_hook": True,
             },
+            _graphql_persisted_queries={"preprocessor": _str_as_bool},
             _graphql_retry_max={"value": 20, "preprocessor": int},
             _graphql_retry_wait_min_seconds={"value": 2, "preprocessor": float},
             _graphql_retry_wait_max_seconds={"value": 60, "preprocessor": float},
             _graphql_timeout_seconds={"value": 30.0, "preprocessor": float},
+            _health_probe={"preprocessor": _str_as_bool},
+            _history_jsonl={"preprocessor": _str_as_bool},
+            _history_rate_limit={"preprocessor": float},
             _internal_check_process={"value": 8, "preprocessor": float},
+            _internal_log_max_files={"preprocessor": int},
+            _internal_log_max_megabytes={"preprocessor": int},
             _internal_queue_timeout={"value": 2, "preprocessor": float},
             _ipython={
                 "hook": lambda _: _get_python_type() == "ipython",
@@ -690,7 +806,15 @@ class Settings(SettingsData):
             },
             _kaggle={"hook": lambda _: util._is_likely_kaggle(), "auto_hook": True},
             _log_level={"value": logging.DEBUG},
+            _max_metrics={"preprocessor": int},
+            _max_value_bytes={"preprocessor": int},
+            _metadata_env={
+                "hook": lambda _: _get_metadata_env(self._metadata_env_vars),
+                "auto_hook": True,
+            },
+            _metadata_env_vars={"preprocessor": _str_as_tuple},
             _network_buffer={"preprocessor": int},
+            _network_warmup_seconds={"preprocessor": float},
             _noop={"hook": lambda _: self.mode == "disabled", "auto_hook": True},
             _notebook={
                 "hook": lambda _: self._ipython
@@ -708,9 +832,11 @@ class Settings(SettingsData):
                 "auto_hook": True,
             },
             _platform={"value": util.get_platform_name()},
+            _preflight={"preprocessor": _str_as_bool},
             _proxies={
                 "preprocessor": _str_as_json,
             },
+            _record_queue_size={"preprocessor": int},
             _require_core={"value": False, "preprocessor": _str_as_bool},
             _save_requirements={"value": True, "preprocessor": _str_as_bool},
             _service_wait={
@@ -718,11 +844,18 @@ class Settings(SettingsData):
                 "preprocessor": float,
                 "validator": self._validate__service_wait,
             },
+            _settings_file={
+                "preprocessor": lambda x: os.path.abspath(os.path.expanduser(x)),
+            },
             _shared={
                 "hook": lambda _: self.mode == "shared",
                 "auto_hook": True,
             },
             _start_datetime={"preprocessor": _datetime_as_str},
+            _stats_compaction_max_gap_seconds={"preprocessor": float},
+            _stats_compaction_tolerance={"preprocessor": float},
+            _stats_disabled_assets={"preprocessor": _str_as_tuple},
+            _stats_pending_samples_max={"preprocessor": int},
             _stats_sample_rate_seconds={
                 "value": 2.0,
                 "preprocessor": float,
@@ -753,11 +886,19 @@ class Settings(SettingsData):
                 "value": 0,
                 "preprocessor": int,
             },
+            _summary_aggregation_meta={"preprocessor": _str_as_bool},
+            _summary_env_file={
+                "preprocessor": lambda x: os.path.abspath(os.path.expanduser(x)),
+            },
+            _summary_env_preserve_case={"preprocessor": _str_as_bool},
+            _summary_update_interval_ms={"preprocessor": int},
             _sync={"value": False},
+            _tail_log_files={"preprocessor": _str_as_tuple},
             _tmp_code_dir={
                 "value": "code",
                 "hook": lambda x: self._path_convert(self.tmp_dir, x),
             },
+            _transaction_log_fsync_records={"preprocessor": int},
             _windows={
                 "hook": lambda _: platform.system() == "Windows",
                 "auto_hook": True,
@@ -854,6 +995,7 @@ class Settings(SettingsData):
                 "hook": lambda x: self._path_convert(self.log_dir, x),
             },
             login_timeout={"preprocessor": lambda x: float(x)},
+            max_run_duration={"preprocessor": float},
             mode={"value": "online", "validator": self._validate_mode},
             program={
                 "hook": lambda x: self._get_program(x),
@@ -951,7 +1093,9 @@ class Settings(SettingsData):
                 ),
             },
             wandb_dir={
-                "hook": lambda _: _get_wandb_dir(self.root_dir or ""),
+                "hook": lambda _: _get_wandb_dir(
+                    self.root_dir or "", self._run_dir_fallback
+                ),
                 "auto_hook": True,
             },
         )
@@ -1862,6 +2006,11 @@ class Settings(SettingsData):
                         "Tried to auto resume run with "
                         f"id {resume_run_id} but id {self.run_id} is set.",
                     )
+        if self.run_id_seed:
+            # the seed takes precedence so that retried jobs map to the same run
+            self.update(
+                {"run_id": generate_seeded_id(self.run_id_seed)}, source=Source.INIT
+            )
         self.update({"run_id": self.run_id or generate_id()}, source=Source.INIT)
         # persist our run id in case of failure
         # check None for mypy
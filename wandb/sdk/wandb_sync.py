@@ -1,15 +1,19 @@
 import pathlib
+import time
 from typing import TYPE_CHECKING, Optional
 
 from ..errors.term import termerror, termlog
 from . import wandb_setup
 from .backend.backend import Backend
-from .lib.mailbox import Mailbox
+from .lib.mailbox import Mailbox, MailboxProgress
 from .lib.runid import generate_id
 
 if TYPE_CHECKING:
     from wandb.proto import wandb_internal_pb2
 
+# How often to print sync progress reported by the service, in seconds.
+_PROGRESS_INTERVAL = 5
+
 
 def _sync(
     path: str,
@@ -19,6 +23,7 @@ def _sync(
     mark_synced: Optional[bool] = None,
     append: Optional[bool] = None,
     skip_console: Optional[bool] = None,
+    verify: Optional[bool] = None,
 ) -> "wandb_internal_pb2.SyncResponse":
     p = pathlib.Path(path)
 
@@ -57,8 +62,26 @@ def _sync(
         project=project,
         run_id=run_id,
         skip_output_raw=skip_console,
+        verify=verify,
     )
-    result = handle.wait(timeout=-1)
+    last_progress_time = time.monotonic()
+
+    def print_progress(_: MailboxProgress) -> None:
+        nonlocal last_progress_time
+        if time.monotonic() - last_progress_time < _PROGRESS_INTERVAL:
+            return
+        last_progress_time = time.monotonic()
+
+        assert backend.interface
+        messages_handle = backend.interface.deliver_internal_messages()
+        messages_result = messages_handle.wait(timeout=_PROGRESS_INTERVAL)
+        if not messages_result:
+            return
+        response = messages_result.response.internal_messages_response
+        for message in response.messages.warning:
+            termlog(message)
+
+    result = handle.wait(timeout=-1, on_progress=print_progress)
     assert result and result.response
     response = result.response.sync_response
     if response.url:
@@ -71,5 +94,12 @@ def _sync(
         termerror(f"Failed to sync {p}")
     if response.error and response.error.message:
         termerror(response.error.message)
+    elif verify:
+        if response.divergences:
+            termerror(f"The backend differs from {p}:")
+            for divergence in response.divergences:
+                termerror(f"  {divergence}")
+        else:
+            termlog(f"Verified that the backend matches {p}")
 
     return response